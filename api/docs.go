@@ -24,14 +24,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/categories": {
-            "post": {
+        "/accounts": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new category with the provided details",
+                "description": "Retrieve an account by its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -39,25 +39,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
+                    "Accounts"
                 ],
-                "summary": "Create a new category",
+                "summary": "Get a specific account",
                 "parameters": [
                     {
-                        "description": "Request body to create a new category",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.CreateCategoryRequest"
-                        }
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "account_id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/usecases.CreateCategoryOutputDto"
+                            "$ref": "#/definitions/usecases.GetAccountOutputDto"
                         }
                     },
                     "400": {
@@ -72,6 +70,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
                     },
+                    "404": {
+                        "description": "Account Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -79,16 +83,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/categories/all": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve all categories for the authenticated user",
+                "description": "Create a new account or payment method (checking, savings, credit_card or cash). Credit cards require a closing day and a due day",
                 "consumes": [
                     "application/json"
                 ],
@@ -96,17 +98,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
+                    "Accounts"
+                ],
+                "summary": "Create an account",
+                "parameters": [
+                    {
+                        "description": "Account data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateAccountRequest"
+                        }
+                    }
                 ],
-                "summary": "Get all categories",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/usecases.GetCategoriesOutputDto"
-                            }
+                            "$ref": "#/definitions/usecases.CreateAccountOutputDto"
                         }
                     },
                     "400": {
@@ -128,16 +138,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/categories/{category_id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get details of a category by its ID",
+                "description": "Delete an account by its ID. Accounts with expenses, incomes or transfers cannot be deleted",
                 "consumes": [
                     "application/json"
                 ],
@@ -145,14 +153,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
+                    "Accounts"
                 ],
-                "summary": "Get category details",
+                "summary": "Delete an account",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Category ID",
-                        "name": "category_id",
+                        "description": "Account ID",
+                        "name": "account_id",
                         "in": "query",
                         "required": true
                     }
@@ -161,7 +169,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/usecases.GetCategoryOutputDto"
+                            "$ref": "#/definitions/usecases.DeleteAccountOutputDto"
                         }
                     },
                     "400": {
@@ -177,7 +185,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Not Found",
+                        "description": "Account Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "Account Has Transactions",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
@@ -190,13 +204,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a category by its ID",
+                "description": "Update an existing account",
                 "consumes": [
                     "application/json"
                 ],
@@ -204,23 +218,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
+                    "Accounts"
                 ],
-                "summary": "Delete a category",
+                "summary": "Update an account",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Category ID",
-                        "name": "category_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Updated account data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateAccountRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/usecases.DeleteCategoryOutputDto"
+                            "$ref": "#/definitions/usecases.UpdateAccountOutputDto"
                         }
                     },
                     "400": {
@@ -236,7 +252,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Not Found",
+                        "description": "Account Not Found",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
@@ -248,14 +264,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/accounts/all": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update details of an existing category",
+                "description": "Retrieve all accounts for the authenticated user",
                 "consumes": [
                     "application/json"
                 ],
@@ -263,31 +281,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
-                ],
-                "summary": "Update a category",
-                "parameters": [
-                    {
-                        "description": "Request body to update a category",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.UpdateCategoryRequest"
-                        }
-                    }
+                    "Accounts"
                 ],
+                "summary": "Get all accounts",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/usecases.UpdateCategoryOutputDto"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/util.ProblemDetails"
+                            "$ref": "#/definitions/usecases.GetAccountsOutputDto"
                         }
                     },
                     "401": {
@@ -296,12 +297,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/util.ProblemDetails"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -311,44 +306,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/expenses": {
-            "post": {
+        "/accounts/balances": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new expense entry",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves the balance of each account (opening balance, incomes, expenses and transfers) up to a date. Defaults to today",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Expenses"
+                    "Presenters"
                 ],
-                "summary": "Create an expense",
+                "summary": "Get account balances",
                 "parameters": [
                     {
-                        "description": "Expense data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.CreateExpenseRequest"
-                        }
+                        "type": "string",
+                        "description": "Date (DDMMYYYY)",
+                        "name": "date",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/usecases.CreateExpenseOutputDto"
+                            "$ref": "#/definitions/presenters.GetAccountBalancesOutputDto"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Bad Request - Invalid date",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
@@ -368,32 +357,55 @@ const docTemplate = `{
                 }
             }
         },
-        "/expenses/all": {
+        "/accounts/balances/running": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve all expenses for the authenticated user",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves the day-by-day running balance of an account for a date range",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Expenses"
+                    "Presenters"
+                ],
+                "summary": "Get running balance of an account",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "account_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date (DDMMYYYY)",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (DDMMYYYY)",
+                        "name": "end_date",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "Get all expenses",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/usecases.GetExpensesOutputDto"
-                            }
+                            "$ref": "#/definitions/presenters.GetAccountRunningBalanceOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing account, start date or end date",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
                         }
                     },
                     "401": {
@@ -402,6 +414,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
                     },
+                    "404": {
+                        "description": "Account Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -411,26 +429,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/expenses/available-months-years": {
+        "/accounts/statements": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves the list of months and years for which expense data is available for a user",
+                "description": "Retrieves the statement cycles of a credit card account for a year, each with its closing date, due date and invoice total. Statements are listed by due month",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Utility"
+                    "Presenters"
+                ],
+                "summary": "Get credit card statements",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "account_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Year (YYYY), defaults to the current year",
+                        "name": "year",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get available months and years",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenters.GetAvailableMonthsYearsOutputDto"
+                            "$ref": "#/definitions/presenters.GetCardStatementsOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing account, invalid year or account is not a credit card",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
                         }
                     },
                     "401": {
@@ -439,6 +478,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
                     },
+                    "404": {
+                        "description": "Account Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -448,21 +493,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/expenses/categories": {
+        "/cashflow/categories": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves the expenses of a user categorized by category for a specified date range",
+                "description": "Retrieves income and expense totals grouped by category, with net cash flow and savings rate, for a date range",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Presenters"
                 ],
-                "summary": "Get expenses by category for a period",
+                "summary": "Get income vs expense by category for a period",
                 "parameters": [
                     {
                         "type": "string",
@@ -483,7 +528,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenters.GetExpensesByCategoryPeriodOutputDto"
+                            "$ref": "#/definitions/presenters.GetIncomeVsExpenseByCategoryPeriodOutputDto"
                         }
                     },
                     "400": {
@@ -507,21 +552,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/expenses/categories/monthly": {
+        "/cashflow/monthly/year": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves the monthly expenses of a user categorized by category for a specific year",
+                "description": "Retrieves income, expenses, net cash flow and savings rate for each month of a year",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Presenters"
                 ],
-                "summary": "Get monthly expenses by category for a specific year",
+                "summary": "Get net cash flow by month for a specific year",
                 "parameters": [
                     {
                         "type": "string",
@@ -535,7 +580,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenters.GetMonthlyExpensesByCategoryYearOutputDto"
+                            "$ref": "#/definitions/presenters.GetNetCashFlowYearOutputDto"
                         }
                     },
                     "400": {
@@ -559,26 +604,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/expenses/day/day/period": {
-            "get": {
+        "/categories": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "A date range is passed to return a set of expenses in that range",
+                "description": "Create a new category with the provided details",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Presenters"
+                    "Categories"
+                ],
+                "summary": "Create a new category",
+                "parameters": [
+                    {
+                        "description": "Request body to create a new category",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateCategoryRequest"
+                        }
+                    }
                 ],
-                "summary": "Get expenses in a time interval",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/presenters.GetDayToDayExpensesPeriodOutputDto"
+                            "$ref": "#/definitions/usecases.CreateCategoryOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
                         }
                     },
                     "401": {
@@ -596,30 +661,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/expenses/monthly/total": {
+        "/categories/all": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves the total expenses of a user for the current month",
+                "description": "Retrieve all categories for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Presenters"
+                    "Categories"
+                ],
+                "summary": "Get all categories",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category kind (expense or income)",
+                        "name": "kind",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get total expenses for the current month",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenters.GetTotalExpensesForCurrentMonthOutputDto"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/usecases.GetCategoriesOutputDto"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Invalid parameters",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
@@ -639,35 +718,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/expenses/monthly/year": {
+        "/categories/{category_id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves expenses of a user for a specific month and year",
+                "description": "Get details of a category by its ID",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Presenters"
+                    "Categories"
                 ],
-                "summary": "Get expenses by month and year",
+                "summary": "Get category details",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "year",
-                        "description": "Year of the expenses",
-                        "name": "year",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "month",
-                        "description": "Month of the expenses",
-                        "name": "month",
+                        "description": "Category ID",
+                        "name": "category_id",
                         "in": "query",
                         "required": true
                     }
@@ -676,11 +749,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenters.GetExpensesByMonthYearOutputDto"
+                            "$ref": "#/definitions/usecases.GetCategoryOutputDto"
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Missing or invalid parameters",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
@@ -691,6 +764,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -698,28 +777,29 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/expenses/tags/monthly": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves the monthly expenses of a user categorized by tags for a specific year",
+                "description": "Delete a category by its ID",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Presenters"
+                    "Categories"
                 ],
-                "summary": "Get monthly expenses by tag for a specific year",
+                "summary": "Delete a category",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Year (YYYY)",
-                        "name": "year",
+                        "description": "Category ID",
+                        "name": "category_id",
                         "in": "query",
                         "required": true
                     }
@@ -728,11 +808,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenters.GetMonthlyExpensesByTagYearOutputDto"
+                            "$ref": "#/definitions/usecases.DeleteCategoryOutputDto"
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Missing or invalid year",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
@@ -743,6 +823,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -750,48 +836,44 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/expenses/tags/monthly/total": {
-            "get": {
+            },
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves the total expenses by category tags for a given month and year for a user",
+                "description": "Update details of an existing category",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Presenters"
+                    "Categories"
                 ],
-                "summary": "Get totals of expenses by category tags for a specific month and year",
+                "summary": "Update a category",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Year for which to retrieve expenses by category tags",
-                        "name": "year",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Month for which to retrieve expenses by category tags",
-                        "name": "month",
-                        "in": "query",
-                        "required": true
+                        "description": "Request body to update a category",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateCategoryRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenters.GetCategoryTagsTotalsByMonthYearOutputDto"
+                            "$ref": "#/definitions/usecases.UpdateCategoryOutputDto"
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Missing or invalid year/month",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
@@ -802,6 +884,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -811,22 +899,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/expenses/total": {
+        "/charts": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves the total expenses of a user for a specified date range",
+                "description": "Renders the expenses by category or tag for a period, or their time series, as a bar, line, pie or stacked chart in SVG or PNG. Categories and tags are drawn with their stored colors; when there are more than ten slices or series the smallest ones are merged into \"Others\". A time series of more than 1000 buckets is rejected with 400",
                 "produces": [
-                    "application/json"
+                    "image/svg+xml",
+                    "image/png"
                 ],
                 "tags": [
                     "Presenters"
                 ],
-                "summary": "Get total expenses for a period",
+                "summary": "Render an expense chart",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Chart type: bar (default), line, pie or stacked",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Chart data: categories (default), tags or series",
+                        "name": "data",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "Start date (DDMMYYYY)",
@@ -840,17 +941,65 @@ const docTemplate = `{
                         "name": "end_date",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bucket size of the series data: day, week, month (default), quarter or year",
+                        "name": "granularity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Splits the series data by category, tag, account or weekday",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Expense basis: cash (default) or accrual",
+                        "name": "basis",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Image format: svg (default) or png",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Width in pixels, between 200 and 2000 (default 600)",
+                        "name": "width",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Height in pixels, between 200 and 2000 (default 400)",
+                        "name": "height",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Theme: light (default) or dark",
+                        "name": "theme",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Title drawn above the chart",
+                        "name": "title",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Chart image",
                         "schema": {
-                            "$ref": "#/definitions/presenters.GetTotalExpensesForPeriodOutputDto"
+                            "type": "file"
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Missing start date or end date",
+                        "description": "Bad Request - Invalid chart options or dates",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
@@ -870,39 +1019,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/expenses/total/monthly/year": {
-            "get": {
+        "/charts/share": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves the total expenses for each month of the specified year for a user",
+                "description": "Returns a signed link to a chart that can be embedded without logging in until it expires. The link renders the chart with the options, dates and language it was shared with",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Presenters"
                 ],
-                "summary": "Get total expenses for each month in the specified year",
+                "summary": "Share an expense chart",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Year for which to retrieve monthly expenses",
-                        "name": "year",
-                        "in": "query",
-                        "required": true
+                        "description": "Chart options, as in GET /charts, and the expiration in hours (default 24, at most 720)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ShareExpenseChartRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenters.GetTotalExpensesMonthCurrentYearOutputDto"
+                            "$ref": "#/definitions/presenters.ShareExpenseChartOutputDto"
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Missing or invalid year",
+                        "description": "Bad Request - Invalid chart options, dates or expiration",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
@@ -922,36 +1076,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/expenses/weekly/total": {
+        "/charts/shared": {
             "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Retrieves the total expenses of a user for the current week",
+                "description": "Renders a chart from a signed link created with POST /charts/share. No login is needed; the link is rejected when any of its parameters was changed or it has expired",
                 "produces": [
-                    "application/json"
+                    "image/svg+xml",
+                    "image/png"
                 ],
                 "tags": [
                     "Presenters"
                 ],
-                "summary": "Get total expenses for the current week",
+                "summary": "Render a shared expense chart",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expiration of the link (Unix time)",
+                        "name": "expires",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Signature of the link",
+                        "name": "signature",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/presenters.GetTotalExpensesForCurrentWeekOutputDto"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request - Invalid parameters",
+                        "description": "Chart image",
                         "schema": {
-                            "$ref": "#/definitions/util.ProblemDetails"
+                            "type": "file"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden - Invalid or expired link",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
@@ -965,14 +1125,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/expenses/{expense_id}": {
-            "get": {
+        "/digests": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve an expense by its ID",
+                "description": "Opt in to a weekly or monthly email summarizing the previous period: total against the prior period, top categories and largest expenses. An address other than the account's own gets a confirmation link first",
                 "consumes": [
                     "application/json"
                 ],
@@ -980,23 +1140,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Expenses"
+                    "Digests"
                 ],
-                "summary": "Get a specific expense",
+                "summary": "Subscribe to a spending digest",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Expense ID",
-                        "name": "expense_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Digest subscription data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateDigestSubscriptionRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/usecases.GetExpenseOutputDto"
+                            "$ref": "#/definitions/usecases.CreateDigestSubscriptionOutputDto"
                         }
                     },
                     "400": {
@@ -1011,6 +1173,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1025,7 +1193,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete an expense by its ID",
+                "description": "Stop sending a spending digest",
                 "consumes": [
                     "application/json"
                 ],
@@ -1033,14 +1201,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Expenses"
+                    "Digests"
                 ],
-                "summary": "Delete an expense",
+                "summary": "Unsubscribe from a spending digest",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Expense ID",
-                        "name": "expense_id",
+                        "description": "Digest subscription ID",
+                        "name": "subscription_id",
                         "in": "query",
                         "required": true
                     }
@@ -1049,7 +1217,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/usecases.DeleteExpenseOutputDto"
+                            "$ref": "#/definitions/usecases.DeleteDigestSubscriptionOutputDto"
                         }
                     },
                     "400": {
@@ -1065,7 +1233,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Expense Not Found",
+                        "description": "Digest Subscription Not Found",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
@@ -1077,14 +1245,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/digests/all": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing expense",
+                "description": "Retrieve the spending digests the authenticated user opted in to",
                 "consumes": [
                     "application/json"
                 ],
@@ -1092,31 +1262,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Expenses"
-                ],
-                "summary": "Update an expense",
-                "parameters": [
-                    {
-                        "description": "Updated expense data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.UpdateExpenseRequest"
-                        }
-                    }
+                    "Digests"
                 ],
+                "summary": "Get all digest subscriptions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/usecases.UpdateExpenseOutputDto"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/util.ProblemDetails"
+                            "$ref": "#/definitions/usecases.GetDigestSubscriptionsOutputDto"
                         }
                     },
                     "401": {
@@ -1125,12 +1278,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
                     },
-                    "404": {
-                        "description": "Expense Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/util.ProblemDetails"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1140,9 +1287,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/login": {
-            "post": {
-                "description": "Authenticates a user and returns a JWT token",
+        "/digests/confirm": {
+            "get": {
+                "description": "Follow the signed link emailed to a digest address to start sending the digest to it",
                 "consumes": [
                     "application/json"
                 ],
@@ -1150,29 +1297,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Digests"
                 ],
-                "summary": "Login a user",
+                "summary": "Confirm a digest subscription",
                 "parameters": [
                     {
-                        "description": "User credentials",
-                        "name": "LoginRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/usecases.LoginInputDto"
-                        }
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Digest subscription ID",
+                        "name": "subscription_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Link signature",
+                        "name": "signature",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/usecases.LoginOutputDto"
+                            "$ref": "#/definitions/usecases.ConfirmDigestSubscriptionOutputDto"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
@@ -1180,9 +1345,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/signup": {
-            "post": {
-                "description": "Registers a new user in the system",
+        "/digests/preview": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Render the weekly or monthly digest for the last complete period, as HTML and plain text, without sending it",
                 "consumes": [
                     "application/json"
                 ],
@@ -1190,25 +1360,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Digests"
                 ],
-                "summary": "Create a new user",
+                "summary": "Preview a spending digest",
                 "parameters": [
                     {
-                        "description": "User data",
-                        "name": "CreateUserRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/usecases.CreateUserInputDto"
-                        }
+                        "type": "string",
+                        "description": "Digest frequency (weekly or monthly)",
+                        "name": "frequency",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/usecases.CreateUserOutputDto"
+                            "$ref": "#/definitions/usecases.PreviewSpendingDigestOutputDto"
                         }
                     },
                     "400": {
@@ -1216,47 +1384,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
-                    }
-                }
-            }
-        },
-        "/tags": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Retrieve all tags for the authenticated user",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Tags"
-                ],
-                "summary": "Get all tags",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/usecases.GetTagsOutputDto"
-                        }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
                     }
                 }
-            },
+            }
+        },
+        "/expenses": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a tag with a name and color",
+                "description": "Create a new expense entry. Without a merchant_id the merchant is matched from the notes against the names and aliases of existing merchants, and created from the first words of the notes when none matches",
                 "consumes": [
                     "application/json"
                 ],
@@ -1264,17 +1415,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tags"
+                    "Expenses"
                 ],
-                "summary": "Create a new tag",
+                "summary": "Create an expense",
                 "parameters": [
                     {
-                        "description": "Tag data",
-                        "name": "CreateTagRequest",
+                        "description": "Expense data",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreateTagRequest"
+                            "$ref": "#/definitions/handlers.CreateExpenseRequest"
                         }
                     }
                 ],
@@ -1282,11 +1433,11 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/usecases.CreateTagOutputDto"
+                            "$ref": "#/definitions/usecases.CreateExpenseOutputDto"
                         }
                     },
                     "400": {
-                        "description": "Did not bind JSON",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
@@ -1296,30 +1447,43 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
                     }
                 }
             }
         },
-        "/tags/{tag_id}": {
+        "/expenses/accounts": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a tag by its ID",
+                "description": "Retrieves expenses grouped by account for a date range. Expenses without an account are grouped as Unassigned",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tags"
+                    "Presenters"
                 ],
-                "summary": "Get a tag by ID",
+                "summary": "Get expenses by account for a period",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Tag ID",
-                        "name": "tag_id",
+                        "description": "Start date (DDMMYYYY)",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (DDMMYYYY)",
+                        "name": "end_date",
                         "in": "query",
                         "required": true
                     }
@@ -1328,11 +1492,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/usecases.GetTagOutputDto"
+                            "$ref": "#/definitions/presenters.GetExpensesByAccountPeriodOutputDto"
                         }
                     },
                     "400": {
-                        "description": "Missing Tag ID",
+                        "description": "Bad Request - Missing start date or end date",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
@@ -1342,16 +1506,24 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/expenses/all": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update a tag's name and color by its ID",
+                "description": "Retrieve all expenses for the authenticated user",
                 "consumes": [
                     "application/json"
                 ],
@@ -1359,115 +1531,106 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tags"
-                ],
-                "summary": "Update a tag by ID",
-                "parameters": [
-                    {
-                        "description": "Updated tag data",
-                        "name": "UpdateTagRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.UpdateTagRequest"
-                        }
-                    }
+                    "Expenses"
                 ],
+                "summary": "Get all expenses",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/usecases.UpdateTagOutputDto"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/usecases.GetExpensesOutputDto"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Did not bind JSON",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/expenses/anomalies": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a specific tag by its ID",
+                "description": "Retrieve the expenses flagged as unusual, such as amounts far above the category baseline or duplicate charges, with an explanation for each flag. Dismissed flags are not returned",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tags"
-                ],
-                "summary": "Delete a tag by ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Tag ID",
-                        "name": "tag_id",
-                        "in": "query",
-                        "required": true
-                    }
+                    "Expenses"
                 ],
+                "summary": "Get expense anomalies",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/usecases.DeleteTagOutputDto"
+                            "$ref": "#/definitions/usecases.GetExpenseAnomaliesOutputDto"
                         }
                     },
-                    "400": {
-                        "description": "Missing Tag ID",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
                     }
                 }
-            }
-        },
-        "/users": {
-            "get": {
+            },
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves the details of a user by their user_id",
+                "description": "Dismiss an anomaly flag so it is no longer reported",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Expenses"
                 ],
-                "summary": "Get a user by ID",
+                "summary": "Dismiss an expense anomaly",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Anomaly to dismiss",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DismissExpenseAnomalyRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/usecases.GetUserOutputDto"
+                            "$ref": "#/definitions/usecases.DismissExpenseAnomalyOutputDto"
                         }
                     },
                     "400": {
@@ -1481,41 +1644,111 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
+                    },
+                    "404": {
+                        "description": "Anomaly Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "Anomaly Already Dismissed",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/expenses/available-months-years": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a specific user by their user_id",
+                "description": "Retrieves the list of months and years for which expense data is available for a user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Utility"
                 ],
-                "summary": "Delete a user by ID",
+                "summary": "Get available months and years",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.GetAvailableMonthsYearsOutputDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/categories": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the expenses of a user categorized by category for a specified date range",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Get expenses by category for a period",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
+                        "description": "Start date (DDMMYYYY)",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (DDMMYYYY)",
+                        "name": "end_date",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Expense date basis: purchase (default) or statement",
+                        "name": "basis",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/usecases.DeleteUserOutputDto"
+                            "$ref": "#/definitions/presenters.GetExpensesByCategoryPeriodOutputDto"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Bad Request - Missing start date or end date",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
@@ -1525,46 +1758,136 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/expenses/categories/monthly": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates details of a specific user",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves the monthly expenses of a user categorized by category for a specific year",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Presenters"
                 ],
-                "summary": "Update a user",
+                "summary": "Get monthly expenses by category for a specific year",
                 "parameters": [
                     {
-                        "description": "User data to update",
-                        "name": "UpdateUserRequest",
-                        "in": "body",
-                        "required": true,
+                        "type": "string",
+                        "description": "Year (YYYY)",
+                        "name": "year",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Expense date basis: purchase (default) or statement",
+                        "name": "basis",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/usecases.UpdateUserInputDto"
+                            "$ref": "#/definitions/presenters.GetMonthlyExpensesByCategoryYearOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing or invalid year",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/comparison": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Compares total, per-category and per-tag spending of a period against a comparison period, with absolute and percent changes and the categories that appeared or disappeared. Use a preset (mom, yoy or same_period_last_year) to derive the comparison period, or send both date ranges. Without dates, mom and same_period_last_year compare the month to date and yoy the year to date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Compare spending between two periods",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comparison preset: mom, yoy or same_period_last_year",
+                        "name": "preset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date (DDMMYYYY)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (DDMMYYYY)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comparison start date (DDMMYYYY), required without preset",
+                        "name": "compare_start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comparison end date (DDMMYYYY), required without preset",
+                        "name": "compare_end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Expense date basis: purchase (default) or statement",
+                        "name": "basis",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/usecases.UpdateUserOutputDto"
+                            "$ref": "#/definitions/presenters.GetPeriodComparisonOutputDto"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Bad Request - Invalid preset or dates",
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
@@ -1574,33 +1897,73 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
                     }
                 }
             }
         },
-        "/users/all": {
+        "/expenses/day/day/period": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves a list of all users",
+                "description": "A date range is passed to return a set of expenses in that range",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Presenters"
                 ],
-                "summary": "Get all users",
+                "summary": "Get expenses in a time interval",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/usecases.GetUsersOutputDto"
-                            }
+                            "$ref": "#/definitions/presenters.GetDayToDayExpensesPeriodOutputDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/forecast": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Projects the total and per-category spending for the current month with low and high bands. Installments, future-dated expenses and recurring charges not billed yet are added as known amounts; the rest is extrapolated from the day-of-month distribution of the previous months, or from the pace so far when there is not enough history",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Get month-end spending forecast",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.GetMonthEndForecastOutputDto"
                         }
                     },
                     "401": {
@@ -1608,592 +1971,7741 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/util.ProblemDetails"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/heatmap": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns one entry per calendar day of the range, or of the year when no range is given, up to today in the user's timezone, with the total, the number of expenses and an intensity level from 0 (no spending) to 4 based on the quartiles of the daily totals. Also returns the busiest days and the longest streaks without spending",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Get a calendar heatmap of daily spending",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Year (defaults to the current year)",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date (DDMMYYYY), overrides year",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (DDMMYYYY), overrides year",
+                        "name": "end_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.GetSpendingHeatmapOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid year or dates",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/locations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the expenses with a location in a date range, optionally only those inside a bounding box. A box whose min_longitude is greater than its max_longitude crosses the antimeridian",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Get geotagged expenses for a period",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date (DDMMYYYY)",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (DDMMYYYY)",
+                        "name": "end_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bounding box: min_longitude,min_latitude,max_longitude,max_latitude",
+                        "name": "bbox",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.GetExpenseLocationsOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing start date or end date",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/locations/clusters": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Groups the geotagged expenses of a date range by geohash cell, with the total and count of each, largest total first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Get expense clusters for a period",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date (DDMMYYYY)",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (DDMMYYYY)",
+                        "name": "end_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bounding box: min_longitude,min_latitude,max_longitude,max_latitude",
+                        "name": "bbox",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Geohash length from 1 to 9 (default 5, cells of about 5 km)",
+                        "name": "precision",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.GetExpenseClustersOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing start date or end date",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/locations/geojson": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Downloads the geotagged expenses of a date range as a GeoJSON FeatureCollection of points",
+                "produces": [
+                    "application/geo+json"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Export geotagged expenses as GeoJSON",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date (DDMMYYYY)",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (DDMMYYYY)",
+                        "name": "end_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bounding box: min_longitude,min_latitude,max_longitude,max_latitude",
+                        "name": "bbox",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "GeoJSON file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing start date or end date",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/merchants": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Ranks merchants by their total in a date range, with expense counts, averages and their share of the spending with a merchant. Expenses without a merchant are left out",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Get top merchants for a period",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date (DDMMYYYY)",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (DDMMYYYY)",
+                        "name": "end_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of merchants (default 10, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Expense date basis: purchase (default) or statement",
+                        "name": "basis",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.GetTopMerchantsOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing start date or end date",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/monthly/total": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the total expenses of a user for the current month",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Get total expenses for the current month",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.GetTotalExpensesForCurrentMonthOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/monthly/year": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves expenses of a user for a specific month and year",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Get expenses by month and year",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "year",
+                        "description": "Year of the expenses",
+                        "name": "year",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "month",
+                        "description": "Month of the expenses",
+                        "name": "month",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.GetExpensesByMonthYearOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing or invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/pivot": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cross-tabulates active expenses of a period with any dimension as rows and, optionally, another as columns, including row, column and grand totals. Totals are computed over the expenses, so the total of an average is the average of all expenses in the row. With tags on either side an expense counts once for each of its tags in the tag cells and tag totals, and expenses without tags are reported as Untagged; the other totals count each expense once, so tag cells can add up to more than them. With month or week on either side, a period of more than 1000 months or weeks is rejected with 400. Send format=csv to download the table as CSV",
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Get a pivot report of expenses",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date (DDMMYYYY)",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (DDMMYYYY)",
+                        "name": "end_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Row dimension: category, tag, month, week, weekday or account",
+                        "name": "rows",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column dimension, different from rows",
+                        "name": "columns",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "sum (default), count, average, min or max",
+                        "name": "measure",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Expense date basis: purchase (default) or statement",
+                        "name": "basis",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "json (default) or csv",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.GetPivotReportOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing or invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/report.pdf": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Renders a PDF for a month, or for the whole year when no month is given, with the headline totals, category and tag breakdowns as bar charts, the week-by-week (or month-by-month) totals and the itemized expense list",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Download a PDF expense report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Year of the report",
+                        "name": "year",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month of the report (1-12); the whole year when omitted",
+                        "name": "month",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PDF report",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing or invalid year or month",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/series": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sums active expenses per day, week, month, quarter or year of the period, optionally split by category, tag, account or weekday. Every bucket of the period is returned, oldest first, with zero when there is no spending, and each series has one value per bucket. Weeks start on the user's week start. With group_by=tag an expense counts once for each of its tags. A period of more than 1000 buckets is rejected with 400",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Get expenses as a time series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date (DDMMYYYY)",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (DDMMYYYY)",
+                        "name": "end_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bucket size: day, week, month, quarter or year",
+                        "name": "granularity",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Split into series by category, tag, account or weekday",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only expenses of these categories",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only expenses with any of these tags",
+                        "name": "tag_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only expenses of these accounts",
+                        "name": "account_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Expense date basis: purchase (default) or statement",
+                        "name": "basis",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.GetExpensesTimeSeriesOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing or invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/suggest": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Suggest categories and tags for an expense from its notes and amount, learned from the user's expense history",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Suggest category and tags",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expense notes",
+                        "name": "notes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Expense amount",
+                        "name": "amount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.SuggestExpenseOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/tags/monthly": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the monthly expenses of a user categorized by tags for a specific year",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Get monthly expenses by tag for a specific year",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Year (YYYY)",
+                        "name": "year",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.GetMonthlyExpensesByTagYearOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing or invalid year",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/tags/monthly/total": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the total expenses by category tags for a given month and year for a user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Get totals of expenses by category tags for a specific month and year",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Year for which to retrieve expenses by category tags",
+                        "name": "year",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month for which to retrieve expenses by category tags",
+                        "name": "month",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.GetCategoryTagsTotalsByMonthYearOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing or invalid year/month",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/tax-report": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Totals the deductible expenses of a calendar year by tax category (health, education, private_pension, alimony, donation) and provider, for the Brazilian income tax return (IRPF). An expense is deductible under its own tax category or, when it has none, under its category's; expenses with tax category none are left out. Send format=csv for the totals by provider or format=pdf for a printable report",
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Get the income tax deductions of a year",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Calendar year (defaults to the previous year)",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "json (default), csv or pdf",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.GetTaxDeductionReportOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid year or format",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/total": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the total expenses of a user for a specified date range",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Get total expenses for a period",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date (DDMMYYYY)",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (DDMMYYYY)",
+                        "name": "end_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Expense date basis: purchase (default) or statement",
+                        "name": "basis",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.GetTotalExpensesForPeriodOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing start date or end date",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/total/monthly/year": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the total expenses for each month of the specified year for a user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Get total expenses for each month in the specified year",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Year for which to retrieve monthly expenses",
+                        "name": "year",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Expense date basis: purchase (default) or statement",
+                        "name": "basis",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.GetTotalExpensesMonthCurrentYearOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing or invalid year",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/weekly/total": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the total expenses of a user from the start of the current week, using the user's week start",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Get total expenses for the current week",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Also return the total up to the same point of the previous week",
+                        "name": "compare",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.GetTotalExpensesForCurrentWeekOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/{expense_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve an expense by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Get a specific expense",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expense ID",
+                        "name": "expense_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetExpenseOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an expense by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Delete an expense",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expense ID",
+                        "name": "expense_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.DeleteExpenseOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Expense Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing expense",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Update an expense",
+                "parameters": [
+                    {
+                        "description": "Updated expense data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateExpenseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.UpdateExpenseOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Expense Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/goals": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a savings goal by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "Get a specific goal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Goal ID",
+                        "name": "goal_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetGoalOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Goal Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a savings goal with a target amount, an optional deadline (DDMMYYYY) and an optional linked category whose expenses count as contributions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "Create a savings goal",
+                "parameters": [
+                    {
+                        "description": "Goal data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateGoalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.CreateGoalOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a savings goal and its contributions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "Delete a goal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Goal ID",
+                        "name": "goal_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.DeleteGoalOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Goal Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing savings goal. Empty fields are left unchanged",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "Update a goal",
+                "parameters": [
+                    {
+                        "description": "Updated goal data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateGoalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.UpdateGoalOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Goal Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/goals/all": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve all savings goals for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "Get all goals",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetGoalsOutputDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/goals/contributions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the manual contributions of a savings goal",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "Get goal contributions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Goal ID",
+                        "name": "goal_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetGoalContributionsOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Goal Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Record a manual contribution to a savings goal. Negative amounts record withdrawals",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "Add a goal contribution",
+                "parameters": [
+                    {
+                        "description": "Contribution data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateGoalContributionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.CreateGoalContributionOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Goal Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a manual contribution by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "Delete a goal contribution",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Contribution ID",
+                        "name": "contribution_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.DeleteGoalContributionOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Contribution Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/goals/progress": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the progress of the user's savings goals: percent complete, the monthly contribution required to meet the deadline and a projected completion date based on the contribution history. Expenses in a goal's linked category count as contributions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Get savings goals progress",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Goal ID, omit to retrieve every goal",
+                        "name": "goal_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.GetGoalsProgressOutputDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Goal Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/graphql": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Runs a GraphQL query or mutation over expenses, categories, tags, accounts, merchants and the dashboard aggregates. Bodies larger than 1 MB, queries longer than 64 KB, queries deeper than 8 levels and queries costing more than 5000 are rejected. A field costs 1 and the fields under a list count once per item: its first argument, or 20 for lists without one. The expenses of a category, tag, account or merchant require first, at most 100. Use case problems are returned in errors[].extensions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "GraphQL"
+                ],
+                "summary": "Run a GraphQL query",
+                "parameters": [
+                    {
+                        "description": "Query, variables and operation name",
+                        "name": "GraphQLRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GraphQLRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GraphQLResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Did not bind JSON",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/incomes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve an income by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Incomes"
+                ],
+                "summary": "Get a specific income",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Income ID",
+                        "name": "income_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetIncomeOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Income Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new income entry such as salary, freelance payments or refunds",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Incomes"
+                ],
+                "summary": "Create an income",
+                "parameters": [
+                    {
+                        "description": "Income data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateIncomeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.CreateIncomeOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Category Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an income by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Incomes"
+                ],
+                "summary": "Delete an income",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Income ID",
+                        "name": "income_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.DeleteIncomeOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Income Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing income",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Incomes"
+                ],
+                "summary": "Update an income",
+                "parameters": [
+                    {
+                        "description": "Updated income data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateIncomeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.UpdateIncomeOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Income Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/incomes/all": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve all incomes for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Incomes"
+                ],
+                "summary": "Get all incomes",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetIncomesOutputDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/installments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve an installment purchase and its active installments by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Installments"
+                ],
+                "summary": "Get a specific installment purchase",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Installment purchase ID",
+                        "name": "installment_purchase_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetInstallmentPurchaseOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Installment Purchase Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Split a purchase into monthly installments (parcelamento). One linked expense is created per month, with the rounding remainder in the first or last installment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Installments"
+                ],
+                "summary": "Create an installment purchase",
+                "parameters": [
+                    {
+                        "description": "Installment purchase data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateInstallmentPurchaseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.CreateInstallmentPurchaseOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Account Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cancel an installment purchase and remove the installments due from today on",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Installments"
+                ],
+                "summary": "Cancel an installment purchase",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Installment purchase ID",
+                        "name": "installment_purchase_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.DeleteInstallmentPurchaseOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Installment Purchase Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an installment purchase. Installments due from today on are regenerated; past installments are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Installments"
+                ],
+                "summary": "Update an installment purchase",
+                "parameters": [
+                    {
+                        "description": "Updated installment purchase data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateInstallmentPurchaseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.UpdateInstallmentPurchaseOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Installment Purchase Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/installments/all": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve all installment purchases for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Installments"
+                ],
+                "summary": "Get all installment purchases",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetInstallmentPurchasesOutputDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/installments/committed": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the installments already committed from the current month on, grouped by month",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Presenters"
+                ],
+                "summary": "Get committed installments by month",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.GetCommittedInstallmentsByMonthOutputDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/login": {
+            "post": {
+                "description": "Authenticates a user and returns a JWT token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Login a user",
+                "parameters": [
+                    {
+                        "description": "User credentials",
+                        "name": "LoginRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecases.LoginInputDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.LoginOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/merchants": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a merchant with its expenses, newest first, its totals and the totals of each month it was paid in",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Merchants"
+                ],
+                "summary": "Get a merchant with its history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Merchant ID",
+                        "name": "merchant_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetMerchantOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Merchant Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a merchant with the aliases that match its raw descriptions, as in \"UBER *TRIP\". The name is always an alias",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Merchants"
+                ],
+                "summary": "Create a merchant",
+                "parameters": [
+                    {
+                        "description": "Merchant data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateMerchantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.CreateMerchantOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "Merchant already exists",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a merchant. Its expenses are kept without a merchant",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Merchants"
+                ],
+                "summary": "Delete a merchant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Merchant ID",
+                        "name": "merchant_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.DeleteMerchantOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Merchant Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Rename a merchant and, when aliases are sent, replace them. The previous name stays an alias",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Merchants"
+                ],
+                "summary": "Update a merchant",
+                "parameters": [
+                    {
+                        "description": "Updated merchant data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateMerchantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.UpdateMerchantOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Merchant Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/merchants/all": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve all merchants of the authenticated user, by name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Merchants"
+                ],
+                "summary": "Get all merchants",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetMerchantsOutputDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/rules": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get details of a rule by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rules"
+                ],
+                "summary": "Get rule details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Rule ID",
+                        "name": "rule_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetRuleOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a rule that sets the category, adds tags or rewrites notes of matching new expenses",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rules"
+                ],
+                "summary": "Create a new categorization rule",
+                "parameters": [
+                    {
+                        "description": "Request body to create a new rule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.CreateRuleOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a rule by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rules"
+                ],
+                "summary": "Delete a rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Rule ID",
+                        "name": "rule_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.DeleteRuleOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the name, priority, conditions and actions of an existing rule",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rules"
+                ],
+                "summary": "Update a rule",
+                "parameters": [
+                    {
+                        "description": "Request body to update a rule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.UpdateRuleOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/rules/all": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve all rules for the authenticated user ordered by priority",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rules"
+                ],
+                "summary": "Get all rules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetRulesOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/rules/apply": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Apply the actions of a rule to every existing expense it matches",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rules"
+                ],
+                "summary": "Apply a rule retroactively",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Rule ID",
+                        "name": "rule_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.ApplyRuleOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/rules/preview": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the existing expenses that a rule would match",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rules"
+                ],
+                "summary": "Preview a rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Rule ID",
+                        "name": "rule_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.PreviewRuleOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/signup": {
+            "post": {
+                "description": "Registers a new user in the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Create a new user",
+                "parameters": [
+                    {
+                        "description": "User data",
+                        "name": "CreateUserRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecases.CreateUserInputDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.CreateUserOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve all tags for the authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Get all tags",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetTagsOutputDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a tag with a name and color",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Create a new tag",
+                "parameters": [
+                    {
+                        "description": "Tag data",
+                        "name": "CreateTagRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.CreateTagOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Did not bind JSON",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{tag_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a tag by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Get a tag by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "tag_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetTagOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing Tag ID",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update a tag's name and color by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Update a tag by ID",
+                "parameters": [
+                    {
+                        "description": "Updated tag data",
+                        "name": "UpdateTagRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.UpdateTagOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Did not bind JSON",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a specific tag by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Delete a tag by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "tag_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.DeleteTagOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing Tag ID",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a transfer by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Accounts"
+                ],
+                "summary": "Get a specific transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transfer ID",
+                        "name": "transfer_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetTransferOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Transfer Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move money between two accounts. Transfers are not counted as spending",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Accounts"
+                ],
+                "summary": "Create a transfer",
+                "parameters": [
+                    {
+                        "description": "Transfer data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.CreateTransferOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Account Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a transfer by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Accounts"
+                ],
+                "summary": "Delete a transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transfer ID",
+                        "name": "transfer_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.DeleteTransferOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Transfer Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers/all": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve all transfers for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Accounts"
+                ],
+                "summary": "Get all transfers",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetTransfersOutputDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the details of a user by their user_id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get a user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetUserOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a specific user by their user_id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Delete a user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.DeleteUserOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates details of a specific user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update a user",
+                "parameters": [
+                    {
+                        "description": "User data to update",
+                        "name": "UpdateUserRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecases.UpdateUserInputDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.UpdateUserOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/all": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves a list of all users",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get all users",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/usecases.GetUsersOutputDto"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Subscribe a URL to expense, category and tag events. Event types accept exact names (expense.created) and wildcards (category.*, *). When no secret is given one is generated; it is only returned here and signs every payload in the X-Webhook-Signature header as sha256=\u003chex HMAC-SHA256 of the body\u003e",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Create a webhook subscription",
+                "parameters": [
+                    {
+                        "description": "Webhook subscription data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateWebhookSubscriptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.CreateWebhookSubscriptionOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a webhook subscription and cancel its pending deliveries",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Delete a webhook subscription",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook subscription ID",
+                        "name": "subscription_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.DeleteWebhookSubscriptionOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook Subscription Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the URL or the event types of a webhook subscription. Empty fields are left unchanged",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Update a webhook subscription",
+                "parameters": [
+                    {
+                        "description": "Webhook subscription data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateWebhookSubscriptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.UpdateWebhookSubscriptionOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook Subscription Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/all": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve all webhook subscriptions for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get all webhook subscriptions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetWebhookSubscriptionsOutputDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the latest 100 webhook deliveries with their status, attempts and last response, optionally for a single subscription",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook subscription ID",
+                        "name": "subscription_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.GetWebhookDeliveriesOutputDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook Subscription Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/test": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Immediately deliver a webhook.test event to the subscription URL and return the delivery result. Failed test deliveries are retried like any other event",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Send a test webhook event",
+                "parameters": [
+                    {
+                        "description": "Webhook subscription",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SendTestWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.SendTestWebhookOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook Subscription Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "entities.Account": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "closing_day": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "deactivated_at": {
+                    "type": "string"
+                },
+                "due_day": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "opening_balance": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.Category": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "color": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deactivated_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "tax_category": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.DigestSubscription": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deactivated_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "frequency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "verified": {
+                    "type": "boolean"
+                },
+                "verified_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.Expense": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "active": {
+                    "type": "boolean"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "anomalies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.ExpenseAnomaly"
+                    }
+                },
+                "category": {
+                    "$ref": "#/definitions/entities.Category"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deactivated_at": {
+                    "type": "string"
+                },
+                "expense_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "installment_number": {
+                    "type": "integer"
+                },
+                "installment_purchase_id": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "merchant_id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "place_name": {
+                    "type": "string"
+                },
+                "provider_name": {
+                    "type": "string"
+                },
+                "provider_tax_id": {
+                    "type": "string"
+                },
+                "statement_date": {
+                    "type": "string"
+                },
+                "tag_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.Tag"
+                    }
+                },
+                "tax_category": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.ExpenseAnomaly": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "baseline": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deactivated_at": {
+                    "type": "string"
+                },
+                "dismissed": {
+                    "type": "boolean"
+                },
+                "dismissed_at": {
+                    "type": "string"
+                },
+                "expense_id": {
+                    "type": "string"
+                },
+                "explanation": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "related_expense_id": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.Goal": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deactivated_at": {
+                    "type": "string"
+                },
+                "deadline": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "target_amount": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.GoalContribution": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "contribution_date": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deactivated_at": {
+                    "type": "string"
+                },
+                "goal_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.Income": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "active": {
+                    "type": "boolean"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "category": {
+                    "$ref": "#/definitions/entities.Category"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deactivated_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "income_date": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.InstallmentPurchase": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "active": {
+                    "type": "boolean"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deactivated_at": {
+                    "type": "string"
+                },
+                "first_due_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "installment_count": {
+                    "type": "integer"
+                },
+                "installments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.Expense"
+                    }
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "remainder_policy": {
+                    "type": "string"
+                },
+                "total_amount": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.Merchant": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deactivated_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.Rule": {
+            "type": "object",
+            "properties": {
+                "actions": {
+                    "$ref": "#/definitions/entities.RuleActions"
+                },
+                "active": {
+                    "type": "boolean"
+                },
+                "conditions": {
+                    "$ref": "#/definitions/entities.RuleConditions"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deactivated_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "stop_processing": {
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.RuleActions": {
+            "type": "object",
+            "properties": {
+                "add_tag_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "rewrite_notes": {
+                    "type": "string"
+                },
+                "set_category_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.RuleConditions": {
+            "type": "object",
+            "properties": {
+                "max_amount": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "min_amount": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "notes_contains": {
+                    "type": "string"
+                },
+                "notes_regex": {
+                    "type": "string"
+                },
+                "weekdays": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "entities.Tag": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "color": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deactivated_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.Transfer": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deactivated_at": {
+                    "type": "string"
+                },
+                "from_account": {
+                    "$ref": "#/definitions/entities.Account"
+                },
+                "from_account_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "to_account": {
+                    "$ref": "#/definitions/entities.Account"
+                },
+                "to_account_id": {
+                    "type": "string"
+                },
+                "transfer_date": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deactivated_at": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_attempt_at": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "response_status": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "subscription_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.WebhookSubscription": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deactivated_at": {
+                    "type": "string"
+                },
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateAccountRequest": {
+            "type": "object",
+            "properties": {
+                "closing_day": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "due_day": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "opening_balance": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "tax_category": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateDigestSubscriptionRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "frequency": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateExpenseRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "expense_date": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "merchant_id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "place_name": {
+                    "type": "string"
+                },
+                "provider_name": {
+                    "type": "string"
+                },
+                "provider_tax_id": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tax_category": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateGoalContributionRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "contribution_date": {
+                    "type": "string"
+                },
+                "goal_id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateGoalRequest": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "deadline": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "target_amount": {
+                    "type": "string",
+                    "example": "0"
+                }
+            }
+        },
+        "handlers.CreateIncomeRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "income_date": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateInstallmentPurchaseRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "first_due_date": {
+                    "type": "string"
+                },
+                "installment_count": {
+                    "type": "integer"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "remainder_policy": {
+                    "type": "string"
+                },
+                "total_amount": {
+                    "type": "string",
+                    "example": "0"
+                }
+            }
+        },
+        "handlers.CreateMerchantRequest": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateRuleRequest": {
+            "type": "object",
+            "properties": {
+                "actions": {
+                    "$ref": "#/definitions/entities.RuleActions"
+                },
+                "conditions": {
+                    "$ref": "#/definitions/entities.RuleConditions"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "stop_processing": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handlers.CreateTagRequest": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateTransferRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "from_account_id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "to_account_id": {
+                    "type": "string"
+                },
+                "transfer_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateWebhookSubscriptionRequest": {
+            "type": "object",
+            "properties": {
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.DismissExpenseAnomalyRequest": {
+            "type": "object",
+            "properties": {
+                "anomaly_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.GraphQLRequest": {
+            "type": "object",
+            "properties": {
+                "operationName": {
+                    "type": "string"
+                },
+                "query": {
+                    "type": "string"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": true
+                }
+            }
+        },
+        "handlers.GraphQLResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "object"
+                    }
+                }
+            }
+        },
+        "handlers.SendTestWebhookRequest": {
+            "type": "object",
+            "properties": {
+                "subscription_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.ShareExpenseChartRequest": {
+            "type": "object",
+            "properties": {
+                "basis": {
+                    "type": "string"
+                },
+                "data": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "expires_in": {
+                    "type": "integer"
+                },
+                "format": {
+                    "type": "string"
+                },
+                "granularity": {
+                    "type": "string"
+                },
+                "group_by": {
+                    "type": "string"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "theme": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.UpdateAccountRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "closing_day": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "due_day": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "opening_balance": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.UpdateCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "color": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "tax_category": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.UpdateExpenseRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "expense_date": {
+                    "type": "string"
+                },
+                "expense_id": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "merchant_id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "place_name": {
+                    "type": "string"
+                },
+                "provider_name": {
+                    "type": "string"
+                },
+                "provider_tax_id": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tax_category": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.UpdateGoalRequest": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "deadline": {
+                    "type": "string"
+                },
+                "goal_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "target_amount": {
+                    "type": "string",
+                    "example": "0"
+                }
+            }
+        },
+        "handlers.UpdateIncomeRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "income_date": {
+                    "type": "string"
+                },
+                "income_id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.UpdateInstallmentPurchaseRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "first_due_date": {
+                    "type": "string"
+                },
+                "installment_count": {
+                    "type": "integer"
+                },
+                "installment_purchase_id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "remainder_policy": {
+                    "type": "string"
+                },
+                "total_amount": {
+                    "type": "string",
+                    "example": "0"
+                }
+            }
+        },
+        "handlers.UpdateMerchantRequest": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "merchant_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.UpdateRuleRequest": {
+            "type": "object",
+            "properties": {
+                "actions": {
+                    "$ref": "#/definitions/entities.RuleActions"
+                },
+                "conditions": {
+                    "$ref": "#/definitions/entities.RuleConditions"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "rule_id": {
+                    "type": "string"
+                },
+                "stop_processing": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handlers.UpdateTagRequest": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "tag_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.UpdateWebhookSubscriptionRequest": {
+            "type": "object",
+            "properties": {
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "subscription_id": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenters.ComparisonDelta": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "type": "number"
+                },
+                "change_percent": {
+                    "type": "number"
+                },
+                "color": {
+                    "type": "string"
+                },
+                "current": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "previous": {
+                    "type": "number"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenters.ComparisonPeriod": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "presenters.DayToDayExpense": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "day": {
+                    "type": "string"
+                },
+                "day_name": {
+                    "type": "string"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "year": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenters.ExpenseCluster": {
+            "type": "object",
+            "properties": {
+                "bounds": {
+                    "$ref": "#/definitions/repositories.BoundingBox"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "geohash": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "place_name": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "presenters.GetAccountBalancesOutputDto": {
+            "type": "object",
+            "properties": {
+                "balances": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.AccountBalance"
+                    }
+                }
+            }
+        },
+        "presenters.GetAccountRunningBalanceOutputDto": {
+            "type": "object",
+            "properties": {
+                "running_balance": {
+                    "$ref": "#/definitions/repositories.AccountRunningBalance"
+                }
+            }
+        },
+        "presenters.GetAvailableMonthsYearsOutputDto": {
+            "type": "object",
+            "properties": {
+                "available_months": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.MonthOption"
+                    }
+                },
+                "available_years": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "presenters.GetCardStatementsOutputDto": {
+            "type": "object",
+            "properties": {
+                "card_statements": {
+                    "$ref": "#/definitions/repositories.CardStatements"
+                }
+            }
+        },
+        "presenters.GetCategoryTagsTotalsByMonthYearOutputDto": {
+            "type": "object",
+            "properties": {
+                "expenses": {
+                    "$ref": "#/definitions/repositories.CategoryTagsTotals"
+                }
+            }
+        },
+        "presenters.GetCommittedInstallmentsByMonthOutputDto": {
+            "type": "object",
+            "properties": {
+                "months": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.MonthCommittedInstallments"
+                    }
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "presenters.GetDayToDayExpensesPeriodOutputDto": {
+            "type": "object",
+            "properties": {
+                "expenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenters.DayToDayExpense"
+                    }
+                }
+            }
+        },
+        "presenters.GetExpenseClustersOutputDto": {
+            "type": "object",
+            "properties": {
+                "clusters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenters.ExpenseCluster"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "precision": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "presenters.GetExpenseLocationsOutputDto": {
+            "type": "object",
+            "properties": {
+                "bbox": {
+                    "$ref": "#/definitions/repositories.BoundingBox"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "expenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.GeotaggedExpense"
+                    }
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "presenters.GetExpensesByAccountPeriodOutputDto": {
+            "type": "object",
+            "properties": {
+                "expenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.AccountExpense"
+                    }
+                }
+            }
+        },
+        "presenters.GetExpensesByCategoryPeriodOutputDto": {
+            "type": "object",
+            "properties": {
+                "expenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.CategoryExpense"
+                    }
+                }
+            }
+        },
+        "presenters.GetExpensesByMonthYearOutputDto": {
+            "type": "object",
+            "properties": {
+                "expenses": {
+                    "$ref": "#/definitions/repositories.MonthExpenses"
+                }
+            }
+        },
+        "presenters.GetExpensesTimeSeriesOutputDto": {
+            "type": "object",
+            "properties": {
+                "buckets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenters.TimeSeriesBucket"
+                    }
+                },
+                "granularity": {
+                    "type": "string"
+                },
+                "group_by": {
+                    "type": "string"
+                },
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenters.TimeSeriesLine"
+                    }
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "presenters.GetGoalsProgressOutputDto": {
+            "type": "object",
+            "properties": {
+                "goals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.GoalProgress"
+                    }
+                }
+            }
+        },
+        "presenters.GetIncomeVsExpenseByCategoryPeriodOutputDto": {
+            "type": "object",
+            "properties": {
+                "income_vs_expense": {
+                    "$ref": "#/definitions/repositories.IncomeVsExpense"
+                }
+            }
+        },
+        "presenters.GetMonthEndForecastOutputDto": {
+            "type": "object",
+            "properties": {
+                "forecast": {
+                    "$ref": "#/definitions/repositories.MonthForecast"
+                }
+            }
+        },
+        "presenters.GetMonthlyExpensesByCategoryYearOutputDto": {
+            "type": "object",
+            "properties": {
+                "available_years": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "expenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.MonthlyCategoryExpense"
+                    }
+                }
+            }
+        },
+        "presenters.GetMonthlyExpensesByTagYearOutputDto": {
+            "type": "object",
+            "properties": {
+                "available_years": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "expenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.MonthlyTagExpense"
+                    }
+                }
+            }
+        },
+        "presenters.GetNetCashFlowYearOutputDto": {
+            "type": "object",
+            "properties": {
+                "cash_flow": {
+                    "$ref": "#/definitions/repositories.CashFlowYear"
+                }
+            }
+        },
+        "presenters.GetPeriodComparisonOutputDto": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenters.ComparisonDelta"
+                    }
+                },
+                "change": {
+                    "type": "number"
+                },
+                "change_percent": {
+                    "type": "number"
+                },
+                "current": {
+                    "$ref": "#/definitions/presenters.ComparisonPeriod"
+                },
+                "disappeared_categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "new_categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "previous": {
+                    "$ref": "#/definitions/presenters.ComparisonPeriod"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenters.ComparisonDelta"
+                    }
+                }
+            }
+        },
+        "presenters.GetPivotReportOutputDto": {
+            "type": "object",
+            "properties": {
+                "cells": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "number"
+                        }
+                    }
+                },
+                "column_headers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.PivotHeader"
+                    }
+                },
+                "column_totals": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "columns": {
+                    "type": "string"
+                },
+                "measure": {
+                    "type": "string"
+                },
+                "row_headers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.PivotHeader"
+                    }
+                },
+                "row_totals": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "rows": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "presenters.GetSpendingHeatmapOutputDto": {
+            "type": "object",
+            "properties": {
+                "busiest_days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenters.HeatmapDay"
+                    }
+                },
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenters.HeatmapDay"
+                    }
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "no_spend_days": {
+                    "type": "integer"
+                },
+                "no_spend_streaks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenters.NoSpendStreak"
+                    }
+                },
+                "spending_days": {
+                    "type": "integer"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "thresholds": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "presenters.GetTaxDeductionReportOutputDto": {
+            "type": "object",
+            "properties": {
+                "expenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenters.TaxDeductibleExpense"
+                    }
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "missing_provider_count": {
+                    "type": "integer"
+                },
+                "tax_categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenters.TaxCategoryTotal"
+                    }
+                },
+                "total": {
+                    "type": "number"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presenters.GetTopMerchantsOutputDto": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "merchants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenters.TopMerchant"
+                    }
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "presenters.GetTotalExpensesForCurrentMonthOutputDto": {
+            "type": "object",
+            "properties": {
+                "current_month": {
+                    "type": "string"
+                },
+                "total_expenses": {
+                    "type": "number"
+                }
+            }
+        },
+        "presenters.GetTotalExpensesForCurrentWeekOutputDto": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "type": "number"
+                },
+                "current_week": {
+                    "type": "string"
+                },
+                "previous_week": {
+                    "type": "string"
+                },
+                "previous_week_total": {
+                    "type": "number"
+                },
+                "total_expenses": {
+                    "type": "number"
+                },
+                "week_start": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenters.GetTotalExpensesForPeriodOutputDto": {
+            "type": "object",
+            "properties": {
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "presenters.GetTotalExpensesMonthCurrentYearOutputDto": {
+            "type": "object",
+            "properties": {
+                "expenses_month_current_year": {
+                    "$ref": "#/definitions/repositories.ExpensesMonthCurrentYear"
+                }
+            }
+        },
+        "presenters.HeatmapDay": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "number"
+                },
+                "weekday": {
+                    "type": "integer"
+                },
+                "weekday_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenters.NoSpendStreak": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "integer"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenters.ShareExpenseChartOutputDto": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenters.TaxCategoryTotal": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "providers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenters.TaxProviderTotal"
+                    }
+                },
+                "tax_category": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "presenters.TaxDeductibleExpense": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "category_name": {
+                    "type": "string"
+                },
+                "expense_date": {
+                    "type": "string"
+                },
+                "expense_id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "provider_name": {
+                    "type": "string"
+                },
+                "provider_tax_id": {
+                    "type": "string"
+                },
+                "tax_category": {
+                    "type": "string"
+                },
+                "tax_category_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenters.TaxProviderTotal": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "tax_id": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "presenters.TimeSeriesBucket": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "presenters.TimeSeriesLine": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                },
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "presenters.TopMerchant": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "number"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "merchant_id": {
+                    "type": "string"
+                },
+                "merchant_name": {
+                    "type": "string"
+                },
+                "share_percent": {
+                    "type": "number"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "repositories.AccountBalance": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "balance": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "expenses": {
+                    "type": "number"
+                },
+                "income": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "opening_balance": {
+                    "type": "number"
+                },
+                "transfers_in": {
+                    "type": "number"
+                },
+                "transfers_out": {
+                    "type": "number"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "repositories.AccountBalanceDay": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "number"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "inflow": {
+                    "type": "number"
+                },
+                "outflow": {
+                    "type": "number"
+                }
+            }
+        },
+        "repositories.AccountExpense": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "account_name": {
+                    "type": "string"
+                },
+                "account_type": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "repositories.AccountRunningBalance": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.AccountBalanceDay"
+                    }
+                },
+                "ending_balance": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "starting_balance": {
+                    "type": "number"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "repositories.BoundingBox": {
+            "type": "object",
+            "properties": {
+                "max_latitude": {
+                    "type": "number"
+                },
+                "max_longitude": {
+                    "type": "number"
+                },
+                "min_latitude": {
+                    "type": "number"
+                },
+                "min_longitude": {
+                    "type": "number"
+                }
+            }
+        },
+        "repositories.CardStatement": {
+            "type": "object",
+            "properties": {
+                "closing_date": {
+                    "type": "string"
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "expense_count": {
+                    "type": "integer"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "repositories.CardStatements": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "available_years": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "closing_day": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "due_day": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "statements": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.CardStatement"
+                    }
+                },
+                "total": {
+                    "type": "number"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repositories.CashFlowYear": {
+            "type": "object",
+            "properties": {
+                "available_years": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "expenses": {
+                    "type": "number"
+                },
+                "income": {
+                    "type": "number"
+                },
+                "months": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.MonthCashFlow"
+                    }
+                },
+                "net": {
+                    "type": "number"
+                },
+                "savings_rate": {
+                    "type": "number"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repositories.CategoryExpense": {
+            "type": "object",
+            "properties": {
+                "category_color": {
+                    "type": "string"
+                },
+                "category_name": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "repositories.CategoryTagTotal": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "tag_amount": {
+                    "type": "number"
+                }
+            }
+        },
+        "repositories.CategoryTagsTotals": {
+            "type": "object",
+            "properties": {
+                "available_months": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.MonthOption"
+                    }
+                },
+                "available_years": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.CategoryWithTags"
+                    }
+                },
+                "expenses_amount": {
+                    "type": "number"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "month_number": {
+                    "type": "integer"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repositories.CategoryWithTags": {
+            "type": "object",
+            "properties": {
+                "category_amount": {
+                    "type": "number"
+                },
+                "color": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.CategoryTagTotal"
+                    }
+                }
+            }
+        },
+        "repositories.CommittedInstallment": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "installment_count": {
+                    "type": "integer"
+                },
+                "installment_number": {
+                    "type": "integer"
+                },
+                "installment_purchase_id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                }
+            }
+        },
+        "repositories.DayExpense": {
+            "type": "object",
+            "properties": {
+                "day": {
+                    "type": "string"
+                },
+                "day_name": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.ExpenseTag"
+                    }
+                },
+                "total": {
+                    "type": "number"
+                },
+                "weekday": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repositories.ExpenseTag": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "repositories.ExpensesMonthCurrentYear": {
+            "type": "object",
+            "properties": {
+                "available_years": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "months": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.MonthCurrentYear"
+                    }
+                },
+                "total": {
+                    "type": "number"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repositories.ForecastCategory": {
+            "type": "object",
+            "properties": {
+                "category_color": {
+                    "type": "string"
+                },
+                "category_name": {
+                    "type": "string"
+                },
+                "estimate": {
+                    "type": "number"
+                },
+                "high": {
+                    "type": "number"
+                },
+                "low": {
+                    "type": "number"
+                },
+                "scheduled": {
+                    "type": "number"
+                },
+                "spent_so_far": {
+                    "type": "number"
+                }
+            }
+        },
+        "repositories.ForecastRecurringCharge": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "category_name": {
+                    "type": "string"
+                },
+                "expected_date": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                }
+            }
+        },
+        "repositories.GeotaggedExpense": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "category_color": {
+                    "type": "string"
+                },
+                "category_name": {
+                    "type": "string"
+                },
+                "expense_date": {
+                    "type": "string"
+                },
+                "expense_id": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "place_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "repositories.GoalProgress": {
+            "type": "object",
+            "properties": {
+                "average_monthly_contribution": {
+                    "type": "number"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "completed": {
+                    "type": "boolean"
+                },
+                "deadline": {
+                    "type": "string"
+                },
+                "goal_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "on_track": {
+                    "type": "boolean"
+                },
+                "percent_complete": {
+                    "type": "number"
+                },
+                "projected_completion_date": {
+                    "type": "string"
+                },
+                "remaining": {
+                    "type": "number"
+                },
+                "required_monthly_contribution": {
+                    "type": "number"
+                },
+                "saved": {
+                    "type": "number"
+                },
+                "target_amount": {
+                    "type": "number"
+                }
+            }
+        },
+        "repositories.IncomeVsExpense": {
+            "type": "object",
+            "properties": {
+                "expense_categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.CategoryExpense"
+                    }
+                },
+                "expenses": {
+                    "type": "number"
+                },
+                "income": {
+                    "type": "number"
+                },
+                "income_categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.CategoryExpense"
+                    }
+                },
+                "net": {
+                    "type": "number"
+                },
+                "savings_rate": {
+                    "type": "number"
+                }
+            }
+        },
+        "repositories.LargestExpense": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "category_color": {
+                    "type": "string"
+                },
+                "category_name": {
+                    "type": "string"
+                },
+                "expense_date": {
+                    "type": "string"
+                },
+                "expense_id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                }
+            }
+        },
+        "repositories.MonthCashFlow": {
+            "type": "object",
+            "properties": {
+                "expenses": {
+                    "type": "number"
+                },
+                "income": {
+                    "type": "number"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "month_number": {
+                    "type": "integer"
+                },
+                "net": {
+                    "type": "number"
+                },
+                "savings_rate": {
+                    "type": "number"
+                }
+            }
+        },
+        "repositories.MonthCommittedInstallments": {
+            "type": "object",
+            "properties": {
+                "installments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.CommittedInstallment"
+                    }
+                },
+                "month": {
+                    "type": "string"
+                },
+                "month_number": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "number"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repositories.MonthCurrentYear": {
+            "type": "object",
+            "properties": {
+                "month": {
+                    "type": "string"
+                },
+                "month_number": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "repositories.MonthExpenses": {
+            "type": "object",
+            "properties": {
+                "available_years": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "month": {
+                    "type": "string"
+                },
+                "month_number": {
+                    "type": "integer"
+                },
+                "total_expenses": {
+                    "type": "number"
+                },
+                "weeks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.WeekExpenses"
+                    }
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repositories.MonthForecast": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.ForecastCategory"
+                    }
+                },
+                "day": {
+                    "type": "integer"
+                },
+                "days_in_month": {
+                    "type": "integer"
+                },
+                "estimate": {
+                    "type": "number"
+                },
+                "high": {
+                    "type": "number"
+                },
+                "history_months": {
+                    "type": "integer"
+                },
+                "low": {
+                    "type": "number"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "month_number": {
+                    "type": "integer"
+                },
+                "recurring_charges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.ForecastRecurringCharge"
+                    }
+                },
+                "scheduled": {
+                    "type": "number"
+                },
+                "spent_so_far": {
+                    "type": "number"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repositories.MonthOption": {
+            "type": "object",
+            "properties": {
+                "label": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "repositories.MonthlyCategoryExpense": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.CategoryExpense"
+                    }
+                },
+                "month": {
+                    "type": "string"
+                },
+                "month_number": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "number"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repositories.MonthlyTagExpense": {
+            "type": "object",
+            "properties": {
+                "month": {
+                    "type": "string"
+                },
+                "month_number": {
+                    "type": "integer"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.TagExpense"
+                    }
+                },
+                "total": {
+                    "type": "number"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repositories.PivotHeader": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "repositories.TagExpense": {
+            "type": "object",
+            "properties": {
+                "tag_color": {
+                    "type": "string"
+                },
+                "tag_name": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "repositories.WeekExpenses": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.DayExpense"
+                    }
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "week": {
+                    "type": "integer"
+                }
+            }
+        },
+        "usecases.ApplyRuleOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
+                    "type": "string"
+                },
+                "rule_id": {
+                    "type": "string"
+                },
+                "success_message": {
+                    "type": "string"
+                },
+                "updated_expenses": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "usecases.ConfirmDigestSubscriptionOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
+                    "type": "string"
+                },
+                "subscription_id": {
+                    "type": "string"
+                },
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.CreateAccountOutputDto": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "content_message": {
+                    "type": "string"
+                },
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.CreateCategoryOutputDto": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "content_message": {
+                    "type": "string"
+                },
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.CreateDigestSubscriptionOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
+                    "type": "string"
+                },
+                "subscription_id": {
+                    "type": "string"
+                },
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.CreateExpenseOutputDto": {
+            "type": "object",
+            "properties": {
+                "anomalies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.ExpenseAnomaly"
                     }
+                },
+                "content_message": {
+                    "type": "string"
+                },
+                "expense_id": {
+                    "type": "string"
+                },
+                "success_message": {
+                    "type": "string"
                 }
             }
-        }
-    },
-    "definitions": {
-        "entities.Category": {
+        },
+        "usecases.CreateGoalContributionOutputDto": {
             "type": "object",
             "properties": {
-                "active": {
-                    "type": "boolean"
+                "content_message": {
+                    "type": "string"
                 },
-                "color": {
+                "contribution_id": {
                     "type": "string"
                 },
-                "created_at": {
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.CreateGoalOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
                     "type": "string"
                 },
-                "deactivated_at": {
+                "goal_id": {
                     "type": "string"
                 },
-                "id": {
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.CreateIncomeOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
                     "type": "string"
                 },
-                "name": {
+                "income_id": {
                     "type": "string"
                 },
-                "updated_at": {
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.CreateInstallmentPurchaseOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
                     "type": "string"
                 },
-                "user_id": {
+                "installment_purchase_id": {
+                    "type": "string"
+                },
+                "success_message": {
                     "type": "string"
                 }
             }
         },
-        "entities.Expense": {
+        "usecases.CreateMerchantOutputDto": {
             "type": "object",
             "properties": {
-                "active": {
-                    "type": "boolean"
+                "content_message": {
+                    "type": "string"
                 },
-                "amount": {
-                    "type": "string",
-                    "example": "0"
+                "merchant_id": {
+                    "type": "string"
                 },
-                "category": {
-                    "$ref": "#/definitions/entities.Category"
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.CreateRuleOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
+                    "type": "string"
                 },
-                "category_id": {
+                "rule_id": {
                     "type": "string"
                 },
-                "created_at": {
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.CreateTagOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
                     "type": "string"
                 },
-                "deactivated_at": {
+                "success_message": {
                     "type": "string"
                 },
-                "expense_date": {
+                "tag_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.CreateTransferOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
                     "type": "string"
                 },
-                "id": {
+                "success_message": {
                     "type": "string"
                 },
-                "notes": {
+                "transfer_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.CreateUserInputDto": {
+            "type": "object",
+            "properties": {
+                "email": {
                     "type": "string"
                 },
-                "tag_ids": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "language": {
+                    "type": "string"
                 },
-                "tags": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/entities.Tag"
-                    }
+                "name": {
+                    "type": "string"
                 },
-                "updated_at": {
+                "password": {
                     "type": "string"
                 },
-                "user_id": {
+                "timezone": {
+                    "type": "string"
+                },
+                "week_start": {
                     "type": "string"
                 }
             }
         },
-        "entities.Tag": {
+        "usecases.CreateUserOutputDto": {
             "type": "object",
             "properties": {
-                "active": {
-                    "type": "boolean"
-                },
-                "color": {
+                "content_message": {
                     "type": "string"
                 },
-                "created_at": {
+                "name": {
                     "type": "string"
                 },
-                "deactivated_at": {
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.CreateWebhookSubscriptionOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
                     "type": "string"
                 },
-                "id": {
+                "secret": {
                     "type": "string"
                 },
-                "name": {
+                "subscription_id": {
                     "type": "string"
                 },
-                "updated_at": {
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.DeleteAccountOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
                     "type": "string"
                 },
-                "user_id": {
+                "success_message": {
                     "type": "string"
                 }
             }
         },
-        "handlers.CreateCategoryRequest": {
+        "usecases.DeleteCategoryOutputDto": {
             "type": "object",
             "properties": {
-                "color": {
+                "content_message": {
                     "type": "string"
                 },
-                "name": {
+                "success_message": {
                     "type": "string"
                 }
             }
         },
-        "handlers.CreateExpenseRequest": {
+        "usecases.DeleteDigestSubscriptionOutputDto": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "string",
-                    "example": "0"
+                "content_message": {
+                    "type": "string"
                 },
-                "category_id": {
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.DeleteExpenseOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
                     "type": "string"
                 },
-                "expense_date": {
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.DeleteGoalContributionOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
                     "type": "string"
                 },
-                "notes": {
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.DeleteGoalOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
                     "type": "string"
                 },
-                "tags": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "success_message": {
+                    "type": "string"
                 }
             }
         },
-        "handlers.CreateTagRequest": {
+        "usecases.DeleteIncomeOutputDto": {
             "type": "object",
             "properties": {
-                "color": {
+                "content_message": {
                     "type": "string"
                 },
-                "name": {
+                "success_message": {
                     "type": "string"
                 }
             }
         },
-        "handlers.UpdateCategoryRequest": {
+        "usecases.DeleteInstallmentPurchaseOutputDto": {
             "type": "object",
             "properties": {
-                "category_id": {
+                "content_message": {
                     "type": "string"
                 },
-                "color": {
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.DeleteMerchantOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
                     "type": "string"
                 },
-                "name": {
+                "success_message": {
                     "type": "string"
                 }
             }
         },
-        "handlers.UpdateExpenseRequest": {
+        "usecases.DeleteRuleOutputDto": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "string",
-                    "example": "0"
+                "content_message": {
+                    "type": "string"
                 },
-                "category_id": {
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.DeleteTagOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
                     "type": "string"
                 },
-                "expense_date": {
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.DeleteTransferOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
                     "type": "string"
                 },
-                "expense_id": {
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.DeleteUserOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
                     "type": "string"
                 },
-                "notes": {
+                "success_message": {
                     "type": "string"
-                },
-                "tags": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
                 }
             }
         },
-        "handlers.UpdateTagRequest": {
+        "usecases.DeleteWebhookSubscriptionOutputDto": {
             "type": "object",
             "properties": {
-                "color": {
-                    "type": "string"
-                },
-                "name": {
+                "content_message": {
                     "type": "string"
                 },
-                "tag_id": {
+                "success_message": {
                     "type": "string"
                 }
             }
         },
-        "presenters.DayToDayExpense": {
+        "usecases.DismissExpenseAnomalyOutputDto": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "number"
-                },
-                "day": {
-                    "type": "string"
-                },
-                "day_name": {
+                "content_message": {
                     "type": "string"
                 },
-                "month": {
+                "success_message": {
                     "type": "string"
                 }
             }
         },
-        "presenters.GetAvailableMonthsYearsOutputDto": {
+        "usecases.GetAccountOutputDto": {
             "type": "object",
             "properties": {
-                "available_months": {
+                "account": {
+                    "$ref": "#/definitions/entities.Account"
+                }
+            }
+        },
+        "usecases.GetAccountsOutputDto": {
+            "type": "object",
+            "properties": {
+                "accounts": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repositories.MonthOption"
+                        "$ref": "#/definitions/entities.Account"
                     }
-                },
-                "available_years": {
+                }
+            }
+        },
+        "usecases.GetCategoriesOutputDto": {
+            "type": "object",
+            "properties": {
+                "categories": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/entities.Category"
                     }
                 }
             }
         },
-        "presenters.GetCategoryTagsTotalsByMonthYearOutputDto": {
+        "usecases.GetCategoryOutputDto": {
             "type": "object",
             "properties": {
-                "expenses": {
-                    "$ref": "#/definitions/repositories.CategoryTagsTotals"
+                "category": {
+                    "$ref": "#/definitions/entities.Category"
                 }
             }
         },
-        "presenters.GetDayToDayExpensesPeriodOutputDto": {
+        "usecases.GetDigestSubscriptionsOutputDto": {
             "type": "object",
             "properties": {
-                "expenses": {
+                "subscriptions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/presenters.DayToDayExpense"
+                        "$ref": "#/definitions/entities.DigestSubscription"
                     }
                 }
             }
         },
-        "presenters.GetExpensesByCategoryPeriodOutputDto": {
+        "usecases.GetExpenseAnomaliesOutputDto": {
             "type": "object",
             "properties": {
                 "expenses": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repositories.CategoryExpense"
+                        "$ref": "#/definitions/entities.Expense"
                     }
                 }
             }
         },
-        "presenters.GetExpensesByMonthYearOutputDto": {
+        "usecases.GetExpenseOutputDto": {
             "type": "object",
             "properties": {
-                "expenses": {
-                    "$ref": "#/definitions/repositories.MonthExpenses"
+                "expense": {
+                    "$ref": "#/definitions/entities.Expense"
                 }
             }
         },
-        "presenters.GetMonthlyExpensesByCategoryYearOutputDto": {
+        "usecases.GetExpensesOutputDto": {
             "type": "object",
             "properties": {
-                "available_years": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
-                },
                 "expenses": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repositories.MonthlyCategoryExpense"
+                        "$ref": "#/definitions/entities.Expense"
                     }
                 }
             }
         },
-        "presenters.GetMonthlyExpensesByTagYearOutputDto": {
+        "usecases.GetGoalContributionsOutputDto": {
             "type": "object",
             "properties": {
-                "available_years": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
-                },
-                "expenses": {
+                "contributions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repositories.MonthlyTagExpense"
+                        "$ref": "#/definitions/entities.GoalContribution"
                     }
                 }
             }
         },
-        "presenters.GetTotalExpensesForCurrentMonthOutputDto": {
+        "usecases.GetGoalOutputDto": {
             "type": "object",
             "properties": {
-                "current_month": {
-                    "type": "string"
-                },
-                "total_expenses": {
-                    "type": "number"
+                "goal": {
+                    "$ref": "#/definitions/entities.Goal"
                 }
             }
         },
-        "presenters.GetTotalExpensesForCurrentWeekOutputDto": {
+        "usecases.GetGoalsOutputDto": {
             "type": "object",
             "properties": {
-                "current_week": {
-                    "type": "string"
-                },
-                "total_expenses": {
-                    "type": "number"
+                "goals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.Goal"
+                    }
                 }
             }
         },
-        "presenters.GetTotalExpensesForPeriodOutputDto": {
+        "usecases.GetIncomeOutputDto": {
             "type": "object",
             "properties": {
-                "total": {
-                    "type": "number"
+                "income": {
+                    "$ref": "#/definitions/entities.Income"
                 }
             }
         },
-        "presenters.GetTotalExpensesMonthCurrentYearOutputDto": {
+        "usecases.GetIncomesOutputDto": {
             "type": "object",
             "properties": {
-                "expenses_month_current_year": {
-                    "$ref": "#/definitions/repositories.ExpensesMonthCurrentYear"
+                "incomes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.Income"
+                    }
                 }
             }
         },
-        "repositories.CategoryExpense": {
+        "usecases.GetInstallmentPurchaseOutputDto": {
             "type": "object",
             "properties": {
-                "category_color": {
-                    "type": "string"
-                },
-                "category_name": {
-                    "type": "string"
-                },
-                "total": {
-                    "type": "number"
+                "installment_purchase": {
+                    "$ref": "#/definitions/entities.InstallmentPurchase"
                 }
             }
         },
-        "repositories.CategoryTagTotal": {
+        "usecases.GetInstallmentPurchasesOutputDto": {
             "type": "object",
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "tag_amount": {
-                    "type": "number"
+                "installment_purchases": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.InstallmentPurchase"
+                    }
                 }
             }
         },
-        "repositories.CategoryTagsTotals": {
+        "usecases.GetMerchantOutputDto": {
             "type": "object",
             "properties": {
-                "available_months": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repositories.MonthOption"
-                    }
+                "average": {
+                    "type": "number"
                 },
-                "available_years": {
+                "count": {
+                    "type": "integer"
+                },
+                "expenses": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/entities.Expense"
                     }
                 },
-                "categories": {
+                "first_expense_date": {
+                    "type": "string"
+                },
+                "last_expense_date": {
+                    "type": "string"
+                },
+                "merchant": {
+                    "$ref": "#/definitions/entities.Merchant"
+                },
+                "months": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repositories.CategoryWithTags"
+                        "$ref": "#/definitions/usecases.MerchantMonthTotal"
                     }
                 },
-                "expenses_amount": {
+                "total": {
                     "type": "number"
-                },
-                "month": {
-                    "type": "string"
-                },
-                "year": {
-                    "type": "integer"
                 }
             }
         },
-        "repositories.CategoryWithTags": {
+        "usecases.GetMerchantsOutputDto": {
             "type": "object",
             "properties": {
-                "category_amount": {
-                    "type": "number"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "tags": {
+                "merchants": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repositories.CategoryTagTotal"
+                        "$ref": "#/definitions/entities.Merchant"
                     }
                 }
             }
         },
-        "repositories.DayExpense": {
+        "usecases.GetRuleOutputDto": {
             "type": "object",
             "properties": {
-                "day": {
-                    "type": "string"
-                },
-                "day_name": {
-                    "type": "string"
-                },
-                "tags": {
+                "rule": {
+                    "$ref": "#/definitions/entities.Rule"
+                }
+            }
+        },
+        "usecases.GetRulesOutputDto": {
+            "type": "object",
+            "properties": {
+                "rules": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repositories.ExpenseTag"
+                        "$ref": "#/definitions/entities.Rule"
                     }
-                },
-                "total": {
-                    "type": "number"
                 }
             }
         },
-        "repositories.ExpenseTag": {
+        "usecases.GetTagOutputDto": {
             "type": "object",
             "properties": {
-                "color": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "total": {
-                    "type": "number"
+                "tag": {
+                    "$ref": "#/definitions/entities.Tag"
                 }
             }
         },
-        "repositories.ExpensesMonthCurrentYear": {
+        "usecases.GetTagsOutputDto": {
             "type": "object",
             "properties": {
-                "available_years": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
-                },
-                "months": {
+                "tags": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repositories.MonthCurrentYear"
+                        "$ref": "#/definitions/entities.Tag"
                     }
-                },
-                "total": {
-                    "type": "number"
-                },
-                "year": {
-                    "type": "integer"
                 }
             }
         },
-        "repositories.MonthCurrentYear": {
+        "usecases.GetTransferOutputDto": {
             "type": "object",
             "properties": {
-                "month": {
-                    "type": "string"
-                },
-                "total": {
-                    "type": "number"
+                "transfer": {
+                    "$ref": "#/definitions/entities.Transfer"
                 }
             }
         },
-        "repositories.MonthExpenses": {
+        "usecases.GetTransfersOutputDto": {
             "type": "object",
             "properties": {
-                "available_years": {
+                "transfers": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/entities.Transfer"
                     }
-                },
-                "month": {
-                    "type": "string"
-                },
-                "total_expenses": {
-                    "type": "number"
-                },
-                "weeks": {
+                }
+            }
+        },
+        "usecases.GetUserOutputDto": {
+            "type": "object",
+            "properties": {
+                "user": {
+                    "$ref": "#/definitions/usecases.UserOutput"
+                }
+            }
+        },
+        "usecases.GetUsersOutputDto": {
+            "type": "object",
+            "properties": {
+                "users": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repositories.WeekExpenses"
+                        "$ref": "#/definitions/usecases.UserOutput"
                     }
-                },
-                "year": {
-                    "type": "integer"
                 }
             }
         },
-        "repositories.MonthOption": {
+        "usecases.GetWebhookDeliveriesOutputDto": {
             "type": "object",
-            "properties": {
-                "label": {
-                    "type": "string"
-                },
-                "value": {
-                    "type": "string"
+            "properties": {
+                "deliveries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.WebhookDelivery"
+                    }
                 }
             }
         },
-        "repositories.MonthlyCategoryExpense": {
+        "usecases.GetWebhookSubscriptionsOutputDto": {
             "type": "object",
             "properties": {
-                "categories": {
+                "subscriptions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repositories.CategoryExpense"
+                        "$ref": "#/definitions/entities.WebhookSubscription"
                     }
-                },
-                "month": {
+                }
+            }
+        },
+        "usecases.LoginInputDto": {
+            "type": "object",
+            "properties": {
+                "email": {
                     "type": "string"
                 },
-                "total": {
-                    "type": "number"
-                },
-                "year": {
-                    "type": "integer"
+                "password": {
+                    "type": "string"
                 }
             }
         },
-        "repositories.MonthlyTagExpense": {
+        "usecases.LoginOutputDto": {
             "type": "object",
             "properties": {
-                "month": {
+                "access_token": {
                     "type": "string"
                 },
-                "tags": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repositories.TagExpense"
-                    }
+                "content_message": {
+                    "type": "string"
                 },
-                "total": {
-                    "type": "number"
+                "name": {
+                    "type": "string"
                 },
-                "year": {
-                    "type": "integer"
+                "success_message": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
                 }
             }
         },
-        "repositories.TagExpense": {
+        "usecases.MerchantMonthTotal": {
             "type": "object",
             "properties": {
-                "tag_color": {
-                    "type": "string"
+                "count": {
+                    "type": "integer"
                 },
-                "tag_name": {
+                "month": {
                     "type": "string"
                 },
                 "total": {
@@ -2201,93 +9713,149 @@ const docTemplate = `{
                 }
             }
         },
-        "repositories.WeekExpenses": {
+        "usecases.PreviewRuleOutputDto": {
             "type": "object",
             "properties": {
-                "days": {
+                "expenses": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repositories.DayExpense"
+                        "$ref": "#/definitions/entities.Expense"
                     }
                 },
-                "week": {
+                "matches": {
                     "type": "integer"
+                },
+                "rule_id": {
+                    "type": "string"
                 }
             }
         },
-        "usecases.CreateCategoryOutputDto": {
+        "usecases.PreviewSpendingDigestOutputDto": {
             "type": "object",
             "properties": {
-                "category_id": {
+                "digest": {
+                    "$ref": "#/definitions/usecases.SpendingDigest"
+                },
+                "html": {
                     "type": "string"
                 },
-                "content_message": {
+                "subject": {
                     "type": "string"
                 },
-                "success_message": {
+                "text": {
                     "type": "string"
                 }
             }
         },
-        "usecases.CreateExpenseOutputDto": {
+        "usecases.SendTestWebhookOutputDto": {
             "type": "object",
             "properties": {
                 "content_message": {
                     "type": "string"
                 },
-                "expense_id": {
-                    "type": "string"
+                "delivery": {
+                    "$ref": "#/definitions/entities.WebhookDelivery"
                 },
                 "success_message": {
                     "type": "string"
                 }
             }
         },
-        "usecases.CreateTagOutputDto": {
+        "usecases.SpendingDigest": {
             "type": "object",
             "properties": {
-                "content_message": {
+                "change": {
+                    "type": "number"
+                },
+                "frequency": {
                     "type": "string"
                 },
-                "success_message": {
+                "largest_expenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.LargestExpense"
+                    }
+                },
+                "period_end": {
                     "type": "string"
                 },
-                "tag_id": {
+                "period_label": {
+                    "type": "string"
+                },
+                "period_start": {
                     "type": "string"
+                },
+                "prior_total": {
+                    "type": "number"
+                },
+                "top_categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repositories.CategoryExpense"
+                    }
+                },
+                "total": {
+                    "type": "number"
                 }
             }
         },
-        "usecases.CreateUserInputDto": {
+        "usecases.SuggestExpenseOutputDto": {
             "type": "object",
             "properties": {
-                "email": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecases.SuggestedCategory"
+                    }
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecases.SuggestedTag"
+                    }
+                }
+            }
+        },
+        "usecases.SuggestedCategory": {
+            "type": "object",
+            "properties": {
+                "category_id": {
                     "type": "string"
                 },
-                "name": {
+                "color": {
                     "type": "string"
                 },
-                "password": {
+                "confidence": {
+                    "type": "number"
+                },
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "usecases.CreateUserOutputDto": {
+        "usecases.SuggestedTag": {
             "type": "object",
             "properties": {
-                "content_message": {
+                "color": {
                     "type": "string"
                 },
+                "confidence": {
+                    "type": "number"
+                },
                 "name": {
                     "type": "string"
                 },
-                "success_message": {
+                "tag_id": {
                     "type": "string"
                 }
             }
         },
-        "usecases.DeleteCategoryOutputDto": {
+        "usecases.UpdateAccountOutputDto": {
             "type": "object",
             "properties": {
+                "account_id": {
+                    "type": "string"
+                },
                 "content_message": {
                     "type": "string"
                 },
@@ -2296,9 +9864,12 @@ const docTemplate = `{
                 }
             }
         },
-        "usecases.DeleteExpenseOutputDto": {
+        "usecases.UpdateCategoryOutputDto": {
             "type": "object",
             "properties": {
+                "category_id": {
+                    "type": "string"
+                },
                 "content_message": {
                     "type": "string"
                 },
@@ -2307,142 +9878,69 @@ const docTemplate = `{
                 }
             }
         },
-        "usecases.DeleteTagOutputDto": {
+        "usecases.UpdateExpenseOutputDto": {
             "type": "object",
             "properties": {
                 "content_message": {
                     "type": "string"
                 },
+                "expense_id": {
+                    "type": "string"
+                },
                 "success_message": {
                     "type": "string"
                 }
             }
         },
-        "usecases.DeleteUserOutputDto": {
+        "usecases.UpdateGoalOutputDto": {
             "type": "object",
             "properties": {
                 "content_message": {
                     "type": "string"
                 },
+                "goal_id": {
+                    "type": "string"
+                },
                 "success_message": {
                     "type": "string"
                 }
             }
         },
-        "usecases.GetCategoriesOutputDto": {
-            "type": "object",
-            "properties": {
-                "categories": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/entities.Category"
-                    }
-                }
-            }
-        },
-        "usecases.GetCategoryOutputDto": {
-            "type": "object",
-            "properties": {
-                "category": {
-                    "$ref": "#/definitions/entities.Category"
-                }
-            }
-        },
-        "usecases.GetExpenseOutputDto": {
-            "type": "object",
-            "properties": {
-                "expense": {
-                    "$ref": "#/definitions/entities.Expense"
-                }
-            }
-        },
-        "usecases.GetExpensesOutputDto": {
-            "type": "object",
-            "properties": {
-                "expenses": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/entities.Expense"
-                    }
-                }
-            }
-        },
-        "usecases.GetTagOutputDto": {
-            "type": "object",
-            "properties": {
-                "tag": {
-                    "$ref": "#/definitions/entities.Tag"
-                }
-            }
-        },
-        "usecases.GetTagsOutputDto": {
-            "type": "object",
-            "properties": {
-                "tags": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/entities.Tag"
-                    }
-                }
-            }
-        },
-        "usecases.GetUserOutputDto": {
-            "type": "object",
-            "properties": {
-                "user": {
-                    "$ref": "#/definitions/usecases.UserOutput"
-                }
-            }
-        },
-        "usecases.GetUsersOutputDto": {
-            "type": "object",
-            "properties": {
-                "users": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/usecases.UserOutput"
-                    }
-                }
-            }
-        },
-        "usecases.LoginInputDto": {
+        "usecases.UpdateIncomeOutputDto": {
             "type": "object",
             "properties": {
-                "email": {
+                "content_message": {
                     "type": "string"
                 },
-                "password": {
+                "income_id": {
+                    "type": "string"
+                },
+                "success_message": {
                     "type": "string"
                 }
             }
         },
-        "usecases.LoginOutputDto": {
+        "usecases.UpdateInstallmentPurchaseOutputDto": {
             "type": "object",
             "properties": {
-                "access_token": {
-                    "type": "string"
-                },
                 "content_message": {
                     "type": "string"
                 },
-                "name": {
+                "installment_purchase_id": {
                     "type": "string"
                 },
                 "success_message": {
                     "type": "string"
-                },
-                "user_id": {
-                    "type": "string"
                 }
             }
         },
-        "usecases.UpdateCategoryOutputDto": {
+        "usecases.UpdateMerchantOutputDto": {
             "type": "object",
             "properties": {
-                "category_id": {
+                "content_message": {
                     "type": "string"
                 },
-                "content_message": {
+                "merchant_id": {
                     "type": "string"
                 },
                 "success_message": {
@@ -2450,13 +9948,13 @@ const docTemplate = `{
                 }
             }
         },
-        "usecases.UpdateExpenseOutputDto": {
+        "usecases.UpdateRuleOutputDto": {
             "type": "object",
             "properties": {
                 "content_message": {
                     "type": "string"
                 },
-                "expense_id": {
+                "rule_id": {
                     "type": "string"
                 },
                 "success_message": {
@@ -2481,11 +9979,20 @@ const docTemplate = `{
         "usecases.UpdateUserInputDto": {
             "type": "object",
             "properties": {
+                "language": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
+                "timezone": {
+                    "type": "string"
+                },
                 "user_id": {
                     "type": "string"
+                },
+                "week_start": {
+                    "type": "string"
                 }
             }
         },
@@ -2503,6 +10010,20 @@ const docTemplate = `{
                 }
             }
         },
+        "usecases.UpdateWebhookSubscriptionOutputDto": {
+            "type": "object",
+            "properties": {
+                "content_message": {
+                    "type": "string"
+                },
+                "subscription_id": {
+                    "type": "string"
+                },
+                "success_message": {
+                    "type": "string"
+                }
+            }
+        },
         "usecases.UserOutput": {
             "type": "object",
             "properties": {
@@ -2518,11 +10039,20 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "language": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
+                "timezone": {
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
+                },
+                "week_start": {
+                    "type": "string"
                 }
             }
         },
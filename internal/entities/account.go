@@ -0,0 +1,266 @@
+package entities
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+const (
+	ACCOUNT_TYPE_CHECKING    = "checking"
+	ACCOUNT_TYPE_SAVINGS     = "savings"
+	ACCOUNT_TYPE_CREDIT_CARD = "credit_card"
+	ACCOUNT_TYPE_CASH        = "cash"
+	ACCOUNT_DEFAULT_CURRENCY = "BRL"
+)
+
+var currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+
+type Account struct {
+	SharedEntity
+	UserID         string  `json:"user_id"`
+	Name           string  `json:"name"`
+	Type           string  `json:"type"`
+	Currency       string  `json:"currency"`
+	OpeningBalance float64 `json:"opening_balance,string"`
+	ClosingDay     int     `json:"closing_day,omitempty"`
+	DueDay         int     `json:"due_day,omitempty"`
+}
+
+type StatementPeriod struct {
+	StartDate   time.Time `json:"start_date"`
+	ClosingDate time.Time `json:"closing_date"`
+	DueDate     time.Time `json:"due_date"`
+}
+
+func NewAccount(userID string, name string, accountType string, currency string, openingBalance float64, closingDay int, dueDay int) (*Account, []util.ProblemDetails) {
+	if currency == "" {
+		currency = ACCOUNT_DEFAULT_CURRENCY
+	}
+
+	validationErrors := ValidateAccount(userID, name, accountType, currency)
+	validationErrors = append(validationErrors, validateStatementDays(accountType, closingDay, dueDay)...)
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return &Account{
+		SharedEntity:   *NewSharedEntity(),
+		UserID:         userID,
+		Name:           name,
+		Type:           accountType,
+		Currency:       currency,
+		OpeningBalance: openingBalance,
+		ClosingDay:     closingDay,
+		DueDay:         dueDay,
+	}, nil
+}
+
+func ValidateAccount(userID string, name string, accountType string, currency string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if userID == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing user id",
+			Instance: util.RFC400,
+		})
+	}
+
+	validationErrors = append(validationErrors, validateAccountName(name)...)
+	validationErrors = append(validationErrors, validateAccountType(accountType)...)
+	validationErrors = append(validationErrors, validateAccountCurrency(currency)...)
+
+	return validationErrors
+}
+
+func validateAccountName(name string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if name == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing account name",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(name) > 100 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Account name cannot exceed 100 characters",
+			Instance: util.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+func validateAccountType(accountType string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if accountType != ACCOUNT_TYPE_CHECKING && accountType != ACCOUNT_TYPE_SAVINGS && accountType != ACCOUNT_TYPE_CREDIT_CARD && accountType != ACCOUNT_TYPE_CASH {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Account type must be checking, savings, credit_card or cash",
+			Instance: util.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+func validateAccountCurrency(currency string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if !currencyRegex.MatchString(currency) {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Currency must be a three-letter ISO 4217 code (e.g., BRL or USD)",
+			Instance: util.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+func validateStatementDays(accountType string, closingDay int, dueDay int) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if accountType != ACCOUNT_TYPE_CREDIT_CARD {
+		if closingDay != 0 || dueDay != 0 {
+			validationErrors = append(validationErrors, util.ProblemDetails{
+				Type:     "Validation Error",
+				Title:    "Bad Request",
+				Status:   400,
+				Detail:   "Only credit card accounts have closing and due days",
+				Instance: util.RFC400,
+			})
+		}
+
+		return validationErrors
+	}
+
+	if closingDay < 1 || closingDay > 31 || dueDay < 1 || dueDay > 31 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Credit card closing and due days must be between 1 and 31",
+			Instance: util.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+func (a *Account) ChangeName(newName string) []util.ProblemDetails {
+	validationErrors := validateAccountName(newName)
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	a.UpdatedAt = time.Now()
+	a.Name = newName
+
+	return validationErrors
+}
+
+func (a *Account) ChangeType(newType string, closingDay int, dueDay int) []util.ProblemDetails {
+	validationErrors := validateAccountType(newType)
+	validationErrors = append(validationErrors, validateStatementDays(newType, closingDay, dueDay)...)
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	a.UpdatedAt = time.Now()
+	a.Type = newType
+	a.ClosingDay = closingDay
+	a.DueDay = dueDay
+
+	return validationErrors
+}
+
+func (a *Account) ChangeCurrency(newCurrency string) []util.ProblemDetails {
+	validationErrors := validateAccountCurrency(newCurrency)
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	a.UpdatedAt = time.Now()
+	a.Currency = newCurrency
+
+	return validationErrors
+}
+
+func (a *Account) ChangeOpeningBalance(newOpeningBalance float64) {
+	a.UpdatedAt = time.Now()
+	a.OpeningBalance = newOpeningBalance
+}
+
+func (a *Account) IsCreditCard() bool {
+	return a.Type == ACCOUNT_TYPE_CREDIT_CARD && a.ClosingDay > 0 && a.DueDay > 0
+}
+
+// StatementPeriodFor returns the card statement a purchase made on date is
+// billed in. Purchases on the closing day still belong to that statement.
+func (a *Account) StatementPeriodFor(date time.Time) StatementPeriod {
+	closingDate := dayOfMonth(date.Year(), date.Month(), a.ClosingDay, date.Location())
+	if date.Day() > closingDate.Day() {
+		closingDate = dayOfMonth(date.Year(), date.Month()+1, a.ClosingDay, date.Location())
+	}
+
+	return a.statementPeriodClosingOn(closingDate)
+}
+
+// StatementPeriodDueIn returns the statement whose due date falls in the
+// given month.
+func (a *Account) StatementPeriodDueIn(year int, month time.Month, location *time.Location) StatementPeriod {
+	closingMonth := month
+	if a.DueDay <= a.ClosingDay {
+		closingMonth = month - 1
+	}
+
+	return a.statementPeriodClosingOn(dayOfMonth(year, closingMonth, a.ClosingDay, location))
+}
+
+func (a *Account) statementPeriodClosingOn(closingDate time.Time) StatementPeriod {
+	previousClosingDate := dayOfMonth(closingDate.Year(), closingDate.Month()-1, a.ClosingDay, closingDate.Location())
+
+	dueMonth := closingDate.Month()
+	if a.DueDay <= a.ClosingDay {
+		dueMonth++
+	}
+
+	return StatementPeriod{
+		StartDate:   previousClosingDate.AddDate(0, 0, 1),
+		ClosingDate: closingDate,
+		DueDate:     dayOfMonth(closingDate.Year(), dueMonth, a.DueDay, closingDate.Location()),
+	}
+}
+
+func dayOfMonth(year int, month time.Month, day int, location *time.Location) time.Time {
+	firstOfMonth := time.Date(year, month, 1, 0, 0, 0, 0, location)
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(firstOfMonth.Year(), firstOfMonth.Month(), day, 0, 0, 0, 0, location)
+}
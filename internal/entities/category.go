@@ -6,15 +6,40 @@ import (
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
 )
 
+const (
+	CATEGORY_KIND_EXPENSE = "expense"
+	CATEGORY_KIND_INCOME  = "income"
+)
+
+// Tax categories group the expenses that are deductible in the Brazilian
+// income tax return (IRPF). TAX_CATEGORY_NONE only applies to expenses, to
+// leave one out of a deductible category.
+const (
+	TAX_CATEGORY_HEALTH          = "health"
+	TAX_CATEGORY_EDUCATION       = "education"
+	TAX_CATEGORY_PRIVATE_PENSION = "private_pension"
+	TAX_CATEGORY_ALIMONY         = "alimony"
+	TAX_CATEGORY_DONATION        = "donation"
+	TAX_CATEGORY_NONE            = "none"
+)
+
+var TaxCategories = []string{TAX_CATEGORY_HEALTH, TAX_CATEGORY_EDUCATION, TAX_CATEGORY_PRIVATE_PENSION, TAX_CATEGORY_ALIMONY, TAX_CATEGORY_DONATION}
+
 type Category struct {
 	SharedEntity
-	UserID string `json:"user_id"`
-	Name   string `json:"name"`
-	Color  string `json:"color"`
+	UserID      string `json:"user_id"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Kind        string `json:"kind"`
+	TaxCategory string `json:"tax_category"`
 }
 
-func NewCategory(userID string, name string, color string) (*Category, []util.ProblemDetails) {
-	validationErrors := ValidateCategory(userID, name, color)
+func NewCategory(userID string, name string, color string, kind string) (*Category, []util.ProblemDetails) {
+	if kind == "" {
+		kind = CATEGORY_KIND_EXPENSE
+	}
+
+	validationErrors := ValidateCategory(userID, name, color, kind)
 
 	if len(validationErrors) > 0 {
 		return nil, validationErrors
@@ -25,10 +50,11 @@ func NewCategory(userID string, name string, color string) (*Category, []util.Pr
 		UserID:       userID,
 		Name:         name,
 		Color:        color,
+		Kind:         kind,
 	}, nil
 }
 
-func ValidateCategory(userID string, name string, color string) []util.ProblemDetails {
+func ValidateCategory(userID string, name string, color string, kind string) []util.ProblemDetails {
 	var validationErrors []util.ProblemDetails
 
 	if userID == "" {
@@ -71,6 +97,16 @@ func ValidateCategory(userID string, name string, color string) []util.ProblemDe
 		})
 	}
 
+	if kind != CATEGORY_KIND_EXPENSE && kind != CATEGORY_KIND_INCOME {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Category kind must be expense or income",
+			Instance: util.RFC400,
+		})
+	}
+
 	return validationErrors
 }
 
@@ -129,3 +165,48 @@ func (c *Category) ChangeColor(newColor string) []util.ProblemDetails {
 
 	return validationErrors
 }
+
+// ChangeTaxCategory marks the expenses of the category as deductible under
+// the tax category, or as not deductible when it is empty.
+func (c *Category) ChangeTaxCategory(newTaxCategory string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if newTaxCategory != "" && !IsTaxCategory(newTaxCategory) {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Tax category must be health, education, private_pension, alimony or donation",
+			Instance: util.RFC400,
+		})
+	}
+
+	if newTaxCategory != "" && c.Kind == CATEGORY_KIND_INCOME {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Only expense categories can be deductible",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	c.UpdatedAt = time.Now()
+	c.TaxCategory = newTaxCategory
+
+	return validationErrors
+}
+
+func IsTaxCategory(taxCategory string) bool {
+	for _, existing := range TaxCategories {
+		if existing == taxCategory {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,67 @@
+package entities
+
+import "time"
+
+const (
+	DIGEST_DELIVERY_STATUS_PENDING = "pending"
+	DIGEST_DELIVERY_STATUS_SENT    = "sent"
+	DIGEST_DELIVERY_STATUS_FAILED  = "failed"
+
+	DIGEST_DELIVERY_MAX_ATTEMPTS = 5
+)
+
+// DigestDelivery records the digest of one subscription for one period. It is
+// unique per subscription and period key, which is what keeps the scheduler
+// from sending the same digest twice.
+type DigestDelivery struct {
+	SharedEntity
+	UserID         string     `json:"user_id"`
+	SubscriptionID string     `json:"subscription_id"`
+	PeriodKey      string     `json:"period_key"`
+	PeriodStart    time.Time  `json:"period_start"`
+	PeriodEnd      time.Time  `json:"period_end"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	SentAt         *time.Time `json:"sent_at,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+}
+
+func NewDigestDelivery(subscription DigestSubscription, periodStart time.Time, periodEnd time.Time) *DigestDelivery {
+	return &DigestDelivery{
+		SharedEntity:   *NewSharedEntity(),
+		UserID:         subscription.UserID,
+		SubscriptionID: subscription.ID,
+		PeriodKey:      subscription.PeriodKey(periodStart),
+		PeriodStart:    periodStart,
+		PeriodEnd:      periodEnd,
+		Status:         DIGEST_DELIVERY_STATUS_PENDING,
+	}
+}
+
+func (d *DigestDelivery) MarkSent() {
+	now := time.Now()
+
+	d.Attempts++
+	d.Status = DIGEST_DELIVERY_STATUS_SENT
+	d.SentAt = &now
+	d.LastError = ""
+	d.UpdatedAt = now
+}
+
+func (d *DigestDelivery) MarkFailed(sendErr error) {
+	d.Attempts++
+	d.Status = DIGEST_DELIVERY_STATUS_FAILED
+	d.LastError = sendErr.Error()
+	d.UpdatedAt = time.Now()
+}
+
+// Retry puts a failed delivery back to pending. Attempts is left alone so the
+// repository can use it to claim the retry only once.
+func (d *DigestDelivery) Retry() {
+	d.Status = DIGEST_DELIVERY_STATUS_PENDING
+	d.UpdatedAt = time.Now()
+}
+
+func (d *DigestDelivery) CanRetry() bool {
+	return d.Status == DIGEST_DELIVERY_STATUS_FAILED && d.Attempts < DIGEST_DELIVERY_MAX_ATTEMPTS
+}
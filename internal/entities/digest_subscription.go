@@ -0,0 +1,120 @@
+package entities
+
+import (
+	"net/mail"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+const (
+	DIGEST_FREQUENCY_WEEKLY  = "weekly"
+	DIGEST_FREQUENCY_MONTHLY = "monthly"
+)
+
+// DigestSubscription sends a spending digest to Email. Digests only go out
+// once the subscription is verified: either Email is the account's own
+// address or its owner followed the confirmation link.
+type DigestSubscription struct {
+	SharedEntity
+	UserID     string     `json:"user_id"`
+	Email      string     `json:"email"`
+	Frequency  string     `json:"frequency"`
+	Verified   bool       `json:"verified"`
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+}
+
+func NewDigestSubscription(userID string, email string, frequency string) (*DigestSubscription, []util.ProblemDetails) {
+	validationErrors := ValidateDigestSubscription(userID, email, frequency)
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	address, _ := mail.ParseAddress(email)
+
+	return &DigestSubscription{
+		SharedEntity: *NewSharedEntity(),
+		UserID:       userID,
+		Email:        address.Address,
+		Frequency:    frequency,
+	}, nil
+}
+
+func ValidateDigestSubscription(userID string, email string, frequency string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if userID == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing user id",
+			Instance: util.RFC400,
+		})
+	}
+
+	if _, err := mail.ParseAddress(email); err != nil {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Invalid email address",
+			Instance: util.RFC400,
+		})
+	}
+
+	if frequency != DIGEST_FREQUENCY_WEEKLY && frequency != DIGEST_FREQUENCY_MONTHLY {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Frequency must be weekly or monthly",
+			Instance: util.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+func (d *DigestSubscription) Verify() {
+	timeNow := time.Now()
+
+	d.Verified = true
+	d.VerifiedAt = &timeNow
+	d.UpdatedAt = timeNow
+}
+
+// PreviousPeriod returns the last complete week, starting on weekStart, or
+// calendar month before now, with an inclusive end so it can be used with
+// BETWEEN.
+func (d *DigestSubscription) PreviousPeriod(now time.Time, weekStart time.Weekday) (time.Time, time.Time) {
+	if d.Frequency == DIGEST_FREQUENCY_MONTHLY {
+		currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		return currentMonth.AddDate(0, -1, 0), currentMonth.Add(-time.Nanosecond)
+	}
+
+	currentWeek := util.StartOfWeek(now, weekStart)
+
+	return currentWeek.AddDate(0, 0, -7), currentWeek.Add(-time.Nanosecond)
+}
+
+// PriorPeriod returns the period right before the one starting at periodStart.
+func (d *DigestSubscription) PriorPeriod(periodStart time.Time) (time.Time, time.Time) {
+	if d.Frequency == DIGEST_FREQUENCY_MONTHLY {
+		return periodStart.AddDate(0, -1, 0), periodStart.Add(-time.Nanosecond)
+	}
+
+	return periodStart.AddDate(0, 0, -7), periodStart.Add(-time.Nanosecond)
+}
+
+// PeriodKey names the period starting at periodStart by the calendar date its
+// week starts on or by its month, which stays the same when the user's
+// timezone changes and the instant periodStart points to moves.
+func (d *DigestSubscription) PeriodKey(periodStart time.Time) string {
+	if d.Frequency == DIGEST_FREQUENCY_MONTHLY {
+		return periodStart.Format("2006-01")
+	}
+
+	return periodStart.Format("2006-01-02")
+}
@@ -0,0 +1,8 @@
+package entities
+
+type EmailMessage struct {
+	To       string `json:"to"`
+	Subject  string `json:"subject"`
+	HTMLBody string `json:"html_body"`
+	TextBody string `json:"text_body"`
+}
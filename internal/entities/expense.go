@@ -8,14 +8,26 @@ import (
 
 type Expense struct {
 	SharedEntity
-	UserID      string    `json:"user_id"`
-	Amount      float64   `json:"amount,string,omitempty"`
-	ExpenseDate time.Time `json:"expense_date"`
-	CategoryID  string    `json:"category_id"`
-	TagIDs      []string  `json:"tag_ids"`
-	Notes       string    `json:"notes"`
-	Category    Category  `json:"category"`
-	Tags        []Tag     `json:"tags"`
+	UserID                string           `json:"user_id"`
+	Amount                float64          `json:"amount,string,omitempty"`
+	ExpenseDate           time.Time        `json:"expense_date"`
+	CategoryID            string           `json:"category_id"`
+	AccountID             string           `json:"account_id"`
+	MerchantID            string           `json:"merchant_id,omitempty"`
+	InstallmentPurchaseID string           `json:"installment_purchase_id,omitempty"`
+	InstallmentNumber     int              `json:"installment_number,omitempty"`
+	StatementDate         *time.Time       `json:"statement_date,omitempty"`
+	TagIDs                []string         `json:"tag_ids"`
+	Notes                 string           `json:"notes"`
+	TaxCategory           string           `json:"tax_category,omitempty"`
+	ProviderTaxID         string           `json:"provider_tax_id,omitempty"`
+	ProviderName          string           `json:"provider_name,omitempty"`
+	Latitude              *float64         `json:"latitude,omitempty"`
+	Longitude             *float64         `json:"longitude,omitempty"`
+	PlaceName             string           `json:"place_name,omitempty"`
+	Category              Category         `json:"category"`
+	Tags                  []Tag            `json:"tags"`
+	Anomalies             []ExpenseAnomaly `json:"anomalies,omitempty"`
 }
 
 func NewExpense(userID string, amount float64, expenseDate time.Time, categoryID string, notes string) (*Expense, []util.ProblemDetails) {
@@ -104,7 +116,7 @@ func (e *Expense) ChangeAmount(newAmount float64) []util.ProblemDetails {
 	}
 }
 
-func (e *Expense) ChangeExpenseDate(expenseDate string) []util.ProblemDetails {
+func (e *Expense) ChangeExpenseDate(expenseDate string, timezone string) []util.ProblemDetails {
 	var validationErrors []util.ProblemDetails
 
 	if expenseDate == "" {
@@ -121,7 +133,7 @@ func (e *Expense) ChangeExpenseDate(expenseDate string) []util.ProblemDetails {
 		return validationErrors
 	}
 
-	newExpenseDate, parseDateErr := util.ParseDate(expenseDate)
+	newExpenseDate, parseDateErr := util.ParseDate(expenseDate, timezone)
 	if parseDateErr != nil {
 		validationErrors = append(validationErrors, util.ProblemDetails{
 			Type:     "Validation Error",
@@ -165,6 +177,28 @@ func (e *Expense) ChangeCategory(newCategoryID string) []util.ProblemDetails {
 	}
 }
 
+func (e *Expense) ChangeAccount(newAccountID string) {
+	e.UpdatedAt = time.Now()
+	e.AccountID = newAccountID
+}
+
+func (e *Expense) ChangeMerchant(newMerchantID string) {
+	e.UpdatedAt = time.Now()
+	e.MerchantID = newMerchantID
+}
+
+// AssignStatement bills the expense in the card statement of its day in the
+// user's location, whatever location ExpenseDate was loaded in.
+func (e *Expense) AssignStatement(account *Account, location *time.Location) {
+	if account == nil || !account.IsCreditCard() {
+		e.StatementDate = nil
+		return
+	}
+
+	dueDate := account.StatementPeriodFor(e.ExpenseDate.In(location)).DueDate
+	e.StatementDate = &dueDate
+}
+
 func (e *Expense) ChangeNotes(newNotes string) []util.ProblemDetails {
 	var validationErrors []util.ProblemDetails
 
@@ -232,3 +266,145 @@ func (e *Expense) AddTagByID(tagID string) []util.ProblemDetails {
 
 	return validationErrors
 }
+
+func (e *Expense) HasTag(tagID string) bool {
+	for _, existingTagID := range e.TagIDs {
+		if existingTagID == tagID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ChangeTaxCategory overrides the tax category of the expense's category. An
+// empty value follows the category and TAX_CATEGORY_NONE makes the expense not
+// deductible.
+func (e *Expense) ChangeTaxCategory(newTaxCategory string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if newTaxCategory != "" && newTaxCategory != TAX_CATEGORY_NONE && !IsTaxCategory(newTaxCategory) {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Tax category must be health, education, private_pension, alimony, donation or none",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	e.UpdatedAt = time.Now()
+	e.TaxCategory = newTaxCategory
+
+	return validationErrors
+}
+
+// ChangeProvider stores who was paid, identified by CPF or CNPJ as the income
+// tax return asks for deductible expenses. The tax ID is stored without
+// punctuation.
+func (e *Expense) ChangeProvider(newTaxID string, newName string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	newTaxID = util.NormalizeTaxID(newTaxID)
+
+	if newTaxID != "" && !util.IsValidCPF(newTaxID) && !util.IsValidCNPJ(newTaxID) {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Provider tax ID must be a valid CPF or CNPJ",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(newName) > 100 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Provider name cannot exceed 100 characters",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	e.UpdatedAt = time.Now()
+	e.ProviderTaxID = newTaxID
+	e.ProviderName = newName
+
+	return validationErrors
+}
+
+// ChangeLocation stores where the expense was made, as sent by the client.
+// Latitude and longitude go together; leaving both out clears the location.
+func (e *Expense) ChangeLocation(newLatitude *float64, newLongitude *float64, newPlaceName string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if (newLatitude == nil) != (newLongitude == nil) {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Latitude and longitude must be sent together",
+			Instance: util.RFC400,
+		})
+	}
+
+	if newLatitude != nil && !util.IsValidLatitude(*newLatitude) {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Latitude must be between -90 and 90",
+			Instance: util.RFC400,
+		})
+	}
+
+	if newLongitude != nil && !util.IsValidLongitude(*newLongitude) {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Longitude must be between -180 and 180",
+			Instance: util.RFC400,
+		})
+	}
+
+	if newPlaceName != "" && newLatitude == nil {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Place name requires latitude and longitude",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(newPlaceName) > 100 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Place name cannot exceed 100 characters",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	e.UpdatedAt = time.Now()
+	e.Latitude = newLatitude
+	e.Longitude = newLongitude
+	e.PlaceName = newPlaceName
+
+	return validationErrors
+}
@@ -0,0 +1,148 @@
+package entities
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	ANOMALY_KIND_AMOUNT_OUTLIER = "amount_outlier"
+	ANOMALY_KIND_DUPLICATE      = "duplicate"
+	ANOMALY_WINDOW_DAYS         = 90
+	ANOMALY_MIN_SAMPLES         = 5
+	ANOMALY_SCORE_THRESHOLD     = 3.5
+	ANOMALY_MIN_SCALE_RATIO     = 0.1
+)
+
+type ExpenseAnomaly struct {
+	SharedEntity
+	UserID           string     `json:"user_id"`
+	ExpenseID        string     `json:"expense_id"`
+	Kind             string     `json:"kind"`
+	Score            float64    `json:"score"`
+	Baseline         float64    `json:"baseline"`
+	RelatedExpenseID string     `json:"related_expense_id,omitempty"`
+	Explanation      string     `json:"explanation"`
+	Dismissed        bool       `json:"dismissed"`
+	DismissedAt      *time.Time `json:"dismissed_at,omitempty"`
+}
+
+func NewExpenseAnomaly(expense Expense, kind string, score float64, baseline float64, relatedExpenseID string, explanation string) *ExpenseAnomaly {
+	return &ExpenseAnomaly{
+		SharedEntity:     *NewSharedEntity(),
+		UserID:           expense.UserID,
+		ExpenseID:        expense.ID,
+		Kind:             kind,
+		Score:            score,
+		Baseline:         baseline,
+		RelatedExpenseID: relatedExpenseID,
+		Explanation:      explanation,
+	}
+}
+
+func (a *ExpenseAnomaly) Dismiss() {
+	timeNow := time.Now()
+	a.Dismissed = true
+	a.DismissedAt = &timeNow
+	a.UpdatedAt = timeNow
+}
+
+// DetectExpenseAnomalies compares expense with the same-category history of
+// the trailing ANOMALY_WINDOW_DAYS. Amounts are scored with the modified
+// z-score 0.6745 * (x - median) / MAD, so a few large past expenses do not
+// shift the baseline; only unusually high amounts are flagged. When more
+// than half of the history has the same amount the MAD is zero, so the
+// mean absolute deviation is used instead, and when every amount is the same
+// the scale falls back to ANOMALY_MIN_SCALE_RATIO of the median. An expense
+// with the same amount, day and notes as one in the history is flagged as a
+// duplicate, comparing days in the user's location. Installments are skipped,
+// their amounts are known in advance.
+func DetectExpenseAnomalies(expense Expense, history []Expense, location *time.Location) []ExpenseAnomaly {
+	anomalies := []ExpenseAnomaly{}
+
+	if expense.InstallmentPurchaseID != "" {
+		return anomalies
+	}
+
+	var amounts []float64
+	var duplicateFound bool
+	for _, past := range history {
+		if past.ID == expense.ID || past.InstallmentPurchaseID != "" {
+			continue
+		}
+
+		if !duplicateFound && isDuplicateExpense(expense, past, location) {
+			duplicateFound = true
+			explanation := fmt.Sprintf("Same amount (%.2f), category and date as an expense created on %s", expense.Amount, past.CreatedAt.Format("02/01/2006 15:04"))
+			anomalies = append(anomalies, *NewExpenseAnomaly(expense, ANOMALY_KIND_DUPLICATE, 1, past.Amount, past.ID, explanation))
+		}
+
+		amounts = append(amounts, past.Amount)
+	}
+
+	if len(amounts) < ANOMALY_MIN_SAMPLES {
+		return anomalies
+	}
+
+	baseline := median(amounts)
+
+	deviations := make([]float64, len(amounts))
+	for i, amount := range amounts {
+		deviations[i] = math.Abs(amount - baseline)
+	}
+
+	if expense.Amount <= baseline {
+		return anomalies
+	}
+
+	var score float64
+	if mad := median(deviations); mad > 0 {
+		score = 0.6745 * (expense.Amount - baseline) / mad
+	} else if meanDeviation := mean(deviations); meanDeviation > 0 {
+		score = (expense.Amount - baseline) / (1.253314 * meanDeviation)
+	} else {
+		score = 0.6745 * (expense.Amount - baseline) / math.Max(baseline*ANOMALY_MIN_SCALE_RATIO, 0.01)
+	}
+	if score > ANOMALY_SCORE_THRESHOLD {
+		explanation := fmt.Sprintf("Amount %.2f is %.1fx the median of %.2f for this category over the last %d days (%d expenses)", expense.Amount, expense.Amount/baseline, baseline, ANOMALY_WINDOW_DAYS, len(amounts))
+		anomalies = append(anomalies, *NewExpenseAnomaly(expense, ANOMALY_KIND_AMOUNT_OUTLIER, score, baseline, "", explanation))
+	}
+
+	return anomalies
+}
+
+func isDuplicateExpense(expense Expense, other Expense, location *time.Location) bool {
+	if math.Round(expense.Amount*100) != math.Round(other.Amount*100) || expense.CategoryID != other.CategoryID {
+		return false
+	}
+
+	if expense.ExpenseDate.In(location).Format("02012006") != other.ExpenseDate.In(location).Format("02012006") {
+		return false
+	}
+
+	return strings.EqualFold(strings.TrimSpace(expense.Notes), strings.TrimSpace(other.Notes))
+}
+
+func median(values []float64) float64 {
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+
+	return sorted[middle]
+}
+
+func mean(values []float64) float64 {
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum / float64(len(values))
+}
@@ -0,0 +1,130 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type Goal struct {
+	SharedEntity
+	UserID       string     `json:"user_id"`
+	Name         string     `json:"name"`
+	TargetAmount float64    `json:"target_amount,string"`
+	Deadline     *time.Time `json:"deadline,omitempty"`
+	CategoryID   string     `json:"category_id,omitempty"`
+}
+
+func NewGoal(userID string, name string, targetAmount float64, deadline *time.Time, categoryID string) (*Goal, []util.ProblemDetails) {
+	validationErrors := ValidateGoal(userID, name, targetAmount)
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return &Goal{
+		SharedEntity: *NewSharedEntity(),
+		UserID:       userID,
+		Name:         name,
+		TargetAmount: targetAmount,
+		Deadline:     deadline,
+		CategoryID:   categoryID,
+	}, nil
+}
+
+func ValidateGoal(userID string, name string, targetAmount float64) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if userID == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing user id",
+			Instance: util.RFC400,
+		})
+	}
+
+	validationErrors = append(validationErrors, validateGoalName(name)...)
+	validationErrors = append(validationErrors, validateGoalTargetAmount(targetAmount)...)
+
+	return validationErrors
+}
+
+func validateGoalName(name string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if name == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing goal name",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(name) > 100 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Goal name cannot exceed 100 characters",
+			Instance: util.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+func validateGoalTargetAmount(targetAmount float64) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if targetAmount <= 0 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Target amount must be greater than 0",
+			Instance: util.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+func (g *Goal) ChangeName(newName string) []util.ProblemDetails {
+	validationErrors := validateGoalName(newName)
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	g.UpdatedAt = time.Now()
+	g.Name = newName
+
+	return validationErrors
+}
+
+func (g *Goal) ChangeTargetAmount(newTargetAmount float64) []util.ProblemDetails {
+	validationErrors := validateGoalTargetAmount(newTargetAmount)
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	g.UpdatedAt = time.Now()
+	g.TargetAmount = newTargetAmount
+
+	return validationErrors
+}
+
+func (g *Goal) ChangeDeadline(newDeadline *time.Time) {
+	g.UpdatedAt = time.Now()
+	g.Deadline = newDeadline
+}
+
+func (g *Goal) ChangeCategory(newCategoryID string) {
+	g.UpdatedAt = time.Now()
+	g.CategoryID = newCategoryID
+}
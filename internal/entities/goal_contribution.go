@@ -0,0 +1,79 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GoalContribution struct {
+	SharedEntity
+	UserID           string    `json:"user_id"`
+	GoalID           string    `json:"goal_id"`
+	Amount           float64   `json:"amount,string"`
+	ContributionDate time.Time `json:"contribution_date"`
+	Notes            string    `json:"notes"`
+}
+
+func NewGoalContribution(userID string, goalID string, amount float64, contributionDate time.Time, notes string) (*GoalContribution, []util.ProblemDetails) {
+	validationErrors := ValidateGoalContribution(userID, goalID, amount, notes)
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return &GoalContribution{
+		SharedEntity:     *NewSharedEntity(),
+		UserID:           userID,
+		GoalID:           goalID,
+		Amount:           amount,
+		ContributionDate: contributionDate,
+		Notes:            notes,
+	}, nil
+}
+
+func ValidateGoalContribution(userID string, goalID string, amount float64, notes string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if userID == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing user id",
+			Instance: util.RFC400,
+		})
+	}
+
+	if goalID == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing goal id",
+			Instance: util.RFC400,
+		})
+	}
+
+	if amount == 0 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Amount cannot be zero",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(notes) > 200 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Notes cannot exceed 200 characters",
+			Instance: util.RFC400,
+		})
+	}
+
+	return validationErrors
+}
@@ -0,0 +1,179 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type Income struct {
+	SharedEntity
+	UserID     string    `json:"user_id"`
+	Amount     float64   `json:"amount,string,omitempty"`
+	IncomeDate time.Time `json:"income_date"`
+	CategoryID string    `json:"category_id"`
+	AccountID  string    `json:"account_id"`
+	Notes      string    `json:"notes"`
+	Category   Category  `json:"category"`
+}
+
+func NewIncome(userID string, amount float64, incomeDate time.Time, categoryID string, notes string) (*Income, []util.ProblemDetails) {
+	validationErrors := ValidateIncome(userID, amount, categoryID, notes)
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return &Income{
+		SharedEntity: *NewSharedEntity(),
+		UserID:       userID,
+		Amount:       amount,
+		IncomeDate:   incomeDate,
+		CategoryID:   categoryID,
+		Notes:        notes,
+	}, nil
+}
+
+func ValidateIncome(userID string, amount float64, categoryID string, notes string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if userID == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing user ID",
+			Instance: util.RFC400,
+		})
+	}
+
+	if amount <= 0 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Amount must be greater than 0",
+			Instance: util.RFC400,
+		})
+	}
+
+	if categoryID == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing category ID",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(notes) > 200 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Notes cannot exceed 200 characters",
+			Instance: util.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+func (i *Income) ChangeAmount(newAmount float64) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if newAmount <= 0 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "New amount must be greater than 0",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	i.UpdatedAt = time.Now()
+	i.Amount = newAmount
+
+	return validationErrors
+}
+
+func (i *Income) ChangeIncomeDate(incomeDate string, timezone string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	newIncomeDate, parseDateErr := util.ParseDate(incomeDate, timezone)
+	if parseDateErr != nil {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Invalid income date format",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	i.UpdatedAt = time.Now()
+	i.IncomeDate = newIncomeDate
+
+	return validationErrors
+}
+
+func (i *Income) ChangeCategory(newCategoryID string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if newCategoryID == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing new category ID",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	i.UpdatedAt = time.Now()
+	i.CategoryID = newCategoryID
+
+	return validationErrors
+}
+
+func (i *Income) ChangeAccount(newAccountID string) {
+	i.UpdatedAt = time.Now()
+	i.AccountID = newAccountID
+}
+
+func (i *Income) ChangeNotes(newNotes string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if len(newNotes) > 200 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "New notes cannot exceed 200 characters",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	i.UpdatedAt = time.Now()
+	i.Notes = newNotes
+
+	return validationErrors
+}
@@ -0,0 +1,213 @@
+package entities
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+const (
+	INSTALLMENT_REMAINDER_FIRST = "first"
+	INSTALLMENT_REMAINDER_LAST  = "last"
+	INSTALLMENT_MAX_COUNT       = 72
+)
+
+type InstallmentPurchase struct {
+	SharedEntity
+	UserID           string    `json:"user_id"`
+	TotalAmount      float64   `json:"total_amount,string,omitempty"`
+	InstallmentCount int       `json:"installment_count"`
+	FirstDueDate     time.Time `json:"first_due_date"`
+	RemainderPolicy  string    `json:"remainder_policy"`
+	CategoryID       string    `json:"category_id"`
+	AccountID        string    `json:"account_id"`
+	Notes            string    `json:"notes"`
+	Installments     []Expense `json:"installments"`
+}
+
+func NewInstallmentPurchase(userID string, totalAmount float64, installmentCount int, firstDueDate time.Time, remainderPolicy string, categoryID string, accountID string, notes string) (*InstallmentPurchase, []util.ProblemDetails) {
+	if remainderPolicy == "" {
+		remainderPolicy = INSTALLMENT_REMAINDER_FIRST
+	}
+
+	validationErrors := ValidateInstallmentPurchase(userID, totalAmount, installmentCount, remainderPolicy, categoryID, notes)
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return &InstallmentPurchase{
+		SharedEntity:     *NewSharedEntity(),
+		UserID:           userID,
+		TotalAmount:      totalAmount,
+		InstallmentCount: installmentCount,
+		FirstDueDate:     firstDueDate,
+		RemainderPolicy:  remainderPolicy,
+		CategoryID:       categoryID,
+		AccountID:        accountID,
+		Notes:            notes,
+	}, nil
+}
+
+func ValidateInstallmentPurchase(userID string, totalAmount float64, installmentCount int, remainderPolicy string, categoryID string, notes string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if userID == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing user ID",
+			Instance: util.RFC400,
+		})
+	}
+
+	if totalAmount <= 0 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Total amount must be greater than 0",
+			Instance: util.RFC400,
+		})
+	}
+
+	if installmentCount < 1 || installmentCount > INSTALLMENT_MAX_COUNT {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   fmt.Sprintf("Installment count must be between 1 and %d", INSTALLMENT_MAX_COUNT),
+			Instance: util.RFC400,
+		})
+	}
+
+	if totalAmount > 0 && installmentCount > 0 && math.Round(totalAmount*100) < float64(installmentCount) {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Each installment must be at least 0.01",
+			Instance: util.RFC400,
+		})
+	}
+
+	if remainderPolicy != INSTALLMENT_REMAINDER_FIRST && remainderPolicy != INSTALLMENT_REMAINDER_LAST {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Remainder policy must be first or last",
+			Instance: util.RFC400,
+		})
+	}
+
+	if categoryID == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing category ID",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(notes) > 200 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Notes cannot exceed 200 characters",
+			Instance: util.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+func (p *InstallmentPurchase) Change(totalAmount float64, installmentCount int, firstDueDate time.Time, remainderPolicy string, categoryID string, accountID string, notes string) []util.ProblemDetails {
+	validationErrors := ValidateInstallmentPurchase(p.UserID, totalAmount, installmentCount, remainderPolicy, categoryID, notes)
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	p.UpdatedAt = time.Now()
+	p.TotalAmount = totalAmount
+	p.InstallmentCount = installmentCount
+	p.FirstDueDate = firstDueDate
+	p.RemainderPolicy = remainderPolicy
+	p.CategoryID = categoryID
+	p.AccountID = accountID
+	p.Notes = notes
+
+	return validationErrors
+}
+
+// GenerateInstallments splits what is left of the purchase, after the
+// installments already paid, into one child expense per remaining month.
+func (p *InstallmentPurchase) GenerateInstallments(paidCount int, paidAmount float64) ([]Expense, []util.ProblemDetails) {
+	remainingCount := p.InstallmentCount - paidCount
+	remainingCents := int64(math.Round(p.TotalAmount*100) - math.Round(paidAmount*100))
+
+	if remainingCount <= 0 || remainingCents < int64(remainingCount) {
+		return nil, []util.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Bad Request",
+				Status:   400,
+				Detail:   "Total amount and installment count must cover the installments already paid",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	baseCents := remainingCents / int64(remainingCount)
+	remainderCents := remainingCents - baseCents*int64(remainingCount)
+
+	var installments []Expense
+
+	for i := 0; i < remainingCount; i++ {
+		number := paidCount + i + 1
+
+		cents := baseCents
+		if (p.RemainderPolicy == INSTALLMENT_REMAINDER_FIRST && i == 0) || (p.RemainderPolicy == INSTALLMENT_REMAINDER_LAST && i == remainingCount-1) {
+			cents += remainderCents
+		}
+
+		suffix := fmt.Sprintf(" (%d/%d)", number, p.InstallmentCount)
+		notes := []rune(p.Notes)
+		for len(string(notes))+len(suffix) > 200 {
+			notes = notes[:len(notes)-1]
+		}
+
+		installment, installmentErr := NewExpense(p.UserID, float64(cents)/100, AddMonthsClamped(p.FirstDueDate, number-1), p.CategoryID, string(notes)+suffix)
+		if len(installmentErr) > 0 {
+			return nil, installmentErr
+		}
+
+		installment.AccountID = p.AccountID
+		installment.InstallmentPurchaseID = p.ID
+		installment.InstallmentNumber = number
+
+		installments = append(installments, *installment)
+	}
+
+	return installments, nil
+}
+
+// AddMonthsClamped moves a date forward by whole months, keeping the day of
+// month when possible and falling back to the last day of shorter months.
+func AddMonthsClamped(date time.Time, months int) time.Time {
+	firstOfMonth := time.Date(date.Year(), date.Month()+time.Month(months), 1, date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), date.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	day := date.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(firstOfMonth.Year(), firstOfMonth.Month(), day, date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), date.Location())
+}
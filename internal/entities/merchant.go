@@ -0,0 +1,244 @@
+package entities
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+// merchantProcessors are the payment processors that prefix card statement
+// descriptions, as in "MP*LOJA" or "PAYPAL *NETFLIX". Their merchant follows
+// the "*"; for anyone else it comes first, as in "UBER *TRIP".
+var merchantProcessors = map[string]bool{
+	"ebanx": true, "ec": true, "ifd": true, "mercpago": true, "mp": true, "pag": true,
+	"pagseguro": true, "paypal": true, "pg": true, "pp": true, "sq": true,
+}
+
+// merchantNoise are company suffixes and web leftovers that do not tell
+// merchants apart.
+var merchantNoise = map[string]bool{
+	"bv": true, "br": true, "co": true, "com": true, "eireli": true, "inc": true, "llc": true,
+	"ltd": true, "ltda": true, "me": true, "sa": true, "www": true,
+}
+
+var merchantAccents = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a", "ç", "c", "é", "e", "ê", "e", "è", "e", "ë", "e",
+	"í", "i", "î", "i", "ì", "i", "ï", "i", "ñ", "n", "ó", "o", "ô", "o", "õ", "o", "ò", "o", "ö", "o",
+	"ú", "u", "û", "u", "ù", "u", "ü", "u",
+)
+
+// MERCHANT_AUTO_NAME_MAX_LENGTH caps the name of a merchant created from an
+// expense description. Notes can be free text, and only their first words
+// tend to name the merchant.
+const MERCHANT_AUTO_NAME_MAX_LENGTH = 40
+
+type Merchant struct {
+	SharedEntity
+	UserID  string   `json:"user_id"`
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+}
+
+// NewMerchant normalizes the aliases and always adds the name, so a merchant
+// matches its own name.
+func NewMerchant(userID string, name string, aliases []string) (*Merchant, []util.ProblemDetails) {
+	validationErrors := ValidateMerchant(userID, name)
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return &Merchant{
+		SharedEntity: *NewSharedEntity(),
+		UserID:       userID,
+		Name:         name,
+		Aliases:      normalizeMerchantAliases(name, aliases),
+	}, nil
+}
+
+func ValidateMerchant(userID string, name string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if userID == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing user id",
+			Instance: util.RFC400,
+		})
+	}
+
+	if name == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing merchant name",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(name) > 100 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Merchant name cannot exceed 100 characters",
+			Instance: util.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+func (m *Merchant) ChangeName(newName string) []util.ProblemDetails {
+	validationErrors := ValidateMerchant(m.UserID, newName)
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	m.UpdatedAt = time.Now()
+	m.Name = newName
+	m.Aliases = normalizeMerchantAliases(newName, m.Aliases)
+
+	return validationErrors
+}
+
+func (m *Merchant) ChangeAliases(newAliases []string) {
+	m.UpdatedAt = time.Now()
+	m.Aliases = normalizeMerchantAliases(m.Name, newAliases)
+}
+
+// AddAlias reports whether the alias was new.
+func (m *Merchant) AddAlias(alias string) bool {
+	alias = NormalizeMerchantDescription(alias)
+	if alias == "" {
+		return false
+	}
+
+	for _, existing := range m.Aliases {
+		if existing == alias {
+			return false
+		}
+	}
+
+	m.UpdatedAt = time.Now()
+	m.Aliases = append(m.Aliases, alias)
+
+	return true
+}
+
+// Match returns the length of the longest alias the normalized description
+// starts with, word for word, or 0 when none does.
+func (m *Merchant) Match(description string) int {
+	longest := 0
+
+	for _, alias := range m.Aliases {
+		if len(alias) > longest && (description == alias || strings.HasPrefix(description, alias+" ")) {
+			longest = len(alias)
+		}
+	}
+
+	return longest
+}
+
+// MatchMerchant finds the merchant of a raw description: the one with the
+// longest matching alias, so "uber eats" wins over "uber" for "UBER EATS
+// 123". It returns nil when no merchant matches.
+func MatchMerchant(merchants []Merchant, description string) *Merchant {
+	description = NormalizeMerchantDescription(description)
+	if description == "" {
+		return nil
+	}
+
+	var match *Merchant
+	longest := 0
+
+	for i := range merchants {
+		if length := merchants[i].Match(description); length > longest {
+			match = &merchants[i]
+			longest = length
+		}
+	}
+
+	return match
+}
+
+// NormalizeMerchantDescription reduces a raw description to the words that
+// name its merchant: lower case without accents, without the payment
+// processor prefix or the detail after a "*", and without numbers, company
+// suffixes or punctuation. "UBER *TRIP", "Uber BV" and "uber" all become
+// "uber".
+func NormalizeMerchantDescription(description string) string {
+	description = merchantAccents.Replace(strings.ToLower(description))
+
+	if prefix, rest, found := strings.Cut(description, "*"); found {
+		if merchantProcessors[strings.TrimSpace(prefix)] {
+			description = rest
+			if _, detail, nested := strings.Cut(rest, "*"); nested {
+				description = detail
+			}
+		} else if strings.TrimSpace(prefix) != "" {
+			description = prefix
+		} else {
+			description = rest
+		}
+	}
+
+	var words []string
+	for _, word := range strings.FieldsFunc(description, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if merchantNoise[word] || strings.IndexFunc(word, unicode.IsLetter) < 0 {
+			continue
+		}
+		words = append(words, word)
+	}
+
+	return strings.Join(words, " ")
+}
+
+func normalizeMerchantAliases(name string, aliases []string) []string {
+	normalized := []string{}
+	seen := map[string]bool{}
+
+	for _, alias := range append([]string{name}, aliases...) {
+		alias = NormalizeMerchantDescription(alias)
+		if alias != "" && !seen[alias] {
+			seen[alias] = true
+			normalized = append(normalized, alias)
+		}
+	}
+
+	return normalized
+}
+
+// MerchantNameFromDescription names a merchant created from a description,
+// capitalizing the leading words of its normalized form that fit in
+// MERCHANT_AUTO_NAME_MAX_LENGTH. It returns "" when no letters remain or the
+// first word alone is too long, and then no merchant should be created.
+func MerchantNameFromDescription(description string) string {
+	normalized := NormalizeMerchantDescription(description)
+	if strings.IndexFunc(normalized, unicode.IsLetter) < 0 {
+		return ""
+	}
+
+	var name []string
+	length := -1
+
+	for _, word := range strings.Fields(normalized) {
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+
+		if length += len(string(runes)) + 1; length > MERCHANT_AUTO_NAME_MAX_LENGTH {
+			break
+		}
+		name = append(name, string(runes))
+	}
+
+	return strings.Join(name, " ")
+}
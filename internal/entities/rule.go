@@ -0,0 +1,389 @@
+package entities
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type RuleConditions struct {
+	NotesContains string  `json:"notes_contains"`
+	NotesRegex    string  `json:"notes_regex"`
+	MinAmount     float64 `json:"min_amount,string,omitempty"`
+	MaxAmount     float64 `json:"max_amount,string,omitempty"`
+	Weekdays      []int   `json:"weekdays"`
+}
+
+type RuleActions struct {
+	SetCategoryID string   `json:"set_category_id"`
+	AddTagIDs     []string `json:"add_tag_ids"`
+	RewriteNotes  string   `json:"rewrite_notes"`
+}
+
+type Rule struct {
+	SharedEntity
+	UserID         string         `json:"user_id"`
+	Name           string         `json:"name"`
+	Priority       int            `json:"priority"`
+	StopProcessing bool           `json:"stop_processing"`
+	Conditions     RuleConditions `json:"conditions"`
+	Actions        RuleActions    `json:"actions"`
+
+	notesRegex *regexp.Regexp
+}
+
+func NewRule(userID string, name string, priority int, stopProcessing bool, conditions RuleConditions, actions RuleActions) (*Rule, []util.ProblemDetails) {
+	validationErrors := ValidateRule(userID, name, priority, conditions, actions)
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return &Rule{
+		SharedEntity:   *NewSharedEntity(),
+		UserID:         userID,
+		Name:           name,
+		Priority:       priority,
+		StopProcessing: stopProcessing,
+		Conditions:     conditions,
+		Actions:        actions,
+	}, nil
+}
+
+func ValidateRule(userID string, name string, priority int, conditions RuleConditions, actions RuleActions) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if userID == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing user id",
+			Instance: util.RFC400,
+		})
+	}
+
+	if name == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing rule name",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(name) > 100 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Rule name cannot exceed 100 characters",
+			Instance: util.RFC400,
+		})
+	}
+
+	if priority < 0 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Priority cannot be negative",
+			Instance: util.RFC400,
+		})
+	}
+
+	validationErrors = append(validationErrors, ValidateRuleConditions(conditions)...)
+	validationErrors = append(validationErrors, ValidateRuleActions(actions)...)
+
+	return validationErrors
+}
+
+func ValidateRuleConditions(conditions RuleConditions) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if conditions.NotesContains == "" && conditions.NotesRegex == "" && conditions.MinAmount == 0 && conditions.MaxAmount == 0 && len(conditions.Weekdays) == 0 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Rule must have at least one condition",
+			Instance: util.RFC400,
+		})
+	}
+
+	if conditions.NotesRegex != "" {
+		if _, err := regexp.Compile(conditions.NotesRegex); err != nil {
+			validationErrors = append(validationErrors, util.ProblemDetails{
+				Type:     "Validation Error",
+				Title:    "Bad Request",
+				Status:   400,
+				Detail:   "Invalid notes regex: " + err.Error(),
+				Instance: util.RFC400,
+			})
+		}
+	}
+
+	if conditions.MinAmount < 0 || conditions.MaxAmount < 0 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Amount range cannot be negative",
+			Instance: util.RFC400,
+		})
+	}
+
+	if conditions.MaxAmount > 0 && conditions.MinAmount > conditions.MaxAmount {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Minimum amount cannot be greater than maximum amount",
+			Instance: util.RFC400,
+		})
+	}
+
+	for _, weekday := range conditions.Weekdays {
+		if weekday < int(time.Sunday) || weekday > int(time.Saturday) {
+			validationErrors = append(validationErrors, util.ProblemDetails{
+				Type:     "Validation Error",
+				Title:    "Bad Request",
+				Status:   400,
+				Detail:   "Weekdays must be between 0 (Sunday) and 6 (Saturday)",
+				Instance: util.RFC400,
+			})
+			break
+		}
+	}
+
+	return validationErrors
+}
+
+func ValidateRuleActions(actions RuleActions) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if actions.SetCategoryID == "" && len(actions.AddTagIDs) == 0 && actions.RewriteNotes == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Rule must have at least one action",
+			Instance: util.RFC400,
+		})
+	}
+
+	for _, tagID := range actions.AddTagIDs {
+		if tagID == "" {
+			validationErrors = append(validationErrors, util.ProblemDetails{
+				Type:     "Validation Error",
+				Title:    "Invalid Tag ID",
+				Status:   400,
+				Detail:   "Tag ID cannot be empty",
+				Instance: util.RFC400,
+			})
+			break
+		}
+	}
+
+	if len(actions.RewriteNotes) > 200 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Rewritten notes cannot exceed 200 characters",
+			Instance: util.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+func (r *Rule) ChangeName(newName string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if newName == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing rule name",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(newName) > 100 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Rule name cannot exceed 100 characters",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	r.UpdatedAt = time.Now()
+	r.Name = newName
+
+	return validationErrors
+}
+
+func (r *Rule) ChangePriority(newPriority int, stopProcessing bool) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if newPriority < 0 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Priority cannot be negative",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	r.UpdatedAt = time.Now()
+	r.Priority = newPriority
+	r.StopProcessing = stopProcessing
+
+	return validationErrors
+}
+
+func (r *Rule) ChangeConditions(newConditions RuleConditions) []util.ProblemDetails {
+	validationErrors := ValidateRuleConditions(newConditions)
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	r.UpdatedAt = time.Now()
+	r.Conditions = newConditions
+
+	return validationErrors
+}
+
+func (r *Rule) ChangeActions(newActions RuleActions) []util.ProblemDetails {
+	validationErrors := ValidateRuleActions(newActions)
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	r.UpdatedAt = time.Now()
+	r.Actions = newActions
+
+	return validationErrors
+}
+
+// Matches reports whether the expense meets every condition of the rule.
+// Weekdays are those of the expense date in the user's location.
+func (r *Rule) Matches(expense Expense, location *time.Location) bool {
+	if r.Conditions.NotesContains != "" && !strings.Contains(strings.ToLower(expense.Notes), strings.ToLower(r.Conditions.NotesContains)) {
+		return false
+	}
+
+	if r.Conditions.NotesRegex != "" {
+		regex := r.compiledNotesRegex()
+		if regex == nil || !regex.MatchString(expense.Notes) {
+			return false
+		}
+	}
+
+	if r.Conditions.MinAmount > 0 && expense.Amount < r.Conditions.MinAmount {
+		return false
+	}
+
+	if r.Conditions.MaxAmount > 0 && expense.Amount > r.Conditions.MaxAmount {
+		return false
+	}
+
+	if len(r.Conditions.Weekdays) > 0 {
+		weekdayMatches := false
+		for _, weekday := range r.Conditions.Weekdays {
+			if int(expense.ExpenseDate.In(location).Weekday()) == weekday {
+				weekdayMatches = true
+				break
+			}
+		}
+
+		if !weekdayMatches {
+			return false
+		}
+	}
+
+	return true
+}
+
+// compiledNotesRegex compiles the notes pattern on first use and keeps it,
+// so matching a rule against many expenses compiles it once.
+func (r *Rule) compiledNotesRegex() *regexp.Regexp {
+	if r.notesRegex == nil || r.notesRegex.String() != r.Conditions.NotesRegex {
+		regex, err := regexp.Compile(r.Conditions.NotesRegex)
+		if err != nil {
+			return nil
+		}
+		r.notesRegex = regex
+	}
+
+	return r.notesRegex
+}
+
+func (r *Rule) Apply(expense *Expense) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if r.Actions.SetCategoryID != "" {
+		validationErrors = append(validationErrors, expense.ChangeCategory(r.Actions.SetCategoryID)...)
+	}
+
+	for _, tagID := range r.Actions.AddTagIDs {
+		if !expense.HasTag(tagID) {
+			validationErrors = append(validationErrors, expense.AddTagByID(tagID)...)
+		}
+	}
+
+	if r.Actions.RewriteNotes != "" {
+		validationErrors = append(validationErrors, expense.ChangeNotes(r.Actions.RewriteNotes)...)
+	}
+
+	return validationErrors
+}
+
+func ApplyRules(rules []Rule, expense *Expense, location *time.Location) ([]string, []util.ProblemDetails) {
+	var appliedRuleIDs []string
+
+	sortedRules := make([]Rule, len(rules))
+	copy(sortedRules, rules)
+
+	sort.SliceStable(sortedRules, func(i, j int) bool {
+		return sortedRules[i].Priority < sortedRules[j].Priority
+	})
+
+	for _, rule := range sortedRules {
+		if !rule.Active || !rule.Matches(*expense, location) {
+			continue
+		}
+
+		applyErr := rule.Apply(expense)
+		if len(applyErr) > 0 {
+			return appliedRuleIDs, applyErr
+		}
+
+		appliedRuleIDs = append(appliedRuleIDs, rule.ID)
+
+		if rule.StopProcessing {
+			break
+		}
+	}
+
+	return appliedRuleIDs, nil
+}
@@ -0,0 +1,284 @@
+package entities
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	SUGGESTION_KIND_DOCUMENT = "document"
+	SUGGESTION_KIND_CATEGORY = "category"
+	SUGGESTION_KIND_TAG      = "tag"
+	SUGGESTION_DOCUMENTS     = "__documents__"
+)
+
+var amountBuckets = []float64{10, 25, 50, 100, 250, 500, 1000, 2500}
+
+type Suggestion struct {
+	ID         string  `json:"id"`
+	Confidence float64 `json:"confidence"`
+}
+
+type SuggestionObservation struct {
+	Features   []string `json:"features"`
+	CategoryID string   `json:"category_id"`
+	TagIDs     []string `json:"tag_ids"`
+}
+
+type SuggestionModel struct {
+	UserID            string                    `json:"user_id"`
+	Documents         int                       `json:"documents"`
+	Features          map[string]int            `json:"features"`
+	CategoryDocuments map[string]int            `json:"category_documents"`
+	CategoryFeatures  map[string]map[string]int `json:"category_features"`
+	TagDocuments      map[string]int            `json:"tag_documents"`
+	TagFeatures       map[string]map[string]int `json:"tag_features"`
+}
+
+func NewSuggestionModel(userID string) *SuggestionModel {
+	return &SuggestionModel{
+		UserID:            userID,
+		Documents:         0,
+		Features:          map[string]int{},
+		CategoryDocuments: map[string]int{},
+		CategoryFeatures:  map[string]map[string]int{},
+		TagDocuments:      map[string]int{},
+		TagFeatures:       map[string]map[string]int{},
+	}
+}
+
+func NewSuggestionObservation(expense Expense) SuggestionObservation {
+	return SuggestionObservation{
+		Features:   SuggestionFeatures(expense.Notes, expense.Amount),
+		CategoryID: expense.CategoryID,
+		TagIDs:     expense.TagIDs,
+	}
+}
+
+func SuggestionFeatures(notes string, amount float64) []string {
+	var features []string
+
+	tokens := strings.FieldsFunc(strings.ToLower(notes), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for _, token := range tokens {
+		if len([]rune(token)) < 2 {
+			continue
+		}
+		features = append(features, "token:"+token)
+	}
+
+	if amount > 0 {
+		features = append(features, "amount:"+amountBucket(amount))
+	}
+
+	return features
+}
+
+func amountBucket(amount float64) string {
+	for _, limit := range amountBuckets {
+		if amount < limit {
+			return "<" + formatBucketLimit(limit)
+		}
+	}
+
+	return ">=" + formatBucketLimit(amountBuckets[len(amountBuckets)-1])
+}
+
+func formatBucketLimit(limit float64) string {
+	return strconv.FormatFloat(limit, 'f', -1, 64)
+}
+
+func (m *SuggestionModel) Observe(observation SuggestionObservation, delta int) {
+	m.Documents += delta
+
+	for _, feature := range observation.Features {
+		m.Features[feature] += delta
+	}
+
+	if observation.CategoryID != "" {
+		m.CategoryDocuments[observation.CategoryID] += delta
+
+		if m.CategoryFeatures[observation.CategoryID] == nil {
+			m.CategoryFeatures[observation.CategoryID] = map[string]int{}
+		}
+
+		for _, feature := range observation.Features {
+			m.CategoryFeatures[observation.CategoryID][feature] += delta
+		}
+	}
+
+	for _, tagID := range observation.TagIDs {
+		if tagID == "" {
+			continue
+		}
+
+		m.TagDocuments[tagID] += delta
+
+		if m.TagFeatures[tagID] == nil {
+			m.TagFeatures[tagID] = map[string]int{}
+		}
+
+		for _, feature := range observation.Features {
+			m.TagFeatures[tagID][feature] += delta
+		}
+	}
+}
+
+func (m *SuggestionModel) SuggestCategories(features []string, limit int) []Suggestion {
+	suggestions := []Suggestion{}
+
+	if m.Documents <= 0 {
+		return suggestions
+	}
+
+	vocabulary := m.vocabularySize()
+
+	var categoryIDs []string
+	var scores []float64
+
+	for categoryID, documents := range m.CategoryDocuments {
+		if documents <= 0 {
+			continue
+		}
+
+		score := math.Log(float64(documents) / float64(m.Documents))
+		score += logLikelihood(m.CategoryFeatures[categoryID], features, vocabulary)
+
+		categoryIDs = append(categoryIDs, categoryID)
+		scores = append(scores, score)
+	}
+
+	confidences := softmax(scores)
+
+	for i, categoryID := range categoryIDs {
+		suggestions = append(suggestions, Suggestion{
+			ID:         categoryID,
+			Confidence: confidences[i],
+		})
+	}
+
+	return rankSuggestions(suggestions, limit)
+}
+
+func (m *SuggestionModel) SuggestTags(features []string, limit int) []Suggestion {
+	suggestions := []Suggestion{}
+
+	if m.Documents <= 0 {
+		return suggestions
+	}
+
+	vocabulary := m.vocabularySize()
+
+	for tagID, documents := range m.TagDocuments {
+		if documents <= 0 {
+			continue
+		}
+
+		withoutTagDocuments := m.Documents - documents
+		withoutTagFeatures := map[string]int{}
+		for feature, count := range m.Features {
+			withoutTagFeatures[feature] = count - m.TagFeatures[tagID][feature]
+		}
+
+		withTag := math.Log(float64(documents)/float64(m.Documents)) + logLikelihood(m.TagFeatures[tagID], features, vocabulary)
+
+		confidence := 1.0
+		if withoutTagDocuments > 0 {
+			withoutTag := math.Log(float64(withoutTagDocuments)/float64(m.Documents)) + logLikelihood(withoutTagFeatures, features, vocabulary)
+			confidence = 1 / (1 + math.Exp(withoutTag-withTag))
+		}
+
+		suggestions = append(suggestions, Suggestion{
+			ID:         tagID,
+			Confidence: confidence,
+		})
+	}
+
+	return rankSuggestions(suggestions, limit)
+}
+
+func (m *SuggestionModel) vocabularySize() int {
+	vocabulary := 0
+
+	for _, count := range m.Features {
+		if count > 0 {
+			vocabulary++
+		}
+	}
+
+	if vocabulary == 0 {
+		return 1
+	}
+
+	return vocabulary
+}
+
+func logLikelihood(labelFeatures map[string]int, features []string, vocabulary int) float64 {
+	total := 0
+
+	for _, count := range labelFeatures {
+		if count > 0 {
+			total += count
+		}
+	}
+
+	likelihood := 0.0
+
+	for _, feature := range features {
+		count := labelFeatures[feature]
+		if count < 0 {
+			count = 0
+		}
+
+		likelihood += math.Log(float64(count+1) / float64(total+vocabulary))
+	}
+
+	return likelihood
+}
+
+func softmax(scores []float64) []float64 {
+	probabilities := make([]float64, len(scores))
+
+	if len(scores) == 0 {
+		return probabilities
+	}
+
+	maxScore := scores[0]
+	for _, score := range scores {
+		if score > maxScore {
+			maxScore = score
+		}
+	}
+
+	sum := 0.0
+	for i, score := range scores {
+		probabilities[i] = math.Exp(score - maxScore)
+		sum += probabilities[i]
+	}
+
+	for i := range probabilities {
+		probabilities[i] /= sum
+	}
+
+	return probabilities
+}
+
+func rankSuggestions(suggestions []Suggestion, limit int) []Suggestion {
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Confidence == suggestions[j].Confidence {
+			return suggestions[i].ID < suggestions[j].ID
+		}
+		return suggestions[i].Confidence > suggestions[j].Confidence
+	})
+
+	if limit > 0 && len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+
+	return suggestions
+}
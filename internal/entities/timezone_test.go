@@ -0,0 +1,103 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("load %s: %v", name, err)
+	}
+
+	return location
+}
+
+func TestValidateTimezone(t *testing.T) {
+	tests := []struct {
+		timezone string
+		valid    bool
+	}{
+		{"America/Sao_Paulo", true},
+		{"UTC", true},
+		{"", false},
+		{"Local", false},
+		{"Not/AZone", false},
+	}
+
+	for _, test := range tests {
+		if got := len(ValidateTimezone(test.timezone)) == 0; got != test.valid {
+			t.Errorf("ValidateTimezone(%q) valid = %v, want %v", test.timezone, got, test.valid)
+		}
+	}
+}
+
+func TestAssignStatementUsesTheUserLocation(t *testing.T) {
+	saoPaulo := mustLoadLocation(t, "America/Sao_Paulo")
+	account := &Account{Type: ACCOUNT_TYPE_CREDIT_CARD, ClosingDay: 10, DueDay: 20}
+
+	// 22:00 on the closing day in Sao Paulo, read back from the database in UTC.
+	expense := Expense{ExpenseDate: time.Date(2026, time.January, 10, 22, 0, 0, 0, saoPaulo).UTC()}
+
+	expense.AssignStatement(account, saoPaulo)
+
+	if expense.StatementDate == nil {
+		t.Fatal("expected a statement date")
+	}
+
+	if got := expense.StatementDate; got.Month() != time.January || got.Day() != 20 {
+		t.Errorf("due date %v, want January 20th", got)
+	}
+
+	expense.AssignStatement(account, time.UTC)
+
+	if got := expense.StatementDate; got.Month() != time.February {
+		t.Errorf("in UTC the purchase is past closing, due date %v, want February", got)
+	}
+}
+
+func TestRuleMatchesWeekdayInTheUserLocation(t *testing.T) {
+	saoPaulo := mustLoadLocation(t, "America/Sao_Paulo")
+	rule := Rule{Conditions: RuleConditions{Weekdays: []int{int(time.Sunday)}}}
+
+	// Sunday evening in Sao Paulo is already Monday in UTC.
+	expense := Expense{ExpenseDate: time.Date(2026, time.March, 1, 22, 0, 0, 0, saoPaulo).UTC()}
+
+	if !rule.Matches(expense, saoPaulo) {
+		t.Error("expected the rule to match on Sunday in Sao Paulo")
+	}
+
+	if rule.Matches(expense, time.UTC) {
+		t.Error("expected the rule not to match on Monday in UTC")
+	}
+}
+
+func TestDuplicateExpenseComparesDaysInTheUserLocation(t *testing.T) {
+	saoPaulo := mustLoadLocation(t, "America/Sao_Paulo")
+
+	expense := Expense{
+		SharedEntity: SharedEntity{ID: "new"},
+		Amount:       42.5,
+		CategoryID:   "food",
+		Notes:        "Lunch",
+		ExpenseDate:  time.Date(2026, time.March, 1, 22, 0, 0, 0, saoPaulo),
+	}
+	past := Expense{
+		SharedEntity: SharedEntity{ID: "past"},
+		Amount:       42.5,
+		CategoryID:   "food",
+		Notes:        "lunch ",
+		ExpenseDate:  time.Date(2026, time.March, 1, 9, 0, 0, 0, saoPaulo).UTC(),
+	}
+
+	if !isDuplicateExpense(expense, past, saoPaulo) {
+		t.Error("expected expenses on the same Sao Paulo day to be duplicates")
+	}
+
+	if isDuplicateExpense(expense, past, time.UTC) {
+		t.Error("expected expenses on different UTC days not to be duplicates")
+	}
+}
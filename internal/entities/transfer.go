@@ -0,0 +1,93 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type Transfer struct {
+	SharedEntity
+	UserID        string    `json:"user_id"`
+	FromAccountID string    `json:"from_account_id"`
+	ToAccountID   string    `json:"to_account_id"`
+	Amount        float64   `json:"amount,string,omitempty"`
+	TransferDate  time.Time `json:"transfer_date"`
+	Notes         string    `json:"notes"`
+	FromAccount   Account   `json:"from_account"`
+	ToAccount     Account   `json:"to_account"`
+}
+
+func NewTransfer(userID string, fromAccountID string, toAccountID string, amount float64, transferDate time.Time, notes string) (*Transfer, []util.ProblemDetails) {
+	validationErrors := ValidateTransfer(userID, fromAccountID, toAccountID, amount, notes)
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return &Transfer{
+		SharedEntity:  *NewSharedEntity(),
+		UserID:        userID,
+		FromAccountID: fromAccountID,
+		ToAccountID:   toAccountID,
+		Amount:        amount,
+		TransferDate:  transferDate,
+		Notes:         notes,
+	}, nil
+}
+
+func ValidateTransfer(userID string, fromAccountID string, toAccountID string, amount float64, notes string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if userID == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing user ID",
+			Instance: util.RFC400,
+		})
+	}
+
+	if fromAccountID == "" || toAccountID == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing source or destination account ID",
+			Instance: util.RFC400,
+		})
+	}
+
+	if fromAccountID != "" && fromAccountID == toAccountID {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Source and destination accounts must be different",
+			Instance: util.RFC400,
+		})
+	}
+
+	if amount <= 0 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Amount must be greater than 0",
+			Instance: util.RFC400,
+		})
+	}
+
+	if len(notes) > 200 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Notes cannot exceed 200 characters",
+			Instance: util.RFC400,
+		})
+	}
+
+	return validationErrors
+}
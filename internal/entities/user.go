@@ -6,10 +6,18 @@ import (
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
 )
 
+const (
+	WEEK_START_SUNDAY = "sunday"
+	WEEK_START_MONDAY = "monday"
+)
+
 type User struct {
 	SharedEntity
-	Name  string `json:"name"`
-	Login Login  `json:"login"`
+	Name      string `json:"name"`
+	Login     Login  `json:"login"`
+	Timezone  string `json:"timezone"`
+	Language  string `json:"language"`
+	WeekStart string `json:"week_start"`
 }
 
 func NewUser(name string, login Login) (*User, []util.ProblemDetails) {
@@ -23,6 +31,8 @@ func NewUser(name string, login Login) (*User, []util.ProblemDetails) {
 		SharedEntity: *NewSharedEntity(),
 		Name:         name,
 		Login:        login,
+		Timezone:     util.TIMEZONE,
+		WeekStart:    WEEK_START_MONDAY,
 	}, nil
 }
 
@@ -64,3 +74,115 @@ func (u *User) ChangeName(newName string) []util.ProblemDetails {
 
 	return validationErrors
 }
+
+func ValidateTimezone(timezone string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if timezone == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Timezone cannot be empty",
+			Status:   400,
+			Detail:   "Timezone is required",
+			Instance: util.RFC400,
+		})
+
+		return validationErrors
+	}
+
+	// "Local" would follow whatever zone the server runs in.
+	if _, err := time.LoadLocation(timezone); err != nil || timezone == "Local" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Invalid timezone",
+			Status:   400,
+			Detail:   "Timezone must be an IANA name such as America/Sao_Paulo",
+			Instance: util.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+func (u *User) ChangeTimezone(newTimezone string) []util.ProblemDetails {
+	validationErrors := ValidateTimezone(newTimezone)
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	u.UpdatedAt = time.Now()
+	u.Timezone = newTimezone
+
+	return validationErrors
+}
+
+func ValidateLanguage(language string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if language != "" && !util.IsSupportedLocale(language) {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Invalid language",
+			Status:   400,
+			Detail:   "Language must be one of en, pt-BR or es",
+			Instance: util.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+// ChangeLanguage sets the language used for the user's responses. An empty
+// language follows the Accept-Language header again.
+func (u *User) ChangeLanguage(newLanguage string) []util.ProblemDetails {
+	validationErrors := ValidateLanguage(newLanguage)
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	u.UpdatedAt = time.Now()
+	u.Language = newLanguage
+
+	return validationErrors
+}
+
+func ValidateWeekStart(weekStart string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if weekStart != WEEK_START_SUNDAY && weekStart != WEEK_START_MONDAY {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Invalid week start",
+			Status:   400,
+			Detail:   "Week start must be sunday or monday",
+			Instance: util.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+func (u *User) ChangeWeekStart(newWeekStart string) []util.ProblemDetails {
+	validationErrors := ValidateWeekStart(newWeekStart)
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	u.UpdatedAt = time.Now()
+	u.WeekStart = newWeekStart
+
+	return validationErrors
+}
+
+// FirstDayOfWeek returns the weekday the user's weeks start on. Monday weeks
+// are numbered as ISO weeks.
+func (u *User) FirstDayOfWeek() time.Weekday {
+	if u.WeekStart == WEEK_START_SUNDAY {
+		return time.Sunday
+	}
+
+	return time.Monday
+}
@@ -0,0 +1,125 @@
+package entities
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+const (
+	WEBHOOK_DELIVERY_STATUS_PENDING   = "pending"
+	WEBHOOK_DELIVERY_STATUS_SUCCEEDED = "succeeded"
+	WEBHOOK_DELIVERY_STATUS_FAILED    = "failed"
+
+	WEBHOOK_DELIVERY_MAX_ATTEMPTS = 8
+	WEBHOOK_DELIVERY_BASE_BACKOFF = 30 * time.Second
+
+	// WEBHOOK_DELIVERY_LEASE is how long a claimed delivery stays hidden from
+	// other dispatchers. It outlasts a full batch of timed out requests, and a
+	// delivery whose dispatcher died is picked up again once it expires.
+	WEBHOOK_DELIVERY_LEASE = 15 * time.Minute
+
+	WEBHOOK_SIGNATURE_HEADER = "X-Webhook-Signature"
+	WEBHOOK_EVENT_HEADER     = "X-Webhook-Event"
+	WEBHOOK_DELIVERY_HEADER  = "X-Webhook-Delivery"
+)
+
+type WebhookDelivery struct {
+	SharedEntity
+	UserID         string     `json:"user_id"`
+	SubscriptionID string     `json:"subscription_id"`
+	EventType      string     `json:"event_type"`
+	Payload        string     `json:"payload"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at,omitempty"`
+	LastAttemptAt  *time.Time `json:"last_attempt_at,omitempty"`
+	ResponseStatus int        `json:"response_status,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+}
+
+type webhookPayload struct {
+	ID        string      `json:"id"`
+	Event     string      `json:"event"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// NewWebhookDelivery queues an event for a single subscription. The JSON body
+// is rendered once so every retry sends exactly the same signed bytes.
+func NewWebhookDelivery(subscription WebhookSubscription, eventType string, data interface{}) (*WebhookDelivery, error) {
+	sharedEntity := NewSharedEntity()
+
+	payload, err := json.Marshal(webhookPayload{
+		ID:        sharedEntity.ID,
+		Event:     eventType,
+		CreatedAt: sharedEntity.CreatedAt,
+		Data:      data,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nextAttemptAt := sharedEntity.CreatedAt
+
+	return &WebhookDelivery{
+		SharedEntity:   *sharedEntity,
+		UserID:         subscription.UserID,
+		SubscriptionID: subscription.ID,
+		EventType:      eventType,
+		Payload:        string(payload),
+		Status:         WEBHOOK_DELIVERY_STATUS_PENDING,
+		NextAttemptAt:  &nextAttemptAt,
+	}, nil
+}
+
+// SignWebhookPayload returns the value of the signature header: the hex
+// HMAC-SHA256 of the raw body keyed with the subscription secret.
+func SignWebhookPayload(secret string, payload string) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(payload))
+
+	return "sha256=" + hex.EncodeToString(h.Sum(nil))
+}
+
+// RecordAttempt stores the outcome of one delivery attempt. Any 2xx response
+// completes the delivery; otherwise it is retried with exponential backoff
+// until WEBHOOK_DELIVERY_MAX_ATTEMPTS is reached.
+func (d *WebhookDelivery) RecordAttempt(responseStatus int, attemptErr error) {
+	now := time.Now()
+
+	d.Attempts++
+	d.LastAttemptAt = &now
+	d.ResponseStatus = responseStatus
+	d.UpdatedAt = now
+	d.LastError = ""
+
+	if attemptErr != nil {
+		d.LastError = attemptErr.Error()
+	}
+
+	if attemptErr == nil && responseStatus >= 200 && responseStatus < 300 {
+		d.Status = WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+		d.NextAttemptAt = nil
+		return
+	}
+
+	if d.Attempts >= WEBHOOK_DELIVERY_MAX_ATTEMPTS {
+		d.Status = WEBHOOK_DELIVERY_STATUS_FAILED
+		d.NextAttemptAt = nil
+		return
+	}
+
+	nextAttemptAt := now.Add(WEBHOOK_DELIVERY_BASE_BACKOFF << (d.Attempts - 1))
+	d.Status = WEBHOOK_DELIVERY_STATUS_PENDING
+	d.NextAttemptAt = &nextAttemptAt
+}
+
+func (d *WebhookDelivery) Abandon(reason string) {
+	d.Status = WEBHOOK_DELIVERY_STATUS_FAILED
+	d.NextAttemptAt = nil
+	d.LastError = reason
+	d.UpdatedAt = time.Now()
+}
@@ -0,0 +1,230 @@
+package entities
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+const (
+	WEBHOOK_EVENT_EXPENSE_CREATED  = "expense.created"
+	WEBHOOK_EVENT_EXPENSE_UPDATED  = "expense.updated"
+	WEBHOOK_EVENT_EXPENSE_DELETED  = "expense.deleted"
+	WEBHOOK_EVENT_CATEGORY_CREATED = "category.created"
+	WEBHOOK_EVENT_CATEGORY_UPDATED = "category.updated"
+	WEBHOOK_EVENT_CATEGORY_DELETED = "category.deleted"
+	WEBHOOK_EVENT_TAG_CREATED      = "tag.created"
+	WEBHOOK_EVENT_TAG_UPDATED      = "tag.updated"
+	WEBHOOK_EVENT_TAG_DELETED      = "tag.deleted"
+	WEBHOOK_EVENT_TEST             = "webhook.test"
+)
+
+var WEBHOOK_EVENTS = []string{
+	WEBHOOK_EVENT_EXPENSE_CREATED,
+	WEBHOOK_EVENT_EXPENSE_UPDATED,
+	WEBHOOK_EVENT_EXPENSE_DELETED,
+	WEBHOOK_EVENT_CATEGORY_CREATED,
+	WEBHOOK_EVENT_CATEGORY_UPDATED,
+	WEBHOOK_EVENT_CATEGORY_DELETED,
+	WEBHOOK_EVENT_TAG_CREATED,
+	WEBHOOK_EVENT_TAG_UPDATED,
+	WEBHOOK_EVENT_TAG_DELETED,
+}
+
+type WebhookSubscription struct {
+	SharedEntity
+	UserID     string   `json:"user_id"`
+	URL        string   `json:"url"`
+	Secret     string   `json:"-"`
+	EventTypes []string `json:"event_types"`
+}
+
+func NewWebhookSubscription(userID string, targetURL string, secret string, eventTypes []string) (*WebhookSubscription, []util.ProblemDetails) {
+	validationErrors := ValidateWebhookSubscription(userID, targetURL, secret, eventTypes)
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	if secret == "" {
+		generatedSecret, err := newWebhookSecret()
+		if err != nil {
+			return nil, []util.ProblemDetails{
+				{
+					Type:     "Internal Server Error",
+					Title:    "Error generating webhook secret",
+					Status:   500,
+					Detail:   err.Error(),
+					Instance: util.RFC500,
+				},
+			}
+		}
+		secret = generatedSecret
+	}
+
+	return &WebhookSubscription{
+		SharedEntity: *NewSharedEntity(),
+		UserID:       userID,
+		URL:          targetURL,
+		Secret:       secret,
+		EventTypes:   eventTypes,
+	}, nil
+}
+
+func ValidateWebhookSubscription(userID string, targetURL string, secret string, eventTypes []string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if userID == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Missing user id",
+			Instance: util.RFC400,
+		})
+	}
+
+	validationErrors = append(validationErrors, validateWebhookURL(targetURL)...)
+
+	if secret != "" && len(secret) < 16 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Webhook secret must have at least 16 characters",
+			Instance: util.RFC400,
+		})
+	}
+
+	validationErrors = append(validationErrors, validateWebhookEventTypes(eventTypes)...)
+
+	return validationErrors
+}
+
+func validateWebhookURL(targetURL string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	parsedURL, err := url.Parse(targetURL)
+	if targetURL == "" || err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "Webhook URL must be an absolute http or https URL",
+			Instance: util.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+func validateWebhookEventTypes(eventTypes []string) []util.ProblemDetails {
+	var validationErrors []util.ProblemDetails
+
+	if len(eventTypes) == 0 {
+		validationErrors = append(validationErrors, util.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Status:   400,
+			Detail:   "At least one event type is required",
+			Instance: util.RFC400,
+		})
+	}
+
+	for _, eventType := range eventTypes {
+		if !isValidWebhookEventType(eventType) {
+			validationErrors = append(validationErrors, util.ProblemDetails{
+				Type:     "Validation Error",
+				Title:    "Bad Request",
+				Status:   400,
+				Detail:   "Invalid event type: " + eventType,
+				Instance: util.RFC400,
+			})
+		}
+	}
+
+	return validationErrors
+}
+
+func isValidWebhookEventType(eventType string) bool {
+	if eventType == "*" {
+		return true
+	}
+
+	for _, event := range WEBHOOK_EVENTS {
+		if event == eventType || matchesWebhookEventPattern(eventType, event) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesWebhookEventPattern accepts exact event names, "*" and resource
+// wildcards such as "category.*".
+func matchesWebhookEventPattern(pattern string, eventType string) bool {
+	if pattern == "*" || pattern == eventType {
+		return true
+	}
+
+	if prefix, found := strings.CutSuffix(pattern, ".*"); found {
+		return strings.HasPrefix(eventType, prefix+".")
+	}
+
+	return false
+}
+
+func newWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secret), nil
+}
+
+// Subscribes reports whether the subscription should receive the event. Test
+// events are always delivered so a receiver can be checked before it is used.
+func (w *WebhookSubscription) Subscribes(eventType string) bool {
+	if eventType == WEBHOOK_EVENT_TEST {
+		return true
+	}
+
+	for _, pattern := range w.EventTypes {
+		if matchesWebhookEventPattern(pattern, eventType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (w *WebhookSubscription) ChangeURL(newURL string) []util.ProblemDetails {
+	validationErrors := validateWebhookURL(newURL)
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	w.UpdatedAt = time.Now()
+	w.URL = newURL
+
+	return validationErrors
+}
+
+func (w *WebhookSubscription) ChangeEventTypes(newEventTypes []string) []util.ProblemDetails {
+	validationErrors := validateWebhookEventTypes(newEventTypes)
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	w.UpdatedAt = time.Now()
+	w.EventTypes = newEventTypes
+
+	return validationErrors
+}
@@ -0,0 +1,48 @@
+package factory
+
+import (
+	repositoriesgorm "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/repositories_gorm"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"gorm.io/gorm"
+)
+
+type AccountFactory struct {
+	CreateAccount  *usecases.CreateAccountUseCase
+	DeleteAccount  *usecases.DeleteAccountUseCase
+	GetAccounts    *usecases.GetAccountsUseCase
+	GetAccount     *usecases.GetAccountUseCase
+	UpdateAccount  *usecases.UpdateAccountUseCase
+	CreateTransfer *usecases.CreateTransferUseCase
+	DeleteTransfer *usecases.DeleteTransferUseCase
+	GetTransfers   *usecases.GetTransfersUseCase
+	GetTransfer    *usecases.GetTransferUseCase
+}
+
+func NewAccountFactory(db *gorm.DB) *AccountFactory {
+	accountRepository := repositoriesgorm.NewAccountRepository(db)
+	transferRepository := repositoriesgorm.NewTransferRepository(db)
+	expenseRepository := repositoriesgorm.NewExpenseRepository(db)
+	userRepository := repositoriesgorm.NewUserRepository(db)
+
+	createAccount := usecases.NewCreateAccountUseCase(accountRepository, userRepository)
+	deleteAccount := usecases.NewDeleteAccountUseCase(accountRepository, userRepository)
+	getAccounts := usecases.NewGetAccountsUseCase(accountRepository, userRepository)
+	getAccount := usecases.NewGetAccountUseCase(accountRepository, userRepository)
+	updateAccount := usecases.NewUpdateAccountUseCase(accountRepository, expenseRepository, userRepository)
+	createTransfer := usecases.NewCreateTransferUseCase(transferRepository, accountRepository, userRepository)
+	deleteTransfer := usecases.NewDeleteTransferUseCase(transferRepository, userRepository)
+	getTransfers := usecases.NewGetTransfersUseCase(transferRepository, userRepository)
+	getTransfer := usecases.NewGetTransferUseCase(transferRepository, userRepository)
+
+	return &AccountFactory{
+		CreateAccount:  createAccount,
+		DeleteAccount:  deleteAccount,
+		GetAccounts:    getAccounts,
+		GetAccount:     getAccount,
+		UpdateAccount:  updateAccount,
+		CreateTransfer: createTransfer,
+		DeleteTransfer: deleteTransfer,
+		GetTransfers:   getTransfers,
+		GetTransfer:    getTransfer,
+	}
+}
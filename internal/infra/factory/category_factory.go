@@ -17,12 +17,13 @@ type CategoryFactory struct {
 func NewCategoryFactory(db *gorm.DB) *CategoryFactory {
 	categoryRepository := repositoriesgorm.NewCategoryRepository(db)
 	userRepository := repositoriesgorm.NewUserRepository(db)
+	webhookRepository := repositoriesgorm.NewWebhookRepository(db)
 
-	createCategory := usecases.NewCreateCategoryUseCase(categoryRepository, userRepository)
-	deleteCategory := usecases.NewDeleteCategoryUseCase(categoryRepository, userRepository)
+	createCategory := usecases.NewCreateCategoryUseCase(categoryRepository, userRepository, webhookRepository)
+	deleteCategory := usecases.NewDeleteCategoryUseCase(categoryRepository, userRepository, webhookRepository)
 	getCategories := usecases.NewGetCategoriesUseCase(categoryRepository, userRepository)
 	getCategory := usecases.NewGetCategoryUseCase(categoryRepository, userRepository)
-	updateCategory := usecases.NewUpdateCategoryUseCase(categoryRepository, userRepository)
+	updateCategory := usecases.NewUpdateCategoryUseCase(categoryRepository, userRepository, webhookRepository)
 
 	return &CategoryFactory{
 		CreateCategory: createCategory,
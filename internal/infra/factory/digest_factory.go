@@ -0,0 +1,41 @@
+package factory
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/config"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/mailer"
+	repositoriesgorm "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/repositories_gorm"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"gorm.io/gorm"
+)
+
+type DigestFactory struct {
+	CreateDigestSubscription  *usecases.CreateDigestSubscriptionUseCase
+	ConfirmDigestSubscription *usecases.ConfirmDigestSubscriptionUseCase
+	DeleteDigestSubscription  *usecases.DeleteDigestSubscriptionUseCase
+	GetDigestSubscriptions    *usecases.GetDigestSubscriptionsUseCase
+	PreviewSpendingDigest     *usecases.PreviewSpendingDigestUseCase
+	SendSpendingDigests       *usecases.SendSpendingDigestsUseCase
+}
+
+func NewDigestFactory(db *gorm.DB) *DigestFactory {
+	digestRepository := repositoriesgorm.NewDigestRepository(db)
+	presentersRepository := repositoriesgorm.NewPresentersRepository(db)
+	userRepository := repositoriesgorm.NewUserRepository(db)
+	smtpMailer := mailer.NewSMTPMailer(config.SMTP_VAR.SMTP_HOST, config.SMTP_VAR.SMTP_PORT, config.SMTP_VAR.SMTP_USERNAME, config.SMTP_VAR.SMTP_PASSWORD, config.SMTP_VAR.SMTP_FROM)
+
+	createDigestSubscription := usecases.NewCreateDigestSubscriptionUseCase(digestRepository, userRepository, smtpMailer)
+	confirmDigestSubscription := usecases.NewConfirmDigestSubscriptionUseCase(digestRepository)
+	deleteDigestSubscription := usecases.NewDeleteDigestSubscriptionUseCase(digestRepository, userRepository)
+	getDigestSubscriptions := usecases.NewGetDigestSubscriptionsUseCase(digestRepository, userRepository)
+	previewSpendingDigest := usecases.NewPreviewSpendingDigestUseCase(presentersRepository, userRepository)
+	sendSpendingDigests := usecases.NewSendSpendingDigestsUseCase(digestRepository, presentersRepository, smtpMailer, userRepository)
+
+	return &DigestFactory{
+		CreateDigestSubscription:  createDigestSubscription,
+		ConfirmDigestSubscription: confirmDigestSubscription,
+		DeleteDigestSubscription:  deleteDigestSubscription,
+		GetDigestSubscriptions:    getDigestSubscriptions,
+		PreviewSpendingDigest:     previewSpendingDigest,
+		SendSpendingDigests:       sendSpendingDigests,
+	}
+}
@@ -7,28 +7,50 @@ import (
 )
 
 type ExpenseFactory struct {
-	CreateExpense *usecases.CreateExpenseUseCase
-	DeleteExpense *usecases.DeleteExpenseUseCase
-	GetExpenses   *usecases.GetExpensesUseCase
-	GetExpense    *usecases.GetExpenseUseCase
-	UpdateExpense *usecases.UpdateExpenseUseCase
+	CreateExpense         *usecases.CreateExpenseUseCase
+	DeleteExpense         *usecases.DeleteExpenseUseCase
+	GetExpenses           *usecases.GetExpensesUseCase
+	GetExpensesPage       *usecases.GetExpensesPageUseCase
+	GetExpense            *usecases.GetExpenseUseCase
+	UpdateExpense         *usecases.UpdateExpenseUseCase
+	SuggestExpense        *usecases.SuggestExpenseUseCase
+	GetExpenseAnomalies   *usecases.GetExpenseAnomaliesUseCase
+	DismissExpenseAnomaly *usecases.DismissExpenseAnomalyUseCase
+	TrainSuggestionModels *usecases.TrainSuggestionModelsUseCase
 }
 
 func NewExpenseFactory(db *gorm.DB) *ExpenseFactory {
 	expenseRepository := repositoriesgorm.NewExpenseRepository(db)
 	userRepository := repositoriesgorm.NewUserRepository(db)
+	ruleRepository := repositoriesgorm.NewRuleRepository(db)
+	suggestionRepository := repositoriesgorm.NewSuggestionRepository(db)
+	categoryRepository := repositoriesgorm.NewCategoryRepository(db)
+	tagRepository := repositoriesgorm.NewTagRepository(db)
+	accountRepository := repositoriesgorm.NewAccountRepository(db)
+	webhookRepository := repositoriesgorm.NewWebhookRepository(db)
+	merchantRepository := repositoriesgorm.NewMerchantRepository(db)
 
-	createExpense := usecases.NewCreateExpenseUseCase(expenseRepository, userRepository)
-	deleteExpense := usecases.NewDeleteExpenseUseCase(expenseRepository, userRepository)
+	createExpense := usecases.NewCreateExpenseUseCase(expenseRepository, userRepository, ruleRepository, suggestionRepository, accountRepository, webhookRepository, merchantRepository)
+	deleteExpense := usecases.NewDeleteExpenseUseCase(expenseRepository, userRepository, suggestionRepository, webhookRepository)
 	getExpenses := usecases.NewGetExpensesUseCase(expenseRepository, userRepository)
+	getExpensesPage := usecases.NewGetExpensesPageUseCase(expenseRepository, userRepository)
 	getExpense := usecases.NewGetExpenseUseCase(expenseRepository, userRepository)
-	updateExpense := usecases.NewUpdateExpenseUseCase(expenseRepository, userRepository)
+	updateExpense := usecases.NewUpdateExpenseUseCase(expenseRepository, userRepository, suggestionRepository, accountRepository, webhookRepository, merchantRepository)
+	suggestExpense := usecases.NewSuggestExpenseUseCase(suggestionRepository, expenseRepository, categoryRepository, tagRepository, userRepository)
+	getExpenseAnomalies := usecases.NewGetExpenseAnomaliesUseCase(expenseRepository, userRepository)
+	dismissExpenseAnomaly := usecases.NewDismissExpenseAnomalyUseCase(expenseRepository, userRepository)
+	trainSuggestionModels := usecases.NewTrainSuggestionModelsUseCase(suggestionRepository, expenseRepository, userRepository)
 
 	return &ExpenseFactory{
-		CreateExpense: createExpense,
-		DeleteExpense: deleteExpense,
-		GetExpenses:   getExpenses,
-		GetExpense:    getExpense,
-		UpdateExpense: updateExpense,
+		CreateExpense:         createExpense,
+		DeleteExpense:         deleteExpense,
+		GetExpenses:           getExpenses,
+		GetExpensesPage:       getExpensesPage,
+		GetExpense:            getExpense,
+		UpdateExpense:         updateExpense,
+		SuggestExpense:        suggestExpense,
+		GetExpenseAnomalies:   getExpenseAnomalies,
+		DismissExpenseAnomaly: dismissExpenseAnomaly,
+		TrainSuggestionModels: trainSuggestionModels,
 	}
 }
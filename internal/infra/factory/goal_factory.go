@@ -0,0 +1,43 @@
+package factory
+
+import (
+	repositoriesgorm "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/repositories_gorm"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"gorm.io/gorm"
+)
+
+type GoalFactory struct {
+	CreateGoal             *usecases.CreateGoalUseCase
+	DeleteGoal             *usecases.DeleteGoalUseCase
+	GetGoals               *usecases.GetGoalsUseCase
+	GetGoal                *usecases.GetGoalUseCase
+	UpdateGoal             *usecases.UpdateGoalUseCase
+	CreateGoalContribution *usecases.CreateGoalContributionUseCase
+	DeleteGoalContribution *usecases.DeleteGoalContributionUseCase
+	GetGoalContributions   *usecases.GetGoalContributionsUseCase
+}
+
+func NewGoalFactory(db *gorm.DB) *GoalFactory {
+	goalRepository := repositoriesgorm.NewGoalRepository(db)
+	userRepository := repositoriesgorm.NewUserRepository(db)
+
+	createGoal := usecases.NewCreateGoalUseCase(goalRepository, userRepository)
+	deleteGoal := usecases.NewDeleteGoalUseCase(goalRepository, userRepository)
+	getGoals := usecases.NewGetGoalsUseCase(goalRepository, userRepository)
+	getGoal := usecases.NewGetGoalUseCase(goalRepository, userRepository)
+	updateGoal := usecases.NewUpdateGoalUseCase(goalRepository, userRepository)
+	createGoalContribution := usecases.NewCreateGoalContributionUseCase(goalRepository, userRepository)
+	deleteGoalContribution := usecases.NewDeleteGoalContributionUseCase(goalRepository, userRepository)
+	getGoalContributions := usecases.NewGetGoalContributionsUseCase(goalRepository, userRepository)
+
+	return &GoalFactory{
+		CreateGoal:             createGoal,
+		DeleteGoal:             deleteGoal,
+		GetGoals:               getGoals,
+		GetGoal:                getGoal,
+		UpdateGoal:             updateGoal,
+		CreateGoalContribution: createGoalContribution,
+		DeleteGoalContribution: deleteGoalContribution,
+		GetGoalContributions:   getGoalContributions,
+	}
+}
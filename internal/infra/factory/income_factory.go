@@ -0,0 +1,36 @@
+package factory
+
+import (
+	repositoriesgorm "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/repositories_gorm"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"gorm.io/gorm"
+)
+
+type IncomeFactory struct {
+	CreateIncome *usecases.CreateIncomeUseCase
+	DeleteIncome *usecases.DeleteIncomeUseCase
+	GetIncomes   *usecases.GetIncomesUseCase
+	GetIncome    *usecases.GetIncomeUseCase
+	UpdateIncome *usecases.UpdateIncomeUseCase
+}
+
+func NewIncomeFactory(db *gorm.DB) *IncomeFactory {
+	incomeRepository := repositoriesgorm.NewIncomeRepository(db)
+	categoryRepository := repositoriesgorm.NewCategoryRepository(db)
+	userRepository := repositoriesgorm.NewUserRepository(db)
+	accountRepository := repositoriesgorm.NewAccountRepository(db)
+
+	createIncome := usecases.NewCreateIncomeUseCase(incomeRepository, categoryRepository, userRepository, accountRepository)
+	deleteIncome := usecases.NewDeleteIncomeUseCase(incomeRepository, userRepository)
+	getIncomes := usecases.NewGetIncomesUseCase(incomeRepository, userRepository)
+	getIncome := usecases.NewGetIncomeUseCase(incomeRepository, userRepository)
+	updateIncome := usecases.NewUpdateIncomeUseCase(incomeRepository, categoryRepository, userRepository, accountRepository)
+
+	return &IncomeFactory{
+		CreateIncome: createIncome,
+		DeleteIncome: deleteIncome,
+		GetIncomes:   getIncomes,
+		GetIncome:    getIncome,
+		UpdateIncome: updateIncome,
+	}
+}
@@ -0,0 +1,40 @@
+package factory
+
+import (
+	repositoriesgorm "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/repositories_gorm"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"gorm.io/gorm"
+)
+
+type InstallmentPurchaseFactory struct {
+	CreateInstallmentPurchase *usecases.CreateInstallmentPurchaseUseCase
+	DeleteInstallmentPurchase *usecases.DeleteInstallmentPurchaseUseCase
+	GetInstallmentPurchases   *usecases.GetInstallmentPurchasesUseCase
+	GetInstallmentPurchase    *usecases.GetInstallmentPurchaseUseCase
+	UpdateInstallmentPurchase *usecases.UpdateInstallmentPurchaseUseCase
+}
+
+func NewInstallmentPurchaseFactory(db *gorm.DB) *InstallmentPurchaseFactory {
+	installmentPurchaseRepository := repositoriesgorm.NewInstallmentPurchaseRepository(db)
+	accountRepository := repositoriesgorm.NewAccountRepository(db)
+	userRepository := repositoriesgorm.NewUserRepository(db)
+	expenseRepository := repositoriesgorm.NewExpenseRepository(db)
+	merchantRepository := repositoriesgorm.NewMerchantRepository(db)
+	ruleRepository := repositoriesgorm.NewRuleRepository(db)
+	suggestionRepository := repositoriesgorm.NewSuggestionRepository(db)
+	webhookRepository := repositoriesgorm.NewWebhookRepository(db)
+
+	createInstallmentPurchase := usecases.NewCreateInstallmentPurchaseUseCase(installmentPurchaseRepository, accountRepository, userRepository, expenseRepository, merchantRepository, ruleRepository, suggestionRepository, webhookRepository)
+	deleteInstallmentPurchase := usecases.NewDeleteInstallmentPurchaseUseCase(installmentPurchaseRepository, userRepository, suggestionRepository, webhookRepository)
+	getInstallmentPurchases := usecases.NewGetInstallmentPurchasesUseCase(installmentPurchaseRepository, userRepository)
+	getInstallmentPurchase := usecases.NewGetInstallmentPurchaseUseCase(installmentPurchaseRepository, userRepository)
+	updateInstallmentPurchase := usecases.NewUpdateInstallmentPurchaseUseCase(installmentPurchaseRepository, accountRepository, userRepository, expenseRepository, merchantRepository, ruleRepository, suggestionRepository, webhookRepository)
+
+	return &InstallmentPurchaseFactory{
+		CreateInstallmentPurchase: createInstallmentPurchase,
+		DeleteInstallmentPurchase: deleteInstallmentPurchase,
+		GetInstallmentPurchases:   getInstallmentPurchases,
+		GetInstallmentPurchase:    getInstallmentPurchase,
+		UpdateInstallmentPurchase: updateInstallmentPurchase,
+	}
+}
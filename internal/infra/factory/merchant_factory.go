@@ -0,0 +1,34 @@
+package factory
+
+import (
+	repositoriesgorm "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/repositories_gorm"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"gorm.io/gorm"
+)
+
+type MerchantFactory struct {
+	CreateMerchant *usecases.CreateMerchantUseCase
+	DeleteMerchant *usecases.DeleteMerchantUseCase
+	GetMerchants   *usecases.GetMerchantsUseCase
+	GetMerchant    *usecases.GetMerchantUseCase
+	UpdateMerchant *usecases.UpdateMerchantUseCase
+}
+
+func NewMerchantFactory(db *gorm.DB) *MerchantFactory {
+	merchantRepository := repositoriesgorm.NewMerchantRepository(db)
+	userRepository := repositoriesgorm.NewUserRepository(db)
+
+	createMerchant := usecases.NewCreateMerchantUseCase(merchantRepository, userRepository)
+	deleteMerchant := usecases.NewDeleteMerchantUseCase(merchantRepository, userRepository)
+	getMerchants := usecases.NewGetMerchantsUseCase(merchantRepository, userRepository)
+	getMerchant := usecases.NewGetMerchantUseCase(merchantRepository, userRepository)
+	updateMerchant := usecases.NewUpdateMerchantUseCase(merchantRepository, userRepository)
+
+	return &MerchantFactory{
+		CreateMerchant: createMerchant,
+		DeleteMerchant: deleteMerchant,
+		GetMerchants:   getMerchants,
+		GetMerchant:    getMerchant,
+		UpdateMerchant: updateMerchant,
+	}
+}
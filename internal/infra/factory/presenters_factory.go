@@ -7,17 +7,39 @@ import (
 )
 
 type PresentersFactory struct {
-	GetTotalExpensesForPeriod        *presenters.GetTotalExpensesForPeriodUseCase
-	GetExpensesByCategoryPeriod      *presenters.GetExpensesByCategoryPeriodUseCase
-	GetMonthlyExpensesByCategoryYear *presenters.GetMonthlyExpensesByCategoryYearUseCase
-	GetMonthlyExpensesByTagYear      *presenters.GetMonthlyExpensesByTagYearUseCase
-	GetTotalExpensesForCurrentMonth  *presenters.GetTotalExpensesForCurrentMonthUseCase
-	GetTotalExpensesForCurrentWeek   *presenters.GetTotalExpensesForCurrentWeekUseCase
-	GetExpensesByMonthYear           *presenters.GetExpensesByMonthYearUseCase
-	GetTotalExpensesMonthCurrentYear *presenters.GetTotalExpensesMonthCurrentYearUseCase
-	GetCategoryTagsTotalsByMonthYear *presenters.GetCategoryTagsTotalsByMonthYearUseCase
-	GetAvailableMonthsYears          *presenters.GetAvailableMonthsYearsUseCase
-	GetDayToDayExpensesPeriod        *presenters.GetDayToDayExpensesPeriodUseCase
+	GetTotalExpensesForPeriod          *presenters.GetTotalExpensesForPeriodUseCase
+	GetExpensesByCategoryPeriod        *presenters.GetExpensesByCategoryPeriodUseCase
+	GetMonthlyExpensesByCategoryYear   *presenters.GetMonthlyExpensesByCategoryYearUseCase
+	GetMonthlyExpensesByTagYear        *presenters.GetMonthlyExpensesByTagYearUseCase
+	GetTotalExpensesForCurrentMonth    *presenters.GetTotalExpensesForCurrentMonthUseCase
+	GetTotalExpensesForCurrentWeek     *presenters.GetTotalExpensesForCurrentWeekUseCase
+	GetExpensesByMonthYear             *presenters.GetExpensesByMonthYearUseCase
+	GetTotalExpensesMonthCurrentYear   *presenters.GetTotalExpensesMonthCurrentYearUseCase
+	GetCategoryTagsTotalsByMonthYear   *presenters.GetCategoryTagsTotalsByMonthYearUseCase
+	GetAvailableMonthsYears            *presenters.GetAvailableMonthsYearsUseCase
+	GetDayToDayExpensesPeriod          *presenters.GetDayToDayExpensesPeriodUseCase
+	GetNetCashFlowYear                 *presenters.GetNetCashFlowYearUseCase
+	GetIncomeVsExpenseByCategoryPeriod *presenters.GetIncomeVsExpenseByCategoryPeriodUseCase
+	GetAccountBalances                 *presenters.GetAccountBalancesUseCase
+	GetAccountRunningBalance           *presenters.GetAccountRunningBalanceUseCase
+	GetExpensesByAccountPeriod         *presenters.GetExpensesByAccountPeriodUseCase
+	GetCommittedInstallmentsByMonth    *presenters.GetCommittedInstallmentsByMonthUseCase
+	GetCardStatements                  *presenters.GetCardStatementsUseCase
+	GetGoalsProgress                   *presenters.GetGoalsProgressUseCase
+	GetMonthEndForecast                *presenters.GetMonthEndForecastUseCase
+	GetPeriodComparison                *presenters.GetPeriodComparisonUseCase
+	GetExpensesTimeSeries              *presenters.GetExpensesTimeSeriesUseCase
+	GetPivotReport                     *presenters.GetPivotReportUseCase
+	GetSpendingHeatmap                 *presenters.GetSpendingHeatmapUseCase
+	GetExpenseReportPDF                *presenters.GetExpenseReportPDFUseCase
+	GetExpenseChart                    *presenters.GetExpenseChartUseCase
+	ShareExpenseChart                  *presenters.ShareExpenseChartUseCase
+	GetSharedExpenseChart              *presenters.GetSharedExpenseChartUseCase
+	GetTaxDeductionReport              *presenters.GetTaxDeductionReportUseCase
+	GetTopMerchants                    *presenters.GetTopMerchantsUseCase
+	GetExpenseLocations                *presenters.GetExpenseLocationsUseCase
+	GetExpenseClusters                 *presenters.GetExpenseClustersUseCase
+	GetExpensesGeoJSON                 *presenters.GetExpensesGeoJSONUseCase
 }
 
 func NewPresentersFactory(db *gorm.DB) *PresentersFactory {
@@ -35,18 +57,62 @@ func NewPresentersFactory(db *gorm.DB) *PresentersFactory {
 	getCategoryTagsTotalsByMonthYear := presenters.NewGetCategoryTagsTotalsByMonthYearUseCase(presentersRepository, userRepository)
 	getAvailableMonthsYears := presenters.NewGetAvailableMonthsYearsUseCase(presentersRepository, userRepository)
 	getDayToDayExpensesPeriod := presenters.NewGetDayToDayExpensesPeriodUseCase(presentersRepository, userRepository)
+	getNetCashFlowYear := presenters.NewGetNetCashFlowYearUseCase(presentersRepository, userRepository)
+	getIncomeVsExpenseByCategoryPeriod := presenters.NewGetIncomeVsExpenseByCategoryPeriodUseCase(presentersRepository, userRepository)
+	getAccountBalances := presenters.NewGetAccountBalancesUseCase(presentersRepository, userRepository)
+	getAccountRunningBalance := presenters.NewGetAccountRunningBalanceUseCase(presentersRepository, userRepository)
+	getExpensesByAccountPeriod := presenters.NewGetExpensesByAccountPeriodUseCase(presentersRepository, userRepository)
+	getCommittedInstallmentsByMonth := presenters.NewGetCommittedInstallmentsByMonthUseCase(presentersRepository, userRepository)
+	getCardStatements := presenters.NewGetCardStatementsUseCase(presentersRepository, userRepository)
+	getGoalsProgress := presenters.NewGetGoalsProgressUseCase(presentersRepository, userRepository)
+	getMonthEndForecast := presenters.NewGetMonthEndForecastUseCase(presentersRepository, userRepository)
+	getPeriodComparison := presenters.NewGetPeriodComparisonUseCase(presentersRepository, userRepository)
+	getExpensesTimeSeries := presenters.NewGetExpensesTimeSeriesUseCase(presentersRepository, userRepository)
+	getPivotReport := presenters.NewGetPivotReportUseCase(presentersRepository, userRepository)
+	getSpendingHeatmap := presenters.NewGetSpendingHeatmapUseCase(presentersRepository, userRepository)
+	getExpenseReportPDF := presenters.NewGetExpenseReportPDFUseCase(presentersRepository, userRepository)
+	getExpenseChart := presenters.NewGetExpenseChartUseCase(presentersRepository, userRepository)
+	shareExpenseChart := presenters.NewShareExpenseChartUseCase(presentersRepository, userRepository)
+	getSharedExpenseChart := presenters.NewGetSharedExpenseChartUseCase(presentersRepository, userRepository)
+	getTaxDeductionReport := presenters.NewGetTaxDeductionReportUseCase(presentersRepository, userRepository)
+	getTopMerchants := presenters.NewGetTopMerchantsUseCase(presentersRepository, userRepository)
+	getExpenseLocations := presenters.NewGetExpenseLocationsUseCase(presentersRepository, userRepository)
+	getExpenseClusters := presenters.NewGetExpenseClustersUseCase(presentersRepository, userRepository)
+	getExpensesGeoJSON := presenters.NewGetExpensesGeoJSONUseCase(presentersRepository, userRepository)
 
 	return &PresentersFactory{
-		GetTotalExpensesForPeriod:        getTotalExpensesForPeriod,
-		GetExpensesByCategoryPeriod:      getExpensesByCategoryPeriod,
-		GetMonthlyExpensesByCategoryYear: getMonthlyExpensesByCategoryYear,
-		GetMonthlyExpensesByTagYear:      getMonthlyExpensesByTagYear,
-		GetTotalExpensesForCurrentMonth:  getTotalExpensesForCurrentMonth,
-		GetTotalExpensesForCurrentWeek:   getTotalExpensesForCurrentWeek,
-		GetExpensesByMonthYear:           getExpensesByMonthYear,
-		GetTotalExpensesMonthCurrentYear: getTotalExpensesMonthCurrentYear,
-		GetCategoryTagsTotalsByMonthYear: getCategoryTagsTotalsByMonthYear,
-		GetAvailableMonthsYears:          getAvailableMonthsYears,
-		GetDayToDayExpensesPeriod:        getDayToDayExpensesPeriod,
+		GetTotalExpensesForPeriod:          getTotalExpensesForPeriod,
+		GetExpensesByCategoryPeriod:        getExpensesByCategoryPeriod,
+		GetMonthlyExpensesByCategoryYear:   getMonthlyExpensesByCategoryYear,
+		GetMonthlyExpensesByTagYear:        getMonthlyExpensesByTagYear,
+		GetTotalExpensesForCurrentMonth:    getTotalExpensesForCurrentMonth,
+		GetTotalExpensesForCurrentWeek:     getTotalExpensesForCurrentWeek,
+		GetExpensesByMonthYear:             getExpensesByMonthYear,
+		GetTotalExpensesMonthCurrentYear:   getTotalExpensesMonthCurrentYear,
+		GetCategoryTagsTotalsByMonthYear:   getCategoryTagsTotalsByMonthYear,
+		GetAvailableMonthsYears:            getAvailableMonthsYears,
+		GetDayToDayExpensesPeriod:          getDayToDayExpensesPeriod,
+		GetNetCashFlowYear:                 getNetCashFlowYear,
+		GetIncomeVsExpenseByCategoryPeriod: getIncomeVsExpenseByCategoryPeriod,
+		GetAccountBalances:                 getAccountBalances,
+		GetAccountRunningBalance:           getAccountRunningBalance,
+		GetExpensesByAccountPeriod:         getExpensesByAccountPeriod,
+		GetCommittedInstallmentsByMonth:    getCommittedInstallmentsByMonth,
+		GetCardStatements:                  getCardStatements,
+		GetGoalsProgress:                   getGoalsProgress,
+		GetMonthEndForecast:                getMonthEndForecast,
+		GetPeriodComparison:                getPeriodComparison,
+		GetExpensesTimeSeries:              getExpensesTimeSeries,
+		GetPivotReport:                     getPivotReport,
+		GetSpendingHeatmap:                 getSpendingHeatmap,
+		GetExpenseReportPDF:                getExpenseReportPDF,
+		GetExpenseChart:                    getExpenseChart,
+		ShareExpenseChart:                  shareExpenseChart,
+		GetSharedExpenseChart:              getSharedExpenseChart,
+		GetTaxDeductionReport:              getTaxDeductionReport,
+		GetTopMerchants:                    getTopMerchants,
+		GetExpenseLocations:                getExpenseLocations,
+		GetExpenseClusters:                 getExpenseClusters,
+		GetExpensesGeoJSON:                 getExpensesGeoJSON,
 	}
 }
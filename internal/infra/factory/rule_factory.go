@@ -0,0 +1,45 @@
+package factory
+
+import (
+	repositoriesgorm "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/repositories_gorm"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"gorm.io/gorm"
+)
+
+type RuleFactory struct {
+	CreateRule  *usecases.CreateRuleUseCase
+	DeleteRule  *usecases.DeleteRuleUseCase
+	GetRules    *usecases.GetRulesUseCase
+	GetRule     *usecases.GetRuleUseCase
+	UpdateRule  *usecases.UpdateRuleUseCase
+	PreviewRule *usecases.PreviewRuleUseCase
+	ApplyRule   *usecases.ApplyRuleUseCase
+}
+
+func NewRuleFactory(db *gorm.DB) *RuleFactory {
+	ruleRepository := repositoriesgorm.NewRuleRepository(db)
+	expenseRepository := repositoriesgorm.NewExpenseRepository(db)
+	userRepository := repositoriesgorm.NewUserRepository(db)
+	suggestionRepository := repositoriesgorm.NewSuggestionRepository(db)
+	webhookRepository := repositoriesgorm.NewWebhookRepository(db)
+	categoryRepository := repositoriesgorm.NewCategoryRepository(db)
+	tagRepository := repositoriesgorm.NewTagRepository(db)
+
+	createRule := usecases.NewCreateRuleUseCase(ruleRepository, userRepository, categoryRepository, tagRepository)
+	deleteRule := usecases.NewDeleteRuleUseCase(ruleRepository, userRepository)
+	getRules := usecases.NewGetRulesUseCase(ruleRepository, userRepository)
+	getRule := usecases.NewGetRuleUseCase(ruleRepository, userRepository)
+	updateRule := usecases.NewUpdateRuleUseCase(ruleRepository, userRepository, categoryRepository, tagRepository)
+	previewRule := usecases.NewPreviewRuleUseCase(ruleRepository, expenseRepository, userRepository)
+	applyRule := usecases.NewApplyRuleUseCase(ruleRepository, expenseRepository, userRepository, suggestionRepository, webhookRepository)
+
+	return &RuleFactory{
+		CreateRule:  createRule,
+		DeleteRule:  deleteRule,
+		GetRules:    getRules,
+		GetRule:     getRule,
+		UpdateRule:  updateRule,
+		PreviewRule: previewRule,
+		ApplyRule:   applyRule,
+	}
+}
@@ -17,12 +17,13 @@ type TagFactory struct {
 func NewTagFactory(db *gorm.DB) *TagFactory {
 	tagRepository := repositoriesgorm.NewTagRepository(db)
 	userRepository := repositoriesgorm.NewUserRepository(db)
+	webhookRepository := repositoriesgorm.NewWebhookRepository(db)
 
-	createTag := usecases.NewCreateTagUseCase(tagRepository, userRepository)
-	deleteTag := usecases.NewDeleteTagUseCase(tagRepository, userRepository)
+	createTag := usecases.NewCreateTagUseCase(tagRepository, userRepository, webhookRepository)
+	deleteTag := usecases.NewDeleteTagUseCase(tagRepository, userRepository, webhookRepository)
 	getTags := usecases.NewGetTagsUseCase(tagRepository, userRepository)
 	getTag := usecases.NewGetTagUseCase(tagRepository, userRepository)
-	updateTag := usecases.NewUpdateTagUseCase(tagRepository, userRepository)
+	updateTag := usecases.NewUpdateTagUseCase(tagRepository, userRepository, webhookRepository)
 
 	return &TagFactory{
 		CreateTag: createTag,
@@ -0,0 +1,43 @@
+package factory
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/config"
+	repositoriesgorm "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/repositories_gorm"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/webhook"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"gorm.io/gorm"
+)
+
+type WebhookFactory struct {
+	CreateWebhookSubscription *usecases.CreateWebhookSubscriptionUseCase
+	DeleteWebhookSubscription *usecases.DeleteWebhookSubscriptionUseCase
+	GetWebhookSubscriptions   *usecases.GetWebhookSubscriptionsUseCase
+	UpdateWebhookSubscription *usecases.UpdateWebhookSubscriptionUseCase
+	GetWebhookDeliveries      *usecases.GetWebhookDeliveriesUseCase
+	SendTestWebhook           *usecases.SendTestWebhookUseCase
+	DeliverWebhooks           *usecases.DeliverWebhooksUseCase
+}
+
+func NewWebhookFactory(db *gorm.DB) *WebhookFactory {
+	webhookRepository := repositoriesgorm.NewWebhookRepository(db)
+	userRepository := repositoriesgorm.NewUserRepository(db)
+	httpClient := webhook.NewHTTPClient(config.WEBHOOK_VAR.WEBHOOK_ALLOW_LOOPBACK == "true")
+
+	createWebhookSubscription := usecases.NewCreateWebhookSubscriptionUseCase(webhookRepository, userRepository)
+	deleteWebhookSubscription := usecases.NewDeleteWebhookSubscriptionUseCase(webhookRepository, userRepository)
+	getWebhookSubscriptions := usecases.NewGetWebhookSubscriptionsUseCase(webhookRepository, userRepository)
+	updateWebhookSubscription := usecases.NewUpdateWebhookSubscriptionUseCase(webhookRepository, userRepository)
+	getWebhookDeliveries := usecases.NewGetWebhookDeliveriesUseCase(webhookRepository, userRepository)
+	sendTestWebhook := usecases.NewSendTestWebhookUseCase(webhookRepository, userRepository, httpClient)
+	deliverWebhooks := usecases.NewDeliverWebhooksUseCase(webhookRepository, httpClient)
+
+	return &WebhookFactory{
+		CreateWebhookSubscription: createWebhookSubscription,
+		DeleteWebhookSubscription: deleteWebhookSubscription,
+		GetWebhookSubscriptions:   getWebhookSubscriptions,
+		UpdateWebhookSubscription: updateWebhookSubscription,
+		GetWebhookDeliveries:      getWebhookDeliveries,
+		SendTestWebhook:           sendTestWebhook,
+		DeliverWebhooks:           deliverWebhooks,
+	}
+}
@@ -0,0 +1,89 @@
+package mailer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+)
+
+type SMTPMailer struct {
+	host     string
+	port     string
+	username string
+	password string
+	from     string
+}
+
+func NewSMTPMailer(host string, port string, username string, password string, from string) *SMTPMailer {
+	return &SMTPMailer{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+	}
+}
+
+// SendEmail sends a multipart/alternative message so clients can pick the
+// HTML or the plain text body.
+func (s *SMTPMailer) SendEmail(message entities.EmailMessage) error {
+	if s.host == "" {
+		return errors.New("smtp host is not configured")
+	}
+
+	to, err := mail.ParseAddress(message.To)
+	if err != nil {
+		return errors.New("invalid recipient address: " + err.Error())
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	fmt.Fprintf(&body, "From: %s\r\n", s.from)
+	fmt.Fprintf(&body, "To: %s\r\n", to.Address)
+	fmt.Fprintf(&body, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&body, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&body, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&body, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", writer.Boundary())
+
+	parts := []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=utf-8", message.TextBody},
+		{"text/html; charset=utf-8", message.HTMLBody},
+	}
+
+	for _, part := range parts {
+		partWriter, err := writer.CreatePart(textproto.MIMEHeader{"Content-Type": {part.contentType}})
+		if err != nil {
+			return errors.New("failed to build email: " + err.Error())
+		}
+		if _, err := partWriter.Write([]byte(part.content)); err != nil {
+			return errors.New("failed to build email: " + err.Error())
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return errors.New("failed to build email: " + err.Error())
+	}
+
+	var auth smtp.Auth
+	if s.username != "" {
+		auth = smtp.PlainAuth("", s.username, s.password, s.host)
+	}
+
+	if err := smtp.SendMail(s.host+":"+s.port, auth, s.from, []string{to.Address}, body.Bytes()); err != nil {
+		return errors.New("failed to send email: " + err.Error())
+	}
+
+	return nil
+}
@@ -17,10 +17,88 @@ type Categories struct {
 	UserID        string    `gorm:"not null"`
 	Name          string    `gorm:"not null"`
 	Color         string    `gorm:"not null"`
+	Kind          string    `gorm:"not null;default:expense"`
+	TaxCategory   string    `gorm:"null"`
 	User          Users     `gorm:"foreignKey:UserID"`
 }
 
 type Expenses struct {
+	ID                    string             `gorm:"primaryKey;not null"`
+	Active                bool               `gorm:"not null"`
+	CreatedAt             time.Time          `gorm:"not null"`
+	UpdatedAt             time.Time          `gorm:"not null"`
+	DeactivatedAt         time.Time          `gorm:"not null"`
+	UserID                string             `gorm:"not null"`
+	Amount                float64            `gorm:"not null"`
+	ExpanseDate           time.Time          `gorm:"not null"`
+	CategoryID            string             `gorm:"not null"`
+	AccountID             string             `gorm:"null;index"`
+	MerchantID            string             `gorm:"null;index"`
+	InstallmentPurchaseID string             `gorm:"null;index"`
+	InstallmentNumber     int                `gorm:"null"`
+	StatementDate         *time.Time         `gorm:"null;index"`
+	Notes                 string             `gorm:"null"`
+	TaxCategory           string             `gorm:"null"`
+	ProviderTaxID         string             `gorm:"null;index"`
+	ProviderName          string             `gorm:"null"`
+	Latitude              *float64           `gorm:"null;index:idx_expenses_location"`
+	Longitude             *float64           `gorm:"null;index:idx_expenses_location"`
+	PlaceName             string             `gorm:"null"`
+	Category              Categories         `gorm:"foreignKey:CategoryID"`
+	Tags                  []Tags             `gorm:"many2many:expense_tags"`
+	Anomalies             []ExpenseAnomalies `gorm:"foreignKey:ExpenseID"`
+	User                  Users              `gorm:"foreignKey:UserID"`
+}
+
+type Merchants struct {
+	ID            string    `gorm:"primaryKey;not null"`
+	Active        bool      `gorm:"not null"`
+	CreatedAt     time.Time `gorm:"not null"`
+	UpdatedAt     time.Time `gorm:"not null"`
+	DeactivatedAt time.Time `gorm:"not null"`
+	UserID        string    `gorm:"not null;index"`
+	Name          string    `gorm:"not null"`
+	Aliases       string    `gorm:"type:text;not null"`
+	User          Users     `gorm:"foreignKey:UserID"`
+}
+
+type ExpenseAnomalies struct {
+	ID               string     `gorm:"primaryKey;not null"`
+	Active           bool       `gorm:"not null"`
+	CreatedAt        time.Time  `gorm:"not null"`
+	UpdatedAt        time.Time  `gorm:"not null"`
+	DeactivatedAt    time.Time  `gorm:"not null"`
+	UserID           string     `gorm:"not null"`
+	ExpenseID        string     `gorm:"not null;index"`
+	Kind             string     `gorm:"not null"`
+	Score            float64    `gorm:"not null"`
+	Baseline         float64    `gorm:"not null"`
+	RelatedExpenseID string     `gorm:"null"`
+	Explanation      string     `gorm:"not null"`
+	Dismissed        bool       `gorm:"not null;default:false"`
+	DismissedAt      *time.Time `gorm:"null"`
+	User             Users      `gorm:"foreignKey:UserID"`
+}
+
+type InstallmentPurchases struct {
+	ID               string     `gorm:"primaryKey;not null"`
+	Active           bool       `gorm:"not null"`
+	CreatedAt        time.Time  `gorm:"not null"`
+	UpdatedAt        time.Time  `gorm:"not null"`
+	DeactivatedAt    time.Time  `gorm:"not null"`
+	UserID           string     `gorm:"not null"`
+	TotalAmount      float64    `gorm:"not null"`
+	InstallmentCount int        `gorm:"not null"`
+	FirstDueDate     time.Time  `gorm:"not null"`
+	RemainderPolicy  string     `gorm:"not null"`
+	CategoryID       string     `gorm:"not null"`
+	AccountID        string     `gorm:"null"`
+	Notes            string     `gorm:"null"`
+	Category         Categories `gorm:"foreignKey:CategoryID"`
+	User             Users      `gorm:"foreignKey:UserID"`
+}
+
+type Incomes struct {
 	ID            string     `gorm:"primaryKey;not null"`
 	Active        bool       `gorm:"not null"`
 	CreatedAt     time.Time  `gorm:"not null"`
@@ -28,14 +106,76 @@ type Expenses struct {
 	DeactivatedAt time.Time  `gorm:"not null"`
 	UserID        string     `gorm:"not null"`
 	Amount        float64    `gorm:"not null"`
-	ExpanseDate   time.Time  `gorm:"not null"`
+	IncomeDate    time.Time  `gorm:"not null"`
 	CategoryID    string     `gorm:"not null"`
+	AccountID     string     `gorm:"null;index"`
 	Notes         string     `gorm:"null"`
 	Category      Categories `gorm:"foreignKey:CategoryID"`
-	Tags          []Tags     `gorm:"many2many:expense_tags"`
 	User          Users      `gorm:"foreignKey:UserID"`
 }
 
+type Accounts struct {
+	ID             string    `gorm:"primaryKey;not null"`
+	Active         bool      `gorm:"not null"`
+	CreatedAt      time.Time `gorm:"not null"`
+	UpdatedAt      time.Time `gorm:"not null"`
+	DeactivatedAt  time.Time `gorm:"not null"`
+	UserID         string    `gorm:"not null"`
+	Name           string    `gorm:"not null"`
+	Type           string    `gorm:"not null"`
+	Currency       string    `gorm:"not null"`
+	OpeningBalance float64   `gorm:"not null"`
+	ClosingDay     int       `gorm:"null"`
+	DueDay         int       `gorm:"null"`
+	User           Users     `gorm:"foreignKey:UserID"`
+}
+
+type Transfers struct {
+	ID            string    `gorm:"primaryKey;not null"`
+	Active        bool      `gorm:"not null"`
+	CreatedAt     time.Time `gorm:"not null"`
+	UpdatedAt     time.Time `gorm:"not null"`
+	DeactivatedAt time.Time `gorm:"not null"`
+	UserID        string    `gorm:"not null"`
+	FromAccountID string    `gorm:"not null"`
+	ToAccountID   string    `gorm:"not null"`
+	Amount        float64   `gorm:"not null"`
+	TransferDate  time.Time `gorm:"not null"`
+	Notes         string    `gorm:"null"`
+	FromAccount   Accounts  `gorm:"foreignKey:FromAccountID"`
+	ToAccount     Accounts  `gorm:"foreignKey:ToAccountID"`
+	User          Users     `gorm:"foreignKey:UserID"`
+}
+
+type Goals struct {
+	ID            string     `gorm:"primaryKey;not null"`
+	Active        bool       `gorm:"not null"`
+	CreatedAt     time.Time  `gorm:"not null"`
+	UpdatedAt     time.Time  `gorm:"not null"`
+	DeactivatedAt time.Time  `gorm:"not null"`
+	UserID        string     `gorm:"not null"`
+	Name          string     `gorm:"not null"`
+	TargetAmount  float64    `gorm:"not null"`
+	Deadline      *time.Time `gorm:"null"`
+	CategoryID    string     `gorm:"null;index"`
+	User          Users      `gorm:"foreignKey:UserID"`
+}
+
+type GoalContributions struct {
+	ID               string    `gorm:"primaryKey;not null"`
+	Active           bool      `gorm:"not null"`
+	CreatedAt        time.Time `gorm:"not null"`
+	UpdatedAt        time.Time `gorm:"not null"`
+	DeactivatedAt    time.Time `gorm:"not null"`
+	UserID           string    `gorm:"not null"`
+	GoalID           string    `gorm:"not null;index"`
+	Amount           float64   `gorm:"not null"`
+	ContributionDate time.Time `gorm:"not null"`
+	Notes            string    `gorm:"null"`
+	Goal             Goals     `gorm:"foreignKey:GoalID"`
+	User             Users     `gorm:"foreignKey:UserID"`
+}
+
 type Tags struct {
 	ID            string    `gorm:"primaryKey;not null"`
 	Active        bool      `gorm:"not null"`
@@ -57,6 +197,101 @@ type Users struct {
 	Name          string    `gorm:"not null"`
 	Email         string    `gorm:"not null"`
 	Password      string    `gorm:"not null"`
+	Timezone      string    `gorm:"not null;default:'America/Sao_Paulo'"`
+	Language      string    `gorm:"not null;default:''"`
+	WeekStart     string    `gorm:"not null;default:'monday'"`
+}
+
+type Rules struct {
+	ID                  string    `gorm:"primaryKey;not null"`
+	Active              bool      `gorm:"not null"`
+	CreatedAt           time.Time `gorm:"not null"`
+	UpdatedAt           time.Time `gorm:"not null"`
+	DeactivatedAt       time.Time `gorm:"not null"`
+	UserID              string    `gorm:"not null"`
+	Name                string    `gorm:"not null"`
+	Priority            int       `gorm:"not null"`
+	StopProcessing      bool      `gorm:"not null"`
+	NotesContains       string    `gorm:"null"`
+	NotesRegex          string    `gorm:"null"`
+	MinAmount           float64   `gorm:"null"`
+	MaxAmount           float64   `gorm:"null"`
+	Weekdays            string    `gorm:"null"`
+	ActionSetCategoryID string    `gorm:"null"`
+	ActionAddTagIDs     string    `gorm:"null"`
+	ActionRewriteNotes  string    `gorm:"null"`
+	User                Users     `gorm:"foreignKey:UserID"`
+}
+
+type WebhookSubscriptions struct {
+	ID            string    `gorm:"primaryKey;not null"`
+	Active        bool      `gorm:"not null"`
+	CreatedAt     time.Time `gorm:"not null"`
+	UpdatedAt     time.Time `gorm:"not null"`
+	DeactivatedAt time.Time `gorm:"not null"`
+	UserID        string    `gorm:"not null;index"`
+	URL           string    `gorm:"not null"`
+	Secret        string    `gorm:"not null"`
+	EventTypes    string    `gorm:"not null"`
+	User          Users     `gorm:"foreignKey:UserID"`
+}
+
+type WebhookDeliveries struct {
+	ID             string     `gorm:"primaryKey;not null"`
+	Active         bool       `gorm:"not null"`
+	CreatedAt      time.Time  `gorm:"not null"`
+	UpdatedAt      time.Time  `gorm:"not null"`
+	DeactivatedAt  time.Time  `gorm:"not null"`
+	UserID         string     `gorm:"not null;index"`
+	SubscriptionID string     `gorm:"not null;index"`
+	EventType      string     `gorm:"not null"`
+	Payload        string     `gorm:"type:text;not null"`
+	Status         string     `gorm:"not null;index"`
+	Attempts       int        `gorm:"not null;default:0"`
+	NextAttemptAt  *time.Time `gorm:"null;index"`
+	LastAttemptAt  *time.Time `gorm:"null"`
+	ResponseStatus int        `gorm:"null"`
+	LastError      string     `gorm:"type:text;null"`
+}
+
+type DigestSubscriptions struct {
+	ID            string     `gorm:"primaryKey;not null"`
+	Active        bool       `gorm:"not null"`
+	CreatedAt     time.Time  `gorm:"not null"`
+	UpdatedAt     time.Time  `gorm:"not null"`
+	DeactivatedAt time.Time  `gorm:"not null"`
+	UserID        string     `gorm:"not null;index"`
+	Email         string     `gorm:"not null"`
+	Frequency     string     `gorm:"not null"`
+	Verified      bool       `gorm:"not null;default:false"`
+	VerifiedAt    *time.Time `gorm:"null"`
+	User          Users      `gorm:"foreignKey:UserID"`
+}
+
+type DigestDeliveries struct {
+	ID             string     `gorm:"primaryKey;not null"`
+	Active         bool       `gorm:"not null"`
+	CreatedAt      time.Time  `gorm:"not null"`
+	UpdatedAt      time.Time  `gorm:"not null"`
+	DeactivatedAt  time.Time  `gorm:"not null"`
+	UserID         string     `gorm:"not null;index"`
+	SubscriptionID string     `gorm:"not null;uniqueIndex:idx_digest_deliveries_period_key"`
+	PeriodKey      string     `gorm:"not null;uniqueIndex:idx_digest_deliveries_period_key"`
+	PeriodStart    time.Time  `gorm:"not null"`
+	PeriodEnd      time.Time  `gorm:"not null"`
+	Status         string     `gorm:"not null"`
+	Attempts       int        `gorm:"not null;default:0"`
+	SentAt         *time.Time `gorm:"null"`
+	LastError      string     `gorm:"type:text;null"`
+}
+
+type SuggestionFeatures struct {
+	UserID    string    `gorm:"primaryKey;not null"`
+	Kind      string    `gorm:"primaryKey;not null"`
+	LabelID   string    `gorm:"primaryKey;not null"`
+	Feature   string    `gorm:"primaryKey;not null"`
+	Count     int       `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
 }
 
 func Migration(db *gorm.DB, sqlDB *sql.DB) {
@@ -64,7 +299,21 @@ func Migration(db *gorm.DB, sqlDB *sql.DB) {
 		Categories{},
 		Tags{},
 		Expenses{},
+		Merchants{},
+		ExpenseAnomalies{},
+		InstallmentPurchases{},
+		Incomes{},
+		Accounts{},
+		Transfers{},
+		Goals{},
+		GoalContributions{},
 		Users{},
+		Rules{},
+		SuggestionFeatures{},
+		WebhookSubscriptions{},
+		WebhookDeliveries{},
+		DigestSubscriptions{},
+		DigestDeliveries{},
 	); err != nil {
 		fmt.Println("Error during migration:", err)
 		return
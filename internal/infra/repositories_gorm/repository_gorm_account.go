@@ -0,0 +1,176 @@
+package repositoriesgorm
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"gorm.io/gorm"
+)
+
+type AccountRepository struct {
+	gorm *gorm.DB
+}
+
+func NewAccountRepository(gorm *gorm.DB) *AccountRepository {
+	return &AccountRepository{
+		gorm: gorm,
+	}
+}
+
+func (a *AccountRepository) CreateAccount(account entities.Account) error {
+	tx := a.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Create(&Accounts{
+		ID:             account.ID,
+		Active:         account.Active,
+		CreatedAt:      account.CreatedAt,
+		UpdatedAt:      account.UpdatedAt,
+		DeactivatedAt:  account.DeactivatedAt,
+		UserID:         account.UserID,
+		Name:           account.Name,
+		Type:           account.Type,
+		Currency:       account.Currency,
+		OpeningBalance: account.OpeningBalance,
+		ClosingDay:     account.ClosingDay,
+		DueDay:         account.DueDay,
+	}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (a *AccountRepository) DeleteAccount(account entities.Account) error {
+	tx := a.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	var expenseCount int64
+	if err := tx.Model(&Expenses{}).Where("account_id = ? AND active = ?", account.ID, true).Count(&expenseCount).Error; err != nil {
+		tx.Rollback()
+		return errors.New("failed to check expenses associated with the account: " + err.Error())
+	}
+
+	var incomeCount int64
+	if err := tx.Model(&Incomes{}).Where("account_id = ? AND active = ?", account.ID, true).Count(&incomeCount).Error; err != nil {
+		tx.Rollback()
+		return errors.New("failed to check incomes associated with the account: " + err.Error())
+	}
+
+	var transferCount int64
+	if err := tx.Model(&Transfers{}).Where("(from_account_id = ? OR to_account_id = ?) AND active = ?", account.ID, account.ID, true).Count(&transferCount).Error; err != nil {
+		tx.Rollback()
+		return errors.New("failed to check transfers associated with the account: " + err.Error())
+	}
+
+	if expenseCount > 0 || incomeCount > 0 || transferCount > 0 {
+		tx.Rollback()
+		return errors.New("there are transactions associated with this account")
+	}
+
+	result := tx.Model(&Accounts{}).Where("id = ? AND user_id = ? AND active = ?", account.ID, account.UserID, true).
+		Select("Active", "DeactivatedAt", "UpdatedAt").Updates(Accounts{
+		Active:        account.Active,
+		DeactivatedAt: account.DeactivatedAt,
+		UpdatedAt:     account.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func (a *AccountRepository) GetAccounts(userID string) ([]entities.Account, error) {
+	var accountsModel []Accounts
+
+	if err := a.gorm.Where("user_id = ? AND active = ?", userID, true).Find(&accountsModel).Error; err != nil {
+		return []entities.Account{}, err
+	}
+
+	accounts := []entities.Account{}
+
+	for _, accountModel := range accountsModel {
+		accounts = append(accounts, modelToAccount(accountModel))
+	}
+
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].Name < accounts[j].Name
+	})
+
+	return accounts, nil
+}
+
+func (a *AccountRepository) GetAccount(userID string, accountID string) (entities.Account, error) {
+	var accountModel Accounts
+
+	result := a.gorm.Where("id = ? AND user_id = ? AND active = ?", accountID, userID, true).First(&accountModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entities.Account{}, errors.New("account not found")
+		}
+		return entities.Account{}, errors.New(result.Error.Error())
+	}
+
+	return modelToAccount(accountModel), nil
+}
+
+func (a *AccountRepository) UpdateAccount(account entities.Account) error {
+	tx := a.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&Accounts{}).Where("id = ? AND user_id = ? AND active = ?", account.ID, account.UserID, true).Updates(map[string]interface{}{
+		"name":            account.Name,
+		"type":            account.Type,
+		"currency":        account.Currency,
+		"opening_balance": account.OpeningBalance,
+		"closing_day":     account.ClosingDay,
+		"due_day":         account.DueDay,
+		"updated_at":      account.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func modelToAccount(accountModel Accounts) entities.Account {
+	return entities.Account{
+		SharedEntity: entities.SharedEntity{
+			ID:            accountModel.ID,
+			Active:        accountModel.Active,
+			CreatedAt:     accountModel.CreatedAt,
+			UpdatedAt:     accountModel.UpdatedAt,
+			DeactivatedAt: accountModel.DeactivatedAt,
+		},
+		UserID:         accountModel.UserID,
+		Name:           accountModel.Name,
+		Type:           accountModel.Type,
+		Currency:       accountModel.Currency,
+		OpeningBalance: accountModel.OpeningBalance,
+		ClosingDay:     accountModel.ClosingDay,
+		DueDay:         accountModel.DueDay,
+	}
+}
@@ -36,6 +36,8 @@ func (c *CategoryRepository) CreateCategory(category entities.Category) error {
 		UserID:        category.UserID,
 		Name:          category.Name,
 		Color:         category.Color,
+		Kind:          category.Kind,
+		TaxCategory:   category.TaxCategory,
 	}).Error; err != nil {
 		tx.Rollback()
 		return err
@@ -64,6 +66,17 @@ func (c *CategoryRepository) DeleteCategory(category entities.Category) error {
 		return errors.New("there are expenses associated with this category")
 	}
 
+	var incomeCount int64
+	if err := tx.Model(&Incomes{}).Where("category_id = ? AND active = ?", category.ID, true).Count(&incomeCount).Error; err != nil {
+		tx.Rollback()
+		return errors.New("failed to check incomes associated with the category: " + err.Error())
+	}
+
+	if incomeCount > 0 {
+		tx.Rollback()
+		return errors.New("there are incomes associated with this category")
+	}
+
 	result := tx.Model(&Categories{}).Where("id = ? AND user_id = ? AND active = ?", category.ID, category.UserID, true).
 		Select("Active", "DeactivatedAt", "UpdatedAt").Updates(Categories{
 		Active:        category.Active,
@@ -97,9 +110,11 @@ func (c *CategoryRepository) GetCategories(userID string) ([]entities.Category,
 					UpdatedAt:     categoryModel.UpdatedAt,
 					DeactivatedAt: categoryModel.DeactivatedAt,
 				},
-				UserID: categoryModel.UserID,
-				Name:   categoryModel.Name,
-				Color:  categoryModel.Color,
+				UserID:      categoryModel.UserID,
+				Name:        categoryModel.Name,
+				Color:       categoryModel.Color,
+				Kind:        categoryModel.Kind,
+				TaxCategory: categoryModel.TaxCategory,
 			}
 
 			categories = append(categories, category)
@@ -134,9 +149,11 @@ func (c *CategoryRepository) GetCategory(userID string, categoryID string) (enti
 			UpdatedAt:     categoryModel.UpdatedAt,
 			DeactivatedAt: categoryModel.DeactivatedAt,
 		},
-		UserID: categoryModel.UserID,
-		Name:   categoryModel.Name,
-		Color:  categoryModel.Color,
+		UserID:      categoryModel.UserID,
+		Name:        categoryModel.Name,
+		Color:       categoryModel.Color,
+		Kind:        categoryModel.Kind,
+		TaxCategory: categoryModel.TaxCategory,
 	}
 
 	return category, nil
@@ -151,10 +168,11 @@ func (c *CategoryRepository) UpdateCategory(category entities.Category) error {
 		}
 	}()
 
-	result := tx.Model(&Categories{}).Where("id = ? AND user_id = ? AND active = ?", category.ID, category.UserID, true).Updates(Categories{
-		Name:      category.Name,
-		Color:     category.Color,
-		UpdatedAt: category.UpdatedAt,
+	result := tx.Model(&Categories{}).Where("id = ? AND user_id = ? AND active = ?", category.ID, category.UserID, true).Updates(map[string]interface{}{
+		"name":         category.Name,
+		"color":        category.Color,
+		"tax_category": category.TaxCategory,
+		"updated_at":   category.UpdatedAt,
 	})
 
 	if result.Error != nil {
@@ -0,0 +1,286 @@
+package repositoriesgorm
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DigestRepository struct {
+	gorm *gorm.DB
+}
+
+func NewDigestRepository(gorm *gorm.DB) *DigestRepository {
+	return &DigestRepository{
+		gorm: gorm,
+	}
+}
+
+func (d *DigestRepository) CreateDigestSubscription(subscription entities.DigestSubscription) error {
+	tx := d.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Create(&DigestSubscriptions{
+		ID:            subscription.ID,
+		Active:        subscription.Active,
+		CreatedAt:     subscription.CreatedAt,
+		UpdatedAt:     subscription.UpdatedAt,
+		DeactivatedAt: subscription.DeactivatedAt,
+		UserID:        subscription.UserID,
+		Email:         subscription.Email,
+		Frequency:     subscription.Frequency,
+		Verified:      subscription.Verified,
+		VerifiedAt:    subscription.VerifiedAt,
+	}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (d *DigestRepository) DeleteDigestSubscription(subscription entities.DigestSubscription) error {
+	tx := d.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&DigestSubscriptions{}).Where("id = ? AND user_id = ? AND active = ?", subscription.ID, subscription.UserID, true).
+		Select("Active", "DeactivatedAt", "UpdatedAt").Updates(DigestSubscriptions{
+		Active:        subscription.Active,
+		DeactivatedAt: subscription.DeactivatedAt,
+		UpdatedAt:     subscription.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func (d *DigestRepository) VerifyDigestSubscription(subscription entities.DigestSubscription) error {
+	tx := d.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&DigestSubscriptions{}).Where("id = ? AND user_id = ? AND active = ?", subscription.ID, subscription.UserID, true).
+		Select("Verified", "VerifiedAt", "UpdatedAt").Updates(DigestSubscriptions{
+		Verified:   subscription.Verified,
+		VerifiedAt: subscription.VerifiedAt,
+		UpdatedAt:  subscription.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func (d *DigestRepository) GetDigestSubscriptions(userID string) ([]entities.DigestSubscription, error) {
+	var subscriptionsModel []DigestSubscriptions
+
+	if err := d.gorm.Where("user_id = ? AND active = ?", userID, true).Find(&subscriptionsModel).Error; err != nil {
+		return []entities.DigestSubscription{}, err
+	}
+
+	subscriptions := []entities.DigestSubscription{}
+
+	for _, subscriptionModel := range subscriptionsModel {
+		subscriptions = append(subscriptions, modelToDigestSubscription(subscriptionModel))
+	}
+
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].Frequency > subscriptions[j].Frequency
+	})
+
+	return subscriptions, nil
+}
+
+func (d *DigestRepository) GetDigestSubscription(userID string, subscriptionID string) (entities.DigestSubscription, error) {
+	var subscriptionModel DigestSubscriptions
+
+	result := d.gorm.Where("id = ? AND user_id = ? AND active = ?", subscriptionID, userID, true).First(&subscriptionModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entities.DigestSubscription{}, errors.New("digest subscription not found")
+		}
+		return entities.DigestSubscription{}, errors.New(result.Error.Error())
+	}
+
+	return modelToDigestSubscription(subscriptionModel), nil
+}
+
+func (d *DigestRepository) GetAllDigestSubscriptions() ([]entities.DigestSubscription, error) {
+	var subscriptionsModel []DigestSubscriptions
+
+	if err := d.gorm.Joins("JOIN users ON users.id = digest_subscriptions.user_id").
+		Where("digest_subscriptions.active = ? AND digest_subscriptions.verified = ? AND users.active = ?", true, true, true).
+		Find(&subscriptionsModel).Error; err != nil {
+		return []entities.DigestSubscription{}, errors.New("failed to fetch digest subscriptions: " + err.Error())
+	}
+
+	subscriptions := []entities.DigestSubscription{}
+
+	for _, subscriptionModel := range subscriptionsModel {
+		subscriptions = append(subscriptions, modelToDigestSubscription(subscriptionModel))
+	}
+
+	return subscriptions, nil
+}
+
+func (d *DigestRepository) GetDigestDelivery(subscriptionID string, periodKey string) (entities.DigestDelivery, error) {
+	var deliveryModel DigestDeliveries
+
+	result := d.gorm.Where("subscription_id = ? AND period_key = ?", subscriptionID, periodKey).First(&deliveryModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entities.DigestDelivery{}, errors.New("digest delivery not found")
+		}
+		return entities.DigestDelivery{}, errors.New(result.Error.Error())
+	}
+
+	return modelToDigestDelivery(deliveryModel), nil
+}
+
+// CreateDigestDelivery claims the period for a subscription. It reports false
+// when another run has already claimed it.
+func (d *DigestRepository) CreateDigestDelivery(delivery entities.DigestDelivery) (bool, error) {
+	tx := d.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&DigestDeliveries{
+		ID:             delivery.ID,
+		Active:         delivery.Active,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+		DeactivatedAt:  delivery.DeactivatedAt,
+		UserID:         delivery.UserID,
+		SubscriptionID: delivery.SubscriptionID,
+		PeriodKey:      delivery.PeriodKey,
+		PeriodStart:    delivery.PeriodStart,
+		PeriodEnd:      delivery.PeriodEnd,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		SentAt:         delivery.SentAt,
+		LastError:      delivery.LastError,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return false, errors.New("failed to create digest delivery: " + result.Error.Error())
+	}
+
+	return result.RowsAffected > 0, tx.Commit().Error
+}
+
+// RetryDigestDelivery claims a failed delivery for another attempt. The update
+// only matches while the delivery is still failed with the attempts it was
+// read with, so it reports false when another run got there first.
+func (d *DigestRepository) RetryDigestDelivery(delivery entities.DigestDelivery) (bool, error) {
+	tx := d.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&DigestDeliveries{}).Where("id = ? AND status = ? AND attempts = ?", delivery.ID, entities.DIGEST_DELIVERY_STATUS_FAILED, delivery.Attempts).Updates(map[string]interface{}{
+		"status":     delivery.Status,
+		"updated_at": delivery.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return false, errors.New("failed to retry digest delivery: " + result.Error.Error())
+	}
+
+	return result.RowsAffected > 0, tx.Commit().Error
+}
+
+func (d *DigestRepository) UpdateDigestDelivery(delivery entities.DigestDelivery) error {
+	tx := d.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&DigestDeliveries{}).Where("id = ?", delivery.ID).Updates(map[string]interface{}{
+		"status":     delivery.Status,
+		"attempts":   delivery.Attempts,
+		"sent_at":    delivery.SentAt,
+		"last_error": delivery.LastError,
+		"updated_at": delivery.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func modelToDigestSubscription(subscriptionModel DigestSubscriptions) entities.DigestSubscription {
+	return entities.DigestSubscription{
+		SharedEntity: entities.SharedEntity{
+			ID:            subscriptionModel.ID,
+			Active:        subscriptionModel.Active,
+			CreatedAt:     subscriptionModel.CreatedAt,
+			UpdatedAt:     subscriptionModel.UpdatedAt,
+			DeactivatedAt: subscriptionModel.DeactivatedAt,
+		},
+		UserID:     subscriptionModel.UserID,
+		Email:      subscriptionModel.Email,
+		Frequency:  subscriptionModel.Frequency,
+		Verified:   subscriptionModel.Verified,
+		VerifiedAt: subscriptionModel.VerifiedAt,
+	}
+}
+
+func modelToDigestDelivery(deliveryModel DigestDeliveries) entities.DigestDelivery {
+	return entities.DigestDelivery{
+		SharedEntity: entities.SharedEntity{
+			ID:            deliveryModel.ID,
+			Active:        deliveryModel.Active,
+			CreatedAt:     deliveryModel.CreatedAt,
+			UpdatedAt:     deliveryModel.UpdatedAt,
+			DeactivatedAt: deliveryModel.DeactivatedAt,
+		},
+		UserID:         deliveryModel.UserID,
+		SubscriptionID: deliveryModel.SubscriptionID,
+		PeriodKey:      deliveryModel.PeriodKey,
+		PeriodStart:    deliveryModel.PeriodStart,
+		PeriodEnd:      deliveryModel.PeriodEnd,
+		Status:         deliveryModel.Status,
+		Attempts:       deliveryModel.Attempts,
+		SentAt:         deliveryModel.SentAt,
+		LastError:      deliveryModel.LastError,
+	}
+}
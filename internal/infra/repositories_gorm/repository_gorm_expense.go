@@ -3,6 +3,7 @@ package repositoriesgorm
 import (
 	"errors"
 	"sort"
+	"time"
 
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
 	"gorm.io/gorm"
@@ -27,30 +28,69 @@ func (e *ExpenseRepository) CreateExpense(expense entities.Expense) error {
 		}
 	}()
 
+	if err := createExpense(tx, expense); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func createExpense(tx *gorm.DB, expense entities.Expense) error {
 	if err := tx.Create(&Expenses{
-		ID:            expense.ID,
-		Active:        expense.Active,
-		CreatedAt:     expense.CreatedAt,
-		UpdatedAt:     expense.UpdatedAt,
-		DeactivatedAt: expense.DeactivatedAt,
-		UserID:        expense.UserID,
-		Amount:        expense.Amount,
-		ExpanseDate:   expense.ExpenseDate,
-		CategoryID:    expense.CategoryID,
-		Notes:         expense.Notes,
+		ID:                    expense.ID,
+		Active:                expense.Active,
+		CreatedAt:             expense.CreatedAt,
+		UpdatedAt:             expense.UpdatedAt,
+		DeactivatedAt:         expense.DeactivatedAt,
+		UserID:                expense.UserID,
+		Amount:                expense.Amount,
+		ExpanseDate:           expense.ExpenseDate,
+		CategoryID:            expense.CategoryID,
+		AccountID:             expense.AccountID,
+		MerchantID:            expense.MerchantID,
+		InstallmentPurchaseID: expense.InstallmentPurchaseID,
+		InstallmentNumber:     expense.InstallmentNumber,
+		StatementDate:         expense.StatementDate,
+		Notes:                 expense.Notes,
+		TaxCategory:           expense.TaxCategory,
+		ProviderTaxID:         expense.ProviderTaxID,
+		ProviderName:          expense.ProviderName,
+		Latitude:              expense.Latitude,
+		Longitude:             expense.Longitude,
+		PlaceName:             expense.PlaceName,
 	}).Error; err != nil {
-		tx.Rollback()
 		return err
 	}
 
 	for _, tagID := range expense.TagIDs {
 		if err := tx.Exec("INSERT INTO expense_tags (expenses_id, tags_id) VALUES (?, ?)", expense.ID, tagID).Error; err != nil {
-			tx.Rollback()
 			return err
 		}
 	}
 
-	return tx.Commit().Error
+	for _, anomaly := range expense.Anomalies {
+		if err := tx.Create(&ExpenseAnomalies{
+			ID:               anomaly.ID,
+			Active:           anomaly.Active,
+			CreatedAt:        anomaly.CreatedAt,
+			UpdatedAt:        anomaly.UpdatedAt,
+			DeactivatedAt:    anomaly.DeactivatedAt,
+			UserID:           anomaly.UserID,
+			ExpenseID:        anomaly.ExpenseID,
+			Kind:             anomaly.Kind,
+			Score:            anomaly.Score,
+			Baseline:         anomaly.Baseline,
+			RelatedExpenseID: anomaly.RelatedExpenseID,
+			Explanation:      anomaly.Explanation,
+			Dismissed:        anomaly.Dismissed,
+			DismissedAt:      anomaly.DismissedAt,
+		}).Error; err != nil {
+			return errors.New("failed to create expense anomaly: " + err.Error())
+		}
+	}
+
+	return nil
 }
 
 func (e *ExpenseRepository) DeleteExpense(expense entities.Expense) error {
@@ -84,7 +124,7 @@ func (e *ExpenseRepository) DeleteExpense(expense entities.Expense) error {
 func (e *ExpenseRepository) GetExpenses(userID string) ([]entities.Expense, error) {
 	var expensesModel []Expenses
 
-	if err := e.gorm.Preload("Tags", "active = ?", true).Preload("Category", "active = ?", true).Where("user_id = ? AND active = ?", userID, true).Find(&expensesModel).Order("expense_date DESC").Error; err != nil {
+	if err := e.gorm.Preload("Tags", "active = ?", true).Preload("Category", "active = ?", true).Preload("Anomalies", "active = ? AND dismissed = ?", true, false).Where("user_id = ? AND active = ?", userID, true).Find(&expensesModel).Order("expense_date DESC").Error; err != nil {
 		return []entities.Expense{}, err
 	}
 
@@ -101,9 +141,11 @@ func (e *ExpenseRepository) GetExpenses(userID string) ([]entities.Expense, erro
 					UpdatedAt:     expenseModel.Category.UpdatedAt,
 					DeactivatedAt: expenseModel.Category.DeactivatedAt,
 				},
-				UserID: expenseModel.Category.UserID,
-				Name:   expenseModel.Category.Name,
-				Color:  expenseModel.Category.Color,
+				UserID:      expenseModel.Category.UserID,
+				Name:        expenseModel.Category.Name,
+				Color:       expenseModel.Category.Color,
+				Kind:        expenseModel.Category.Kind,
+				TaxCategory: expenseModel.Category.TaxCategory,
 			}
 
 			var tags []entities.Tag
@@ -134,14 +176,26 @@ func (e *ExpenseRepository) GetExpenses(userID string) ([]entities.Expense, erro
 					UpdatedAt:     expenseModel.UpdatedAt,
 					DeactivatedAt: expenseModel.DeactivatedAt,
 				},
-				UserID:      expenseModel.UserID,
-				Amount:      expenseModel.Amount,
-				ExpenseDate: expenseModel.ExpanseDate,
-				Notes:       expenseModel.Notes,
-				CategoryID:  expenseModel.Category.ID,
-				TagIDs:      tagsIDs,
-				Category:    category,
-				Tags:        tags,
+				UserID:                expenseModel.UserID,
+				Amount:                expenseModel.Amount,
+				ExpenseDate:           expenseModel.ExpanseDate,
+				Notes:                 expenseModel.Notes,
+				TaxCategory:           expenseModel.TaxCategory,
+				ProviderTaxID:         expenseModel.ProviderTaxID,
+				ProviderName:          expenseModel.ProviderName,
+				Latitude:              expenseModel.Latitude,
+				Longitude:             expenseModel.Longitude,
+				PlaceName:             expenseModel.PlaceName,
+				CategoryID:            expenseModel.Category.ID,
+				AccountID:             expenseModel.AccountID,
+				MerchantID:            expenseModel.MerchantID,
+				InstallmentPurchaseID: expenseModel.InstallmentPurchaseID,
+				InstallmentNumber:     expenseModel.InstallmentNumber,
+				StatementDate:         expenseModel.StatementDate,
+				TagIDs:                tagsIDs,
+				Category:              category,
+				Tags:                  tags,
+				Anomalies:             modelsToExpenseAnomalies(expenseModel.Anomalies),
 			}
 
 			expenses = append(expenses, expense)
@@ -160,7 +214,7 @@ func (e *ExpenseRepository) GetExpenses(userID string) ([]entities.Expense, erro
 func (e *ExpenseRepository) GetExpense(userID string, expenseID string) (entities.Expense, error) {
 	var expenseModel Expenses
 
-	result := e.gorm.Preload("Tags", "active = ?", true).Preload("Category", "active = ?", true).Where("id = ? AND user_id = ? AND active = ?", expenseID, userID, true).First(&expenseModel)
+	result := e.gorm.Preload("Tags", "active = ?", true).Preload("Category", "active = ?", true).Preload("Anomalies", "active = ? AND dismissed = ?", true, false).Where("id = ? AND user_id = ? AND active = ?", expenseID, userID, true).First(&expenseModel)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return entities.Expense{}, errors.New("expense not found")
@@ -168,91 +222,73 @@ func (e *ExpenseRepository) GetExpense(userID string, expenseID string) (entitie
 		return entities.Expense{}, errors.New(result.Error.Error())
 	}
 
-	category := entities.Category{
-
-		SharedEntity: entities.SharedEntity{
-			ID:            expenseModel.Category.ID,
-			Active:        expenseModel.Category.Active,
-			CreatedAt:     expenseModel.Category.CreatedAt,
-			UpdatedAt:     expenseModel.Category.UpdatedAt,
-			DeactivatedAt: expenseModel.Category.DeactivatedAt,
-		},
-		UserID: expenseModel.Category.UserID,
-		Name:   expenseModel.Category.Name,
-		Color:  expenseModel.Category.Color,
-	}
-
-	var tags []entities.Tag
-	var tagsIDs []string
-
-	for _, tag := range expenseModel.Tags {
-		tags = append(tags, entities.Tag{
-			SharedEntity: entities.SharedEntity{
-				ID:            tag.ID,
-				Active:        tag.Active,
-				CreatedAt:     tag.CreatedAt,
-				UpdatedAt:     tag.UpdatedAt,
-				DeactivatedAt: tag.DeactivatedAt,
-			},
-			UserID: tag.UserID,
-			Name:   tag.Name,
-			Color:  tag.Color,
-		})
+	return modelToExpense(expenseModel), nil
+}
 
-		tagsIDs = append(tagsIDs, tag.ID)
-	}
+func (e *ExpenseRepository) UpdateExpense(expense entities.Expense) error {
+	tx := e.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
 
-	expense := entities.Expense{
-		SharedEntity: entities.SharedEntity{
-			ID:            expenseModel.ID,
-			Active:        expenseModel.Active,
-			CreatedAt:     expenseModel.CreatedAt,
-			UpdatedAt:     expenseModel.UpdatedAt,
-			DeactivatedAt: expenseModel.DeactivatedAt,
-		},
-		UserID:      expenseModel.UserID,
-		Amount:      expenseModel.Amount,
-		ExpenseDate: expenseModel.ExpanseDate,
-		Notes:       expenseModel.Notes,
-		CategoryID:  expenseModel.Category.ID,
-		TagIDs:      tagsIDs,
-		Category:    category,
-		Tags:        tags,
+	if err := updateExpense(tx, expense); err != nil {
+		tx.Rollback()
+		return err
 	}
 
-	return expense, nil
+	return tx.Commit().Error
 }
 
-func (e *ExpenseRepository) UpdateExpense(expense entities.Expense) error {
+func (e *ExpenseRepository) UpdateExpenses(expenses []entities.Expense) error {
 	tx := e.gorm.Begin()
 	defer func() {
 		if r := recover(); r != nil {
 			tx.Rollback()
+			panic(r)
 		}
 	}()
 
+	for _, expense := range expenses {
+		if err := updateExpense(tx, expense); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+func updateExpense(tx *gorm.DB, expense entities.Expense) error {
 	result := tx.Model(&Expenses{}).Where("id = ? AND active = ?", expense.ID, true).Updates(map[string]interface{}{
-		"amount":       expense.Amount,
-		"notes":        expense.Notes,
-		"category_id":  expense.CategoryID,
-		"expanse_date": expense.ExpenseDate,
-		"updated_at":   expense.UpdatedAt,
+		"amount":          expense.Amount,
+		"notes":           expense.Notes,
+		"tax_category":    expense.TaxCategory,
+		"provider_tax_id": expense.ProviderTaxID,
+		"provider_name":   expense.ProviderName,
+		"latitude":        expense.Latitude,
+		"longitude":       expense.Longitude,
+		"place_name":      expense.PlaceName,
+		"category_id":     expense.CategoryID,
+		"account_id":      expense.AccountID,
+		"merchant_id":     expense.MerchantID,
+		"statement_date":  expense.StatementDate,
+		"expanse_date":    expense.ExpenseDate,
+		"updated_at":      expense.UpdatedAt,
 	})
 
 	if result.Error != nil {
-		tx.Rollback()
 		return errors.New(result.Error.Error())
 	}
 
 	var existingExpense Expenses
 	if err := tx.Preload("Tags").First(&existingExpense, "id = ? AND active = ?", expense.ID, true).Error; err != nil {
-		tx.Rollback()
 		return errors.New("failed to load existing expenses: " + err.Error())
 	}
 
 	if len(existingExpense.Tags) > 0 {
 		if err := tx.Model(&existingExpense).Association("Tags").Clear(); err != nil {
-			tx.Rollback()
 			return errors.New("failed to clear existing tags: " + err.Error())
 		}
 	}
@@ -260,15 +296,281 @@ func (e *ExpenseRepository) UpdateExpense(expense entities.Expense) error {
 	if len(expense.TagIDs) > 0 {
 		var newTags []Tags
 		if err := tx.Where("id IN ?", expense.TagIDs).Find(&newTags).Error; err != nil {
-			tx.Rollback()
 			return errors.New("failed to find new tags: " + err.Error())
 		}
 
 		if err := tx.Model(&existingExpense).Association("Tags").Append(newTags); err != nil {
-			tx.Rollback()
 			return errors.New("failed to add new tags: " + err.Error())
 		}
 	}
 
+	return nil
+}
+
+func (e *ExpenseRepository) GetAccountExpenses(userID string, accountID string) ([]entities.Expense, error) {
+	var expensesModel []Expenses
+
+	if err := e.gorm.Where("user_id = ? AND account_id = ? AND active = ?", userID, accountID, true).Find(&expensesModel).Error; err != nil {
+		return []entities.Expense{}, errors.New("failed to fetch account expenses: " + err.Error())
+	}
+
+	expenses := []entities.Expense{}
+
+	for _, expenseModel := range expensesModel {
+		expenses = append(expenses, entities.Expense{
+			SharedEntity: entities.SharedEntity{
+				ID:            expenseModel.ID,
+				Active:        expenseModel.Active,
+				CreatedAt:     expenseModel.CreatedAt,
+				UpdatedAt:     expenseModel.UpdatedAt,
+				DeactivatedAt: expenseModel.DeactivatedAt,
+			},
+			UserID:                expenseModel.UserID,
+			Amount:                expenseModel.Amount,
+			ExpenseDate:           expenseModel.ExpanseDate,
+			CategoryID:            expenseModel.CategoryID,
+			AccountID:             expenseModel.AccountID,
+			MerchantID:            expenseModel.MerchantID,
+			InstallmentPurchaseID: expenseModel.InstallmentPurchaseID,
+			InstallmentNumber:     expenseModel.InstallmentNumber,
+			StatementDate:         expenseModel.StatementDate,
+			Notes:                 expenseModel.Notes,
+		})
+	}
+
+	return expenses, nil
+}
+
+func (e *ExpenseRepository) UpdateExpenseStatements(expenses []entities.Expense) error {
+	tx := e.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	for _, expense := range expenses {
+		if err := tx.Model(&Expenses{}).Where("id = ? AND user_id = ? AND active = ?", expense.ID, expense.UserID, true).
+			Update("statement_date", expense.StatementDate).Error; err != nil {
+			tx.Rollback()
+			return errors.New("failed to update expense statement: " + err.Error())
+		}
+	}
+
 	return tx.Commit().Error
 }
+
+func (e *ExpenseRepository) GetCategoryExpensesForPeriod(userID string, categoryID string, startDate time.Time, endDate time.Time) ([]entities.Expense, error) {
+	var expensesModel []Expenses
+
+	if err := e.gorm.Where("user_id = ? AND category_id = ? AND active = ? AND expanse_date BETWEEN ? AND ?", userID, categoryID, true, startDate, endDate).Find(&expensesModel).Error; err != nil {
+		return []entities.Expense{}, errors.New("failed to fetch category expenses: " + err.Error())
+	}
+
+	expenses := []entities.Expense{}
+
+	for _, expenseModel := range expensesModel {
+		expenses = append(expenses, entities.Expense{
+			SharedEntity: entities.SharedEntity{
+				ID:            expenseModel.ID,
+				Active:        expenseModel.Active,
+				CreatedAt:     expenseModel.CreatedAt,
+				UpdatedAt:     expenseModel.UpdatedAt,
+				DeactivatedAt: expenseModel.DeactivatedAt,
+			},
+			UserID:                expenseModel.UserID,
+			Amount:                expenseModel.Amount,
+			ExpenseDate:           expenseModel.ExpanseDate,
+			CategoryID:            expenseModel.CategoryID,
+			AccountID:             expenseModel.AccountID,
+			MerchantID:            expenseModel.MerchantID,
+			InstallmentPurchaseID: expenseModel.InstallmentPurchaseID,
+			InstallmentNumber:     expenseModel.InstallmentNumber,
+			StatementDate:         expenseModel.StatementDate,
+			Notes:                 expenseModel.Notes,
+		})
+	}
+
+	return expenses, nil
+}
+
+// GetExpensesPage returns up to limit expenses after afterID, ordered by id.
+// IDs are ULIDs, so the order is creation order and a page starts where the
+// previous one ended without counting the rows before it.
+func (e *ExpenseRepository) GetExpensesPage(userID string, afterID string, limit int) ([]entities.Expense, error) {
+	var expensesModel []Expenses
+
+	if err := e.gorm.Preload("Tags", "active = ?", true).Preload("Category", "active = ?", true).Preload("Anomalies", "active = ? AND dismissed = ?", true, false).
+		Where("user_id = ? AND active = ? AND id > ?", userID, true, afterID).
+		Order("id ASC").Limit(limit).Find(&expensesModel).Error; err != nil {
+		return []entities.Expense{}, errors.New("failed to fetch expenses page: " + err.Error())
+	}
+
+	expenses := []entities.Expense{}
+
+	for _, expenseModel := range expensesModel {
+		expenses = append(expenses, modelToExpense(expenseModel))
+	}
+
+	return expenses, nil
+}
+
+func (e *ExpenseRepository) GetExpenseAnomalies(userID string) ([]entities.Expense, error) {
+	var expensesModel []Expenses
+
+	anomalousExpenseIDs := e.gorm.Model(&ExpenseAnomalies{}).Select("expense_id").
+		Where("user_id = ? AND active = ? AND dismissed = ?", userID, true, false)
+
+	if err := e.gorm.Preload("Tags", "active = ?", true).Preload("Category", "active = ?", true).Preload("Anomalies", "active = ? AND dismissed = ?", true, false).
+		Where("user_id = ? AND active = ? AND id IN (?)", userID, true, anomalousExpenseIDs).
+		Order("expanse_date DESC").Find(&expensesModel).Error; err != nil {
+		return []entities.Expense{}, errors.New("failed to fetch expense anomalies: " + err.Error())
+	}
+
+	expenses := []entities.Expense{}
+
+	for _, expenseModel := range expensesModel {
+		expenses = append(expenses, modelToExpense(expenseModel))
+	}
+
+	return expenses, nil
+}
+
+func (e *ExpenseRepository) GetExpenseAnomaly(userID string, anomalyID string) (entities.ExpenseAnomaly, error) {
+	var anomalyModel ExpenseAnomalies
+
+	result := e.gorm.Where("id = ? AND user_id = ? AND active = ?", anomalyID, userID, true).First(&anomalyModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entities.ExpenseAnomaly{}, errors.New("expense anomaly not found")
+		}
+		return entities.ExpenseAnomaly{}, errors.New(result.Error.Error())
+	}
+
+	return modelToExpenseAnomaly(anomalyModel), nil
+}
+
+func (e *ExpenseRepository) DismissExpenseAnomaly(anomaly entities.ExpenseAnomaly) error {
+	tx := e.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&ExpenseAnomalies{}).Where("id = ? AND user_id = ? AND active = ?", anomaly.ID, anomaly.UserID, true).Updates(map[string]interface{}{
+		"dismissed":    anomaly.Dismissed,
+		"dismissed_at": anomaly.DismissedAt,
+		"updated_at":   anomaly.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func modelToExpenseAnomaly(anomalyModel ExpenseAnomalies) entities.ExpenseAnomaly {
+	return entities.ExpenseAnomaly{
+		SharedEntity: entities.SharedEntity{
+			ID:            anomalyModel.ID,
+			Active:        anomalyModel.Active,
+			CreatedAt:     anomalyModel.CreatedAt,
+			UpdatedAt:     anomalyModel.UpdatedAt,
+			DeactivatedAt: anomalyModel.DeactivatedAt,
+		},
+		UserID:           anomalyModel.UserID,
+		ExpenseID:        anomalyModel.ExpenseID,
+		Kind:             anomalyModel.Kind,
+		Score:            anomalyModel.Score,
+		Baseline:         anomalyModel.Baseline,
+		RelatedExpenseID: anomalyModel.RelatedExpenseID,
+		Explanation:      anomalyModel.Explanation,
+		Dismissed:        anomalyModel.Dismissed,
+		DismissedAt:      anomalyModel.DismissedAt,
+	}
+}
+
+func modelsToExpenseAnomalies(anomaliesModel []ExpenseAnomalies) []entities.ExpenseAnomaly {
+	var anomalies []entities.ExpenseAnomaly
+
+	for _, anomalyModel := range anomaliesModel {
+		anomalies = append(anomalies, modelToExpenseAnomaly(anomalyModel))
+	}
+
+	return anomalies
+}
+
+func modelToExpense(expenseModel Expenses) entities.Expense {
+	category := entities.Category{
+
+		SharedEntity: entities.SharedEntity{
+			ID:            expenseModel.Category.ID,
+			Active:        expenseModel.Category.Active,
+			CreatedAt:     expenseModel.Category.CreatedAt,
+			UpdatedAt:     expenseModel.Category.UpdatedAt,
+			DeactivatedAt: expenseModel.Category.DeactivatedAt,
+		},
+		UserID:      expenseModel.Category.UserID,
+		Name:        expenseModel.Category.Name,
+		Color:       expenseModel.Category.Color,
+		Kind:        expenseModel.Category.Kind,
+		TaxCategory: expenseModel.Category.TaxCategory,
+	}
+
+	var tags []entities.Tag
+	var tagsIDs []string
+
+	for _, tag := range expenseModel.Tags {
+		tags = append(tags, entities.Tag{
+			SharedEntity: entities.SharedEntity{
+				ID:            tag.ID,
+				Active:        tag.Active,
+				CreatedAt:     tag.CreatedAt,
+				UpdatedAt:     tag.UpdatedAt,
+				DeactivatedAt: tag.DeactivatedAt,
+			},
+			UserID: tag.UserID,
+			Name:   tag.Name,
+			Color:  tag.Color,
+		})
+
+		tagsIDs = append(tagsIDs, tag.ID)
+	}
+
+	expense := entities.Expense{
+		SharedEntity: entities.SharedEntity{
+			ID:            expenseModel.ID,
+			Active:        expenseModel.Active,
+			CreatedAt:     expenseModel.CreatedAt,
+			UpdatedAt:     expenseModel.UpdatedAt,
+			DeactivatedAt: expenseModel.DeactivatedAt,
+		},
+		UserID:                expenseModel.UserID,
+		Amount:                expenseModel.Amount,
+		ExpenseDate:           expenseModel.ExpanseDate,
+		Notes:                 expenseModel.Notes,
+		TaxCategory:           expenseModel.TaxCategory,
+		ProviderTaxID:         expenseModel.ProviderTaxID,
+		ProviderName:          expenseModel.ProviderName,
+		Latitude:              expenseModel.Latitude,
+		Longitude:             expenseModel.Longitude,
+		PlaceName:             expenseModel.PlaceName,
+		CategoryID:            expenseModel.Category.ID,
+		AccountID:             expenseModel.AccountID,
+		MerchantID:            expenseModel.MerchantID,
+		InstallmentPurchaseID: expenseModel.InstallmentPurchaseID,
+		InstallmentNumber:     expenseModel.InstallmentNumber,
+		StatementDate:         expenseModel.StatementDate,
+		TagIDs:                tagsIDs,
+		Category:              category,
+		Tags:                  tags,
+		Anomalies:             modelsToExpenseAnomalies(expenseModel.Anomalies),
+	}
+
+	return expense
+}
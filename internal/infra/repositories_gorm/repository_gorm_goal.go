@@ -0,0 +1,260 @@
+package repositoriesgorm
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"gorm.io/gorm"
+)
+
+type GoalRepository struct {
+	gorm *gorm.DB
+}
+
+func NewGoalRepository(gorm *gorm.DB) *GoalRepository {
+	return &GoalRepository{
+		gorm: gorm,
+	}
+}
+
+func (g *GoalRepository) CreateGoal(goal entities.Goal) error {
+	tx := g.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Create(&Goals{
+		ID:            goal.ID,
+		Active:        goal.Active,
+		CreatedAt:     goal.CreatedAt,
+		UpdatedAt:     goal.UpdatedAt,
+		DeactivatedAt: goal.DeactivatedAt,
+		UserID:        goal.UserID,
+		Name:          goal.Name,
+		TargetAmount:  goal.TargetAmount,
+		Deadline:      goal.Deadline,
+		CategoryID:    goal.CategoryID,
+	}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (g *GoalRepository) DeleteGoal(goal entities.Goal) error {
+	tx := g.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&Goals{}).Where("id = ? AND user_id = ? AND active = ?", goal.ID, goal.UserID, true).
+		Select("Active", "DeactivatedAt", "UpdatedAt").Updates(Goals{
+		Active:        goal.Active,
+		DeactivatedAt: goal.DeactivatedAt,
+		UpdatedAt:     goal.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	if err := tx.Model(&GoalContributions{}).Where("goal_id = ? AND user_id = ? AND active = ?", goal.ID, goal.UserID, true).
+		Select("Active", "DeactivatedAt", "UpdatedAt").Updates(GoalContributions{
+		Active:        goal.Active,
+		DeactivatedAt: goal.DeactivatedAt,
+		UpdatedAt:     goal.UpdatedAt,
+	}).Error; err != nil {
+		tx.Rollback()
+		return errors.New("failed to deactivate goal contributions: " + err.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func (g *GoalRepository) GetGoals(userID string) ([]entities.Goal, error) {
+	var goalsModel []Goals
+
+	if err := g.gorm.Where("user_id = ? AND active = ?", userID, true).Find(&goalsModel).Error; err != nil {
+		return []entities.Goal{}, err
+	}
+
+	goals := []entities.Goal{}
+
+	for _, goalModel := range goalsModel {
+		goals = append(goals, modelToGoal(goalModel))
+	}
+
+	sort.Slice(goals, func(i, j int) bool {
+		return goals[i].Name < goals[j].Name
+	})
+
+	return goals, nil
+}
+
+func (g *GoalRepository) GetGoal(userID string, goalID string) (entities.Goal, error) {
+	var goalModel Goals
+
+	result := g.gorm.Where("id = ? AND user_id = ? AND active = ?", goalID, userID, true).First(&goalModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entities.Goal{}, errors.New("goal not found")
+		}
+		return entities.Goal{}, errors.New(result.Error.Error())
+	}
+
+	return modelToGoal(goalModel), nil
+}
+
+func (g *GoalRepository) UpdateGoal(goal entities.Goal) error {
+	tx := g.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&Goals{}).Where("id = ? AND user_id = ? AND active = ?", goal.ID, goal.UserID, true).Updates(map[string]interface{}{
+		"name":          goal.Name,
+		"target_amount": goal.TargetAmount,
+		"deadline":      goal.Deadline,
+		"category_id":   goal.CategoryID,
+		"updated_at":    goal.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func (g *GoalRepository) CreateGoalContribution(contribution entities.GoalContribution) error {
+	tx := g.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Create(&GoalContributions{
+		ID:               contribution.ID,
+		Active:           contribution.Active,
+		CreatedAt:        contribution.CreatedAt,
+		UpdatedAt:        contribution.UpdatedAt,
+		DeactivatedAt:    contribution.DeactivatedAt,
+		UserID:           contribution.UserID,
+		GoalID:           contribution.GoalID,
+		Amount:           contribution.Amount,
+		ContributionDate: contribution.ContributionDate,
+		Notes:            contribution.Notes,
+	}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (g *GoalRepository) DeleteGoalContribution(contribution entities.GoalContribution) error {
+	tx := g.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&GoalContributions{}).Where("id = ? AND user_id = ? AND active = ?", contribution.ID, contribution.UserID, true).
+		Select("Active", "DeactivatedAt", "UpdatedAt").Updates(GoalContributions{
+		Active:        contribution.Active,
+		DeactivatedAt: contribution.DeactivatedAt,
+		UpdatedAt:     contribution.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func (g *GoalRepository) GetGoalContributions(userID string, goalID string) ([]entities.GoalContribution, error) {
+	var contributionsModel []GoalContributions
+
+	if err := g.gorm.Where("user_id = ? AND goal_id = ? AND active = ?", userID, goalID, true).Find(&contributionsModel).Error; err != nil {
+		return []entities.GoalContribution{}, err
+	}
+
+	contributions := []entities.GoalContribution{}
+
+	for _, contributionModel := range contributionsModel {
+		contributions = append(contributions, modelToGoalContribution(contributionModel))
+	}
+
+	sort.Slice(contributions, func(i, j int) bool {
+		return contributions[i].ContributionDate.After(contributions[j].ContributionDate)
+	})
+
+	return contributions, nil
+}
+
+func (g *GoalRepository) GetGoalContribution(userID string, contributionID string) (entities.GoalContribution, error) {
+	var contributionModel GoalContributions
+
+	result := g.gorm.Where("id = ? AND user_id = ? AND active = ?", contributionID, userID, true).First(&contributionModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entities.GoalContribution{}, errors.New("goal contribution not found")
+		}
+		return entities.GoalContribution{}, errors.New(result.Error.Error())
+	}
+
+	return modelToGoalContribution(contributionModel), nil
+}
+
+func modelToGoal(goalModel Goals) entities.Goal {
+	return entities.Goal{
+		SharedEntity: entities.SharedEntity{
+			ID:            goalModel.ID,
+			Active:        goalModel.Active,
+			CreatedAt:     goalModel.CreatedAt,
+			UpdatedAt:     goalModel.UpdatedAt,
+			DeactivatedAt: goalModel.DeactivatedAt,
+		},
+		UserID:       goalModel.UserID,
+		Name:         goalModel.Name,
+		TargetAmount: goalModel.TargetAmount,
+		Deadline:     goalModel.Deadline,
+		CategoryID:   goalModel.CategoryID,
+	}
+}
+
+func modelToGoalContribution(contributionModel GoalContributions) entities.GoalContribution {
+	return entities.GoalContribution{
+		SharedEntity: entities.SharedEntity{
+			ID:            contributionModel.ID,
+			Active:        contributionModel.Active,
+			CreatedAt:     contributionModel.CreatedAt,
+			UpdatedAt:     contributionModel.UpdatedAt,
+			DeactivatedAt: contributionModel.DeactivatedAt,
+		},
+		UserID:           contributionModel.UserID,
+		GoalID:           contributionModel.GoalID,
+		Amount:           contributionModel.Amount,
+		ContributionDate: contributionModel.ContributionDate,
+		Notes:            contributionModel.Notes,
+	}
+}
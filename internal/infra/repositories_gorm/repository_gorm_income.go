@@ -0,0 +1,163 @@
+package repositoriesgorm
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"gorm.io/gorm"
+)
+
+type IncomeRepository struct {
+	gorm *gorm.DB
+}
+
+func NewIncomeRepository(gorm *gorm.DB) *IncomeRepository {
+	return &IncomeRepository{
+		gorm: gorm,
+	}
+}
+
+func (i *IncomeRepository) CreateIncome(income entities.Income) error {
+	tx := i.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Create(&Incomes{
+		ID:            income.ID,
+		Active:        income.Active,
+		CreatedAt:     income.CreatedAt,
+		UpdatedAt:     income.UpdatedAt,
+		DeactivatedAt: income.DeactivatedAt,
+		UserID:        income.UserID,
+		Amount:        income.Amount,
+		IncomeDate:    income.IncomeDate,
+		CategoryID:    income.CategoryID,
+		AccountID:     income.AccountID,
+		Notes:         income.Notes,
+	}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (i *IncomeRepository) DeleteIncome(income entities.Income) error {
+	tx := i.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&Incomes{}).Where("id = ? AND user_id = ? AND active = ?", income.ID, income.UserID, true).
+		Select("Active", "DeactivatedAt", "UpdatedAt").Updates(Incomes{
+		Active:        income.Active,
+		DeactivatedAt: income.DeactivatedAt,
+		UpdatedAt:     income.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func (i *IncomeRepository) GetIncomes(userID string) ([]entities.Income, error) {
+	var incomesModel []Incomes
+
+	if err := i.gorm.Preload("Category", "active = ?", true).Where("user_id = ? AND active = ?", userID, true).Find(&incomesModel).Error; err != nil {
+		return []entities.Income{}, err
+	}
+
+	incomes := []entities.Income{}
+
+	for _, incomeModel := range incomesModel {
+		incomes = append(incomes, modelToIncome(incomeModel))
+	}
+
+	sort.Slice(incomes, func(a, b int) bool {
+		return incomes[a].IncomeDate.After(incomes[b].IncomeDate)
+	})
+
+	return incomes, nil
+}
+
+func (i *IncomeRepository) GetIncome(userID string, incomeID string) (entities.Income, error) {
+	var incomeModel Incomes
+
+	result := i.gorm.Preload("Category", "active = ?", true).Where("id = ? AND user_id = ? AND active = ?", incomeID, userID, true).First(&incomeModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entities.Income{}, errors.New("income not found")
+		}
+		return entities.Income{}, errors.New(result.Error.Error())
+	}
+
+	return modelToIncome(incomeModel), nil
+}
+
+func (i *IncomeRepository) UpdateIncome(income entities.Income) error {
+	tx := i.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&Incomes{}).Where("id = ? AND user_id = ? AND active = ?", income.ID, income.UserID, true).Updates(map[string]interface{}{
+		"amount":      income.Amount,
+		"notes":       income.Notes,
+		"category_id": income.CategoryID,
+		"account_id":  income.AccountID,
+		"income_date": income.IncomeDate,
+		"updated_at":  income.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func modelToIncome(incomeModel Incomes) entities.Income {
+	return entities.Income{
+		SharedEntity: entities.SharedEntity{
+			ID:            incomeModel.ID,
+			Active:        incomeModel.Active,
+			CreatedAt:     incomeModel.CreatedAt,
+			UpdatedAt:     incomeModel.UpdatedAt,
+			DeactivatedAt: incomeModel.DeactivatedAt,
+		},
+		UserID:     incomeModel.UserID,
+		Amount:     incomeModel.Amount,
+		IncomeDate: incomeModel.IncomeDate,
+		CategoryID: incomeModel.CategoryID,
+		AccountID:  incomeModel.AccountID,
+		Notes:      incomeModel.Notes,
+		Category: entities.Category{
+			SharedEntity: entities.SharedEntity{
+				ID:            incomeModel.Category.ID,
+				Active:        incomeModel.Category.Active,
+				CreatedAt:     incomeModel.Category.CreatedAt,
+				UpdatedAt:     incomeModel.Category.UpdatedAt,
+				DeactivatedAt: incomeModel.Category.DeactivatedAt,
+			},
+			UserID: incomeModel.Category.UserID,
+			Name:   incomeModel.Category.Name,
+			Color:  incomeModel.Category.Color,
+			Kind:   incomeModel.Category.Kind,
+		},
+	}
+}
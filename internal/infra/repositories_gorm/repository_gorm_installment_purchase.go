@@ -0,0 +1,252 @@
+package repositoriesgorm
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"gorm.io/gorm"
+)
+
+type InstallmentPurchaseRepository struct {
+	gorm *gorm.DB
+}
+
+func NewInstallmentPurchaseRepository(gorm *gorm.DB) *InstallmentPurchaseRepository {
+	return &InstallmentPurchaseRepository{
+		gorm: gorm,
+	}
+}
+
+func (i *InstallmentPurchaseRepository) CreateInstallmentPurchase(purchase entities.InstallmentPurchase, installments []entities.Expense) error {
+	tx := i.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Create(&InstallmentPurchases{
+		ID:               purchase.ID,
+		Active:           purchase.Active,
+		CreatedAt:        purchase.CreatedAt,
+		UpdatedAt:        purchase.UpdatedAt,
+		DeactivatedAt:    purchase.DeactivatedAt,
+		UserID:           purchase.UserID,
+		TotalAmount:      purchase.TotalAmount,
+		InstallmentCount: purchase.InstallmentCount,
+		FirstDueDate:     purchase.FirstDueDate,
+		RemainderPolicy:  purchase.RemainderPolicy,
+		CategoryID:       purchase.CategoryID,
+		AccountID:        purchase.AccountID,
+		Notes:            purchase.Notes,
+	}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := createInstallments(tx, installments); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (i *InstallmentPurchaseRepository) DeleteInstallmentPurchase(purchase entities.InstallmentPurchase, from time.Time) error {
+	tx := i.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&InstallmentPurchases{}).Where("id = ? AND user_id = ? AND active = ?", purchase.ID, purchase.UserID, true).
+		Select("Active", "DeactivatedAt", "UpdatedAt").Updates(InstallmentPurchases{
+		Active:        purchase.Active,
+		DeactivatedAt: purchase.DeactivatedAt,
+		UpdatedAt:     purchase.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	if err := deactivateInstallments(tx, purchase, from); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (i *InstallmentPurchaseRepository) GetInstallmentPurchases(userID string) ([]entities.InstallmentPurchase, error) {
+	var purchasesModel []InstallmentPurchases
+
+	if err := i.gorm.Where("user_id = ? AND active = ?", userID, true).Find(&purchasesModel).Error; err != nil {
+		return []entities.InstallmentPurchase{}, err
+	}
+
+	purchases := []entities.InstallmentPurchase{}
+
+	for _, purchaseModel := range purchasesModel {
+		purchase := modelToInstallmentPurchase(purchaseModel)
+
+		installments, err := i.getInstallments(purchase.ID)
+		if err != nil {
+			return []entities.InstallmentPurchase{}, err
+		}
+		purchase.Installments = installments
+
+		purchases = append(purchases, purchase)
+	}
+
+	sort.Slice(purchases, func(a, b int) bool {
+		return purchases[a].FirstDueDate.After(purchases[b].FirstDueDate)
+	})
+
+	return purchases, nil
+}
+
+func (i *InstallmentPurchaseRepository) GetInstallmentPurchase(userID string, purchaseID string) (entities.InstallmentPurchase, error) {
+	var purchaseModel InstallmentPurchases
+
+	result := i.gorm.Where("id = ? AND user_id = ? AND active = ?", purchaseID, userID, true).First(&purchaseModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entities.InstallmentPurchase{}, errors.New("installment purchase not found")
+		}
+		return entities.InstallmentPurchase{}, errors.New(result.Error.Error())
+	}
+
+	purchase := modelToInstallmentPurchase(purchaseModel)
+
+	installments, err := i.getInstallments(purchase.ID)
+	if err != nil {
+		return entities.InstallmentPurchase{}, err
+	}
+	purchase.Installments = installments
+
+	return purchase, nil
+}
+
+func (i *InstallmentPurchaseRepository) UpdateInstallmentPurchase(purchase entities.InstallmentPurchase, from time.Time, installments []entities.Expense) error {
+	tx := i.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&InstallmentPurchases{}).Where("id = ? AND user_id = ? AND active = ?", purchase.ID, purchase.UserID, true).Updates(map[string]interface{}{
+		"total_amount":      purchase.TotalAmount,
+		"installment_count": purchase.InstallmentCount,
+		"first_due_date":    purchase.FirstDueDate,
+		"remainder_policy":  purchase.RemainderPolicy,
+		"category_id":       purchase.CategoryID,
+		"account_id":        purchase.AccountID,
+		"notes":             purchase.Notes,
+		"updated_at":        purchase.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	if err := deactivateInstallments(tx, purchase, from); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := createInstallments(tx, installments); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (i *InstallmentPurchaseRepository) getInstallments(purchaseID string) ([]entities.Expense, error) {
+	var expensesModel []Expenses
+
+	if err := i.gorm.Where("installment_purchase_id = ? AND active = ?", purchaseID, true).Order("installment_number").Find(&expensesModel).Error; err != nil {
+		return nil, errors.New("failed to fetch installments: " + err.Error())
+	}
+
+	installments := []entities.Expense{}
+
+	for _, expenseModel := range expensesModel {
+		installments = append(installments, entities.Expense{
+			SharedEntity: entities.SharedEntity{
+				ID:            expenseModel.ID,
+				Active:        expenseModel.Active,
+				CreatedAt:     expenseModel.CreatedAt,
+				UpdatedAt:     expenseModel.UpdatedAt,
+				DeactivatedAt: expenseModel.DeactivatedAt,
+			},
+			UserID:                expenseModel.UserID,
+			Amount:                expenseModel.Amount,
+			ExpenseDate:           expenseModel.ExpanseDate,
+			CategoryID:            expenseModel.CategoryID,
+			AccountID:             expenseModel.AccountID,
+			MerchantID:            expenseModel.MerchantID,
+			InstallmentPurchaseID: expenseModel.InstallmentPurchaseID,
+			InstallmentNumber:     expenseModel.InstallmentNumber,
+			StatementDate:         expenseModel.StatementDate,
+			Notes:                 expenseModel.Notes,
+		})
+	}
+
+	return installments, nil
+}
+
+func createInstallments(tx *gorm.DB, installments []entities.Expense) error {
+	for _, installment := range installments {
+		if err := createExpense(tx, installment); err != nil {
+			return errors.New("failed to create installment: " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+func deactivateInstallments(tx *gorm.DB, purchase entities.InstallmentPurchase, from time.Time) error {
+	timeNow := time.Now()
+
+	if err := tx.Model(&Expenses{}).Where("installment_purchase_id = ? AND user_id = ? AND expanse_date >= ? AND active = ?", purchase.ID, purchase.UserID, from, true).
+		Select("Active", "DeactivatedAt", "UpdatedAt").Updates(Expenses{
+		Active:        false,
+		DeactivatedAt: timeNow,
+		UpdatedAt:     timeNow,
+	}).Error; err != nil {
+		return errors.New("failed to remove future installments: " + err.Error())
+	}
+
+	return nil
+}
+
+func modelToInstallmentPurchase(purchaseModel InstallmentPurchases) entities.InstallmentPurchase {
+	return entities.InstallmentPurchase{
+		SharedEntity: entities.SharedEntity{
+			ID:            purchaseModel.ID,
+			Active:        purchaseModel.Active,
+			CreatedAt:     purchaseModel.CreatedAt,
+			UpdatedAt:     purchaseModel.UpdatedAt,
+			DeactivatedAt: purchaseModel.DeactivatedAt,
+		},
+		UserID:           purchaseModel.UserID,
+		TotalAmount:      purchaseModel.TotalAmount,
+		InstallmentCount: purchaseModel.InstallmentCount,
+		FirstDueDate:     purchaseModel.FirstDueDate,
+		RemainderPolicy:  purchaseModel.RemainderPolicy,
+		CategoryID:       purchaseModel.CategoryID,
+		AccountID:        purchaseModel.AccountID,
+		Notes:            purchaseModel.Notes,
+	}
+}
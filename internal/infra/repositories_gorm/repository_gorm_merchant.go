@@ -0,0 +1,207 @@
+package repositoriesgorm
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"gorm.io/gorm"
+)
+
+type MerchantRepository struct {
+	gorm *gorm.DB
+}
+
+func NewMerchantRepository(gorm *gorm.DB) *MerchantRepository {
+	return &MerchantRepository{
+		gorm: gorm,
+	}
+}
+
+func (m *MerchantRepository) CreateMerchant(merchant entities.Merchant) error {
+	tx := m.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Create(&Merchants{
+		ID:            merchant.ID,
+		Active:        merchant.Active,
+		CreatedAt:     merchant.CreatedAt,
+		UpdatedAt:     merchant.UpdatedAt,
+		DeactivatedAt: merchant.DeactivatedAt,
+		UserID:        merchant.UserID,
+		Name:          merchant.Name,
+		Aliases:       strings.Join(merchant.Aliases, ","),
+	}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// DeleteMerchant deactivates the merchant and unlinks its expenses.
+func (m *MerchantRepository) DeleteMerchant(merchant entities.Merchant) error {
+	tx := m.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&Merchants{}).Where("id = ? AND user_id = ? AND active = ?", merchant.ID, merchant.UserID, true).
+		Select("Active", "DeactivatedAt", "UpdatedAt").Updates(Merchants{
+		Active:        merchant.Active,
+		DeactivatedAt: merchant.DeactivatedAt,
+		UpdatedAt:     merchant.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	if err := tx.Model(&Expenses{}).Where("merchant_id = ? AND user_id = ?", merchant.ID, merchant.UserID).Update("merchant_id", "").Error; err != nil {
+		tx.Rollback()
+		return errors.New("failed to unlink merchant expenses: " + err.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func (m *MerchantRepository) GetMerchants(userID string) ([]entities.Merchant, error) {
+	var merchantsModel []Merchants
+	if err := m.gorm.Where("user_id = ? AND active = ?", userID, true).Find(&merchantsModel).Error; err != nil {
+		return nil, err
+	}
+
+	merchants := []entities.Merchant{}
+
+	for _, merchantModel := range merchantsModel {
+		merchants = append(merchants, modelToMerchant(merchantModel))
+	}
+
+	sort.Slice(merchants, func(i, j int) bool {
+		return merchants[i].Name < merchants[j].Name
+	})
+
+	return merchants, nil
+}
+
+func (m *MerchantRepository) GetMerchant(userID string, merchantID string) (entities.Merchant, error) {
+	var merchantModel Merchants
+
+	result := m.gorm.Model(&Merchants{}).Where("id = ? AND user_id = ? AND active = ?", merchantID, userID, true).First(&merchantModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entities.Merchant{}, errors.New("merchant not found")
+		}
+		return entities.Merchant{}, errors.New(result.Error.Error())
+	}
+
+	return modelToMerchant(merchantModel), nil
+}
+
+func (m *MerchantRepository) ThisMerchantExists(userID string, merchantName string) (bool, error) {
+	var merchantModel Merchants
+
+	result := m.gorm.Model(&Merchants{}).Where("name = ? AND user_id = ? AND active = ?", merchantName, userID, true).First(&merchantModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return false, errors.New("merchant not found")
+		}
+		return false, errors.New(result.Error.Error())
+	}
+
+	return true, nil
+}
+
+func (m *MerchantRepository) UpdateMerchant(merchant entities.Merchant) error {
+	tx := m.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&Merchants{}).Where("id = ? AND user_id = ? AND active = ?", merchant.ID, merchant.UserID, true).Updates(Merchants{
+		Name:      merchant.Name,
+		Aliases:   strings.Join(merchant.Aliases, ","),
+		UpdatedAt: merchant.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+// GetMerchantExpenses lists the active expenses of the merchant, newest
+// first, with their categories.
+func (m *MerchantRepository) GetMerchantExpenses(userID string, merchantID string) ([]entities.Expense, error) {
+	var expensesModel []Expenses
+
+	if err := m.gorm.Preload("Category").Where("user_id = ? AND merchant_id = ? AND active = ?", userID, merchantID, true).
+		Order("expanse_date DESC").Find(&expensesModel).Error; err != nil {
+		return []entities.Expense{}, errors.New("failed to fetch merchant expenses: " + err.Error())
+	}
+
+	expenses := []entities.Expense{}
+
+	for _, expenseModel := range expensesModel {
+		expenses = append(expenses, entities.Expense{
+			SharedEntity: entities.SharedEntity{
+				ID:            expenseModel.ID,
+				Active:        expenseModel.Active,
+				CreatedAt:     expenseModel.CreatedAt,
+				UpdatedAt:     expenseModel.UpdatedAt,
+				DeactivatedAt: expenseModel.DeactivatedAt,
+			},
+			UserID:      expenseModel.UserID,
+			Amount:      expenseModel.Amount,
+			ExpenseDate: expenseModel.ExpanseDate,
+			CategoryID:  expenseModel.CategoryID,
+			AccountID:   expenseModel.AccountID,
+			MerchantID:  expenseModel.MerchantID,
+			Notes:       expenseModel.Notes,
+			Category: entities.Category{
+				SharedEntity: entities.SharedEntity{ID: expenseModel.Category.ID},
+				UserID:       expenseModel.Category.UserID,
+				Name:         expenseModel.Category.Name,
+				Color:        expenseModel.Category.Color,
+				Kind:         expenseModel.Category.Kind,
+			},
+		})
+	}
+
+	return expenses, nil
+}
+
+func modelToMerchant(merchantModel Merchants) entities.Merchant {
+	aliases := []string{}
+	if merchantModel.Aliases != "" {
+		aliases = strings.Split(merchantModel.Aliases, ",")
+	}
+
+	return entities.Merchant{
+		SharedEntity: entities.SharedEntity{
+			ID:            merchantModel.ID,
+			Active:        merchantModel.Active,
+			CreatedAt:     merchantModel.CreatedAt,
+			UpdatedAt:     merchantModel.UpdatedAt,
+			DeactivatedAt: merchantModel.DeactivatedAt,
+		},
+		UserID:  merchantModel.UserID,
+		Name:    merchantModel.Name,
+		Aliases: aliases,
+	}
+}
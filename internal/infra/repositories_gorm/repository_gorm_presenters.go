@@ -3,6 +3,7 @@ package repositoriesgorm
 import (
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -24,12 +25,40 @@ func NewPresentersRepository(gorm *gorm.DB) *PresentersRepository {
 	}
 }
 
-func (p *PresentersRepository) GetTotalExpensesForPeriod(userID string, startDate time.Time, endDate time.Time) (float64, error) {
+func expenseDateColumn(basis string) string {
+	if basis == repositories.EXPENSE_BASIS_STATEMENT {
+		return "COALESCE(expenses.statement_date, expenses.expanse_date)"
+	}
+
+	return "expenses.expanse_date"
+}
+
+// userLocation returns the timezone chosen by the user. Period boundaries and
+// the EXTRACT/TO_CHAR groupings below use it so that an expense made late at
+// night counts in the user's day, month and year rather than in UTC.
+func (p *PresentersRepository) userLocation(userID string) (*time.Location, error) {
+	var timezone string
+
+	if err := p.gorm.Model(&Users{}).Select("timezone").Where("id = ?", userID).Scan(&timezone).Error; err != nil {
+		return nil, errors.New("failed to fetch user timezone: " + err.Error())
+	}
+
+	return util.LoadLocation(timezone)
+}
+
+// localDateColumn converts a timestamptz column to the wall clock time of the
+// location. Location names come from time.LoadLocation, so they are safe to
+// inline.
+func localDateColumn(column string, location *time.Location) string {
+	return "(" + column + " AT TIME ZONE '" + location.String() + "')"
+}
+
+func (p *PresentersRepository) GetTotalExpensesForPeriod(userID string, startDate time.Time, endDate time.Time, basis string) (float64, error) {
 	var total float64
 
 	if err := p.gorm.Table("expenses").
 		Select("COALESCE(SUM(amount), 0)").
-		Where("user_id = ? AND expanse_date BETWEEN ? AND ? AND active = ?", userID, startDate, endDate, true).
+		Where("user_id = ? AND "+expenseDateColumn(basis)+" BETWEEN ? AND ? AND active = ?", userID, startDate, endDate, true).
 		Scan(&total).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			total = 0
@@ -41,13 +70,13 @@ func (p *PresentersRepository) GetTotalExpensesForPeriod(userID string, startDat
 	return total, nil
 }
 
-func (p *PresentersRepository) GetExpensesByCategoryPeriod(userID string, startDate time.Time, endDate time.Time) ([]repositories.CategoryExpense, error) {
+func (p *PresentersRepository) GetExpensesByCategoryPeriod(userID string, startDate time.Time, endDate time.Time, basis string) ([]repositories.CategoryExpense, error) {
 	var expensesByCategory []repositories.CategoryExpense
 
 	if err := p.gorm.Table("expenses").
 		Select("categories.name as category_name, categories.color as category_color, SUM(expenses.amount) as total").
 		Joins("JOIN categories ON expenses.category_id = categories.id").
-		Where("expenses.user_id = ? AND expenses.expanse_date BETWEEN ? AND ? AND expenses.active = ?", userID, startDate, endDate, true).
+		Where("expenses.user_id = ? AND "+expenseDateColumn(basis)+" BETWEEN ? AND ? AND expenses.active = ?", userID, startDate, endDate, true).
 		Group("categories.name, categories.color").Order("total DESC").
 		Scan(&expensesByCategory).Error; err != nil {
 		return nil, errors.New("failed to fetch expenses by category: " + err.Error())
@@ -56,169 +85,151 @@ func (p *PresentersRepository) GetExpensesByCategoryPeriod(userID string, startD
 	return expensesByCategory, nil
 }
 
-func (p *PresentersRepository) GetMonthlyExpensesByCategoryYear(userID string, year int) ([]repositories.MonthlyCategoryExpense, []int, error) {
-	var results []struct {
-		Year         int     `gorm:"column:year"`
-		Month        string  `gorm:"column:month"`
-		CategoryName string  `gorm:"column:category_name"`
-		Color        string  `gorm:"column:color"`
-		Total        float64 `gorm:"column:total"`
+func (p *PresentersRepository) GetExpensesByTagPeriod(userID string, startDate time.Time, endDate time.Time, basis string) ([]repositories.TagExpense, error) {
+	var expensesByTag []repositories.TagExpense
+
+	if err := p.gorm.Table("expenses").
+		Select("tags.name as tag_name, tags.color as tag_color, SUM(expenses.amount) as total").
+		Joins("JOIN expense_tags ON expenses.id = expense_tags.expenses_id").
+		Joins("JOIN tags ON expense_tags.tags_id = tags.id").
+		Where("expenses.user_id = ? AND "+expenseDateColumn(basis)+" BETWEEN ? AND ? AND expenses.active = ?", userID, startDate, endDate, true).
+		Group("tags.name, tags.color").Order("total DESC").
+		Scan(&expensesByTag).Error; err != nil {
+		return nil, errors.New("failed to fetch expenses by tag: " + err.Error())
 	}
 
-	err := p.gorm.Table("expenses").
-		Select("EXTRACT(YEAR FROM expanse_date) AS year, TO_CHAR(expanse_date, 'Month') AS month, categories.name AS category_name, categories.color AS color, SUM(expenses.amount) AS total").
-		Joins("INNER JOIN categories ON expenses.category_id = categories.id").
-		Where("expenses.user_id = ? AND EXTRACT(YEAR FROM expenses.expanse_date) = ? AND expenses.active = ?", userID, year, true).
-		Group("year, month, categories.name, categories.color").
-		Order("MIN(expanse_date)").
-		Scan(&results).Error
+	return expensesByTag, nil
+}
+
+func (p *PresentersRepository) GetMonthlyExpensesByCategoryYear(userID string, year int, basis string) ([]repositories.MonthlyCategoryExpense, []int, error) {
+	location, err := p.userLocation(userID)
+	if err != nil {
+		return nil, []int{}, errors.New("failed to load timezone: " + err.Error())
+	}
 
+	series, err := p.timeSeries(userID, location, yearTimeSeriesQuery(year, location, basis, repositories.TIME_SERIES_GROUP_CATEGORY))
 	if err != nil {
 		return nil, []int{}, errors.New("failed to fetch monthly expenses by category: " + err.Error())
 	}
 
-	var years []int
-	err = p.gorm.Table("expenses").
-		Select("DISTINCT EXTRACT(YEAR FROM expanse_date) AS year").
-		Where("expenses.user_id = ? AND expenses.active = ?", userID, true).
-		Order("year").
-		Scan(&years).Error
-
+	years, err := p.availableYears(userID, localDateColumn(expenseDateColumn(basis), location))
 	if err != nil {
-		p.gorm.Rollback()
-		return nil, nil, errors.New("failed to fetch available years: " + err.Error())
+		return nil, nil, err
 	}
 
-	monthlyExpensesMap := make(map[string]repositories.MonthlyCategoryExpense)
-
-	for _, result := range results {
-		month := strings.TrimSpace(result.Month)
-		key := fmt.Sprintf("%d-%s", result.Year, month)
-
-		if _, exists := monthlyExpensesMap[key]; !exists {
-			monthlyExpensesMap[key] = repositories.MonthlyCategoryExpense{
-				Month:      month,
-				Year:       result.Year,
-				Categories: []repositories.CategoryExpense{},
-				Total:      0,
-			}
+	var monthlyExpenses []repositories.MonthlyCategoryExpense
+	for i, bucket := range series.Buckets {
+		if bucket.Total == 0 {
+			continue
 		}
 
-		current := monthlyExpensesMap[key]
-
-		current.Categories = append(current.Categories, repositories.CategoryExpense{
-			CategoryName:  result.CategoryName,
-			CategoryColor: result.Color,
-			Total:         result.Total,
-		})
+		month := repositories.MonthlyCategoryExpense{
+			Month:       bucket.StartDate.Month().String(),
+			MonthNumber: int(bucket.StartDate.Month()),
+			Year:        year,
+			Categories:  []repositories.CategoryExpense{},
+			Total:       bucket.Total,
+		}
 
-		current.Total += result.Total
+		for _, group := range series.Groups {
+			if group.Totals[i] == 0 {
+				continue
+			}
 
-		monthlyExpensesMap[key] = current
-	}
+			month.Categories = append(month.Categories, repositories.CategoryExpense{
+				CategoryName:  group.Name,
+				CategoryColor: group.Color,
+				Total:         group.Totals[i],
+			})
+		}
 
-	var monthlyExpenses []repositories.MonthlyCategoryExpense
-	for _, categories := range monthlyExpensesMap {
-		monthlyExpenses = append(monthlyExpenses, categories)
+		monthlyExpenses = append(monthlyExpenses, month)
 	}
 
-	sort.Slice(monthlyExpenses, func(i, j int) bool {
-		return getMonthOrder(monthlyExpenses[i].Month) < getMonthOrder(monthlyExpenses[j].Month)
-	})
-
 	return monthlyExpenses, years, nil
 }
 
 func (p *PresentersRepository) GetMonthlyExpensesByTagYear(userID string, year int) ([]repositories.MonthlyTagExpense, []int, error) {
-	var results []struct {
-		Year    int     `gorm:"column:year"`
-		Month   string  `gorm:"column:month"`
-		TagName string  `gorm:"column:tag_name"`
-		Color   string  `gorm:"column:color"`
-		Total   float64 `gorm:"column:total"`
-	}
-
-	err := p.gorm.Table("expenses").
-		Select("EXTRACT(YEAR FROM expanse_date) AS year, TO_CHAR(expanse_date, 'Month') AS month, tags.name AS tag_name, tags.color AS color, SUM(expenses.amount) AS total").
-		Joins("INNER JOIN expense_tags ON expenses.id = expense_tags.expenses_id").
-		Joins("INNER JOIN tags ON expense_tags.tags_id = tags.id").
-		Where("expenses.user_id = ? AND EXTRACT(YEAR FROM expenses.expanse_date) = ? AND expenses.active = ?", userID, year, true).
-		Group("year, month, tags.name, tags.color").
-		Order("MIN(expanse_date)").
-		Scan(&results).Error
+	location, err := p.userLocation(userID)
+	if err != nil {
+		return nil, []int{}, errors.New("failed to load timezone: " + err.Error())
+	}
 
+	series, err := p.timeSeries(userID, location, yearTimeSeriesQuery(year, location, repositories.EXPENSE_BASIS_PURCHASE, repositories.TIME_SERIES_GROUP_TAG))
 	if err != nil {
 		return nil, []int{}, errors.New("failed to fetch monthly expenses by tag: " + err.Error())
 	}
 
-	var years []int
-
-	err = p.gorm.Table("expenses").
-		Select("DISTINCT EXTRACT(YEAR FROM expanse_date) AS year").
-		Where("expenses.user_id = ? AND expenses.active = ?", userID, true).
-		Order("year").
-		Scan(&years).Error
-
+	years, err := p.availableYears(userID, localDateColumn("expenses.expanse_date", location))
 	if err != nil {
-		return nil, nil, errors.New("failed to fetch available years: " + err.Error())
+		return nil, nil, err
 	}
 
-	monthlyExpensesMap := make(map[string]repositories.MonthlyTagExpense)
-
-	for _, result := range results {
-		month := strings.TrimSpace(result.Month)
-		key := fmt.Sprintf("%d-%s", result.Year, month)
-
-		if _, exists := monthlyExpensesMap[key]; !exists {
-			monthlyExpensesMap[key] = repositories.MonthlyTagExpense{
-				Month: month,
-				Year:  result.Year,
-				Tags:  []repositories.TagExpense{},
-				Total: 0,
-			}
+	var monthlyExpenses []repositories.MonthlyTagExpense
+	for i, bucket := range series.Buckets {
+		if bucket.Total == 0 {
+			continue
 		}
 
-		current := monthlyExpensesMap[key]
-
-		current.Tags = append(current.Tags, repositories.TagExpense{
-			TagName:  result.TagName,
-			TagColor: result.Color,
-			Total:    result.Total,
-		})
+		month := repositories.MonthlyTagExpense{
+			Month:       bucket.StartDate.Month().String(),
+			MonthNumber: int(bucket.StartDate.Month()),
+			Year:        year,
+			Tags:        []repositories.TagExpense{},
+			Total:       bucket.Total,
+		}
 
-		current.Total += result.Total
+		for _, group := range series.Groups {
+			if group.Totals[i] == 0 {
+				continue
+			}
 
-		monthlyExpensesMap[key] = current
-	}
+			month.Tags = append(month.Tags, repositories.TagExpense{
+				TagName:  group.Name,
+				TagColor: group.Color,
+				Total:    group.Totals[i],
+			})
+		}
 
-	var monthlyExpenses []repositories.MonthlyTagExpense
-	for _, tags := range monthlyExpensesMap {
-		monthlyExpenses = append(monthlyExpenses, tags)
+		monthlyExpenses = append(monthlyExpenses, month)
 	}
 
-	sort.Slice(monthlyExpenses, func(i, j int) bool {
-		return getMonthOrder(monthlyExpenses[i].Month) < getMonthOrder(monthlyExpenses[j].Month)
-	})
-
 	return monthlyExpenses, years, nil
 }
 
-func getMonthOrder(month string) int {
-	months := map[string]int{
-		"January": 1, "February": 2, "March": 3, "April": 4, "May": 5, "June": 6,
-		"July": 7, "August": 8, "September": 9, "October": 10, "November": 11, "December": 12,
+// yearTimeSeriesQuery covers the twelve months of the year in the user's
+// timezone.
+func yearTimeSeriesQuery(year int, location *time.Location, basis string, groupBy string) repositories.TimeSeriesQuery {
+	return repositories.TimeSeriesQuery{
+		StartDate:   time.Date(year, time.January, 1, 0, 0, 0, 0, location),
+		EndDate:     time.Date(year+1, time.January, 1, 0, 0, 0, 0, location).Add(-time.Nanosecond),
+		Granularity: repositories.TIME_SERIES_MONTH,
+		GroupBy:     groupBy,
+		Basis:       basis,
+	}
+}
+
+func (p *PresentersRepository) availableYears(userID string, dateColumn string) ([]int, error) {
+	var years []int
+
+	if err := p.gorm.Table("expenses").
+		Select("DISTINCT EXTRACT(YEAR FROM "+dateColumn+") AS year").
+		Where("expenses.user_id = ? AND expenses.active = ?", userID, true).
+		Order("year").
+		Scan(&years).Error; err != nil {
+		return nil, errors.New("failed to fetch available years: " + err.Error())
 	}
-	return months[month]
+
+	return years, nil
 }
 
-func (p *PresentersRepository) GetTotalExpensesForCurrentMonth(userID string) (float64, string, error) {
-	location, err := time.LoadLocation(util.TIMEZONE)
+func (p *PresentersRepository) GetTotalExpensesForCurrentMonth(userID string) (float64, time.Month, error) {
+	location, err := p.userLocation(userID)
 	if err != nil {
-		return 0, "", errors.New("failed to load timezone: " + err.Error())
+		return 0, 0, errors.New("failed to load timezone: " + err.Error())
 	}
 
 	var total float64
-	var month string
 
 	now := time.Now().In(location)
 	startOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, location)
@@ -231,21 +242,28 @@ func (p *PresentersRepository) GetTotalExpensesForCurrentMonth(userID string) (f
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			total = 0
 		} else {
-			return 0, "", errors.New("failed to fetch total expenses: " + err.Error())
+			return 0, 0, errors.New("failed to fetch total expenses: " + err.Error())
 		}
 	}
 
-	month = time.Now().Format("January")
-
-	return total, month, nil
+	return total, now.Month(), nil
 }
 
-func (p *PresentersRepository) GetExpensesByMonthYear(userID string, month int, year int) (repositories.MonthExpenses, error) {
+// GetExpensesByMonthYear groups the month's expenses by the user's weeks. A
+// week that starts or ends in a neighbouring month keeps its full date range,
+// but only holds the days of the requested month.
+func (p *PresentersRepository) GetExpensesByMonthYear(userID string, month int, year int, weekStart time.Weekday) (repositories.MonthExpenses, error) {
 	var monthExpenses repositories.MonthExpenses
 	monthExpenses.Month = time.Month(month).String()
+	monthExpenses.MonthNumber = month
 	monthExpenses.Year = year
 
-	startDate := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	location, err := p.userLocation(userID)
+	if err != nil {
+		return repositories.MonthExpenses{}, errors.New("failed to load timezone: " + err.Error())
+	}
+
+	startDate := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, location)
 	endDate := startDate.AddDate(0, 1, 0).Add(-time.Nanosecond)
 
 	var expenses []Expenses
@@ -254,25 +272,25 @@ func (p *PresentersRepository) GetExpensesByMonthYear(userID string, month int,
 		return repositories.MonthExpenses{}, errors.New("failed to fetch expenses: " + err.Error())
 	}
 
-	weeks := make(map[int]map[string]*repositories.DayExpense)
+	weeks := make(map[time.Time]map[string]*repositories.DayExpense)
 	totalExpenses := 0.0
 
 	for _, expense := range expenses {
+		expenseDate := expense.ExpanseDate.In(location)
 
-		_, weekNumber := expense.ExpanseDate.ISOWeek()
-
-		weekNumber = int(weekNumber)
+		weekBegin := util.StartOfWeek(expenseDate, weekStart)
 
-		dayKey := expense.ExpanseDate.Format("02")
+		dayKey := expenseDate.Format("02")
 
-		if weeks[weekNumber] == nil {
-			weeks[weekNumber] = make(map[string]*repositories.DayExpense)
+		if weeks[weekBegin] == nil {
+			weeks[weekBegin] = make(map[string]*repositories.DayExpense)
 		}
 
-		if _, exists := weeks[weekNumber][dayKey]; !exists {
-			weeks[weekNumber][dayKey] = &repositories.DayExpense{
+		if _, exists := weeks[weekBegin][dayKey]; !exists {
+			weeks[weekBegin][dayKey] = &repositories.DayExpense{
 				Day:     dayKey,
-				DayName: expense.ExpanseDate.Weekday().String(),
+				DayName: expenseDate.Weekday().String(),
+				Weekday: int(expenseDate.Weekday()),
 				Total:   0,
 				Tags:    []repositories.ExpenseTag{},
 			}
@@ -287,22 +305,22 @@ func (p *PresentersRepository) GetExpensesByMonthYear(userID string, month int,
 			return repositories.MonthExpenses{}, errors.New("failed to fetch tags for expense: " + err.Error())
 		}
 
-		weeks[weekNumber][dayKey].Total += expense.Amount
+		weeks[weekBegin][dayKey].Total += expense.Amount
 		totalExpenses += expense.Amount
 
 		for _, tag := range tags {
 			tagFound := false
 
-			for i, dayTag := range weeks[weekNumber][dayKey].Tags {
+			for i, dayTag := range weeks[weekBegin][dayKey].Tags {
 				if dayTag.Name == tag.Name {
-					weeks[weekNumber][dayKey].Tags[i].Total += expense.Amount
+					weeks[weekBegin][dayKey].Tags[i].Total += expense.Amount
 					tagFound = true
 					break
 				}
 			}
 
 			if !tagFound {
-				weeks[weekNumber][dayKey].Tags = append(weeks[weekNumber][dayKey].Tags, repositories.ExpenseTag{
+				weeks[weekBegin][dayKey].Tags = append(weeks[weekBegin][dayKey].Tags, repositories.ExpenseTag{
 					Name:  tag.Name,
 					Color: tag.Color,
 					Total: expense.Amount,
@@ -313,10 +331,14 @@ func (p *PresentersRepository) GetExpensesByMonthYear(userID string, month int,
 
 	monthExpenses.TotalExpenses = totalExpenses
 
-	for weekNumber, days := range weeks {
+	for weekBegin, days := range weeks {
+		_, weekNumber := util.WeekOfYear(weekBegin, weekStart)
+
 		weekExpenses := repositories.WeekExpenses{
-			Week: weekNumber,
-			Days: []repositories.DayExpense{},
+			Week:      weekNumber,
+			StartDate: weekBegin.Format("02/01/2006"),
+			EndDate:   weekBegin.AddDate(0, 0, 6).Format("02/01/2006"),
+			Days:      []repositories.DayExpense{},
 		}
 
 		for _, day := range days {
@@ -333,12 +355,14 @@ func (p *PresentersRepository) GetExpensesByMonthYear(userID string, month int,
 	}
 
 	sort.Slice(monthExpenses.Weeks, func(i, j int) bool {
-		return monthExpenses.Weeks[i].Week < monthExpenses.Weeks[j].Week
+		dayI, _ := strconv.Atoi(monthExpenses.Weeks[i].Days[0].Day)
+		dayJ, _ := strconv.Atoi(monthExpenses.Weeks[j].Days[0].Day)
+		return dayI < dayJ
 	})
 
 	var availableYears []int
 	if err := p.gorm.Table("expenses").
-		Select("DISTINCT EXTRACT(YEAR FROM expanse_date) as year").
+		Select("DISTINCT EXTRACT(YEAR FROM "+localDateColumn("expanse_date", location)+") as year").
 		Where("user_id = ? AND active = ?", userID, true).
 		Order("year DESC").
 		Pluck("year", &availableYears).Error; err != nil {
@@ -350,77 +374,62 @@ func (p *PresentersRepository) GetExpensesByMonthYear(userID string, month int,
 	return monthExpenses, nil
 }
 
-func (p *PresentersRepository) GetTotalExpensesForCurrentWeek(userID string) (float64, string, error) {
-	location, err := time.LoadLocation(util.TIMEZONE)
+// GetTotalExpensesForCurrentWeek sums the expenses from the start of the week
+// containing until up to until itself, so passing a date one week back gives
+// the same point of the previous week.
+func (p *PresentersRepository) GetTotalExpensesForCurrentWeek(userID string, weekStart time.Weekday, until time.Time) (float64, string, error) {
+	location, err := p.userLocation(userID)
 	if err != nil {
 		return 0, "", errors.New("failed to load timezone: " + err.Error())
 	}
 
 	var totalExpenses float64
 
-	now := time.Now().In(location)
-
-	startOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
-
-	endOfWeek := now
+	endOfWeek := until.In(location)
+	startOfWeek := util.StartOfWeek(endOfWeek, weekStart)
 
-	var expenses []Expenses
-	if err := p.gorm.
-		Where("user_id = ? AND expanse_date BETWEEN ? AND ?", userID, startOfMonth, endOfWeek).
-		Find(&expenses).Error; err != nil {
+	if err := p.gorm.Table("expenses").
+		Select("COALESCE(SUM(amount), 0)").
+		Where("user_id = ? AND expanse_date BETWEEN ? AND ? AND active = ?", userID, startOfWeek, endOfWeek, true).
+		Scan(&totalExpenses).Error; err != nil {
 		return 0, "", errors.New("failed to fetch expenses: " + err.Error())
 	}
 
-	for _, expense := range expenses {
-		totalExpenses += expense.Amount
-	}
-
-	weekInterval := fmt.Sprintf("%s - %s", startOfMonth.Format("02/01/2006"), endOfWeek.Format("02/01/2006"))
+	weekInterval := fmt.Sprintf("%s - %s", startOfWeek.Format("02/01/2006"), endOfWeek.Format("02/01/2006"))
 
 	return totalExpenses, weekInterval, nil
 }
 
-func (p *PresentersRepository) GetTotalExpensesMonthCurrentYear(userID string, year int) (repositories.ExpensesMonthCurrentYear, error) {
-	var expensesMonthCurrentYear repositories.ExpensesMonthCurrentYear
-	expensesMonthCurrentYear.Year = year
-
-	months := make([]repositories.MonthCurrentYear, 12)
-	for i := 0; i < 12; i++ {
-		months[i] = repositories.MonthCurrentYear{
-			Month: time.Month(i + 1).String(),
-			Total: 0,
-		}
+func (p *PresentersRepository) GetTotalExpensesMonthCurrentYear(userID string, year int, basis string) (repositories.ExpensesMonthCurrentYear, error) {
+	location, err := p.userLocation(userID)
+	if err != nil {
+		return repositories.ExpensesMonthCurrentYear{}, errors.New("failed to load timezone: " + err.Error())
 	}
 
-	type ExpenseMonth struct {
-		Month int     `json:"month"`
-		Total float64 `json:"total"`
-	}
+	dateColumn := localDateColumn(expenseDateColumn(basis), location)
 
-	var expenses []ExpenseMonth
-	if err := p.gorm.Table("expenses").
-		Select("EXTRACT(MONTH FROM expanse_date) as month, COALESCE(SUM(amount), 0) as total").
-		Where("user_id = ? AND EXTRACT(YEAR FROM expanse_date) = ? AND active = ?", userID, year, true).
-		Group("EXTRACT(MONTH FROM expanse_date)").
-		Order("EXTRACT(MONTH FROM expanse_date)").
-		Find(&expenses).Error; err != nil {
+	series, err := p.timeSeries(userID, location, yearTimeSeriesQuery(year, location, basis, ""))
+	if err != nil {
 		return repositories.ExpensesMonthCurrentYear{}, errors.New("failed to fetch expenses by month: " + err.Error())
 	}
 
-	for _, expense := range expenses {
-		months[expense.Month-1].Total = expense.Total
-	}
-
-	var totalYear float64
-	for _, month := range months {
-		totalYear += month.Total
+	var expensesMonthCurrentYear repositories.ExpensesMonthCurrentYear
+	expensesMonthCurrentYear.Year = year
+	expensesMonthCurrentYear.Total = series.Total
+
+	months := make([]repositories.MonthCurrentYear, 0, len(series.Buckets))
+	for _, bucket := range series.Buckets {
+		months = append(months, repositories.MonthCurrentYear{
+			Month:       bucket.StartDate.Month().String(),
+			MonthNumber: int(bucket.StartDate.Month()),
+			Total:       bucket.Total,
+		})
 	}
-	expensesMonthCurrentYear.Total = totalYear
 	expensesMonthCurrentYear.Months = months
 
 	var availableYears []int
 	if err := p.gorm.Table("expenses").
-		Select("DISTINCT EXTRACT(YEAR FROM expanse_date) as year").
+		Select("DISTINCT EXTRACT(YEAR FROM "+dateColumn+") as year").
 		Where("user_id = ? AND active = ?", userID, true).
 		Order("year DESC").
 		Pluck("year", &availableYears).Error; err != nil {
@@ -434,87 +443,79 @@ func (p *PresentersRepository) GetTotalExpensesMonthCurrentYear(userID string, y
 func (p *PresentersRepository) GetCategoryTagsTotalsByMonthYear(userID string, month int, year int) (repositories.CategoryTagsTotals, error) {
 	var categoryTagsTotals repositories.CategoryTagsTotals
 	categoryTagsTotals.Month = time.Month(month).String()
+	categoryTagsTotals.MonthNumber = month
 	categoryTagsTotals.Year = year
 
-	startDate := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
-	endDate := startDate.AddDate(0, 1, 0).Add(-time.Nanosecond)
-
-	var totalExpenses float64
-	if err := p.gorm.Table("expenses").
-		Where("user_id = ? AND expanse_date BETWEEN ? AND ? AND active = ?", userID, startDate, endDate, true).
-		Select("COALESCE(SUM(amount), 0)").
-		Scan(&totalExpenses).Error; err != nil {
-		return repositories.CategoryTagsTotals{}, errors.New("failed to calculate total expenses for the month: " + err.Error())
+	location, err := p.userLocation(userID)
+	if err != nil {
+		return repositories.CategoryTagsTotals{}, errors.New("failed to load timezone: " + err.Error())
 	}
-	categoryTagsTotals.ExpensesAmount = totalExpenses
 
-	var results []struct {
-		CategoryName  string
-		CategoryTotal float64
-		CategoryColor string
+	dateColumn := localDateColumn("expanse_date", location)
+
+	startDate := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, location)
+	endDate := startDate.AddDate(0, 1, 0).Add(-time.Nanosecond)
+
+	query := repositories.PivotQuery{
+		StartDate: startDate,
+		EndDate:   endDate,
+		Rows:      repositories.PIVOT_DIMENSION_CATEGORY,
+		Measure:   repositories.PIVOT_MEASURE_SUM,
+		Basis:     repositories.EXPENSE_BASIS_PURCHASE,
 	}
 
-	if err := p.gorm.Table("expenses").
-		Select("categories.name as category_name, COALESCE(SUM(expenses.amount), 0) as category_total, categories.color as category_color").
-		Joins("LEFT JOIN categories ON categories.id = expenses.category_id").
-		Where("expenses.user_id = ? AND expenses.expanse_date BETWEEN ? AND ? AND expenses.active = ?", userID, startDate, endDate, true).
-		Group("categories.name, categories.color").
-		Scan(&results).Error; err != nil {
+	categories, err := p.pivot(userID, location, query)
+	if err != nil {
 		return repositories.CategoryTagsTotals{}, errors.New("failed to fetch expenses by category: " + err.Error())
 	}
 
-	categoryMap := make(map[string]*repositories.CategoryWithTags)
-	for _, result := range results {
-		categoryMap[result.CategoryName] = &repositories.CategoryWithTags{
-			Name:           result.CategoryName,
-			CategoryAmount: result.CategoryTotal,
-			Color:          result.CategoryColor,
-			Tags:           []repositories.CategoryTagTotal{},
-		}
+	query.Columns = repositories.PIVOT_DIMENSION_TAG
+
+	categoryTags, err := p.pivot(userID, location, query)
+	if err != nil {
+		return repositories.CategoryTagsTotals{}, errors.New("failed to fetch expenses by category and tags: " + err.Error())
 	}
 
-	var resultsTags []struct {
-		CategoryName string
-		TagName      string
-		TagTotal     float64
-		TagColor     string
+	if categories.Total != nil {
+		categoryTagsTotals.ExpensesAmount = *categories.Total
 	}
 
-	if err := p.gorm.Table("expenses").
-		Select("categories.name as category_name, tags.name as tag_name, COALESCE(SUM(expenses.amount), 0) as tag_total, tags.color as tag_color").
-		Joins("LEFT JOIN categories ON categories.id = expenses.category_id").
-		Joins("LEFT JOIN expense_tags ON expense_tags.expenses_id = expenses.id").
-		Joins("LEFT JOIN tags ON tags.id = expense_tags.tags_id").
-		Where("expenses.user_id = ? AND expenses.expanse_date BETWEEN ? AND ? AND expenses.active = ?", userID, startDate, endDate, true).
-		Group("categories.name, tags.name, tags.color").
-		Scan(&resultsTags).Error; err != nil {
-		return repositories.CategoryTagsTotals{}, errors.New("failed to fetch expenses by category and tags: " + err.Error())
+	tagRows := make(map[string]int)
+	for i, row := range categoryTags.Rows {
+		tagRows[row.Key] = i
 	}
 
-	for _, result := range resultsTags {
-		if category, exists := categoryMap[result.CategoryName]; exists {
-			category.Tags = append(category.Tags, repositories.CategoryTagTotal{
-				Name:      result.TagName,
-				TagAmount: result.TagTotal,
-				Color:     result.TagColor,
-			})
+	for i, row := range categories.Rows {
+		category := repositories.CategoryWithTags{
+			Name:           row.Name,
+			CategoryAmount: *categories.RowTotals[i],
+			Color:          row.Color,
+			Tags:           []repositories.CategoryTagTotal{},
 		}
-	}
 
-	for _, category := range categoryMap {
-		categoryTagsTotals.Categories = append(categoryTagsTotals.Categories, *category)
-	}
+		if tagRow, exists := tagRows[row.Key]; exists {
+			for j, column := range categoryTags.Columns {
+				if column.Key == "" || *categoryTags.Cells[tagRow][j] == 0 {
+					continue
+				}
 
-	sort.Slice(categoryTagsTotals.Categories, func(i, j int) bool {
-		return categoryTagsTotals.Categories[i].Name < categoryTagsTotals.Categories[j].Name
-	})
+				category.Tags = append(category.Tags, repositories.CategoryTagTotal{
+					Name:      column.Name,
+					TagAmount: *categoryTags.Cells[tagRow][j],
+					Color:     column.Color,
+				})
+			}
+		}
+
+		categoryTagsTotals.Categories = append(categoryTagsTotals.Categories, category)
+	}
 
 	var availableYears []int
 	if err := p.gorm.Table("expenses").
-		Distinct("EXTRACT(YEAR FROM expanse_date)").
+		Distinct("EXTRACT(YEAR FROM "+dateColumn+")").
 		Where("user_id = ? AND active = ?", userID, true).
-		Order("EXTRACT(YEAR FROM expanse_date) DESC").
-		Pluck("EXTRACT(YEAR FROM expanse_date)", &availableYears).Error; err != nil {
+		Order("EXTRACT(YEAR FROM "+dateColumn+") DESC").
+		Pluck("EXTRACT(YEAR FROM "+dateColumn+")", &availableYears).Error; err != nil {
 		return repositories.CategoryTagsTotals{}, errors.New("failed to fetch available years: " + err.Error())
 	}
 	categoryTagsTotals.AvailableYears = availableYears
@@ -523,8 +524,8 @@ func (p *PresentersRepository) GetCategoryTagsTotalsByMonthYear(userID string, m
 		Month int
 	}
 	if err := p.gorm.Table("expenses").
-		Select("DISTINCT EXTRACT(MONTH FROM expanse_date) AS month").
-		Where("user_id = ? AND EXTRACT(YEAR FROM expanse_date) = ? AND active = ?", userID, year, true).
+		Select("DISTINCT EXTRACT(MONTH FROM "+dateColumn+") AS month").
+		Where("user_id = ? AND EXTRACT(YEAR FROM "+dateColumn+") = ? AND active = ?", userID, year, true).
 		Order("month ASC").
 		Scan(&availableMonths).Error; err != nil {
 		return repositories.CategoryTagsTotals{}, errors.New("failed to fetch available months: " + err.Error())
@@ -541,9 +542,14 @@ func (p *PresentersRepository) GetCategoryTagsTotalsByMonthYear(userID string, m
 }
 
 func (p *PresentersRepository) GetAvailableMonthsYears(userID string) ([]int, []repositories.MonthOption, error) {
+	location, err := p.userLocation(userID)
+	if err != nil {
+		return nil, nil, errors.New("failed to load timezone: " + err.Error())
+	}
+
 	var availableYears []int
 	if err := p.gorm.Table("expenses").
-		Select("DISTINCT EXTRACT(YEAR FROM expanse_date) as year").
+		Select("DISTINCT EXTRACT(YEAR FROM "+localDateColumn("expanse_date", location)+") as year").
 		Where("user_id = ? AND active = ?", userID, true).
 		Order("year DESC").
 		Pluck("year", &availableYears).Error; err != nil {
@@ -595,3 +601,1493 @@ func (p *PresentersRepository) GetDayToDayExpensesPeriod(userID string, startDat
 
 	return expenses, nil
 }
+
+func (p *PresentersRepository) GetNetCashFlowYear(userID string, year int) (repositories.CashFlowYear, error) {
+	var cashFlowYear repositories.CashFlowYear
+	cashFlowYear.Year = year
+
+	location, err := p.userLocation(userID)
+	if err != nil {
+		return repositories.CashFlowYear{}, errors.New("failed to load timezone: " + err.Error())
+	}
+
+	expenseDate := localDateColumn("expanse_date", location)
+	incomeDate := localDateColumn("income_date", location)
+
+	months := make([]repositories.MonthCashFlow, 12)
+	for i := 0; i < 12; i++ {
+		months[i] = repositories.MonthCashFlow{
+			Month:       time.Month(i + 1).String(),
+			MonthNumber: i + 1,
+		}
+	}
+
+	type MonthTotal struct {
+		Month int     `json:"month"`
+		Total float64 `json:"total"`
+	}
+
+	var expenses []MonthTotal
+	if err := p.gorm.Table("expenses").
+		Select("EXTRACT(MONTH FROM "+expenseDate+") as month, COALESCE(SUM(amount), 0) as total").
+		Where("user_id = ? AND EXTRACT(YEAR FROM "+expenseDate+") = ? AND active = ?", userID, year, true).
+		Group("EXTRACT(MONTH FROM " + expenseDate + ")").
+		Find(&expenses).Error; err != nil {
+		return repositories.CashFlowYear{}, errors.New("failed to fetch expenses by month: " + err.Error())
+	}
+
+	var incomes []MonthTotal
+	if err := p.gorm.Table("incomes").
+		Select("EXTRACT(MONTH FROM "+incomeDate+") as month, COALESCE(SUM(amount), 0) as total").
+		Where("user_id = ? AND EXTRACT(YEAR FROM "+incomeDate+") = ? AND active = ?", userID, year, true).
+		Group("EXTRACT(MONTH FROM " + incomeDate + ")").
+		Find(&incomes).Error; err != nil {
+		return repositories.CashFlowYear{}, errors.New("failed to fetch incomes by month: " + err.Error())
+	}
+
+	for _, expense := range expenses {
+		months[expense.Month-1].Expenses = expense.Total
+	}
+
+	for _, income := range incomes {
+		months[income.Month-1].Income = income.Total
+	}
+
+	for i := range months {
+		months[i].Net = months[i].Income - months[i].Expenses
+		months[i].SavingsRate = savingsRate(months[i].Income, months[i].Net)
+
+		cashFlowYear.Income += months[i].Income
+		cashFlowYear.Expenses += months[i].Expenses
+	}
+
+	cashFlowYear.Net = cashFlowYear.Income - cashFlowYear.Expenses
+	cashFlowYear.SavingsRate = savingsRate(cashFlowYear.Income, cashFlowYear.Net)
+	cashFlowYear.Months = months
+
+	var availableYears []int
+	if err := p.gorm.Raw(`SELECT DISTINCT year FROM (
+			SELECT EXTRACT(YEAR FROM `+expenseDate+`) AS year FROM expenses WHERE user_id = ? AND active = ?
+			UNION
+			SELECT EXTRACT(YEAR FROM `+incomeDate+`) AS year FROM incomes WHERE user_id = ? AND active = ?
+		) AS years ORDER BY year DESC`, userID, true, userID, true).
+		Scan(&availableYears).Error; err != nil {
+		return repositories.CashFlowYear{}, errors.New("failed to fetch available years: " + err.Error())
+	}
+	cashFlowYear.AvailableYears = availableYears
+
+	return cashFlowYear, nil
+}
+
+func (p *PresentersRepository) GetIncomeVsExpenseByCategoryPeriod(userID string, startDate time.Time, endDate time.Time) (repositories.IncomeVsExpense, error) {
+	var incomeVsExpense repositories.IncomeVsExpense
+
+	expenseCategories, err := p.GetExpensesByCategoryPeriod(userID, startDate, endDate, repositories.EXPENSE_BASIS_PURCHASE)
+	if err != nil {
+		return repositories.IncomeVsExpense{}, err
+	}
+
+	var incomeCategories []repositories.CategoryExpense
+	if err := p.gorm.Table("incomes").
+		Select("categories.name as category_name, categories.color as category_color, SUM(incomes.amount) as total").
+		Joins("JOIN categories ON incomes.category_id = categories.id").
+		Where("incomes.user_id = ? AND incomes.income_date BETWEEN ? AND ? AND incomes.active = ?", userID, startDate, endDate, true).
+		Group("categories.name, categories.color").Order("total DESC").
+		Scan(&incomeCategories).Error; err != nil {
+		return repositories.IncomeVsExpense{}, errors.New("failed to fetch incomes by category: " + err.Error())
+	}
+
+	if expenseCategories == nil {
+		expenseCategories = []repositories.CategoryExpense{}
+	}
+
+	if incomeCategories == nil {
+		incomeCategories = []repositories.CategoryExpense{}
+	}
+
+	for _, category := range expenseCategories {
+		incomeVsExpense.Expenses += category.Total
+	}
+
+	for _, category := range incomeCategories {
+		incomeVsExpense.Income += category.Total
+	}
+
+	incomeVsExpense.Net = incomeVsExpense.Income - incomeVsExpense.Expenses
+	incomeVsExpense.SavingsRate = savingsRate(incomeVsExpense.Income, incomeVsExpense.Net)
+	incomeVsExpense.IncomeCategories = incomeCategories
+	incomeVsExpense.ExpenseCategories = expenseCategories
+
+	return incomeVsExpense, nil
+}
+
+func savingsRate(income float64, net float64) float64 {
+	if income <= 0 {
+		return 0
+	}
+
+	return net / income * 100
+}
+
+type accountMovement struct {
+	AccountID string
+	Date      time.Time
+	Inflow    float64
+	Outflow   float64
+	Kind      string
+}
+
+func (p *PresentersRepository) getAccountMovements(userID string, accountID string, endDate time.Time) ([]accountMovement, error) {
+	accountFilter := ""
+	if accountID != "" {
+		accountFilter = " AND account_id = @account_id"
+	}
+
+	query := `SELECT account_id, expanse_date AS date, 0 AS inflow, amount AS outflow, 'expense' AS kind
+			FROM expenses WHERE user_id = @user_id AND active = true AND account_id <> '' AND expanse_date <= @end_date` + accountFilter + `
+		UNION ALL
+		SELECT account_id, income_date AS date, amount AS inflow, 0 AS outflow, 'income' AS kind
+			FROM incomes WHERE user_id = @user_id AND active = true AND account_id <> '' AND income_date <= @end_date` + accountFilter + `
+		UNION ALL
+		SELECT to_account_id AS account_id, transfer_date AS date, amount AS inflow, 0 AS outflow, 'transfer_in' AS kind
+			FROM transfers WHERE user_id = @user_id AND active = true AND transfer_date <= @end_date` + strings.Replace(accountFilter, "account_id", "to_account_id", 1) + `
+		UNION ALL
+		SELECT from_account_id AS account_id, transfer_date AS date, 0 AS inflow, amount AS outflow, 'transfer_out' AS kind
+			FROM transfers WHERE user_id = @user_id AND active = true AND transfer_date <= @end_date` + strings.Replace(accountFilter, "account_id", "from_account_id", 1)
+
+	var movements []accountMovement
+	if err := p.gorm.Raw(query, map[string]interface{}{
+		"user_id":    userID,
+		"end_date":   endDate,
+		"account_id": accountID,
+	}).Scan(&movements).Error; err != nil {
+		return nil, errors.New("failed to fetch account movements: " + err.Error())
+	}
+
+	sort.SliceStable(movements, func(i, j int) bool {
+		return movements[i].Date.Before(movements[j].Date)
+	})
+
+	return movements, nil
+}
+
+func (p *PresentersRepository) GetAccountBalances(userID string, date time.Time) ([]repositories.AccountBalance, error) {
+	var accounts []Accounts
+	if err := p.gorm.Where("user_id = ? AND active = ?", userID, true).Order("name").Find(&accounts).Error; err != nil {
+		return nil, errors.New("failed to fetch accounts: " + err.Error())
+	}
+
+	movements, err := p.getAccountMovements(userID, "", date)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := []repositories.AccountBalance{}
+	indexes := make(map[string]int)
+
+	for _, account := range accounts {
+		indexes[account.ID] = len(balances)
+		balances = append(balances, repositories.AccountBalance{
+			AccountID:      account.ID,
+			Name:           account.Name,
+			Type:           account.Type,
+			Currency:       account.Currency,
+			OpeningBalance: account.OpeningBalance,
+			Balance:        account.OpeningBalance,
+		})
+	}
+
+	for _, movement := range movements {
+		index, exists := indexes[movement.AccountID]
+		if !exists {
+			continue
+		}
+
+		switch movement.Kind {
+		case "expense":
+			balances[index].Expenses += movement.Outflow
+		case "income":
+			balances[index].Income += movement.Inflow
+		case "transfer_in":
+			balances[index].TransfersIn += movement.Inflow
+		case "transfer_out":
+			balances[index].TransfersOut += movement.Outflow
+		}
+
+		balances[index].Balance += movement.Inflow - movement.Outflow
+	}
+
+	return balances, nil
+}
+
+func (p *PresentersRepository) GetAccountRunningBalance(userID string, accountID string, startDate time.Time, endDate time.Time) (repositories.AccountRunningBalance, error) {
+	var account Accounts
+	if err := p.gorm.Where("id = ? AND user_id = ? AND active = ?", accountID, userID, true).First(&account).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return repositories.AccountRunningBalance{}, errors.New("account not found")
+		}
+		return repositories.AccountRunningBalance{}, errors.New("failed to fetch account: " + err.Error())
+	}
+
+	location, err := p.userLocation(userID)
+	if err != nil {
+		return repositories.AccountRunningBalance{}, errors.New("failed to load timezone: " + err.Error())
+	}
+
+	movements, err := p.getAccountMovements(userID, accountID, endDate)
+	if err != nil {
+		return repositories.AccountRunningBalance{}, err
+	}
+
+	runningBalance := repositories.AccountRunningBalance{
+		AccountID: account.ID,
+		Name:      account.Name,
+		Type:      account.Type,
+		Currency:  account.Currency,
+		Days:      []repositories.AccountBalanceDay{},
+	}
+
+	balance := account.OpeningBalance
+	dailyInflow := make(map[string]float64)
+	dailyOutflow := make(map[string]float64)
+
+	for _, movement := range movements {
+		if movement.Date.Before(startDate) {
+			balance += movement.Inflow - movement.Outflow
+			continue
+		}
+
+		dayKey := movement.Date.In(location).Format("02/01/2006")
+		dailyInflow[dayKey] += movement.Inflow
+		dailyOutflow[dayKey] += movement.Outflow
+	}
+
+	runningBalance.StartingBalance = balance
+
+	start := startDate.In(location)
+	end := endDate.In(location)
+	for day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, location); !day.After(end); day = day.AddDate(0, 0, 1) {
+		dayKey := day.Format("02/01/2006")
+		balance += dailyInflow[dayKey] - dailyOutflow[dayKey]
+
+		runningBalance.Days = append(runningBalance.Days, repositories.AccountBalanceDay{
+			Date:    dayKey,
+			Inflow:  dailyInflow[dayKey],
+			Outflow: dailyOutflow[dayKey],
+			Balance: balance,
+		})
+	}
+
+	runningBalance.EndingBalance = balance
+
+	return runningBalance, nil
+}
+
+func (p *PresentersRepository) GetExpensesByAccountPeriod(userID string, startDate time.Time, endDate time.Time) ([]repositories.AccountExpense, error) {
+	var expensesByAccount []repositories.AccountExpense
+
+	if err := p.gorm.Table("expenses").
+		Select("COALESCE(accounts.id, '') as account_id, COALESCE(accounts.name, 'Unassigned') as account_name, COALESCE(accounts.type, '') as account_type, SUM(expenses.amount) as total").
+		Joins("LEFT JOIN accounts ON expenses.account_id = accounts.id").
+		Where("expenses.user_id = ? AND expenses.expanse_date BETWEEN ? AND ? AND expenses.active = ?", userID, startDate, endDate, true).
+		Group("accounts.id, accounts.name, accounts.type").Order("total DESC").
+		Scan(&expensesByAccount).Error; err != nil {
+		return nil, errors.New("failed to fetch expenses by account: " + err.Error())
+	}
+
+	if expensesByAccount == nil {
+		expensesByAccount = []repositories.AccountExpense{}
+	}
+
+	return expensesByAccount, nil
+}
+
+// GetExpensesByMerchantPeriod totals the expenses of each merchant, largest
+// first. Expenses without a merchant are left out.
+func (p *PresentersRepository) GetExpensesByMerchantPeriod(userID string, startDate time.Time, endDate time.Time, basis string) ([]repositories.MerchantExpense, error) {
+	var expensesByMerchant []repositories.MerchantExpense
+
+	if err := p.gorm.Table("expenses").
+		Select("merchants.id as merchant_id, merchants.name as merchant_name, SUM(expenses.amount) as total, COUNT(expenses.id) as count").
+		Joins("JOIN merchants ON expenses.merchant_id = merchants.id").
+		Where("expenses.user_id = ? AND "+expenseDateColumn(basis)+" BETWEEN ? AND ? AND expenses.active = ? AND merchants.active = ?", userID, startDate, endDate, true, true).
+		Group("merchants.id, merchants.name").Order("total DESC, merchants.name").
+		Scan(&expensesByMerchant).Error; err != nil {
+		return nil, errors.New("failed to fetch expenses by merchant: " + err.Error())
+	}
+
+	if expensesByMerchant == nil {
+		expensesByMerchant = []repositories.MerchantExpense{}
+	}
+
+	return expensesByMerchant, nil
+}
+
+func (p *PresentersRepository) GetCommittedInstallmentsByMonth(userID string, from time.Time) ([]repositories.MonthCommittedInstallments, error) {
+	var results []struct {
+		InstallmentPurchaseID string
+		Notes                 string
+		InstallmentNumber     int
+		InstallmentCount      int
+		Amount                float64
+		ExpanseDate           time.Time
+	}
+
+	if err := p.gorm.Table("expenses").
+		Select("expenses.installment_purchase_id, installment_purchases.notes, expenses.installment_number, installment_purchases.installment_count, expenses.amount, expenses.expanse_date").
+		Joins("JOIN installment_purchases ON expenses.installment_purchase_id = installment_purchases.id").
+		Where("expenses.user_id = ? AND expenses.expanse_date >= ? AND expenses.active = ? AND installment_purchases.active = ?", userID, from, true, true).
+		Order("expenses.expanse_date, expenses.installment_number").
+		Scan(&results).Error; err != nil {
+		return nil, errors.New("failed to fetch committed installments: " + err.Error())
+	}
+
+	location, err := p.userLocation(userID)
+	if err != nil {
+		return nil, errors.New("failed to load timezone: " + err.Error())
+	}
+
+	months := []repositories.MonthCommittedInstallments{}
+	indexes := make(map[string]int)
+
+	for _, result := range results {
+		date := result.ExpanseDate.In(location)
+		key := date.Format("2006-01")
+
+		index, exists := indexes[key]
+		if !exists {
+			index = len(months)
+			indexes[key] = index
+			months = append(months, repositories.MonthCommittedInstallments{
+				Month:        date.Month().String(),
+				MonthNumber:  int(date.Month()),
+				Year:         date.Year(),
+				Installments: []repositories.CommittedInstallment{},
+			})
+		}
+
+		months[index].Total += result.Amount
+		months[index].Installments = append(months[index].Installments, repositories.CommittedInstallment{
+			InstallmentPurchaseID: result.InstallmentPurchaseID,
+			Notes:                 result.Notes,
+			InstallmentNumber:     result.InstallmentNumber,
+			InstallmentCount:      result.InstallmentCount,
+			Amount:                result.Amount,
+		})
+	}
+
+	return months, nil
+}
+
+func (p *PresentersRepository) GetCardStatements(userID string, accountID string, year int) (repositories.CardStatements, error) {
+	var accountModel Accounts
+	if err := p.gorm.Where("id = ? AND user_id = ? AND active = ?", accountID, userID, true).First(&accountModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return repositories.CardStatements{}, errors.New("account not found")
+		}
+		return repositories.CardStatements{}, errors.New("failed to fetch account: " + err.Error())
+	}
+
+	account := modelToAccount(accountModel)
+	if !account.IsCreditCard() {
+		return repositories.CardStatements{}, errors.New("account is not a credit card")
+	}
+
+	location, err := p.userLocation(userID)
+	if err != nil {
+		return repositories.CardStatements{}, errors.New("failed to load timezone: " + err.Error())
+	}
+
+	var results []struct {
+		StatementDate time.Time
+		ExpenseCount  int
+		Total         float64
+	}
+
+	if err := p.gorm.Table("expenses").
+		Select("statement_date, COUNT(*) AS expense_count, COALESCE(SUM(amount), 0) AS total").
+		Where("user_id = ? AND account_id = ? AND active = ? AND statement_date IS NOT NULL AND EXTRACT(YEAR FROM "+localDateColumn("statement_date", location)+") = ?", userID, accountID, true, year).
+		Group("statement_date").
+		Scan(&results).Error; err != nil {
+		return repositories.CardStatements{}, errors.New("failed to fetch card statements: " + err.Error())
+	}
+
+	totals := make(map[string]repositories.CardStatement)
+	for _, result := range results {
+		dueKey := result.StatementDate.In(location).Format("02/01/2006")
+		statement := totals[dueKey]
+		statement.ExpenseCount += result.ExpenseCount
+		statement.Total += result.Total
+		totals[dueKey] = statement
+	}
+
+	cardStatements := repositories.CardStatements{
+		AccountID:  account.ID,
+		Name:       account.Name,
+		Currency:   account.Currency,
+		ClosingDay: account.ClosingDay,
+		DueDay:     account.DueDay,
+		Year:       year,
+		Statements: []repositories.CardStatement{},
+	}
+
+	for month := time.January; month <= time.December; month++ {
+		period := account.StatementPeriodDueIn(year, month, location)
+		dueKey := period.DueDate.Format("02/01/2006")
+
+		cardStatements.Statements = append(cardStatements.Statements, repositories.CardStatement{
+			StartDate:    period.StartDate.Format("02/01/2006"),
+			ClosingDate:  period.ClosingDate.Format("02/01/2006"),
+			DueDate:      dueKey,
+			ExpenseCount: totals[dueKey].ExpenseCount,
+			Total:        totals[dueKey].Total,
+		})
+		cardStatements.Total += totals[dueKey].Total
+	}
+
+	var availableYears []int
+	if err := p.gorm.Table("expenses").
+		Select("DISTINCT EXTRACT(YEAR FROM "+localDateColumn("statement_date", location)+") as year").
+		Where("user_id = ? AND account_id = ? AND active = ? AND statement_date IS NOT NULL", userID, accountID, true).
+		Order("year DESC").
+		Pluck("year", &availableYears).Error; err != nil {
+		return repositories.CardStatements{}, errors.New("failed to fetch available years: " + err.Error())
+	}
+	cardStatements.AvailableYears = availableYears
+
+	return cardStatements, nil
+}
+
+const averageDaysPerMonth = 365.25 / 12
+
+type goalMovement struct {
+	Date   time.Time
+	Amount float64
+}
+
+func (p *PresentersRepository) GetGoalsProgress(userID string, goalID string, date time.Time) ([]repositories.GoalProgress, error) {
+	location, err := p.userLocation(userID)
+	if err != nil {
+		return nil, errors.New("failed to load timezone: " + err.Error())
+	}
+
+	query := p.gorm.Where("user_id = ? AND active = ?", userID, true)
+	if goalID != "" {
+		query = query.Where("id = ?", goalID)
+	}
+
+	var goals []Goals
+	if err := query.Order("name").Find(&goals).Error; err != nil {
+		return nil, errors.New("failed to fetch goals: " + err.Error())
+	}
+
+	if goalID != "" && len(goals) == 0 {
+		return nil, errors.New("goal not found")
+	}
+
+	progress := []repositories.GoalProgress{}
+
+	for _, goal := range goals {
+		var movements []goalMovement
+
+		if err := p.gorm.Table("goal_contributions").
+			Select("contribution_date AS date, amount").
+			Where("user_id = ? AND goal_id = ? AND active = ? AND contribution_date <= ?", userID, goal.ID, true, date).
+			Scan(&movements).Error; err != nil {
+			return nil, errors.New("failed to fetch goal contributions: " + err.Error())
+		}
+
+		if goal.CategoryID != "" {
+			createdAt := goal.CreatedAt.In(location)
+			startDate := time.Date(createdAt.Year(), createdAt.Month(), createdAt.Day(), 0, 0, 0, 0, location)
+
+			var expenseMovements []goalMovement
+			if err := p.gorm.Table("expenses").
+				Select("expanse_date AS date, amount").
+				Where("user_id = ? AND category_id = ? AND active = ? AND expanse_date BETWEEN ? AND ?", userID, goal.CategoryID, true, startDate, date).
+				Scan(&expenseMovements).Error; err != nil {
+				return nil, errors.New("failed to fetch goal category expenses: " + err.Error())
+			}
+
+			movements = append(movements, expenseMovements...)
+		}
+
+		progress = append(progress, goalProgress(goal, movements, date.In(location)))
+	}
+
+	return progress, nil
+}
+
+// goalProgress projects the completion date from the average monthly
+// contribution since the first one. Histories shorter than a month count as
+// a full month so a single early deposit does not inflate the pace.
+func goalProgress(goal Goals, movements []goalMovement, date time.Time) repositories.GoalProgress {
+	sort.Slice(movements, func(i, j int) bool {
+		return movements[i].Date.Before(movements[j].Date)
+	})
+
+	progress := repositories.GoalProgress{
+		GoalID:       goal.ID,
+		Name:         goal.Name,
+		TargetAmount: goal.TargetAmount,
+		CategoryID:   goal.CategoryID,
+	}
+
+	if goal.Deadline != nil {
+		progress.Deadline = goal.Deadline.In(date.Location()).Format("02/01/2006")
+	}
+
+	var completedAt time.Time
+	for _, movement := range movements {
+		progress.Saved += movement.Amount
+		if completedAt.IsZero() && progress.Saved >= goal.TargetAmount {
+			completedAt = movement.Date
+		}
+	}
+
+	progress.Remaining = math.Max(goal.TargetAmount-progress.Saved, 0)
+	progress.PercentComplete = math.Min(math.Max(progress.Saved, 0)/goal.TargetAmount*100, 100)
+	progress.Completed = progress.Remaining == 0
+
+	if len(movements) > 0 && progress.Saved > 0 {
+		elapsedMonths := math.Max(date.Sub(movements[0].Date).Hours()/24/averageDaysPerMonth, 1)
+		progress.AverageMonthlyContribution = progress.Saved / elapsedMonths
+	}
+
+	if goal.Deadline != nil && !progress.Completed {
+		monthsLeft := math.Max(math.Ceil(goal.Deadline.Sub(date).Hours()/24/averageDaysPerMonth), 1)
+		progress.RequiredMonthlyContribution = progress.Remaining / monthsLeft
+	}
+
+	var projectedDate time.Time
+	if progress.Completed {
+		projectedDate = completedAt
+	} else if progress.AverageMonthlyContribution > 0 {
+		daysLeft := math.Ceil(progress.Remaining / progress.AverageMonthlyContribution * averageDaysPerMonth)
+		projectedDate = date.AddDate(0, 0, int(daysLeft))
+	}
+
+	if !projectedDate.IsZero() {
+		progress.ProjectedCompletionDate = projectedDate.In(date.Location()).Format("02/01/2006")
+		progress.OnTrack = progress.Completed || goal.Deadline == nil || !projectedDate.After(*goal.Deadline)
+	}
+
+	return progress
+}
+
+const (
+	forecastHistoryMonths    = 6
+	forecastMinHistoryMonths = 3
+	forecastPaceBand         = 0.2
+)
+
+type forecastExpense struct {
+	ExpanseDate           time.Time
+	Amount                float64
+	Notes                 string
+	CategoryID            string
+	CategoryName          string
+	CategoryColor         string
+	InstallmentPurchaseID string
+}
+
+type forecastCategory struct {
+	repositories.ForecastCategory
+	discretionarySoFar float64
+	historyTotal       float64
+}
+
+type forecastRecurrence struct {
+	months     map[int]float64
+	lastDate   time.Time
+	notes      string
+	categoryID string
+}
+
+// GetMonthEndForecast projects the month-end spending of the month containing
+// date. Installments, future-dated expenses and recurring charges that have
+// not been billed yet are added as known amounts; the remaining discretionary
+// spending is extrapolated from the share of the month usually spent by this
+// day, or from the linear pace when there is not enough history.
+func (p *PresentersRepository) GetMonthEndForecast(userID string, date time.Time) (repositories.MonthForecast, error) {
+	location, err := p.userLocation(userID)
+	if err != nil {
+		return repositories.MonthForecast{}, errors.New("failed to load timezone: " + err.Error())
+	}
+
+	now := date.In(location)
+	startOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, location)
+	endOfMonth := startOfMonth.AddDate(0, 1, 0).Add(-time.Nanosecond)
+	historyStart := startOfMonth.AddDate(0, -forecastHistoryMonths, 0)
+	daysInMonth := endOfMonth.Day()
+
+	var expenses []forecastExpense
+	if err := p.gorm.Table("expenses").
+		Select("expenses.expanse_date, expenses.amount, expenses.notes, expenses.category_id, categories.name AS category_name, categories.color AS category_color, expenses.installment_purchase_id").
+		Joins("JOIN categories ON expenses.category_id = categories.id").
+		Where("expenses.user_id = ? AND expenses.active = ? AND expenses.expanse_date BETWEEN ? AND ?", userID, true, historyStart, endOfMonth).
+		Scan(&expenses).Error; err != nil {
+		return repositories.MonthForecast{}, errors.New("failed to fetch expenses for forecast: " + err.Error())
+	}
+
+	recurrences := make(map[string]*forecastRecurrence)
+	billedThisMonth := make(map[string]bool)
+	categories := make(map[string]*forecastCategory)
+
+	for _, expense := range expenses {
+		if _, ok := categories[expense.CategoryID]; !ok {
+			categories[expense.CategoryID] = &forecastCategory{
+				ForecastCategory: repositories.ForecastCategory{
+					CategoryName:  expense.CategoryName,
+					CategoryColor: expense.CategoryColor,
+				},
+			}
+		}
+
+		key := recurrenceKey(expense)
+		if key == "" || expense.InstallmentPurchaseID != "" {
+			continue
+		}
+
+		expenseDate := expense.ExpanseDate.In(location)
+		if !expenseDate.Before(startOfMonth) {
+			billedThisMonth[key] = true
+			continue
+		}
+
+		recurrence, ok := recurrences[key]
+		if !ok {
+			recurrence = &forecastRecurrence{months: make(map[int]float64), notes: strings.TrimSpace(expense.Notes), categoryID: expense.CategoryID}
+			recurrences[key] = recurrence
+		}
+
+		recurrence.months[monthsBetween(expenseDate, startOfMonth)] += expense.Amount
+		if expenseDate.After(recurrence.lastDate) {
+			recurrence.lastDate = expenseDate
+		}
+	}
+
+	forecast := repositories.MonthForecast{
+		Month:            now.Month().String(),
+		MonthNumber:      int(now.Month()),
+		Year:             now.Year(),
+		Day:              now.Day(),
+		DaysInMonth:      daysInMonth,
+		Categories:       []repositories.ForecastCategory{},
+		RecurringCharges: []repositories.ForecastRecurringCharge{},
+	}
+
+	recurringKeys := make(map[string]bool)
+	for key, recurrence := range recurrences {
+		var recentMonths int
+		var recentTotal float64
+		for monthsAgo := 1; monthsAgo <= 3; monthsAgo++ {
+			if amount, ok := recurrence.months[monthsAgo]; ok {
+				recentMonths++
+				recentTotal += amount
+			}
+		}
+
+		if recentMonths < 2 {
+			continue
+		}
+		recurringKeys[key] = true
+
+		expectedDate := time.Date(now.Year(), now.Month(), min(recurrence.lastDate.Day(), daysInMonth), 0, 0, 0, 0, location)
+		if billedThisMonth[key] || expectedDate.Day() < now.Day() {
+			continue
+		}
+
+		category := categories[recurrence.categoryID]
+		amount := recentTotal / float64(recentMonths)
+		category.Scheduled += amount
+
+		forecast.RecurringCharges = append(forecast.RecurringCharges, repositories.ForecastRecurringCharge{
+			Notes:        recurrence.notes,
+			CategoryName: category.CategoryName,
+			Amount:       amount,
+			ExpectedDate: expectedDate.Format("02/01/2006"),
+		})
+	}
+
+	monthTotals := make(map[int]float64)
+	monthCumulative := make(map[int]float64)
+
+	for _, expense := range expenses {
+		category := categories[expense.CategoryID]
+		expenseDate := expense.ExpanseDate.In(location)
+		discretionary := expense.InstallmentPurchaseID == "" && !recurringKeys[recurrenceKey(expense)]
+
+		if !expenseDate.Before(startOfMonth) {
+			if expenseDate.After(now) {
+				category.Scheduled += expense.Amount
+				continue
+			}
+
+			category.SpentSoFar += expense.Amount
+			if discretionary {
+				category.discretionarySoFar += expense.Amount
+			}
+			continue
+		}
+
+		if !discretionary {
+			continue
+		}
+
+		monthsAgo := monthsBetween(expenseDate, startOfMonth)
+		monthTotals[monthsAgo] += expense.Amount
+		category.historyTotal += expense.Amount
+
+		lastDayOfMonth := time.Date(expenseDate.Year(), expenseDate.Month()+1, 0, 0, 0, 0, 0, location).Day()
+		if expenseDate.Day() <= min(now.Day(), lastDayOfMonth) {
+			monthCumulative[monthsAgo] += expense.Amount
+		}
+	}
+
+	minFraction := 1 / float64(daysInMonth)
+	fraction := float64(now.Day()) / float64(daysInMonth)
+	lowFraction := math.Min(fraction/(1-forecastPaceBand), 1)
+	highFraction := fraction / (1 + forecastPaceBand)
+	forecast.Method = repositories.FORECAST_METHOD_PACE
+
+	var fractions []float64
+	for monthsAgo, total := range monthTotals {
+		if total > 0 {
+			fractions = append(fractions, monthCumulative[monthsAgo]/total)
+		}
+	}
+	forecast.HistoryMonths = len(fractions)
+
+	if len(fractions) >= forecastMinHistoryMonths {
+		forecast.Method = repositories.FORECAST_METHOD_DAY_OF_MONTH_HISTORY
+
+		fraction, lowFraction, highFraction = 0, 0, 1
+		for _, monthFraction := range fractions {
+			fraction += monthFraction / float64(len(fractions))
+			lowFraction = math.Max(lowFraction, monthFraction)
+			highFraction = math.Min(highFraction, monthFraction)
+		}
+	}
+
+	fraction = math.Max(fraction, minFraction)
+	lowFraction = math.Max(lowFraction, minFraction)
+	highFraction = math.Max(highFraction, minFraction)
+
+	for _, category := range categories {
+		known := category.SpentSoFar + category.Scheduled - category.discretionarySoFar
+
+		if category.discretionarySoFar > 0 {
+			category.Estimate = known + category.discretionarySoFar/fraction
+			category.Low = known + category.discretionarySoFar/lowFraction
+			category.High = known + category.discretionarySoFar/highFraction
+		} else if forecast.Method == repositories.FORECAST_METHOD_DAY_OF_MONTH_HISTORY {
+			monthlyAverage := category.historyTotal / float64(forecast.HistoryMonths)
+			category.Estimate = known + monthlyAverage*(1-fraction)
+			category.Low = known + monthlyAverage*(1-lowFraction)
+			category.High = known + monthlyAverage*(1-highFraction)
+		} else {
+			category.Estimate = known
+			category.Low = known
+			category.High = known
+		}
+
+		if category.Estimate == 0 && category.High == 0 {
+			continue
+		}
+
+		forecast.SpentSoFar += category.SpentSoFar
+		forecast.Scheduled += category.Scheduled
+		forecast.Estimate += category.Estimate
+		forecast.Low += category.Low
+		forecast.High += category.High
+		forecast.Categories = append(forecast.Categories, category.ForecastCategory)
+	}
+
+	sort.Slice(forecast.Categories, func(i, j int) bool {
+		return forecast.Categories[i].Estimate > forecast.Categories[j].Estimate
+	})
+
+	sort.Slice(forecast.RecurringCharges, func(i, j int) bool {
+		return forecast.RecurringCharges[i].ExpectedDate < forecast.RecurringCharges[j].ExpectedDate
+	})
+
+	return forecast, nil
+}
+
+func recurrenceKey(expense forecastExpense) string {
+	notes := strings.ToLower(strings.TrimSpace(expense.Notes))
+	if notes == "" {
+		return ""
+	}
+
+	return expense.CategoryID + "|" + notes
+}
+
+func monthsBetween(date time.Time, startOfMonth time.Time) int {
+	return (startOfMonth.Year()-date.Year())*12 + int(startOfMonth.Month()-date.Month())
+}
+
+func (p *PresentersRepository) GetLargestExpensesForPeriod(userID string, startDate time.Time, endDate time.Time, limit int) ([]repositories.LargestExpense, error) {
+	largestExpenses := []repositories.LargestExpense{}
+
+	if err := p.gorm.Table("expenses").
+		Select("expenses.id AS expense_id, expenses.expanse_date AS expense_date, expenses.amount, categories.name AS category_name, categories.color AS category_color, expenses.notes").
+		Joins("LEFT JOIN categories ON categories.id = expenses.category_id").
+		Where("expenses.user_id = ? AND expenses.expanse_date BETWEEN ? AND ? AND expenses.active = ?", userID, startDate, endDate, true).
+		Order("expenses.amount DESC").Limit(limit).
+		Scan(&largestExpenses).Error; err != nil {
+		return nil, errors.New("failed to fetch largest expenses: " + err.Error())
+	}
+
+	return largestExpenses, nil
+}
+
+func (p *PresentersRepository) GetTimeSeries(userID string, query repositories.TimeSeriesQuery) (repositories.TimeSeries, error) {
+	location, err := p.userLocation(userID)
+	if err != nil {
+		return repositories.TimeSeries{}, errors.New("failed to load timezone: " + err.Error())
+	}
+
+	return p.timeSeries(userID, location, query)
+}
+
+// timeSeries sums expenses per DATE_TRUNC bucket of the user's local date and
+// per group, then lays the rows over every bucket of the range so that empty
+// buckets come back as zeros, oldest first.
+func (p *PresentersRepository) timeSeries(userID string, location *time.Location, query repositories.TimeSeriesQuery) (repositories.TimeSeries, error) {
+	dateColumn := localDateColumn(expenseDateColumn(query.Basis), location)
+
+	bucketColumn, err := truncateDateColumn(dateColumn, query.Granularity, query.WeekStart)
+	if err != nil {
+		return repositories.TimeSeries{}, err
+	}
+
+	db := p.gorm.Table("expenses")
+
+	var groupColumns string
+	switch query.GroupBy {
+	case "":
+		groupColumns = "'' AS group_key, '' AS group_name, '' AS group_color"
+	case repositories.TIME_SERIES_GROUP_CATEGORY:
+		groupColumns = "categories.id AS group_key, categories.name AS group_name, categories.color AS group_color"
+		db = db.Joins("JOIN categories ON expenses.category_id = categories.id")
+	case repositories.TIME_SERIES_GROUP_TAG:
+		groupColumns = "tags.id AS group_key, tags.name AS group_name, tags.color AS group_color"
+		db = db.Joins("JOIN expense_tags ON expenses.id = expense_tags.expenses_id").
+			Joins("JOIN tags ON expense_tags.tags_id = tags.id")
+	case repositories.TIME_SERIES_GROUP_ACCOUNT:
+		groupColumns = "COALESCE(accounts.id, '') AS group_key, COALESCE(accounts.name, 'Unassigned') AS group_name, '' AS group_color"
+		db = db.Joins("LEFT JOIN accounts ON expenses.account_id = accounts.id")
+	case repositories.TIME_SERIES_GROUP_WEEKDAY:
+		groupColumns = "CAST(CAST(EXTRACT(DOW FROM " + dateColumn + ") AS INTEGER) AS TEXT) AS group_key, '' AS group_name, '' AS group_color"
+	default:
+		return repositories.TimeSeries{}, errors.New("invalid group by: " + query.GroupBy)
+	}
+
+	db = db.Where("expenses.user_id = ? AND "+expenseDateColumn(query.Basis)+" BETWEEN ? AND ? AND expenses.active = ?", userID, query.StartDate, query.EndDate, true)
+
+	if len(query.CategoryIDs) > 0 {
+		db = db.Where("expenses.category_id IN ?", query.CategoryIDs)
+	}
+
+	if len(query.AccountIDs) > 0 {
+		db = db.Where("expenses.account_id IN ?", query.AccountIDs)
+	}
+
+	if len(query.TagIDs) > 0 {
+		db = db.Where("expenses.id IN (SELECT expenses_id FROM expense_tags WHERE tags_id IN ?)", query.TagIDs)
+	}
+
+	var results []struct {
+		Bucket     string  `gorm:"column:bucket"`
+		GroupKey   string  `gorm:"column:group_key"`
+		GroupName  string  `gorm:"column:group_name"`
+		GroupColor string  `gorm:"column:group_color"`
+		Total      float64 `gorm:"column:total"`
+	}
+
+	if err := db.
+		Select("TO_CHAR(" + bucketColumn + ", 'YYYY-MM-DD') AS bucket, " + groupColumns + ", SUM(expenses.amount) AS total").
+		Group("bucket, group_key, group_name, group_color").
+		Scan(&results).Error; err != nil {
+		return repositories.TimeSeries{}, errors.New("failed to fetch time series: " + err.Error())
+	}
+
+	series := repositories.TimeSeries{
+		Buckets: []repositories.TimeSeriesBucket{},
+		Groups:  []repositories.TimeSeriesGroup{},
+	}
+
+	bucketIndex := make(map[string]int)
+	first := timeSeriesBucketStart(query.StartDate.In(location), query.Granularity, query.WeekStart)
+	for start := first; !start.After(query.EndDate.In(location)); start = timeSeriesNextBucket(start, query.Granularity) {
+		bucketIndex[start.Format("2006-01-02")] = len(series.Buckets)
+		series.Buckets = append(series.Buckets, repositories.TimeSeriesBucket{
+			StartDate: start,
+			EndDate:   timeSeriesNextBucket(start, query.Granularity).AddDate(0, 0, -1),
+		})
+	}
+
+	groupIndex := make(map[string]int)
+	if query.GroupBy == repositories.TIME_SERIES_GROUP_WEEKDAY {
+		for i := 0; i < 7; i++ {
+			day := time.Weekday((int(query.WeekStart) + i) % 7)
+			groupIndex[strconv.Itoa(int(day))] = i
+			series.Groups = append(series.Groups, repositories.TimeSeriesGroup{
+				Key:    strconv.Itoa(int(day)),
+				Name:   day.String(),
+				Totals: make([]float64, len(series.Buckets)),
+			})
+		}
+	}
+
+	for _, result := range results {
+		i, exists := bucketIndex[result.Bucket]
+		if !exists {
+			continue
+		}
+
+		series.Buckets[i].Total += result.Total
+		series.Total += result.Total
+
+		if query.GroupBy == "" {
+			continue
+		}
+
+		g, exists := groupIndex[result.GroupKey]
+		if !exists {
+			g = len(series.Groups)
+			groupIndex[result.GroupKey] = g
+			series.Groups = append(series.Groups, repositories.TimeSeriesGroup{
+				Key:    result.GroupKey,
+				Name:   result.GroupName,
+				Color:  result.GroupColor,
+				Totals: make([]float64, len(series.Buckets)),
+			})
+		}
+
+		series.Groups[g].Totals[i] += result.Total
+		series.Groups[g].Total += result.Total
+	}
+
+	if query.GroupBy != repositories.TIME_SERIES_GROUP_WEEKDAY {
+		sort.SliceStable(series.Groups, func(i, j int) bool {
+			if series.Groups[i].Total != series.Groups[j].Total {
+				return series.Groups[i].Total > series.Groups[j].Total
+			}
+			return series.Groups[i].Name < series.Groups[j].Name
+		})
+	}
+
+	return series, nil
+}
+
+// truncateDateColumn wraps the column in DATE_TRUNC. DATE_TRUNC only knows ISO
+// (Monday) weeks, so for other week starts the date is shifted before
+// truncating and shifted back after.
+func truncateDateColumn(dateColumn string, granularity string, weekStart time.Weekday) (string, error) {
+	switch granularity {
+	case repositories.TIME_SERIES_DAY, repositories.TIME_SERIES_MONTH, repositories.TIME_SERIES_QUARTER, repositories.TIME_SERIES_YEAR:
+		return "DATE_TRUNC('" + granularity + "', " + dateColumn + ")", nil
+	case repositories.TIME_SERIES_WEEK:
+		shift := strconv.Itoa((int(time.Monday) - int(weekStart) + 7) % 7)
+		return "(DATE_TRUNC('week', " + dateColumn + " + INTERVAL '" + shift + " days') - INTERVAL '" + shift + " days')", nil
+	}
+
+	return "", errors.New("invalid granularity: " + granularity)
+}
+
+func timeSeriesBucketStart(date time.Time, granularity string, weekStart time.Weekday) time.Time {
+	switch granularity {
+	case repositories.TIME_SERIES_WEEK:
+		return util.StartOfWeek(date, weekStart)
+	case repositories.TIME_SERIES_MONTH:
+		return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+	case repositories.TIME_SERIES_QUARTER:
+		return time.Date(date.Year(), (date.Month()-1)/3*3+1, 1, 0, 0, 0, 0, date.Location())
+	case repositories.TIME_SERIES_YEAR:
+		return time.Date(date.Year(), time.January, 1, 0, 0, 0, 0, date.Location())
+	}
+
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+}
+
+func timeSeriesNextBucket(start time.Time, granularity string) time.Time {
+	switch granularity {
+	case repositories.TIME_SERIES_WEEK:
+		return start.AddDate(0, 0, 7)
+	case repositories.TIME_SERIES_MONTH:
+		return start.AddDate(0, 1, 0)
+	case repositories.TIME_SERIES_QUARTER:
+		return start.AddDate(0, 3, 0)
+	case repositories.TIME_SERIES_YEAR:
+		return start.AddDate(1, 0, 0)
+	}
+
+	return start.AddDate(0, 0, 1)
+}
+
+func (p *PresentersRepository) GetPivot(userID string, query repositories.PivotQuery) (repositories.Pivot, error) {
+	location, err := p.userLocation(userID)
+	if err != nil {
+		return repositories.Pivot{}, errors.New("failed to load timezone: " + err.Error())
+	}
+
+	return p.pivot(userID, location, query)
+}
+
+// pivotDimension is the SQL for one side of a pivot. Name and color are
+// aggregates so that only the key is grouped on.
+type pivotDimension struct {
+	key   string
+	name  string
+	color string
+	joins []string
+}
+
+func pivotDimensionColumns(dimension string, dateColumn string, weekStart time.Weekday) (pivotDimension, error) {
+	switch dimension {
+	case repositories.PIVOT_DIMENSION_CATEGORY:
+		return pivotDimension{
+			key:   "categories.id",
+			name:  "MIN(categories.name)",
+			color: "MIN(categories.color)",
+			joins: []string{"JOIN categories ON expenses.category_id = categories.id"},
+		}, nil
+	case repositories.PIVOT_DIMENSION_TAG:
+		return pivotDimension{
+			key:   "COALESCE(tags.id, '')",
+			name:  "COALESCE(MIN(tags.name), 'Untagged')",
+			color: "COALESCE(MIN(tags.color), '')",
+			joins: []string{
+				"LEFT JOIN expense_tags ON expenses.id = expense_tags.expenses_id",
+				"LEFT JOIN tags ON expense_tags.tags_id = tags.id",
+			},
+		}, nil
+	case repositories.PIVOT_DIMENSION_ACCOUNT:
+		return pivotDimension{
+			key:   "COALESCE(accounts.id, '')",
+			name:  "COALESCE(MIN(accounts.name), 'Unassigned')",
+			color: "''",
+			joins: []string{"LEFT JOIN accounts ON expenses.account_id = accounts.id"},
+		}, nil
+	case repositories.PIVOT_DIMENSION_MONTH, repositories.PIVOT_DIMENSION_WEEK:
+		bucketColumn, err := truncateDateColumn(dateColumn, dimension, weekStart)
+		if err != nil {
+			return pivotDimension{}, err
+		}
+
+		return pivotDimension{
+			key:   "TO_CHAR(" + bucketColumn + ", 'YYYY-MM-DD')",
+			name:  "''",
+			color: "''",
+		}, nil
+	case repositories.PIVOT_DIMENSION_WEEKDAY:
+		return pivotDimension{
+			key:   "CAST(CAST(EXTRACT(DOW FROM " + dateColumn + ") AS INTEGER) AS TEXT)",
+			name:  "''",
+			color: "''",
+		}, nil
+	}
+
+	return pivotDimension{}, errors.New("invalid pivot dimension: " + dimension)
+}
+
+func pivotMeasureColumn(measure string) (string, error) {
+	switch measure {
+	case repositories.PIVOT_MEASURE_SUM:
+		return "SUM(expenses.amount)", nil
+	case repositories.PIVOT_MEASURE_COUNT:
+		return "CAST(COUNT(expenses.id) AS DOUBLE PRECISION)", nil
+	case repositories.PIVOT_MEASURE_AVERAGE:
+		return "AVG(expenses.amount)", nil
+	case repositories.PIVOT_MEASURE_MIN:
+		return "MIN(expenses.amount)", nil
+	case repositories.PIVOT_MEASURE_MAX:
+		return "MAX(expenses.amount)", nil
+	}
+
+	return "", errors.New("invalid pivot measure: " + measure)
+}
+
+// pivotResult is one grouping of a pivot query; AllRows and AllColumns are 1
+// when the grouping spans every row or every column.
+type pivotResult struct {
+	RowKey      string   `gorm:"column:row_key"`
+	RowName     string   `gorm:"column:row_name"`
+	RowColor    string   `gorm:"column:row_color"`
+	AllRows     int      `gorm:"column:all_rows"`
+	ColumnKey   string   `gorm:"column:column_key"`
+	ColumnName  string   `gorm:"column:column_name"`
+	ColumnColor string   `gorm:"column:column_color"`
+	AllColumns  int      `gorm:"column:all_columns"`
+	Value       *float64 `gorm:"column:value"`
+}
+
+// pivotGroupingSet says which sides of the pivot a grouping is keyed on.
+type pivotGroupingSet struct {
+	rows    bool
+	columns bool
+}
+
+// pivot computes the cells and every total with GROUPING SETS; GROUPING()
+// tells which rows are totals. Month and week headers cover the whole period
+// and weekday headers the whole week, even without spending. With tags on
+// either side an expense counts once for each of its tags in the groupings
+// keyed on tag, and expenses without tags fall under "Untagged". The other
+// groupings, the grand total among them, are queried without the tag join so
+// they count every expense once.
+func (p *PresentersRepository) pivot(userID string, location *time.Location, query repositories.PivotQuery) (repositories.Pivot, error) {
+	dateColumn := localDateColumn(expenseDateColumn(query.Basis), location)
+
+	rows, err := pivotDimensionColumns(query.Rows, dateColumn, query.WeekStart)
+	if err != nil {
+		return repositories.Pivot{}, err
+	}
+
+	measure, err := pivotMeasureColumn(query.Measure)
+	if err != nil {
+		return repositories.Pivot{}, err
+	}
+
+	var columns pivotDimension
+	sets := []pivotGroupingSet{{rows: true}, {}}
+
+	if query.Columns != "" {
+		if query.Columns == query.Rows {
+			return repositories.Pivot{}, errors.New("pivot rows and columns must be different dimensions")
+		}
+
+		columns, err = pivotDimensionColumns(query.Columns, dateColumn, query.WeekStart)
+		if err != nil {
+			return repositories.Pivot{}, err
+		}
+
+		sets = []pivotGroupingSet{{rows: true, columns: true}, {rows: true}, {columns: true}, {}}
+	}
+
+	var tagSets, otherSets []pivotGroupingSet
+	for _, set := range sets {
+		if (set.rows && query.Rows == repositories.PIVOT_DIMENSION_TAG) || (set.columns && query.Columns == repositories.PIVOT_DIMENSION_TAG) {
+			tagSets = append(tagSets, set)
+		} else {
+			otherSets = append(otherSets, set)
+		}
+	}
+
+	var results []pivotResult
+	for _, batch := range [][]pivotGroupingSet{tagSets, otherSets} {
+		if len(batch) == 0 {
+			continue
+		}
+
+		batchResults, err := p.pivotResults(userID, query, measure, rows, columns, batch)
+		if err != nil {
+			return repositories.Pivot{}, err
+		}
+
+		results = append(results, batchResults...)
+	}
+
+	rowHeaders := pivotHeaders(query.Rows, query, location)
+	columnHeaders := pivotHeaders(query.Columns, query, location)
+
+	for _, result := range results {
+		if result.AllRows == 0 {
+			rowHeaders = addPivotHeader(rowHeaders, repositories.PivotHeader{Key: result.RowKey, Name: result.RowName, Color: result.RowColor})
+		}
+		if result.AllColumns == 0 {
+			columnHeaders = addPivotHeader(columnHeaders, repositories.PivotHeader{Key: result.ColumnKey, Name: result.ColumnName, Color: result.ColumnColor})
+		}
+	}
+
+	sortPivotHeaders(query.Rows, rowHeaders)
+	sortPivotHeaders(query.Columns, columnHeaders)
+
+	var empty *float64
+	if query.Measure == repositories.PIVOT_MEASURE_SUM || query.Measure == repositories.PIVOT_MEASURE_COUNT {
+		zero := 0.0
+		empty = &zero
+	}
+
+	pivot := repositories.Pivot{
+		Rows:         rowHeaders,
+		Columns:      columnHeaders,
+		Cells:        make([][]*float64, len(rowHeaders)),
+		RowTotals:    make([]*float64, len(rowHeaders)),
+		ColumnTotals: make([]*float64, len(columnHeaders)),
+		Total:        empty,
+	}
+
+	rowIndex := make(map[string]int)
+	for i, header := range rowHeaders {
+		rowIndex[header.Key] = i
+		pivot.RowTotals[i] = empty
+		pivot.Cells[i] = make([]*float64, len(columnHeaders))
+		for j := range columnHeaders {
+			pivot.Cells[i][j] = empty
+		}
+	}
+
+	columnIndex := make(map[string]int)
+	for j, header := range columnHeaders {
+		columnIndex[header.Key] = j
+		pivot.ColumnTotals[j] = empty
+	}
+
+	for _, result := range results {
+		if result.Value == nil {
+			continue
+		}
+
+		switch {
+		case result.AllRows == 0 && result.AllColumns == 0:
+			pivot.Cells[rowIndex[result.RowKey]][columnIndex[result.ColumnKey]] = result.Value
+		case result.AllRows == 0:
+			pivot.RowTotals[rowIndex[result.RowKey]] = result.Value
+		case result.AllColumns == 0:
+			pivot.ColumnTotals[columnIndex[result.ColumnKey]] = result.Value
+		default:
+			pivot.Total = result.Value
+		}
+	}
+
+	return pivot, nil
+}
+
+// pivotResults runs one query for the grouping sets. A side no set is keyed
+// on is left out of the query, joins included, and reported as all rows or
+// all columns.
+func (p *PresentersRepository) pivotResults(userID string, query repositories.PivotQuery, measure string, rows pivotDimension, columns pivotDimension, sets []pivotGroupingSet) ([]pivotResult, error) {
+	var useRows, useColumns bool
+	var groupingSets []string
+
+	for _, set := range sets {
+		var keys []string
+		if set.rows {
+			useRows = true
+			keys = append(keys, rows.key)
+		}
+		if set.columns {
+			useColumns = true
+			keys = append(keys, columns.key)
+		}
+
+		groupingSets = append(groupingSets, "("+strings.Join(keys, ", ")+")")
+	}
+
+	db := p.gorm.Table("expenses")
+	selectColumns := ""
+
+	if useRows {
+		for _, join := range rows.joins {
+			db = db.Joins(join)
+		}
+		selectColumns += rows.key + " AS row_key, " + rows.name + " AS row_name, " + rows.color + " AS row_color, GROUPING(" + rows.key + ") AS all_rows, "
+	} else {
+		selectColumns += "'' AS row_key, '' AS row_name, '' AS row_color, 1 AS all_rows, "
+	}
+
+	if useColumns {
+		for _, join := range columns.joins {
+			db = db.Joins(join)
+		}
+		selectColumns += columns.key + " AS column_key, " + columns.name + " AS column_name, " + columns.color + " AS column_color, GROUPING(" + columns.key + ") AS all_columns, "
+	} else {
+		selectColumns += "'' AS column_key, '' AS column_name, '' AS column_color, 1 AS all_columns, "
+	}
+
+	var results []pivotResult
+
+	if err := db.
+		Select(selectColumns+measure+" AS value").
+		Where("expenses.user_id = ? AND "+expenseDateColumn(query.Basis)+" BETWEEN ? AND ? AND expenses.active = ?", userID, query.StartDate, query.EndDate, true).
+		Group("GROUPING SETS (" + strings.Join(groupingSets, ", ") + ")").
+		Scan(&results).Error; err != nil {
+		return nil, errors.New("failed to fetch pivot: " + err.Error())
+	}
+
+	return results, nil
+}
+
+// pivotHeaders returns the headers a dimension always has: every month or week
+// of the period and every day of the week.
+func pivotHeaders(dimension string, query repositories.PivotQuery, location *time.Location) []repositories.PivotHeader {
+	headers := []repositories.PivotHeader{}
+
+	switch dimension {
+	case repositories.PIVOT_DIMENSION_MONTH, repositories.PIVOT_DIMENSION_WEEK:
+		first := timeSeriesBucketStart(query.StartDate.In(location), dimension, query.WeekStart)
+		for start := first; !start.After(query.EndDate.In(location)); start = timeSeriesNextBucket(start, dimension) {
+			headers = append(headers, repositories.PivotHeader{Key: start.Format("2006-01-02")})
+		}
+	case repositories.PIVOT_DIMENSION_WEEKDAY:
+		for i := 0; i < 7; i++ {
+			headers = append(headers, repositories.PivotHeader{Key: strconv.Itoa((int(query.WeekStart) + i) % 7)})
+		}
+	}
+
+	for i := range headers {
+		headers[i].Name = pivotHeaderName(dimension, headers[i].Key)
+	}
+
+	return headers
+}
+
+func pivotHeaderName(dimension string, key string) string {
+	switch dimension {
+	case repositories.PIVOT_DIMENSION_MONTH:
+		if start, err := time.Parse("2006-01-02", key); err == nil {
+			return start.Month().String() + " " + strconv.Itoa(start.Year())
+		}
+	case repositories.PIVOT_DIMENSION_WEEK:
+		if start, err := time.Parse("2006-01-02", key); err == nil {
+			return start.Format("02/01/2006")
+		}
+	case repositories.PIVOT_DIMENSION_WEEKDAY:
+		if day, err := strconv.Atoi(key); err == nil {
+			return time.Weekday(day).String()
+		}
+	}
+
+	return key
+}
+
+func addPivotHeader(headers []repositories.PivotHeader, header repositories.PivotHeader) []repositories.PivotHeader {
+	for _, existing := range headers {
+		if existing.Key == header.Key {
+			return headers
+		}
+	}
+
+	return append(headers, header)
+}
+
+// sortPivotHeaders keeps months, weeks and weekdays in calendar order and
+// sorts everything else by name.
+func sortPivotHeaders(dimension string, headers []repositories.PivotHeader) {
+	switch dimension {
+	case repositories.PIVOT_DIMENSION_MONTH, repositories.PIVOT_DIMENSION_WEEK, repositories.PIVOT_DIMENSION_WEEKDAY:
+		return
+	}
+
+	sort.SliceStable(headers, func(i, j int) bool {
+		return headers[i].Name < headers[j].Name
+	})
+}
+
+// GetDailyExpenseTotals sums and counts active expenses per day of the user's
+// timezone. Days without expenses are left out.
+func (p *PresentersRepository) GetDailyExpenseTotals(userID string, startDate time.Time, endDate time.Time) ([]repositories.DailyExpenseTotal, error) {
+	location, err := p.userLocation(userID)
+	if err != nil {
+		return nil, errors.New("failed to load timezone: " + err.Error())
+	}
+
+	dateColumn := localDateColumn("expenses.expanse_date", location)
+
+	var results []struct {
+		Day   string  `gorm:"column:day"`
+		Total float64 `gorm:"column:total"`
+		Count int     `gorm:"column:count"`
+	}
+
+	if err := p.gorm.Table("expenses").
+		Select("TO_CHAR("+dateColumn+", 'YYYY-MM-DD') AS day, SUM(expenses.amount) AS total, COUNT(expenses.id) AS count").
+		Where("expenses.user_id = ? AND expenses.expanse_date BETWEEN ? AND ? AND expenses.active = ?", userID, startDate, endDate, true).
+		Group("day").
+		Order("day").
+		Scan(&results).Error; err != nil {
+		return nil, errors.New("failed to fetch daily expenses: " + err.Error())
+	}
+
+	dailyTotals := []repositories.DailyExpenseTotal{}
+	for _, result := range results {
+		day, err := time.ParseInLocation("2006-01-02", result.Day, location)
+		if err != nil {
+			return nil, errors.New("failed to parse expense day: " + err.Error())
+		}
+
+		dailyTotals = append(dailyTotals, repositories.DailyExpenseTotal{
+			Date:  day,
+			Total: result.Total,
+			Count: result.Count,
+		})
+	}
+
+	return dailyTotals, nil
+}
+
+// GetExpenseItemsForPeriod lists the active expenses of the period oldest
+// first, with their tag names joined by commas.
+func (p *PresentersRepository) GetExpenseItemsForPeriod(userID string, startDate time.Time, endDate time.Time) ([]repositories.ExpenseItem, error) {
+	expenseItems := []repositories.ExpenseItem{}
+
+	if err := p.gorm.Table("expenses").
+		Select("expenses.id AS expense_id, expenses.expanse_date AS expense_date, expenses.amount, COALESCE(categories.name, '') AS category_name, COALESCE(STRING_AGG(tags.name, ', ' ORDER BY tags.name), '') AS tags, expenses.notes").
+		Joins("LEFT JOIN categories ON categories.id = expenses.category_id").
+		Joins("LEFT JOIN expense_tags ON expense_tags.expenses_id = expenses.id").
+		Joins("LEFT JOIN tags ON tags.id = expense_tags.tags_id").
+		Where("expenses.user_id = ? AND expenses.expanse_date BETWEEN ? AND ? AND expenses.active = ?", userID, startDate, endDate, true).
+		Group("expenses.id, expenses.expanse_date, expenses.amount, categories.name, expenses.notes").
+		Order("expenses.expanse_date, expenses.id").
+		Scan(&expenseItems).Error; err != nil {
+		return nil, errors.New("failed to fetch expenses: " + err.Error())
+	}
+
+	return expenseItems, nil
+}
+
+// GetDeductibleExpenses lists the deductible expenses of the period oldest
+// first. An expense's own tax category overrides its category's, and
+// TAX_CATEGORY_NONE leaves it out.
+func (p *PresentersRepository) GetDeductibleExpenses(userID string, startDate time.Time, endDate time.Time) ([]repositories.DeductibleExpense, error) {
+	deductibleExpenses := []repositories.DeductibleExpense{}
+
+	taxCategory := "COALESCE(NULLIF(expenses.tax_category, ''), NULLIF(categories.tax_category, ''), ?)"
+
+	if err := p.gorm.Table("expenses").
+		Select("expenses.id AS expense_id, expenses.expanse_date AS expense_date, expenses.amount, "+taxCategory+" AS tax_category, "+
+			"COALESCE(categories.name, '') AS category_name, COALESCE(expenses.provider_tax_id, '') AS provider_tax_id, "+
+			"COALESCE(expenses.provider_name, '') AS provider_name, COALESCE(expenses.notes, '') AS notes", entities.TAX_CATEGORY_NONE).
+		Joins("LEFT JOIN categories ON categories.id = expenses.category_id").
+		Where("expenses.user_id = ? AND expenses.expanse_date BETWEEN ? AND ? AND expenses.active = ?", userID, startDate, endDate, true).
+		Where(taxCategory+" <> ?", entities.TAX_CATEGORY_NONE, entities.TAX_CATEGORY_NONE).
+		Order("expenses.expanse_date, expenses.id").
+		Scan(&deductibleExpenses).Error; err != nil {
+		return nil, errors.New("failed to fetch deductible expenses: " + err.Error())
+	}
+
+	return deductibleExpenses, nil
+}
+
+// GetGeotaggedExpenses lists the expenses with a location, oldest first,
+// optionally only those inside the box.
+func (p *PresentersRepository) GetGeotaggedExpenses(userID string, startDate time.Time, endDate time.Time, box *repositories.BoundingBox) ([]repositories.GeotaggedExpense, error) {
+	geotaggedExpenses := []repositories.GeotaggedExpense{}
+
+	query := p.gorm.Table("expenses").
+		Select("expenses.id AS expense_id, expenses.expanse_date AS expense_date, expenses.amount, expenses.latitude, expenses.longitude, "+
+			"COALESCE(expenses.place_name, '') AS place_name, COALESCE(categories.name, '') AS category_name, "+
+			"COALESCE(categories.color, '') AS category_color, COALESCE(expenses.notes, '') AS notes").
+		Joins("LEFT JOIN categories ON categories.id = expenses.category_id").
+		Where("expenses.user_id = ? AND expenses.expanse_date BETWEEN ? AND ? AND expenses.active = ?", userID, startDate, endDate, true).
+		Where("expenses.latitude IS NOT NULL AND expenses.longitude IS NOT NULL")
+
+	if box != nil {
+		query = query.Where("expenses.latitude BETWEEN ? AND ?", box.MinLatitude, box.MaxLatitude)
+
+		if box.MinLongitude <= box.MaxLongitude {
+			query = query.Where("expenses.longitude BETWEEN ? AND ?", box.MinLongitude, box.MaxLongitude)
+		} else {
+			query = query.Where("(expenses.longitude >= ? OR expenses.longitude <= ?)", box.MinLongitude, box.MaxLongitude)
+		}
+	}
+
+	if err := query.Order("expenses.expanse_date, expenses.id").Scan(&geotaggedExpenses).Error; err != nil {
+		return nil, errors.New("failed to fetch geotagged expenses: " + err.Error())
+	}
+
+	return geotaggedExpenses, nil
+}
@@ -0,0 +1,201 @@
+package repositoriesgorm
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"gorm.io/gorm"
+)
+
+type RuleRepository struct {
+	gorm *gorm.DB
+}
+
+func NewRuleRepository(gorm *gorm.DB) *RuleRepository {
+	return &RuleRepository{
+		gorm: gorm,
+	}
+}
+
+func (r *RuleRepository) CreateRule(rule entities.Rule) error {
+	tx := r.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Create(ruleToModel(rule)).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (r *RuleRepository) DeleteRule(rule entities.Rule) error {
+	tx := r.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&Rules{}).Where("id = ? AND user_id = ? AND active = ?", rule.ID, rule.UserID, true).
+		Select("Active", "DeactivatedAt", "UpdatedAt").Updates(Rules{
+		Active:        rule.Active,
+		DeactivatedAt: rule.DeactivatedAt,
+		UpdatedAt:     rule.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func (r *RuleRepository) GetRules(userID string) ([]entities.Rule, error) {
+	var rulesModel []Rules
+	if err := r.gorm.Where("user_id = ? AND active = ?", userID, true).Find(&rulesModel).Error; err != nil {
+		return nil, err
+	}
+
+	rules := []entities.Rule{}
+
+	for _, ruleModel := range rulesModel {
+		rules = append(rules, modelToRule(ruleModel))
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].Priority == rules[j].Priority {
+			return rules[i].CreatedAt.Before(rules[j].CreatedAt)
+		}
+		return rules[i].Priority < rules[j].Priority
+	})
+
+	return rules, nil
+}
+
+func (r *RuleRepository) GetRule(userID string, ruleID string) (entities.Rule, error) {
+	var ruleModel Rules
+
+	result := r.gorm.Model(&Rules{}).Where("id = ? AND user_id = ? AND active = ?", ruleID, userID, true).First(&ruleModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entities.Rule{}, errors.New("rule not found")
+		}
+		return entities.Rule{}, errors.New(result.Error.Error())
+	}
+
+	return modelToRule(ruleModel), nil
+}
+
+func (r *RuleRepository) UpdateRule(rule entities.Rule) error {
+	tx := r.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	ruleModel := ruleToModel(rule)
+
+	result := tx.Model(&Rules{}).Where("id = ? AND user_id = ? AND active = ?", rule.ID, rule.UserID, true).Updates(map[string]interface{}{
+		"name":                   ruleModel.Name,
+		"priority":               ruleModel.Priority,
+		"stop_processing":        ruleModel.StopProcessing,
+		"notes_contains":         ruleModel.NotesContains,
+		"notes_regex":            ruleModel.NotesRegex,
+		"min_amount":             ruleModel.MinAmount,
+		"max_amount":             ruleModel.MaxAmount,
+		"weekdays":               ruleModel.Weekdays,
+		"action_set_category_id": ruleModel.ActionSetCategoryID,
+		"action_add_tag_ids":     ruleModel.ActionAddTagIDs,
+		"action_rewrite_notes":   ruleModel.ActionRewriteNotes,
+		"updated_at":             ruleModel.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func ruleToModel(rule entities.Rule) *Rules {
+	var weekdays []string
+	for _, weekday := range rule.Conditions.Weekdays {
+		weekdays = append(weekdays, strconv.Itoa(weekday))
+	}
+
+	return &Rules{
+		ID:                  rule.ID,
+		Active:              rule.Active,
+		CreatedAt:           rule.CreatedAt,
+		UpdatedAt:           rule.UpdatedAt,
+		DeactivatedAt:       rule.DeactivatedAt,
+		UserID:              rule.UserID,
+		Name:                rule.Name,
+		Priority:            rule.Priority,
+		StopProcessing:      rule.StopProcessing,
+		NotesContains:       rule.Conditions.NotesContains,
+		NotesRegex:          rule.Conditions.NotesRegex,
+		MinAmount:           rule.Conditions.MinAmount,
+		MaxAmount:           rule.Conditions.MaxAmount,
+		Weekdays:            strings.Join(weekdays, ","),
+		ActionSetCategoryID: rule.Actions.SetCategoryID,
+		ActionAddTagIDs:     strings.Join(rule.Actions.AddTagIDs, ","),
+		ActionRewriteNotes:  rule.Actions.RewriteNotes,
+	}
+}
+
+func modelToRule(ruleModel Rules) entities.Rule {
+	weekdays := []int{}
+	if ruleModel.Weekdays != "" {
+		for _, weekday := range strings.Split(ruleModel.Weekdays, ",") {
+			if value, err := strconv.Atoi(weekday); err == nil {
+				weekdays = append(weekdays, value)
+			}
+		}
+	}
+
+	tagIDs := []string{}
+	if ruleModel.ActionAddTagIDs != "" {
+		tagIDs = strings.Split(ruleModel.ActionAddTagIDs, ",")
+	}
+
+	return entities.Rule{
+		SharedEntity: entities.SharedEntity{
+			ID:            ruleModel.ID,
+			Active:        ruleModel.Active,
+			CreatedAt:     ruleModel.CreatedAt,
+			UpdatedAt:     ruleModel.UpdatedAt,
+			DeactivatedAt: ruleModel.DeactivatedAt,
+		},
+		UserID:         ruleModel.UserID,
+		Name:           ruleModel.Name,
+		Priority:       ruleModel.Priority,
+		StopProcessing: ruleModel.StopProcessing,
+		Conditions: entities.RuleConditions{
+			NotesContains: ruleModel.NotesContains,
+			NotesRegex:    ruleModel.NotesRegex,
+			MinAmount:     ruleModel.MinAmount,
+			MaxAmount:     ruleModel.MaxAmount,
+			Weekdays:      weekdays,
+		},
+		Actions: entities.RuleActions{
+			SetCategoryID: ruleModel.ActionSetCategoryID,
+			AddTagIDs:     tagIDs,
+			RewriteNotes:  ruleModel.ActionRewriteNotes,
+		},
+	}
+}
@@ -0,0 +1,165 @@
+package repositoriesgorm
+
+import (
+	"errors"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SuggestionRepository struct {
+	gorm *gorm.DB
+}
+
+func NewSuggestionRepository(gorm *gorm.DB) *SuggestionRepository {
+	return &SuggestionRepository{
+		gorm: gorm,
+	}
+}
+
+func (s *SuggestionRepository) GetSuggestionModel(userID string) (entities.SuggestionModel, error) {
+	var rows []SuggestionFeatures
+
+	if err := s.gorm.Where("user_id = ?", userID).Find(&rows).Error; err != nil {
+		return entities.SuggestionModel{}, errors.New("failed to fetch suggestion model: " + err.Error())
+	}
+
+	model := entities.NewSuggestionModel(userID)
+
+	for _, row := range rows {
+		switch row.Kind {
+		case entities.SUGGESTION_KIND_DOCUMENT:
+			if row.Feature == entities.SUGGESTION_DOCUMENTS {
+				model.Documents = row.Count
+			} else {
+				model.Features[row.Feature] = row.Count
+			}
+		case entities.SUGGESTION_KIND_CATEGORY:
+			if row.Feature == entities.SUGGESTION_DOCUMENTS {
+				model.CategoryDocuments[row.LabelID] = row.Count
+			} else {
+				if model.CategoryFeatures[row.LabelID] == nil {
+					model.CategoryFeatures[row.LabelID] = map[string]int{}
+				}
+				model.CategoryFeatures[row.LabelID][row.Feature] = row.Count
+			}
+		case entities.SUGGESTION_KIND_TAG:
+			if row.Feature == entities.SUGGESTION_DOCUMENTS {
+				model.TagDocuments[row.LabelID] = row.Count
+			} else {
+				if model.TagFeatures[row.LabelID] == nil {
+					model.TagFeatures[row.LabelID] = map[string]int{}
+				}
+				model.TagFeatures[row.LabelID][row.Feature] = row.Count
+			}
+		}
+	}
+
+	return *model, nil
+}
+
+func (s *SuggestionRepository) HasSuggestionModel(userID string) (bool, error) {
+	var count int64
+
+	if err := s.gorm.Model(&SuggestionFeatures{}).
+		Where("user_id = ? AND kind = ? AND feature = ? AND count > ?", userID, entities.SUGGESTION_KIND_DOCUMENT, entities.SUGGESTION_DOCUMENTS, 0).
+		Count(&count).Error; err != nil {
+		return false, errors.New("failed to check suggestion model: " + err.Error())
+	}
+
+	return count > 0, nil
+}
+
+func (s *SuggestionRepository) SaveSuggestionModel(model entities.SuggestionModel) error {
+	tx := s.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Where("user_id = ?", model.UserID).Delete(&SuggestionFeatures{}).Error; err != nil {
+		tx.Rollback()
+		return errors.New("failed to clear suggestion model: " + err.Error())
+	}
+
+	rows := suggestionModelToRows(model)
+	if len(rows) > 0 {
+		if err := tx.CreateInBatches(rows, 500).Error; err != nil {
+			tx.Rollback()
+			return errors.New("failed to save suggestion model: " + err.Error())
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+func (s *SuggestionRepository) UpdateSuggestionModel(userID string, observation entities.SuggestionObservation, delta int) error {
+	model := entities.NewSuggestionModel(userID)
+	model.Observe(observation, delta)
+
+	rows := suggestionModelToRows(*model)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	if err := s.gorm.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}, {Name: "kind"}, {Name: "label_id"}, {Name: "feature"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"count":      gorm.Expr("suggestion_features.count + excluded.count"),
+			"updated_at": gorm.Expr("excluded.updated_at"),
+		}),
+	}).Create(&rows).Error; err != nil {
+		return errors.New("failed to update suggestion model: " + err.Error())
+	}
+
+	return nil
+}
+
+func suggestionModelToRows(model entities.SuggestionModel) []SuggestionFeatures {
+	var rows []SuggestionFeatures
+	timeNow := time.Now()
+
+	appendRow := func(kind string, labelID string, feature string, count int) {
+		if count == 0 {
+			return
+		}
+
+		rows = append(rows, SuggestionFeatures{
+			UserID:    model.UserID,
+			Kind:      kind,
+			LabelID:   labelID,
+			Feature:   feature,
+			Count:     count,
+			UpdatedAt: timeNow,
+		})
+	}
+
+	appendRow(entities.SUGGESTION_KIND_DOCUMENT, "", entities.SUGGESTION_DOCUMENTS, model.Documents)
+	for feature, count := range model.Features {
+		appendRow(entities.SUGGESTION_KIND_DOCUMENT, "", feature, count)
+	}
+
+	for categoryID, count := range model.CategoryDocuments {
+		appendRow(entities.SUGGESTION_KIND_CATEGORY, categoryID, entities.SUGGESTION_DOCUMENTS, count)
+	}
+	for categoryID, features := range model.CategoryFeatures {
+		for feature, count := range features {
+			appendRow(entities.SUGGESTION_KIND_CATEGORY, categoryID, feature, count)
+		}
+	}
+
+	for tagID, count := range model.TagDocuments {
+		appendRow(entities.SUGGESTION_KIND_TAG, tagID, entities.SUGGESTION_DOCUMENTS, count)
+	}
+	for tagID, features := range model.TagFeatures {
+		for feature, count := range features {
+			appendRow(entities.SUGGESTION_KIND_TAG, tagID, feature, count)
+		}
+	}
+
+	return rows
+}
@@ -0,0 +1,126 @@
+package repositoriesgorm
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"gorm.io/gorm"
+)
+
+type TransferRepository struct {
+	gorm *gorm.DB
+}
+
+func NewTransferRepository(gorm *gorm.DB) *TransferRepository {
+	return &TransferRepository{
+		gorm: gorm,
+	}
+}
+
+func (t *TransferRepository) CreateTransfer(transfer entities.Transfer) error {
+	tx := t.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Create(&Transfers{
+		ID:            transfer.ID,
+		Active:        transfer.Active,
+		CreatedAt:     transfer.CreatedAt,
+		UpdatedAt:     transfer.UpdatedAt,
+		DeactivatedAt: transfer.DeactivatedAt,
+		UserID:        transfer.UserID,
+		FromAccountID: transfer.FromAccountID,
+		ToAccountID:   transfer.ToAccountID,
+		Amount:        transfer.Amount,
+		TransferDate:  transfer.TransferDate,
+		Notes:         transfer.Notes,
+	}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (t *TransferRepository) DeleteTransfer(transfer entities.Transfer) error {
+	tx := t.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&Transfers{}).Where("id = ? AND user_id = ? AND active = ?", transfer.ID, transfer.UserID, true).
+		Select("Active", "DeactivatedAt", "UpdatedAt").Updates(Transfers{
+		Active:        transfer.Active,
+		DeactivatedAt: transfer.DeactivatedAt,
+		UpdatedAt:     transfer.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func (t *TransferRepository) GetTransfers(userID string) ([]entities.Transfer, error) {
+	var transfersModel []Transfers
+
+	if err := t.gorm.Preload("FromAccount").Preload("ToAccount").Where("user_id = ? AND active = ?", userID, true).Find(&transfersModel).Error; err != nil {
+		return []entities.Transfer{}, err
+	}
+
+	transfers := []entities.Transfer{}
+
+	for _, transferModel := range transfersModel {
+		transfers = append(transfers, modelToTransfer(transferModel))
+	}
+
+	sort.Slice(transfers, func(i, j int) bool {
+		return transfers[i].TransferDate.After(transfers[j].TransferDate)
+	})
+
+	return transfers, nil
+}
+
+func (t *TransferRepository) GetTransfer(userID string, transferID string) (entities.Transfer, error) {
+	var transferModel Transfers
+
+	result := t.gorm.Preload("FromAccount").Preload("ToAccount").Where("id = ? AND user_id = ? AND active = ?", transferID, userID, true).First(&transferModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entities.Transfer{}, errors.New("transfer not found")
+		}
+		return entities.Transfer{}, errors.New(result.Error.Error())
+	}
+
+	return modelToTransfer(transferModel), nil
+}
+
+func modelToTransfer(transferModel Transfers) entities.Transfer {
+	return entities.Transfer{
+		SharedEntity: entities.SharedEntity{
+			ID:            transferModel.ID,
+			Active:        transferModel.Active,
+			CreatedAt:     transferModel.CreatedAt,
+			UpdatedAt:     transferModel.UpdatedAt,
+			DeactivatedAt: transferModel.DeactivatedAt,
+		},
+		UserID:        transferModel.UserID,
+		FromAccountID: transferModel.FromAccountID,
+		ToAccountID:   transferModel.ToAccountID,
+		Amount:        transferModel.Amount,
+		TransferDate:  transferModel.TransferDate,
+		Notes:         transferModel.Notes,
+		FromAccount:   modelToAccount(transferModel.FromAccount),
+		ToAccount:     modelToAccount(transferModel.ToAccount),
+	}
+}
@@ -35,6 +35,9 @@ func (u *UserRepository) CreateUser(user entities.User) error {
 		Name:          user.Name,
 		Email:         user.Login.Email,
 		Password:      user.Login.Password,
+		Timezone:      user.Timezone,
+		Language:      user.Language,
+		WeekStart:     user.WeekStart,
 	}).Error; err != nil {
 		tx.Rollback()
 		return err
@@ -85,7 +88,10 @@ func (u *UserRepository) GetUsers() ([]entities.User, error) {
 					UpdatedAt:     userModel.UpdatedAt,
 					DeactivatedAt: userModel.DeactivatedAt,
 				},
-				Name: userModel.Name,
+				Name:      userModel.Name,
+				Timezone:  userModel.Timezone,
+				Language:  userModel.Language,
+				WeekStart: userModel.WeekStart,
 			}
 
 			users = append(users, user)
@@ -116,7 +122,10 @@ func (u *UserRepository) GetUser(userID string) (entities.User, error) {
 			UpdatedAt:     userModel.UpdatedAt,
 			DeactivatedAt: userModel.DeactivatedAt,
 		},
-		Name: userModel.Name,
+		Name:      userModel.Name,
+		Timezone:  userModel.Timezone,
+		Language:  userModel.Language,
+		WeekStart: userModel.WeekStart,
 	}
 
 	return user, nil
@@ -131,8 +140,12 @@ func (u *UserRepository) UpdateUser(user entities.User) error {
 		}
 	}()
 
-	result := tx.Model(&Users{}).Where("id", user.ID).Updates(Users{
+	result := tx.Model(&Users{}).Where("id", user.ID).
+		Select("Name", "Timezone", "Language", "WeekStart", "UpdatedAt").Updates(Users{
 		Name:      user.Name,
+		Timezone:  user.Timezone,
+		Language:  user.Language,
+		WeekStart: user.WeekStart,
 		UpdatedAt: user.UpdatedAt,
 	})
 
@@ -163,7 +176,10 @@ func (u *UserRepository) GetUserByEmail(userEmail string) (entities.User, error)
 			UpdatedAt:     userModel.UpdatedAt,
 			DeactivatedAt: userModel.DeactivatedAt,
 		},
-		Name: userModel.Name,
+		Name:      userModel.Name,
+		Timezone:  userModel.Timezone,
+		Language:  userModel.Language,
+		WeekStart: userModel.WeekStart,
 		Login: entities.Login{
 			Email:    userModel.Email,
 			Password: userModel.Password,
@@ -0,0 +1,332 @@
+package repositoriesgorm
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WebhookRepository struct {
+	gorm *gorm.DB
+}
+
+func NewWebhookRepository(gorm *gorm.DB) *WebhookRepository {
+	return &WebhookRepository{
+		gorm: gorm,
+	}
+}
+
+func (w *WebhookRepository) CreateWebhookSubscription(subscription entities.WebhookSubscription) error {
+	tx := w.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Create(&WebhookSubscriptions{
+		ID:            subscription.ID,
+		Active:        subscription.Active,
+		CreatedAt:     subscription.CreatedAt,
+		UpdatedAt:     subscription.UpdatedAt,
+		DeactivatedAt: subscription.DeactivatedAt,
+		UserID:        subscription.UserID,
+		URL:           subscription.URL,
+		Secret:        subscription.Secret,
+		EventTypes:    strings.Join(subscription.EventTypes, ","),
+	}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (w *WebhookRepository) DeleteWebhookSubscription(subscription entities.WebhookSubscription) error {
+	tx := w.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&WebhookSubscriptions{}).Where("id = ? AND user_id = ? AND active = ?", subscription.ID, subscription.UserID, true).
+		Select("Active", "DeactivatedAt", "UpdatedAt").Updates(WebhookSubscriptions{
+		Active:        subscription.Active,
+		DeactivatedAt: subscription.DeactivatedAt,
+		UpdatedAt:     subscription.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	if err := tx.Model(&WebhookDeliveries{}).Where("subscription_id = ? AND status = ?", subscription.ID, entities.WEBHOOK_DELIVERY_STATUS_PENDING).Updates(map[string]interface{}{
+		"status":          entities.WEBHOOK_DELIVERY_STATUS_FAILED,
+		"next_attempt_at": nil,
+		"last_error":      "webhook subscription deleted",
+		"updated_at":      subscription.UpdatedAt,
+	}).Error; err != nil {
+		tx.Rollback()
+		return errors.New("failed to cancel pending webhook deliveries: " + err.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func (w *WebhookRepository) GetWebhookSubscriptions(userID string) ([]entities.WebhookSubscription, error) {
+	var subscriptionsModel []WebhookSubscriptions
+
+	if err := w.gorm.Where("user_id = ? AND active = ?", userID, true).Find(&subscriptionsModel).Error; err != nil {
+		return []entities.WebhookSubscription{}, err
+	}
+
+	subscriptions := []entities.WebhookSubscription{}
+
+	for _, subscriptionModel := range subscriptionsModel {
+		subscriptions = append(subscriptions, modelToWebhookSubscription(subscriptionModel))
+	}
+
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].CreatedAt.Before(subscriptions[j].CreatedAt)
+	})
+
+	return subscriptions, nil
+}
+
+func (w *WebhookRepository) GetWebhookSubscription(userID string, subscriptionID string) (entities.WebhookSubscription, error) {
+	var subscriptionModel WebhookSubscriptions
+
+	result := w.gorm.Where("id = ? AND user_id = ? AND active = ?", subscriptionID, userID, true).First(&subscriptionModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entities.WebhookSubscription{}, repositories.ErrWebhookSubscriptionNotFound
+		}
+		return entities.WebhookSubscription{}, errors.New(result.Error.Error())
+	}
+
+	return modelToWebhookSubscription(subscriptionModel), nil
+}
+
+func (w *WebhookRepository) UpdateWebhookSubscription(subscription entities.WebhookSubscription) error {
+	tx := w.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&WebhookSubscriptions{}).Where("id = ? AND user_id = ? AND active = ?", subscription.ID, subscription.UserID, true).Updates(map[string]interface{}{
+		"url":         subscription.URL,
+		"event_types": strings.Join(subscription.EventTypes, ","),
+		"updated_at":  subscription.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func (w *WebhookRepository) CreateWebhookDeliveries(deliveries []entities.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	tx := w.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	var deliveriesModel []WebhookDeliveries
+	for _, delivery := range deliveries {
+		deliveriesModel = append(deliveriesModel, webhookDeliveryToModel(delivery))
+	}
+
+	if err := tx.Create(&deliveriesModel).Error; err != nil {
+		tx.Rollback()
+		return errors.New("failed to queue webhook deliveries: " + err.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func (w *WebhookRepository) UpdateWebhookDelivery(delivery entities.WebhookDelivery) error {
+	tx := w.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Model(&WebhookDeliveries{}).Where("id = ?", delivery.ID).Updates(map[string]interface{}{
+		"status":          delivery.Status,
+		"attempts":        delivery.Attempts,
+		"next_attempt_at": delivery.NextAttemptAt,
+		"last_attempt_at": delivery.LastAttemptAt,
+		"response_status": delivery.ResponseStatus,
+		"last_error":      delivery.LastError,
+		"updated_at":      delivery.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return errors.New(result.Error.Error())
+	}
+
+	return tx.Commit().Error
+}
+
+func (w *WebhookRepository) GetWebhookDeliveries(userID string, subscriptionID string) ([]entities.WebhookDelivery, error) {
+	var deliveriesModel []WebhookDeliveries
+
+	query := w.gorm.Where("user_id = ?", userID)
+	if subscriptionID != "" {
+		query = query.Where("subscription_id = ?", subscriptionID)
+	}
+
+	if err := query.Order("created_at DESC").Limit(100).Find(&deliveriesModel).Error; err != nil {
+		return []entities.WebhookDelivery{}, err
+	}
+
+	deliveries := []entities.WebhookDelivery{}
+
+	for _, deliveryModel := range deliveriesModel {
+		deliveries = append(deliveries, modelToWebhookDelivery(deliveryModel))
+	}
+
+	return deliveries, nil
+}
+
+// ClaimDueWebhookDeliveries locks up to limit due deliveries, skipping rows
+// another dispatcher holds, and pushes their next attempt out by
+// WEBHOOK_DELIVERY_LEASE in the same transaction. Concurrent dispatchers
+// therefore never send the same delivery twice.
+func (w *WebhookRepository) ClaimDueWebhookDeliveries(now time.Time, limit int) ([]entities.WebhookDelivery, error) {
+	tx := w.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	var deliveriesModel []WebhookDeliveries
+
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ? AND next_attempt_at <= ?", entities.WEBHOOK_DELIVERY_STATUS_PENDING, now).
+		Order("next_attempt_at ASC").Limit(limit).Find(&deliveriesModel).Error; err != nil {
+		tx.Rollback()
+		return []entities.WebhookDelivery{}, err
+	}
+
+	deliveries := []entities.WebhookDelivery{}
+
+	if len(deliveriesModel) == 0 {
+		tx.Rollback()
+		return deliveries, nil
+	}
+
+	ids := []string{}
+	for _, deliveryModel := range deliveriesModel {
+		ids = append(ids, deliveryModel.ID)
+	}
+
+	leasedUntil := now.Add(entities.WEBHOOK_DELIVERY_LEASE)
+
+	if err := tx.Model(&WebhookDeliveries{}).Where("id IN ?", ids).
+		Update("next_attempt_at", leasedUntil).Error; err != nil {
+		tx.Rollback()
+		return []entities.WebhookDelivery{}, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return []entities.WebhookDelivery{}, err
+	}
+
+	for _, deliveryModel := range deliveriesModel {
+		deliveryModel.NextAttemptAt = &leasedUntil
+		deliveries = append(deliveries, modelToWebhookDelivery(deliveryModel))
+	}
+
+	return deliveries, nil
+}
+
+func modelToWebhookSubscription(subscriptionModel WebhookSubscriptions) entities.WebhookSubscription {
+	eventTypes := []string{}
+	if subscriptionModel.EventTypes != "" {
+		eventTypes = strings.Split(subscriptionModel.EventTypes, ",")
+	}
+
+	return entities.WebhookSubscription{
+		SharedEntity: entities.SharedEntity{
+			ID:            subscriptionModel.ID,
+			Active:        subscriptionModel.Active,
+			CreatedAt:     subscriptionModel.CreatedAt,
+			UpdatedAt:     subscriptionModel.UpdatedAt,
+			DeactivatedAt: subscriptionModel.DeactivatedAt,
+		},
+		UserID:     subscriptionModel.UserID,
+		URL:        subscriptionModel.URL,
+		Secret:     subscriptionModel.Secret,
+		EventTypes: eventTypes,
+	}
+}
+
+func webhookDeliveryToModel(delivery entities.WebhookDelivery) WebhookDeliveries {
+	return WebhookDeliveries{
+		ID:             delivery.ID,
+		Active:         delivery.Active,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+		DeactivatedAt:  delivery.DeactivatedAt,
+		UserID:         delivery.UserID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastAttemptAt:  delivery.LastAttemptAt,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      delivery.LastError,
+	}
+}
+
+func modelToWebhookDelivery(deliveryModel WebhookDeliveries) entities.WebhookDelivery {
+	return entities.WebhookDelivery{
+		SharedEntity: entities.SharedEntity{
+			ID:            deliveryModel.ID,
+			Active:        deliveryModel.Active,
+			CreatedAt:     deliveryModel.CreatedAt,
+			UpdatedAt:     deliveryModel.UpdatedAt,
+			DeactivatedAt: deliveryModel.DeactivatedAt,
+		},
+		UserID:         deliveryModel.UserID,
+		SubscriptionID: deliveryModel.SubscriptionID,
+		EventType:      deliveryModel.EventType,
+		Payload:        deliveryModel.Payload,
+		Status:         deliveryModel.Status,
+		Attempts:       deliveryModel.Attempts,
+		NextAttemptAt:  deliveryModel.NextAttemptAt,
+		LastAttemptAt:  deliveryModel.LastAttemptAt,
+		ResponseStatus: deliveryModel.ResponseStatus,
+		LastError:      deliveryModel.LastError,
+	}
+}
@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// NewHTTPClient returns the client used to deliver webhooks. Subscribers
+// choose the target URL, so every connection is checked after the host has
+// been resolved, including the ones made while following redirects, and
+// addresses inside private, loopback or link-local ranges are refused.
+// allowLoopback lets a local receiver be used in development.
+func NewHTTPClient(allowLoopback bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(network string, address string, _ syscall.RawConn) error {
+			return checkAddress(address, allowLoopback)
+		},
+	}
+
+	return &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 5 * time.Second,
+		},
+	}
+}
+
+func checkAddress(address string, allowLoopback bool) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return errors.New("webhook target " + host + " is not an IP address")
+	}
+
+	if ip.IsLoopback() {
+		if allowLoopback {
+			return nil
+		}
+		return errors.New("webhook target " + host + " is a loopback address")
+	}
+
+	if ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() || ip.IsMulticast() {
+		return errors.New("webhook target " + host + " is not a public address")
+	}
+
+	return nil
+}
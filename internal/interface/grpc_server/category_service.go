@@ -0,0 +1,151 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	expensetrackerv1 "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/proto/expensetracker/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func categoryToProto(category entities.Category) *expensetrackerv1.Category {
+	return &expensetrackerv1.Category{
+		Id:          category.ID,
+		Name:        category.Name,
+		Color:       category.Color,
+		Kind:        category.Kind,
+		TaxCategory: category.TaxCategory,
+		CreatedAt:   timestamppb.New(category.CreatedAt),
+		UpdatedAt:   timestamppb.New(category.UpdatedAt),
+	}
+}
+
+func (s *Server) CreateCategory(ctx context.Context, request *expensetrackerv1.CreateCategoryRequest) (*expensetrackerv1.CreateCategoryResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := usecases.CreateCategoryInputDto{
+		UserID:      userID,
+		Name:        request.GetName(),
+		Color:       request.GetColor(),
+		Kind:        request.GetKind(),
+		TaxCategory: request.GetTaxCategory(),
+	}
+
+	output, errs := s.categoryFactory.CreateCategory.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.CreateCategoryResponse{
+		CategoryId:     output.CategoryID,
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
+
+func (s *Server) GetCategory(ctx context.Context, request *expensetrackerv1.GetCategoryRequest) (*expensetrackerv1.GetCategoryResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := requireField(ctx, request.GetCategoryId(), "Missing Category ID", "Category id is required"); err != nil {
+		return nil, err
+	}
+
+	input := usecases.GetCategoryInputDto{
+		UserID:     userID,
+		CategoryID: request.GetCategoryId(),
+	}
+
+	output, errs := s.categoryFactory.GetCategory.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.GetCategoryResponse{Category: categoryToProto(output.Category)}, nil
+}
+
+func (s *Server) GetCategories(ctx context.Context, request *expensetrackerv1.GetCategoriesRequest) (*expensetrackerv1.GetCategoriesResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := usecases.GetCategoriesInputDto{
+		UserID: userID,
+		Kind:   request.GetKind(),
+	}
+
+	output, errs := s.categoryFactory.GetCategories.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	response := &expensetrackerv1.GetCategoriesResponse{}
+	for _, category := range output.Categories {
+		response.Categories = append(response.Categories, categoryToProto(category))
+	}
+
+	return response, nil
+}
+
+func (s *Server) UpdateCategory(ctx context.Context, request *expensetrackerv1.UpdateCategoryRequest) (*expensetrackerv1.UpdateCategoryResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := requireField(ctx, request.GetCategoryId(), "Missing Category ID", "Category id is required"); err != nil {
+		return nil, err
+	}
+
+	input := usecases.UpdateCategoryInputDto{
+		UserID:      userID,
+		CategoryID:  request.GetCategoryId(),
+		Name:        request.GetName(),
+		Color:       request.GetColor(),
+		TaxCategory: request.GetTaxCategory(),
+	}
+
+	output, errs := s.categoryFactory.UpdateCategory.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.UpdateCategoryResponse{
+		CategoryId:     output.CategoryID,
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
+
+func (s *Server) DeleteCategory(ctx context.Context, request *expensetrackerv1.DeleteCategoryRequest) (*expensetrackerv1.DeleteCategoryResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := requireField(ctx, request.GetCategoryId(), "Missing Category ID", "Category id is required"); err != nil {
+		return nil, err
+	}
+
+	input := usecases.DeleteCategoryInputDto{
+		UserID:     userID,
+		CategoryID: request.GetCategoryId(),
+	}
+
+	output, errs := s.categoryFactory.DeleteCategory.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.DeleteCategoryResponse{
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
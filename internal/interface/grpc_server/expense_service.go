@@ -0,0 +1,237 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	expensetrackerv1 "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/proto/expensetracker/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const STREAM_EXPENSES_PAGE_SIZE = 100
+
+func expenseToProto(expense entities.Expense) *expensetrackerv1.Expense {
+	message := &expensetrackerv1.Expense{
+		Id:                    expense.ID,
+		Amount:                expense.Amount,
+		ExpenseDate:           timestamppb.New(expense.ExpenseDate),
+		CategoryId:            expense.CategoryID,
+		AccountId:             expense.AccountID,
+		MerchantId:            expense.MerchantID,
+		Notes:                 expense.Notes,
+		TagIds:                expense.TagIDs,
+		TaxCategory:           expense.TaxCategory,
+		ProviderTaxId:         expense.ProviderTaxID,
+		ProviderName:          expense.ProviderName,
+		Latitude:              expense.Latitude,
+		Longitude:             expense.Longitude,
+		PlaceName:             expense.PlaceName,
+		InstallmentPurchaseId: expense.InstallmentPurchaseID,
+		InstallmentNumber:     int32(expense.InstallmentNumber),
+		CreatedAt:             timestamppb.New(expense.CreatedAt),
+		UpdatedAt:             timestamppb.New(expense.UpdatedAt),
+	}
+
+	if expense.Category.ID != "" {
+		message.Category = categoryToProto(expense.Category)
+	}
+
+	for _, tag := range expense.Tags {
+		message.Tags = append(message.Tags, tagToProto(tag))
+	}
+
+	if expense.StatementDate != nil {
+		message.StatementDate = timestamppb.New(*expense.StatementDate)
+	}
+
+	return message
+}
+
+func (s *Server) CreateExpense(ctx context.Context, request *expensetrackerv1.CreateExpenseRequest) (*expensetrackerv1.CreateExpenseResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := usecases.CreateExpenseInputDto{
+		UserID:        userID,
+		Amount:        request.GetAmount(),
+		ExpenseDate:   request.GetExpenseDate(),
+		CategoryID:    request.GetCategoryId(),
+		AccountID:     request.GetAccountId(),
+		MerchantID:    request.GetMerchantId(),
+		Notes:         request.GetNotes(),
+		Tags:          request.GetTags(),
+		TaxCategory:   request.GetTaxCategory(),
+		ProviderTaxID: request.GetProviderTaxId(),
+		ProviderName:  request.GetProviderName(),
+		Latitude:      request.Latitude,
+		Longitude:     request.Longitude,
+		PlaceName:     request.GetPlaceName(),
+	}
+
+	output, errs := s.expenseFactory.CreateExpense.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.CreateExpenseResponse{
+		ExpenseId:      output.ExpenseID,
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
+
+func (s *Server) GetExpense(ctx context.Context, request *expensetrackerv1.GetExpenseRequest) (*expensetrackerv1.GetExpenseResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := requireField(ctx, request.GetExpenseId(), "Missing Expense ID", "Expense id is required"); err != nil {
+		return nil, err
+	}
+
+	input := usecases.GetExpenseInputDto{
+		UserID:    userID,
+		ExpenseID: request.GetExpenseId(),
+	}
+
+	output, errs := s.expenseFactory.GetExpense.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.GetExpenseResponse{Expense: expenseToProto(output.Expense)}, nil
+}
+
+func (s *Server) GetExpenses(ctx context.Context, request *expensetrackerv1.GetExpensesRequest) (*expensetrackerv1.GetExpensesResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := usecases.GetExpensesInputDto{
+		UserID: userID,
+	}
+
+	output, errs := s.expenseFactory.GetExpenses.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	response := &expensetrackerv1.GetExpensesResponse{}
+	for _, expense := range output.Expenses {
+		response.Expenses = append(response.Expenses, expenseToProto(expense))
+	}
+
+	return response, nil
+}
+
+// StreamExpenses sends the user's expenses one message each, in creation
+// order. It reads them a page at a time, so only one page is held in memory,
+// and stops early when the client goes away.
+func (s *Server) StreamExpenses(request *expensetrackerv1.StreamExpensesRequest, stream grpc.ServerStreamingServer[expensetrackerv1.Expense]) error {
+	ctx := stream.Context()
+
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	input := usecases.GetExpensesPageInputDto{
+		UserID: userID,
+		Limit:  STREAM_EXPENSES_PAGE_SIZE,
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		output, errs := s.expenseFactory.GetExpensesPage.Execute(input)
+		if len(errs) > 0 {
+			return problemError(ctx, errs)
+		}
+
+		for _, expense := range output.Expenses {
+			if err := stream.Send(expenseToProto(expense)); err != nil {
+				return err
+			}
+		}
+
+		if len(output.Expenses) < input.Limit {
+			return nil
+		}
+
+		input.AfterID = output.Expenses[len(output.Expenses)-1].ID
+	}
+}
+
+func (s *Server) UpdateExpense(ctx context.Context, request *expensetrackerv1.UpdateExpenseRequest) (*expensetrackerv1.UpdateExpenseResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := requireField(ctx, request.GetExpenseId(), "Missing Expense ID", "Expense id is required"); err != nil {
+		return nil, err
+	}
+
+	input := usecases.UpdateExpenseInputDto{
+		UserID:        userID,
+		ExpenseID:     request.GetExpenseId(),
+		Amount:        request.GetAmount(),
+		ExpenseDate:   request.GetExpenseDate(),
+		CategoryID:    request.GetCategoryId(),
+		AccountID:     request.GetAccountId(),
+		MerchantID:    request.GetMerchantId(),
+		Notes:         request.GetNotes(),
+		Tags:          request.GetTags(),
+		TaxCategory:   request.GetTaxCategory(),
+		ProviderTaxID: request.GetProviderTaxId(),
+		ProviderName:  request.GetProviderName(),
+		Latitude:      request.Latitude,
+		Longitude:     request.Longitude,
+		PlaceName:     request.GetPlaceName(),
+	}
+
+	output, errs := s.expenseFactory.UpdateExpense.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.UpdateExpenseResponse{
+		ExpenseId:      output.ExpenseID,
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
+
+func (s *Server) DeleteExpense(ctx context.Context, request *expensetrackerv1.DeleteExpenseRequest) (*expensetrackerv1.DeleteExpenseResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := requireField(ctx, request.GetExpenseId(), "Missing Expense ID", "Expense id is required"); err != nil {
+		return nil, err
+	}
+
+	input := usecases.DeleteExpenseInputDto{
+		UserID:    userID,
+		ExpenseID: request.GetExpenseId(),
+	}
+
+	output, errs := s.expenseFactory.DeleteExpense.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.DeleteExpenseResponse{
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
@@ -0,0 +1,435 @@
+package grpcserver
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	expensetrackerv1 "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/proto/expensetracker/v1"
+)
+
+// periodRequest is implemented by PeriodRequest and GetTopMerchantsRequest.
+type periodRequest interface {
+	GetStartDate() string
+	GetEndDate() string
+}
+
+func validatePeriod(ctx context.Context, request periodRequest) error {
+	if err := requireField(ctx, request.GetStartDate(), "Missing start date", "Start date is required"); err != nil {
+		return err
+	}
+
+	return requireField(ctx, request.GetEndDate(), "Missing end date", "End date is required")
+}
+
+// numberString turns an unset int32 into an empty query value, the way a
+// missing parameter reaches the use cases from REST.
+func numberString(value int32) string {
+	if value == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(int(value))
+}
+
+func monthOptionsToProto(months []repositories.MonthOption) []*expensetrackerv1.MonthOption {
+	var messages []*expensetrackerv1.MonthOption
+	for _, month := range months {
+		messages = append(messages, &expensetrackerv1.MonthOption{
+			Label: month.Label,
+			Value: month.Value,
+		})
+	}
+
+	return messages
+}
+
+func categoryExpensesToProto(expenses []repositories.CategoryExpense) []*expensetrackerv1.CategoryExpense {
+	var messages []*expensetrackerv1.CategoryExpense
+	for _, expense := range expenses {
+		messages = append(messages, &expensetrackerv1.CategoryExpense{
+			CategoryName:  expense.CategoryName,
+			CategoryColor: expense.CategoryColor,
+			Total:         expense.Total,
+		})
+	}
+
+	return messages
+}
+
+func tagExpensesToProto(expenses []repositories.TagExpense) []*expensetrackerv1.TagExpense {
+	var messages []*expensetrackerv1.TagExpense
+	for _, expense := range expenses {
+		messages = append(messages, &expensetrackerv1.TagExpense{
+			TagName:  expense.TagName,
+			TagColor: expense.TagColor,
+			Total:    expense.Total,
+		})
+	}
+
+	return messages
+}
+
+func (s *Server) GetTotalExpensesForPeriod(ctx context.Context, request *expensetrackerv1.PeriodRequest) (*expensetrackerv1.GetTotalExpensesForPeriodResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePeriod(ctx, request); err != nil {
+		return nil, err
+	}
+
+	input := presenters.GetTotalExpensesForPeriodInputDto{
+		UserID:    userID,
+		StartDate: request.GetStartDate(),
+		EndDate:   request.GetEndDate(),
+		Basis:     request.GetBasis(),
+	}
+
+	output, errs := s.presentersFactory.GetTotalExpensesForPeriod.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.GetTotalExpensesForPeriodResponse{Total: output.Total}, nil
+}
+
+func (s *Server) GetExpensesByCategoryPeriod(ctx context.Context, request *expensetrackerv1.PeriodRequest) (*expensetrackerv1.GetExpensesByCategoryPeriodResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePeriod(ctx, request); err != nil {
+		return nil, err
+	}
+
+	input := presenters.GetExpensesByCategoryPeriodInputDto{
+		UserID:    userID,
+		StartDate: request.GetStartDate(),
+		EndDate:   request.GetEndDate(),
+		Basis:     request.GetBasis(),
+	}
+
+	output, errs := s.presentersFactory.GetExpensesByCategoryPeriod.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.GetExpensesByCategoryPeriodResponse{Expenses: categoryExpensesToProto(output.Expenses)}, nil
+}
+
+func (s *Server) GetMonthlyExpensesByCategoryYear(ctx context.Context, request *expensetrackerv1.YearRequest) (*expensetrackerv1.GetMonthlyExpensesByCategoryYearResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := requireField(ctx, numberString(request.GetYear()), "Missing year date", "Year date is required"); err != nil {
+		return nil, err
+	}
+
+	input := presenters.GetMonthlyExpensesByCategoryYearInputDto{
+		UserID: userID,
+		Year:   numberString(request.GetYear()),
+		Basis:  request.GetBasis(),
+		Locale: getLocale(ctx),
+	}
+
+	output, errs := s.presentersFactory.GetMonthlyExpensesByCategoryYear.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	response := &expensetrackerv1.GetMonthlyExpensesByCategoryYearResponse{AvailableYears: int32s(output.AvailableYears)}
+	for _, expense := range output.Expenses {
+		response.Expenses = append(response.Expenses, &expensetrackerv1.MonthlyCategoryExpense{
+			Month:       expense.Month,
+			MonthNumber: int32(expense.MonthNumber),
+			Year:        int32(expense.Year),
+			Categories:  categoryExpensesToProto(expense.Categories),
+			Total:       expense.Total,
+		})
+	}
+
+	return response, nil
+}
+
+func (s *Server) GetMonthlyExpensesByTagYear(ctx context.Context, request *expensetrackerv1.YearRequest) (*expensetrackerv1.GetMonthlyExpensesByTagYearResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := requireField(ctx, numberString(request.GetYear()), "Missing year date", "Year date is required"); err != nil {
+		return nil, err
+	}
+
+	input := presenters.GetMonthlyExpensesByTagYearInputDto{
+		UserID: userID,
+		Year:   numberString(request.GetYear()),
+		Locale: getLocale(ctx),
+	}
+
+	output, errs := s.presentersFactory.GetMonthlyExpensesByTagYear.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	response := &expensetrackerv1.GetMonthlyExpensesByTagYearResponse{AvailableYears: int32s(output.AvailableYears)}
+	for _, expense := range output.Expenses {
+		response.Expenses = append(response.Expenses, &expensetrackerv1.MonthlyTagExpense{
+			Month:       expense.Month,
+			MonthNumber: int32(expense.MonthNumber),
+			Year:        int32(expense.Year),
+			Tags:        tagExpensesToProto(expense.Tags),
+			Total:       expense.Total,
+		})
+	}
+
+	return response, nil
+}
+
+func (s *Server) GetTotalExpensesForCurrentMonth(ctx context.Context, request *expensetrackerv1.GetTotalExpensesForCurrentMonthRequest) (*expensetrackerv1.GetTotalExpensesForCurrentMonthResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := presenters.GetTotalExpensesForCurrentMonthInputDto{
+		UserID: userID,
+		Locale: getLocale(ctx),
+	}
+
+	output, errs := s.presentersFactory.GetTotalExpensesForCurrentMonth.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.GetTotalExpensesForCurrentMonthResponse{
+		TotalExpenses: output.TotalExpenses,
+		CurrentMonth:  output.CurrentMonth,
+	}, nil
+}
+
+func (s *Server) GetTotalExpensesForCurrentWeek(ctx context.Context, request *expensetrackerv1.GetTotalExpensesForCurrentWeekRequest) (*expensetrackerv1.GetTotalExpensesForCurrentWeekResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := presenters.GetTotalExpensesForCurrentWeekInputDto{
+		UserID:  userID,
+		Compare: request.GetCompare(),
+	}
+
+	output, errs := s.presentersFactory.GetTotalExpensesForCurrentWeek.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.GetTotalExpensesForCurrentWeekResponse{
+		TotalExpenses:     output.TotalExpenses,
+		CurrentWeek:       output.CurrentWeek,
+		WeekStart:         output.WeekStart,
+		PreviousWeekTotal: output.PreviousWeekTotal,
+		PreviousWeek:      output.PreviousWeek,
+		Change:            output.Change,
+	}, nil
+}
+
+func (s *Server) GetTotalExpensesMonthCurrentYear(ctx context.Context, request *expensetrackerv1.YearRequest) (*expensetrackerv1.GetTotalExpensesMonthCurrentYearResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := requireField(ctx, numberString(request.GetYear()), "Missing year date", "Year date is required"); err != nil {
+		return nil, err
+	}
+
+	input := presenters.GetTotalExpensesMonthCurrentYearInputDto{
+		UserID: userID,
+		Year:   numberString(request.GetYear()),
+		Basis:  request.GetBasis(),
+		Locale: getLocale(ctx),
+	}
+
+	output, errs := s.presentersFactory.GetTotalExpensesMonthCurrentYear.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	expenses := output.ExpensesMonthCurrentYear
+
+	response := &expensetrackerv1.GetTotalExpensesMonthCurrentYearResponse{
+		Year:           int32(expenses.Year),
+		Total:          expenses.Total,
+		AvailableYears: int32s(expenses.AvailableYears),
+	}
+	for _, month := range expenses.Months {
+		response.Months = append(response.Months, &expensetrackerv1.MonthTotal{
+			Month:       month.Month,
+			MonthNumber: int32(month.MonthNumber),
+			Total:       month.Total,
+		})
+	}
+
+	return response, nil
+}
+
+func (s *Server) GetCategoryTagsTotalsByMonthYear(ctx context.Context, request *expensetrackerv1.MonthYearRequest) (*expensetrackerv1.GetCategoryTagsTotalsByMonthYearResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := requireField(ctx, numberString(request.GetYear()), "Missing year date", "Year date is required"); err != nil {
+		return nil, err
+	}
+
+	if err := requireField(ctx, numberString(request.GetMonth()), "Missing month date", "Month date is required"); err != nil {
+		return nil, err
+	}
+
+	input := presenters.GetCategoryTagsTotalsByMonthYearInputDto{
+		UserID: userID,
+		Year:   numberString(request.GetYear()),
+		Month:  numberString(request.GetMonth()),
+		Locale: getLocale(ctx),
+	}
+
+	output, errs := s.presentersFactory.GetCategoryTagsTotalsByMonthYear.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	expenses := output.Expenses
+
+	response := &expensetrackerv1.GetCategoryTagsTotalsByMonthYearResponse{
+		Month:           expenses.Month,
+		MonthNumber:     int32(expenses.MonthNumber),
+		Year:            int32(expenses.Year),
+		ExpensesAmount:  expenses.ExpensesAmount,
+		AvailableYears:  int32s(expenses.AvailableYears),
+		AvailableMonths: monthOptionsToProto(expenses.AvailableMonths),
+	}
+	for _, category := range expenses.Categories {
+		message := &expensetrackerv1.CategoryWithTags{
+			Name:           category.Name,
+			CategoryAmount: category.CategoryAmount,
+			Color:          category.Color,
+		}
+		for _, tag := range category.Tags {
+			message.Tags = append(message.Tags, &expensetrackerv1.CategoryTagTotal{
+				Name:      tag.Name,
+				TagAmount: tag.TagAmount,
+				Color:     tag.Color,
+			})
+		}
+		response.Categories = append(response.Categories, message)
+	}
+
+	return response, nil
+}
+
+func (s *Server) GetAvailableMonthsYears(ctx context.Context, request *expensetrackerv1.GetAvailableMonthsYearsRequest) (*expensetrackerv1.GetAvailableMonthsYearsResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := presenters.GetAvailableMonthsYearsInputDto{
+		UserID: userID,
+		Locale: getLocale(ctx),
+	}
+
+	output, errs := s.presentersFactory.GetAvailableMonthsYears.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.GetAvailableMonthsYearsResponse{
+		AvailableYears:  int32s(output.AvailableYears),
+		AvailableMonths: monthOptionsToProto(output.AvailableMonths),
+	}, nil
+}
+
+func (s *Server) GetDayToDayExpensesPeriod(ctx context.Context, request *expensetrackerv1.PeriodRequest) (*expensetrackerv1.GetDayToDayExpensesPeriodResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePeriod(ctx, request); err != nil {
+		return nil, err
+	}
+
+	input := presenters.GetDayToDayExpensesPeriodInputDto{
+		UserID:    userID,
+		StartDate: request.GetStartDate(),
+		EndDate:   request.GetEndDate(),
+		Locale:    getLocale(ctx),
+	}
+
+	output, errs := s.presentersFactory.GetDayToDayExpensesPeriod.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	response := &expensetrackerv1.GetDayToDayExpensesPeriodResponse{}
+	for _, expense := range output.Expenses {
+		response.Expenses = append(response.Expenses, &expensetrackerv1.DayToDayExpense{
+			Day:     expense.Day,
+			DayName: expense.DayName,
+			Month:   expense.Month,
+			Year:    expense.Year,
+			Amount:  expense.Amount,
+		})
+	}
+
+	return response, nil
+}
+
+func (s *Server) GetTopMerchants(ctx context.Context, request *expensetrackerv1.GetTopMerchantsRequest) (*expensetrackerv1.GetTopMerchantsResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePeriod(ctx, request); err != nil {
+		return nil, err
+	}
+
+	input := presenters.GetTopMerchantsInputDto{
+		UserID:    userID,
+		StartDate: request.GetStartDate(),
+		EndDate:   request.GetEndDate(),
+		Limit:     numberString(request.GetLimit()),
+		Basis:     request.GetBasis(),
+	}
+
+	output, errs := s.presentersFactory.GetTopMerchants.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	response := &expensetrackerv1.GetTopMerchantsResponse{
+		Total: output.Total,
+		Count: int32(output.Count),
+	}
+	for _, merchant := range output.Merchants {
+		response.Merchants = append(response.Merchants, &expensetrackerv1.TopMerchant{
+			MerchantId:   merchant.MerchantID,
+			MerchantName: merchant.MerchantName,
+			Total:        merchant.Total,
+			Count:        int32(merchant.Count),
+			Average:      merchant.Average,
+			SharePercent: merchant.SharePercent,
+		})
+	}
+
+	return response, nil
+}
@@ -0,0 +1,111 @@
+package grpcserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/factory"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+	expensetrackerv1 "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/proto/expensetracker/v1"
+	"google.golang.org/grpc"
+)
+
+// Server exposes the category, tag, expense, user and presenter use cases
+// over gRPC. The services are defined in proto/expensetracker/v1 and their
+// messages and stubs are generated with `make proto`.
+type Server struct {
+	expensetrackerv1.UnimplementedCategoryServiceServer
+	expensetrackerv1.UnimplementedTagServiceServer
+	expensetrackerv1.UnimplementedExpenseServiceServer
+	expensetrackerv1.UnimplementedUserServiceServer
+	expensetrackerv1.UnimplementedPresentersServiceServer
+
+	categoryFactory   *factory.CategoryFactory
+	tagFactory        *factory.TagFactory
+	expenseFactory    *factory.ExpenseFactory
+	userFactory       *factory.UserFactory
+	presentersFactory *factory.PresentersFactory
+}
+
+// NewServer registers every service. Calls go through the same token check
+// and locale resolution as the protected REST routes; CreateUser and Login
+// are public.
+func NewServer(
+	categoryFactory *factory.CategoryFactory,
+	tagFactory *factory.TagFactory,
+	expenseFactory *factory.ExpenseFactory,
+	userFactory *factory.UserFactory,
+	presentersFactory *factory.PresentersFactory,
+	preferredLanguage func(userID string) string,
+) *grpc.Server {
+	s := &Server{
+		categoryFactory:   categoryFactory,
+		tagFactory:        tagFactory,
+		expenseFactory:    expenseFactory,
+		userFactory:       userFactory,
+		presentersFactory: presentersFactory,
+	}
+
+	server := util.NewGRPCServer(
+		util.GRPCLocaleInterceptor(nil),
+		util.GRPCAuthInterceptor(
+			expensetrackerv1.UserService_CreateUser_FullMethodName,
+			expensetrackerv1.UserService_Login_FullMethodName,
+		),
+		util.GRPCLocaleInterceptor(preferredLanguage),
+	)
+
+	expensetrackerv1.RegisterCategoryServiceServer(server, s)
+	expensetrackerv1.RegisterTagServiceServer(server, s)
+	expensetrackerv1.RegisterExpenseServiceServer(server, s)
+	expensetrackerv1.RegisterUserServiceServer(server, s)
+	expensetrackerv1.RegisterPresentersServiceServer(server, s)
+
+	return server
+}
+
+func getLocale(ctx context.Context) string {
+	return util.GRPCContextString(ctx, "locale")
+}
+
+func problemError(ctx context.Context, errs []util.ProblemDetails) error {
+	return util.NewGRPCProblemError(getLocale(ctx), errs)
+}
+
+func getUserID(ctx context.Context) (string, error) {
+	userID := util.GRPCContextString(ctx, "userID")
+	if userID == "" {
+		return "", problemError(ctx, []util.ProblemDetails{{
+			Type:     "Unauthorized",
+			Title:    "Missing User ID",
+			Status:   http.StatusUnauthorized,
+			Detail:   "User id is required",
+			Instance: util.RFC401,
+		}})
+	}
+
+	return userID, nil
+}
+
+func requireField(ctx context.Context, value, title, detail string) error {
+	if value == "" {
+		return problemError(ctx, []util.ProblemDetails{{
+			Type:     "Bad Request",
+			Title:    title,
+			Status:   http.StatusBadRequest,
+			Detail:   detail,
+			Instance: util.RFC400,
+		}})
+	}
+
+	return nil
+}
+
+func int32s(values []int) []int32 {
+	converted := make([]int32, len(values))
+	for i, value := range values {
+		converted[i] = int32(value)
+	}
+
+	return converted
+}
@@ -0,0 +1,145 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	expensetrackerv1 "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/proto/expensetracker/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func tagToProto(tag entities.Tag) *expensetrackerv1.Tag {
+	return &expensetrackerv1.Tag{
+		Id:        tag.ID,
+		Name:      tag.Name,
+		Color:     tag.Color,
+		CreatedAt: timestamppb.New(tag.CreatedAt),
+		UpdatedAt: timestamppb.New(tag.UpdatedAt),
+	}
+}
+
+func (s *Server) CreateTag(ctx context.Context, request *expensetrackerv1.CreateTagRequest) (*expensetrackerv1.CreateTagResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := usecases.CreateTagInputDto{
+		UserID: userID,
+		Name:   request.GetName(),
+		Color:  request.GetColor(),
+	}
+
+	output, errs := s.tagFactory.CreateTag.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.CreateTagResponse{
+		TagId:          output.TagID,
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
+
+func (s *Server) GetTag(ctx context.Context, request *expensetrackerv1.GetTagRequest) (*expensetrackerv1.GetTagResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := requireField(ctx, request.GetTagId(), "Missing Tag ID", "Tag id is required"); err != nil {
+		return nil, err
+	}
+
+	input := usecases.GetTagInputDto{
+		UserID: userID,
+		TagID:  request.GetTagId(),
+	}
+
+	output, errs := s.tagFactory.GetTag.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.GetTagResponse{Tag: tagToProto(output.Tag)}, nil
+}
+
+func (s *Server) GetTags(ctx context.Context, request *expensetrackerv1.GetTagsRequest) (*expensetrackerv1.GetTagsResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := usecases.GetTagsInputDto{
+		UserID: userID,
+	}
+
+	output, errs := s.tagFactory.GetTags.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	response := &expensetrackerv1.GetTagsResponse{}
+	for _, tag := range output.Tags {
+		response.Tags = append(response.Tags, tagToProto(tag))
+	}
+
+	return response, nil
+}
+
+func (s *Server) UpdateTag(ctx context.Context, request *expensetrackerv1.UpdateTagRequest) (*expensetrackerv1.UpdateTagResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := requireField(ctx, request.GetTagId(), "Missing Tag ID", "Tag id is required"); err != nil {
+		return nil, err
+	}
+
+	input := usecases.UpdateTagInputDto{
+		UserID: userID,
+		TagID:  request.GetTagId(),
+		Name:   request.GetName(),
+		Color:  request.GetColor(),
+	}
+
+	output, errs := s.tagFactory.UpdateTag.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.UpdateTagResponse{
+		TagId:          output.TagID,
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
+
+func (s *Server) DeleteTag(ctx context.Context, request *expensetrackerv1.DeleteTagRequest) (*expensetrackerv1.DeleteTagResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := requireField(ctx, request.GetTagId(), "Missing Tag ID", "Tag id is required"); err != nil {
+		return nil, err
+	}
+
+	input := usecases.DeleteTagInputDto{
+		UserID: userID,
+		TagID:  request.GetTagId(),
+	}
+
+	output, errs := s.tagFactory.DeleteTag.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.DeleteTagResponse{
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
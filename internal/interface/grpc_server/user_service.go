@@ -0,0 +1,128 @@
+package grpcserver
+
+import (
+	"context"
+
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	expensetrackerv1 "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/proto/expensetracker/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func userToProto(user usecases.UserOutput) *expensetrackerv1.User {
+	return &expensetrackerv1.User{
+		Id:        user.ID,
+		Name:      user.Name,
+		Timezone:  user.Timezone,
+		Language:  user.Language,
+		WeekStart: user.WeekStart,
+		CreatedAt: timestamppb.New(user.CreatedAt),
+		UpdatedAt: timestamppb.New(user.UpdatedAt),
+	}
+}
+
+func (s *Server) CreateUser(ctx context.Context, request *expensetrackerv1.CreateUserRequest) (*expensetrackerv1.CreateUserResponse, error) {
+	input := usecases.CreateUserInputDto{
+		Name:      request.GetName(),
+		Email:     request.GetEmail(),
+		Password:  request.GetPassword(),
+		Timezone:  request.GetTimezone(),
+		Language:  request.GetLanguage(),
+		WeekStart: request.GetWeekStart(),
+	}
+
+	output, errs := s.userFactory.CreateUser.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.CreateUserResponse{
+		Name:           output.Name,
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
+
+func (s *Server) Login(ctx context.Context, request *expensetrackerv1.LoginRequest) (*expensetrackerv1.LoginResponse, error) {
+	input := usecases.LoginInputDto{
+		Email:    request.GetEmail(),
+		Password: request.GetPassword(),
+	}
+
+	output, errs := s.userFactory.Login.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.LoginResponse{
+		Name:           output.Name,
+		UserId:         output.UserID,
+		AccessToken:    output.AccessToken,
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
+
+func (s *Server) GetUser(ctx context.Context, request *expensetrackerv1.GetUserRequest) (*expensetrackerv1.GetUserResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := usecases.GetUserInputDto{
+		UserID: userID,
+	}
+
+	output, errs := s.userFactory.GetUser.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.GetUserResponse{User: userToProto(output.User)}, nil
+}
+
+func (s *Server) UpdateUser(ctx context.Context, request *expensetrackerv1.UpdateUserRequest) (*expensetrackerv1.UpdateUserResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := usecases.UpdateUserInputDto{
+		UserID:    userID,
+		Name:      request.GetName(),
+		Timezone:  request.GetTimezone(),
+		Language:  request.Language,
+		WeekStart: request.GetWeekStart(),
+	}
+
+	output, errs := s.userFactory.UpdateUser.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.UpdateUserResponse{
+		UserId:         output.UserID,
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
+
+func (s *Server) DeleteUser(ctx context.Context, request *expensetrackerv1.DeleteUserRequest) (*expensetrackerv1.DeleteUserResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := usecases.DeleteUserInputDto{
+		UserID: userID,
+	}
+
+	output, errs := s.userFactory.DeleteUser.Execute(input)
+	if len(errs) > 0 {
+		return nil, problemError(ctx, errs)
+	}
+
+	return &expensetrackerv1.DeleteUserResponse{
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
@@ -0,0 +1,417 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/factory"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+type AccountHandler struct {
+	accountFactory *factory.AccountFactory
+}
+
+func NewAccountHandler(factory *factory.AccountFactory) *AccountHandler {
+	return &AccountHandler{
+		accountFactory: factory,
+	}
+}
+
+// @Summary      Create an account
+// @Description  Create a new account or payment method (checking, savings, credit_card or cash). Credit cards require a closing day and a due day
+// @Tags         Accounts
+// @Accept       json
+// @Produce      json
+// @Param        request body CreateAccountRequest true "Account data"
+// @Success      201 {object} usecases.CreateAccountOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /accounts [post]
+func (h *AccountHandler) CreateAccount(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request CreateAccountRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.CreateAccountInputDto{
+		UserID:         userID,
+		Name:           request.Name,
+		Type:           request.Type,
+		Currency:       request.Currency,
+		OpeningBalance: request.OpeningBalance,
+		ClosingDay:     request.ClosingDay,
+		DueDay:         request.DueDay,
+	}
+
+	output, errs := h.accountFactory.CreateAccount.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+// @Summary      Get a specific account
+// @Description  Retrieve an account by its ID
+// @Tags         Accounts
+// @Accept       json
+// @Produce      json
+// @Param        account_id query string true "Account ID"
+// @Success      200 {object} usecases.GetAccountOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Failure      404 {object} util.ProblemDetails "Account Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /accounts [get]
+func (h *AccountHandler) GetAccount(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	accountID := c.Query("account_id")
+	if accountID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Account ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Account id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.GetAccountInputDto{
+		UserID:    userID,
+		AccountID: accountID,
+	}
+
+	output, errs := h.accountFactory.GetAccount.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Get all accounts
+// @Description  Retrieve all accounts for the authenticated user
+// @Tags         Accounts
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} usecases.GetAccountsOutputDto
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /accounts/all [get]
+func (h *AccountHandler) GetAccounts(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := usecases.GetAccountsInputDto{
+		UserID: userID,
+	}
+
+	output, errs := h.accountFactory.GetAccounts.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Update an account
+// @Description  Update an existing account
+// @Tags         Accounts
+// @Accept       json
+// @Produce      json
+// @Param        request body UpdateAccountRequest true "Updated account data"
+// @Success      200 {object} usecases.UpdateAccountOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Failure      404 {object} util.ProblemDetails "Account Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /accounts [patch]
+func (h *AccountHandler) UpdateAccount(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request UpdateAccountRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.UpdateAccountInputDto{
+		UserID:         userID,
+		AccountID:      request.AccountID,
+		Name:           request.Name,
+		Type:           request.Type,
+		Currency:       request.Currency,
+		OpeningBalance: request.OpeningBalance,
+		ClosingDay:     request.ClosingDay,
+		DueDay:         request.DueDay,
+	}
+
+	output, errs := h.accountFactory.UpdateAccount.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Delete an account
+// @Description  Delete an account by its ID. Accounts with expenses, incomes or transfers cannot be deleted
+// @Tags         Accounts
+// @Accept       json
+// @Produce      json
+// @Param        account_id query string true "Account ID"
+// @Success      200 {object} usecases.DeleteAccountOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Failure      404 {object} util.ProblemDetails "Account Not Found"
+// @Failure      409 {object} util.ProblemDetails "Account Has Transactions"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /accounts [delete]
+func (h *AccountHandler) DeleteAccount(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	accountID := c.Query("account_id")
+	if accountID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Account ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Account id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.DeleteAccountInputDto{
+		UserID:    userID,
+		AccountID: accountID,
+	}
+
+	output, errs := h.accountFactory.DeleteAccount.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Create a transfer
+// @Description  Move money between two accounts. Transfers are not counted as spending
+// @Tags         Accounts
+// @Accept       json
+// @Produce      json
+// @Param        request body CreateTransferRequest true "Transfer data"
+// @Success      201 {object} usecases.CreateTransferOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      404 {object} util.ProblemDetails "Account Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /transfers [post]
+func (h *AccountHandler) CreateTransfer(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request CreateTransferRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.CreateTransferInputDto{
+		UserID:        userID,
+		FromAccountID: request.FromAccountID,
+		ToAccountID:   request.ToAccountID,
+		Amount:        request.Amount,
+		TransferDate:  request.TransferDate,
+		Notes:         request.Notes,
+	}
+
+	output, errs := h.accountFactory.CreateTransfer.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+// @Summary      Get a specific transfer
+// @Description  Retrieve a transfer by its ID
+// @Tags         Accounts
+// @Accept       json
+// @Produce      json
+// @Param        transfer_id query string true "Transfer ID"
+// @Success      200 {object} usecases.GetTransferOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Failure      404 {object} util.ProblemDetails "Transfer Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /transfers [get]
+func (h *AccountHandler) GetTransfer(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	transferID := c.Query("transfer_id")
+	if transferID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Transfer ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Transfer id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.GetTransferInputDto{
+		UserID:     userID,
+		TransferID: transferID,
+	}
+
+	output, errs := h.accountFactory.GetTransfer.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Get all transfers
+// @Description  Retrieve all transfers for the authenticated user
+// @Tags         Accounts
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} usecases.GetTransfersOutputDto
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /transfers/all [get]
+func (h *AccountHandler) GetTransfers(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := usecases.GetTransfersInputDto{
+		UserID: userID,
+	}
+
+	output, errs := h.accountFactory.GetTransfers.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Delete a transfer
+// @Description  Delete a transfer by its ID
+// @Tags         Accounts
+// @Accept       json
+// @Produce      json
+// @Param        transfer_id query string true "Transfer ID"
+// @Success      200 {object} usecases.DeleteTransferOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Failure      404 {object} util.ProblemDetails "Transfer Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /transfers [delete]
+func (h *AccountHandler) DeleteTransfer(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	transferID := c.Query("transfer_id")
+	if transferID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Transfer ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Transfer id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.DeleteTransferInputDto{
+		UserID:     userID,
+		TransferID: transferID,
+	}
+
+	output, errs := h.accountFactory.DeleteTransfer.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
@@ -35,26 +35,28 @@ func NewCategoryHandler(factory *factory.CategoryFactory) *CategoryHandler {
 func (h *CategoryHandler) CreateCategory(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	var request CreateCategoryRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Did not bind JSON",
 			Status:   http.StatusBadRequest,
 			Detail:   err.Error(),
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
 	input := usecases.CreateCategoryInputDto{
-		UserID: userID,
-		Name:   request.Name,
-		Color:  request.Color,
+		UserID:      userID,
+		Name:        request.Name,
+		Color:       request.Color,
+		Kind:        request.Kind,
+		TaxCategory: request.TaxCategory,
 	}
 
 	output, errs := h.categoryFactory.CreateCategory.Execute(input)
@@ -83,19 +85,19 @@ func (h *CategoryHandler) CreateCategory(c *gin.Context) {
 func (h *CategoryHandler) GetCategory(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	categoryID := c.Query("category_id")
 	if categoryID == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing Category ID",
 			Status:   http.StatusBadRequest,
 			Detail:   "Category id is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
@@ -119,6 +121,7 @@ func (h *CategoryHandler) GetCategory(c *gin.Context) {
 // @Tags Categories
 // @Accept json
 // @Produce json
+// @Param kind query string false "Category kind (expense or income)"
 // @Success 200 {array} usecases.GetCategoriesOutputDto
 // @Failure 400 {object} util.ProblemDetails
 // @Failure 500 {object} util.ProblemDetails
@@ -128,12 +131,13 @@ func (h *CategoryHandler) GetCategory(c *gin.Context) {
 func (h *CategoryHandler) GetCategories(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	input := usecases.GetCategoriesInputDto{
 		UserID: userID,
+		Kind:   c.Query("kind"),
 	}
 
 	output, errs := h.categoryFactory.GetCategories.Execute(input)
@@ -162,27 +166,28 @@ func (h *CategoryHandler) GetCategories(c *gin.Context) {
 func (h *CategoryHandler) UpdateCategory(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	var request UpdateCategoryRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Did not bind JSON",
 			Status:   http.StatusBadRequest,
 			Detail:   err.Error(),
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
 	input := usecases.UpdateCategoryInputDto{
-		UserID:     userID,
-		CategoryID: request.CategoryID,
-		Name:       request.Name,
-		Color:      request.Color,
+		UserID:      userID,
+		CategoryID:  request.CategoryID,
+		Name:        request.Name,
+		Color:       request.Color,
+		TaxCategory: request.TaxCategory,
 	}
 
 	output, errs := h.categoryFactory.UpdateCategory.Execute(input)
@@ -211,19 +216,19 @@ func (h *CategoryHandler) UpdateCategory(c *gin.Context) {
 func (h *CategoryHandler) DeleteCategory(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	categoryID := c.Query("category_id")
 	if categoryID == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing Category ID",
 			Status:   http.StatusBadRequest,
 			Detail:   "Category id is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
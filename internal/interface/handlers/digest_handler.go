@@ -0,0 +1,222 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/factory"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+type DigestHandler struct {
+	digestFactory *factory.DigestFactory
+}
+
+func NewDigestHandler(factory *factory.DigestFactory) *DigestHandler {
+	return &DigestHandler{
+		digestFactory: factory,
+	}
+}
+
+// @Summary      Subscribe to a spending digest
+// @Description  Opt in to a weekly or monthly email summarizing the previous period: total against the prior period, top categories and largest expenses. An address other than the account's own gets a confirmation link first
+// @Tags         Digests
+// @Accept       json
+// @Produce      json
+// @Param        request body CreateDigestSubscriptionRequest true "Digest subscription data"
+// @Success      201 {object} usecases.CreateDigestSubscriptionOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      409 {object} util.ProblemDetails "Conflict"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /digests [post]
+func (h *DigestHandler) CreateDigestSubscription(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request CreateDigestSubscriptionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+
+	input := usecases.CreateDigestSubscriptionInputDto{
+		UserID:    userID,
+		Email:     request.Email,
+		Frequency: request.Frequency,
+		BaseURL:   scheme + "://" + c.Request.Host,
+	}
+
+	output, errs := h.digestFactory.CreateDigestSubscription.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+// @Summary      Confirm a digest subscription
+// @Description  Follow the signed link emailed to a digest address to start sending the digest to it
+// @Tags         Digests
+// @Accept       json
+// @Produce      json
+// @Param        user_id query string true "User ID"
+// @Param        subscription_id query string true "Digest subscription ID"
+// @Param        signature query string true "Link signature"
+// @Success      200 {object} usecases.ConfirmDigestSubscriptionOutputDto
+// @Failure      403 {object} util.ProblemDetails "Forbidden"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /digests/confirm [get]
+func (h *DigestHandler) ConfirmDigestSubscription(c *gin.Context) {
+	input := usecases.ConfirmDigestSubscriptionInputDto{
+		UserID:         c.Query("user_id"),
+		SubscriptionID: c.Query("subscription_id"),
+		Signature:      c.Query("signature"),
+	}
+
+	output, errs := h.digestFactory.ConfirmDigestSubscription.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Get all digest subscriptions
+// @Description  Retrieve the spending digests the authenticated user opted in to
+// @Tags         Digests
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} usecases.GetDigestSubscriptionsOutputDto
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /digests/all [get]
+func (h *DigestHandler) GetDigestSubscriptions(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := usecases.GetDigestSubscriptionsInputDto{
+		UserID: userID,
+	}
+
+	output, errs := h.digestFactory.GetDigestSubscriptions.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Unsubscribe from a spending digest
+// @Description  Stop sending a spending digest
+// @Tags         Digests
+// @Accept       json
+// @Produce      json
+// @Param        subscription_id query string true "Digest subscription ID"
+// @Success      200 {object} usecases.DeleteDigestSubscriptionOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      404 {object} util.ProblemDetails "Digest Subscription Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /digests [delete]
+func (h *DigestHandler) DeleteDigestSubscription(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	subscriptionID := c.Query("subscription_id")
+	if subscriptionID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Subscription ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Subscription id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.DeleteDigestSubscriptionInputDto{
+		UserID:         userID,
+		SubscriptionID: subscriptionID,
+	}
+
+	output, errs := h.digestFactory.DeleteDigestSubscription.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Preview a spending digest
+// @Description  Render the weekly or monthly digest for the last complete period, as HTML and plain text, without sending it
+// @Tags         Digests
+// @Accept       json
+// @Produce      json
+// @Param        frequency query string true "Digest frequency (weekly or monthly)"
+// @Success      200 {object} usecases.PreviewSpendingDigestOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /digests/preview [get]
+func (h *DigestHandler) PreviewSpendingDigest(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	frequency := c.Query("frequency")
+	if frequency == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Frequency",
+			Status:   http.StatusBadRequest,
+			Detail:   "Frequency is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.PreviewSpendingDigestInputDto{
+		UserID:    userID,
+		Frequency: frequency,
+	}
+
+	output, errs := h.digestFactory.PreviewSpendingDigest.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
@@ -20,7 +20,7 @@ func NewExpenseHandler(factory *factory.ExpenseFactory) *ExpenseHandler {
 }
 
 // @Summary      Create an expense
-// @Description  Create a new expense entry
+// @Description  Create a new expense entry. Without a merchant_id the merchant is matched from the notes against the names and aliases of existing merchants, and created from the first words of the notes when none matches
 // @Tags         Expenses
 // @Accept       json
 // @Produce      json
@@ -34,29 +34,37 @@ func NewExpenseHandler(factory *factory.ExpenseFactory) *ExpenseHandler {
 func (h *ExpenseHandler) CreateExpense(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	var request CreateExpenseRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Did not bind JSON",
 			Status:   http.StatusBadRequest,
 			Detail:   err.Error(),
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
 	input := usecases.CreateExpenseInputDto{
-		UserID:      userID,
-		CategoryID:  request.CategoryID,
-		Amount:      request.Amount,
-		Tags:        request.Tags,
-		ExpenseDate: request.ExpenseDate,
-		Notes:       request.Notes,
+		UserID:        userID,
+		CategoryID:    request.CategoryID,
+		AccountID:     request.AccountID,
+		MerchantID:    request.MerchantID,
+		Amount:        request.Amount,
+		Tags:          request.Tags,
+		ExpenseDate:   request.ExpenseDate,
+		Notes:         request.Notes,
+		TaxCategory:   request.TaxCategory,
+		ProviderTaxID: request.ProviderTaxID,
+		ProviderName:  request.ProviderName,
+		Latitude:      request.Latitude,
+		Longitude:     request.Longitude,
+		PlaceName:     request.PlaceName,
 	}
 
 	output, errs := h.expenseFactory.CreateExpense.Execute(input)
@@ -84,19 +92,19 @@ func (h *ExpenseHandler) CreateExpense(c *gin.Context) {
 func (h *ExpenseHandler) GetExpense(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	expenseID := c.Query("expense_id")
 	if expenseID == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing Expense ID",
 			Status:   http.StatusBadRequest,
 			Detail:   "Expense id is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
@@ -127,7 +135,7 @@ func (h *ExpenseHandler) GetExpense(c *gin.Context) {
 func (h *ExpenseHandler) GetExpenses(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
@@ -160,30 +168,38 @@ func (h *ExpenseHandler) GetExpenses(c *gin.Context) {
 func (h *ExpenseHandler) UpdateExpense(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	var request UpdateExpenseRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Did not bind JSON",
 			Status:   http.StatusBadRequest,
 			Detail:   err.Error(),
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
 	input := usecases.UpdateExpenseInputDto{
-		UserID:      userID,
-		ExpenseID:   request.ExpenseID,
-		Amount:      request.Amount,
-		ExpenseDate: request.ExpenseDate,
-		CategoryID:  request.CategoryID,
-		Notes:       request.Notes,
-		Tags:        request.Tags,
+		UserID:        userID,
+		ExpenseID:     request.ExpenseID,
+		Amount:        request.Amount,
+		ExpenseDate:   request.ExpenseDate,
+		CategoryID:    request.CategoryID,
+		AccountID:     request.AccountID,
+		MerchantID:    request.MerchantID,
+		Notes:         request.Notes,
+		Tags:          request.Tags,
+		TaxCategory:   request.TaxCategory,
+		ProviderTaxID: request.ProviderTaxID,
+		ProviderName:  request.ProviderName,
+		Latitude:      request.Latitude,
+		Longitude:     request.Longitude,
+		PlaceName:     request.PlaceName,
 	}
 
 	output, erros := h.expenseFactory.UpdateExpense.Execute(input)
@@ -211,19 +227,19 @@ func (h *ExpenseHandler) UpdateExpense(c *gin.Context) {
 func (h *ExpenseHandler) DeleteExpense(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	expenseID := c.Query("expense_id")
 	if expenseID == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing Expense ID",
 			Status:   http.StatusBadRequest,
 			Detail:   "Expense id is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
@@ -240,3 +256,115 @@ func (h *ExpenseHandler) DeleteExpense(c *gin.Context) {
 
 	c.JSON(http.StatusOK, output)
 }
+
+// @Summary      Suggest category and tags
+// @Description  Suggest categories and tags for an expense from its notes and amount, learned from the user's expense history
+// @Tags         Expenses
+// @Accept       json
+// @Produce      json
+// @Param        notes query string false "Expense notes"
+// @Param        amount query string false "Expense amount"
+// @Success      200 {object} usecases.SuggestExpenseOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /expenses/suggest [get]
+func (h *ExpenseHandler) SuggestExpense(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := usecases.SuggestExpenseInputDto{
+		UserID: userID,
+		Notes:  c.Query("notes"),
+		Amount: c.Query("amount"),
+	}
+
+	output, errs := h.expenseFactory.SuggestExpense.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Get expense anomalies
+// @Description  Retrieve the expenses flagged as unusual, such as amounts far above the category baseline or duplicate charges, with an explanation for each flag. Dismissed flags are not returned
+// @Tags         Expenses
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} usecases.GetExpenseAnomaliesOutputDto
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /expenses/anomalies [get]
+func (h *ExpenseHandler) GetExpenseAnomalies(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := usecases.GetExpenseAnomaliesInputDto{
+		UserID: userID,
+	}
+
+	output, errs := h.expenseFactory.GetExpenseAnomalies.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Dismiss an expense anomaly
+// @Description  Dismiss an anomaly flag so it is no longer reported
+// @Tags         Expenses
+// @Accept       json
+// @Produce      json
+// @Param        request body DismissExpenseAnomalyRequest true "Anomaly to dismiss"
+// @Success      200 {object} usecases.DismissExpenseAnomalyOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Failure      404 {object} util.ProblemDetails "Anomaly Not Found"
+// @Failure      409 {object} util.ProblemDetails "Anomaly Already Dismissed"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /expenses/anomalies [patch]
+func (h *ExpenseHandler) DismissExpenseAnomaly(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request DismissExpenseAnomalyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.DismissExpenseAnomalyInputDto{
+		UserID:    userID,
+		AnomalyID: request.AnomalyID,
+	}
+
+	output, errs := h.expenseFactory.DismissExpenseAnomaly.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
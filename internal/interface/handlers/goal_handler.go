@@ -0,0 +1,381 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/factory"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+type GoalHandler struct {
+	goalFactory *factory.GoalFactory
+}
+
+func NewGoalHandler(factory *factory.GoalFactory) *GoalHandler {
+	return &GoalHandler{
+		goalFactory: factory,
+	}
+}
+
+// @Summary      Create a savings goal
+// @Description  Create a savings goal with a target amount, an optional deadline (DDMMYYYY) and an optional linked category whose expenses count as contributions
+// @Tags         Goals
+// @Accept       json
+// @Produce      json
+// @Param        request body CreateGoalRequest true "Goal data"
+// @Success      201 {object} usecases.CreateGoalOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /goals [post]
+func (h *GoalHandler) CreateGoal(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request CreateGoalRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.CreateGoalInputDto{
+		UserID:       userID,
+		Name:         request.Name,
+		TargetAmount: request.TargetAmount,
+		Deadline:     request.Deadline,
+		CategoryID:   request.CategoryID,
+	}
+
+	output, errs := h.goalFactory.CreateGoal.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+// @Summary      Get a specific goal
+// @Description  Retrieve a savings goal by its ID
+// @Tags         Goals
+// @Accept       json
+// @Produce      json
+// @Param        goal_id query string true "Goal ID"
+// @Success      200 {object} usecases.GetGoalOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      404 {object} util.ProblemDetails "Goal Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /goals [get]
+func (h *GoalHandler) GetGoal(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	goalID := c.Query("goal_id")
+	if goalID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Goal ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Goal id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.GetGoalInputDto{
+		UserID: userID,
+		GoalID: goalID,
+	}
+
+	output, errs := h.goalFactory.GetGoal.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Get all goals
+// @Description  Retrieve all savings goals for the authenticated user
+// @Tags         Goals
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} usecases.GetGoalsOutputDto
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /goals/all [get]
+func (h *GoalHandler) GetGoals(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := usecases.GetGoalsInputDto{
+		UserID: userID,
+	}
+
+	output, errs := h.goalFactory.GetGoals.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Update a goal
+// @Description  Update an existing savings goal. Empty fields are left unchanged
+// @Tags         Goals
+// @Accept       json
+// @Produce      json
+// @Param        request body UpdateGoalRequest true "Updated goal data"
+// @Success      200 {object} usecases.UpdateGoalOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      404 {object} util.ProblemDetails "Goal Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /goals [patch]
+func (h *GoalHandler) UpdateGoal(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request UpdateGoalRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.UpdateGoalInputDto{
+		UserID:       userID,
+		GoalID:       request.GoalID,
+		Name:         request.Name,
+		TargetAmount: request.TargetAmount,
+		Deadline:     request.Deadline,
+		CategoryID:   request.CategoryID,
+	}
+
+	output, errs := h.goalFactory.UpdateGoal.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Delete a goal
+// @Description  Delete a savings goal and its contributions
+// @Tags         Goals
+// @Accept       json
+// @Produce      json
+// @Param        goal_id query string true "Goal ID"
+// @Success      200 {object} usecases.DeleteGoalOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      404 {object} util.ProblemDetails "Goal Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /goals [delete]
+func (h *GoalHandler) DeleteGoal(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	goalID := c.Query("goal_id")
+	if goalID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Goal ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Goal id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.DeleteGoalInputDto{
+		UserID: userID,
+		GoalID: goalID,
+	}
+
+	output, errs := h.goalFactory.DeleteGoal.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Add a goal contribution
+// @Description  Record a manual contribution to a savings goal. Negative amounts record withdrawals
+// @Tags         Goals
+// @Accept       json
+// @Produce      json
+// @Param        request body CreateGoalContributionRequest true "Contribution data"
+// @Success      201 {object} usecases.CreateGoalContributionOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      404 {object} util.ProblemDetails "Goal Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /goals/contributions [post]
+func (h *GoalHandler) CreateGoalContribution(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request CreateGoalContributionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.CreateGoalContributionInputDto{
+		UserID:           userID,
+		GoalID:           request.GoalID,
+		Amount:           request.Amount,
+		ContributionDate: request.ContributionDate,
+		Notes:            request.Notes,
+	}
+
+	output, errs := h.goalFactory.CreateGoalContribution.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+// @Summary      Get goal contributions
+// @Description  Retrieve the manual contributions of a savings goal
+// @Tags         Goals
+// @Accept       json
+// @Produce      json
+// @Param        goal_id query string true "Goal ID"
+// @Success      200 {object} usecases.GetGoalContributionsOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      404 {object} util.ProblemDetails "Goal Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /goals/contributions [get]
+func (h *GoalHandler) GetGoalContributions(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	goalID := c.Query("goal_id")
+	if goalID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Goal ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Goal id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.GetGoalContributionsInputDto{
+		UserID: userID,
+		GoalID: goalID,
+	}
+
+	output, errs := h.goalFactory.GetGoalContributions.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Delete a goal contribution
+// @Description  Delete a manual contribution by its ID
+// @Tags         Goals
+// @Accept       json
+// @Produce      json
+// @Param        contribution_id query string true "Contribution ID"
+// @Success      200 {object} usecases.DeleteGoalContributionOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      404 {object} util.ProblemDetails "Contribution Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /goals/contributions [delete]
+func (h *GoalHandler) DeleteGoalContribution(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	contributionID := c.Query("contribution_id")
+	if contributionID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Contribution ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Contribution id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.DeleteGoalContributionInputDto{
+		UserID:         userID,
+		ContributionID: contributionID,
+	}
+
+	output, errs := h.goalFactory.DeleteGoalContribution.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,448 @@
+package handlers
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go/ast"
+)
+
+const (
+	// GRAPHQL_MAX_COST caps the estimated number of fields a query resolves.
+	// Each field costs 1, and the fields under a list are counted once per
+	// item it may return.
+	GRAPHQL_MAX_COST = 5000
+	// GRAPHQL_LIST_COST is the number of items assumed for a list that takes
+	// no first argument.
+	GRAPHQL_LIST_COST = 20
+	// GRAPHQL_MAX_PAGE_SIZE bounds the first argument of the expenses lists
+	// nested under categories, tags, accounts and merchants.
+	GRAPHQL_MAX_PAGE_SIZE = 100
+)
+
+// graphQLSelection is a field, inline fragment or fragment spread of a query,
+// keeping only what the cost estimate needs.
+type graphQLSelection struct {
+	field      string
+	spread     string
+	first      string
+	selections []graphQLSelection
+}
+
+type graphQLDocument struct {
+	operations []graphQLOperation
+	fragments  map[string][]graphQLSelection
+}
+
+type graphQLOperation struct {
+	root       string
+	selections []graphQLSelection
+}
+
+// graphQLQueryCost estimates the cost of the most expensive operation of a
+// query that already passed schema validation. Lists cost their first
+// argument, read from the variables when needed, or GRAPHQL_LIST_COST.
+func graphQLQueryCost(schema *ast.Schema, query string, variables map[string]interface{}) (int, error) {
+	document, err := parseGraphQLDocument(query)
+	if err != nil {
+		return 0, err
+	}
+
+	estimate := graphQLCostEstimate{schema: schema, document: document, variables: variables, visiting: map[string]bool{}}
+
+	cost := 0
+	for _, operation := range document.operations {
+		if operationCost := estimate.selections(operation.selections, operation.root); operationCost > cost {
+			cost = operationCost
+		}
+	}
+
+	return cost, nil
+}
+
+type graphQLCostEstimate struct {
+	schema    *ast.Schema
+	document  graphQLDocument
+	variables map[string]interface{}
+	visiting  map[string]bool
+}
+
+func (e *graphQLCostEstimate) selections(selections []graphQLSelection, typeName string) int {
+	cost := 0
+
+	for _, selection := range selections {
+		switch {
+		case selection.spread != "":
+			if e.visiting[selection.spread] {
+				return GRAPHQL_MAX_COST + 1
+			}
+			e.visiting[selection.spread] = true
+			cost += e.selections(e.document.fragments[selection.spread], typeName)
+			delete(e.visiting, selection.spread)
+		case selection.field == "":
+			cost += e.selections(selection.selections, typeName)
+		default:
+			cost += e.field(selection, typeName)
+		}
+
+		if cost > GRAPHQL_MAX_COST {
+			return GRAPHQL_MAX_COST + 1
+		}
+	}
+
+	return cost
+}
+
+func (e *graphQLCostEstimate) field(selection graphQLSelection, typeName string) int {
+	childType, list := "", false
+
+	if object, ok := e.schema.Types[typeName].(*ast.ObjectTypeDefinition); ok {
+		if definition := object.Fields.Get(selection.field); definition != nil {
+			childType, list = graphQLNamedType(definition.Type)
+		}
+	}
+
+	if len(selection.selections) == 0 {
+		return 1
+	}
+
+	items := 1
+	if list {
+		items = e.listSize(selection.first)
+	}
+
+	children := e.selections(selection.selections, childType)
+	if children > GRAPHQL_MAX_COST/items {
+		return GRAPHQL_MAX_COST + 1
+	}
+
+	return 1 + items*children
+}
+
+// listSize reads the first argument; a variable that is missing or not a
+// number counts as the largest page.
+func (e *graphQLCostEstimate) listSize(first string) int {
+	if first == "" {
+		return GRAPHQL_LIST_COST
+	}
+
+	size := GRAPHQL_MAX_PAGE_SIZE
+	if name, ok := strings.CutPrefix(first, "$"); ok {
+		if value, ok := e.variables[name].(float64); ok {
+			size = int(value)
+		}
+	} else if value, err := strconv.Atoi(first); err == nil {
+		size = value
+	}
+
+	if size < 1 {
+		return 1
+	}
+	if size > GRAPHQL_MAX_PAGE_SIZE {
+		return GRAPHQL_MAX_PAGE_SIZE
+	}
+
+	return size
+}
+
+func graphQLNamedType(fieldType ast.Type) (string, bool) {
+	list := false
+
+	for {
+		switch wrapper := fieldType.(type) {
+		case *ast.NonNull:
+			fieldType = wrapper.OfType
+		case *ast.List:
+			list = true
+			fieldType = wrapper.OfType
+		case ast.NamedType:
+			return wrapper.TypeName(), list
+		default:
+			return "", list
+		}
+	}
+}
+
+var errGraphQLSyntax = errors.New("could not read the query to estimate its cost")
+
+// graphQLParser reads the operations and fragments of an executable
+// document, skipping variable definitions, directives and argument values
+// other than first.
+type graphQLParser struct {
+	tokens []string
+	next   int
+}
+
+func parseGraphQLDocument(query string) (graphQLDocument, error) {
+	tokens, err := graphQLTokens(query)
+	if err != nil {
+		return graphQLDocument{}, err
+	}
+
+	p := &graphQLParser{tokens: tokens}
+	document := graphQLDocument{fragments: map[string][]graphQLSelection{}}
+
+	for p.next < len(p.tokens) {
+		switch p.peek() {
+		case "{":
+			selections, err := p.selectionSet()
+			if err != nil {
+				return graphQLDocument{}, err
+			}
+			document.operations = append(document.operations, graphQLOperation{root: "Query", selections: selections})
+		case "query", "mutation", "subscription":
+			root := strings.ToUpper(p.peek()[:1]) + p.peek()[1:]
+			p.next++
+			if p.peek() != "(" && p.peek() != "@" && p.peek() != "{" {
+				p.next++
+			}
+			if p.peek() == "(" {
+				if err := p.skipGroup("(", ")"); err != nil {
+					return graphQLDocument{}, err
+				}
+			}
+			if err := p.directives(); err != nil {
+				return graphQLDocument{}, err
+			}
+			selections, err := p.selectionSet()
+			if err != nil {
+				return graphQLDocument{}, err
+			}
+			document.operations = append(document.operations, graphQLOperation{root: root, selections: selections})
+		case "fragment":
+			p.next++
+			name := p.take()
+			if p.take() != "on" {
+				return graphQLDocument{}, errGraphQLSyntax
+			}
+			p.next++
+			if err := p.directives(); err != nil {
+				return graphQLDocument{}, err
+			}
+			selections, err := p.selectionSet()
+			if err != nil {
+				return graphQLDocument{}, err
+			}
+			document.fragments[name] = selections
+		default:
+			return graphQLDocument{}, errGraphQLSyntax
+		}
+	}
+
+	return document, nil
+}
+
+func (p *graphQLParser) peek() string {
+	if p.next < len(p.tokens) {
+		return p.tokens[p.next]
+	}
+	return ""
+}
+
+func (p *graphQLParser) take() string {
+	token := p.peek()
+	p.next++
+	return token
+}
+
+func (p *graphQLParser) selectionSet() ([]graphQLSelection, error) {
+	if p.take() != "{" {
+		return nil, errGraphQLSyntax
+	}
+
+	selections := []graphQLSelection{}
+
+	for p.peek() != "}" {
+		if p.peek() == "" {
+			return nil, errGraphQLSyntax
+		}
+
+		selection, err := p.selection()
+		if err != nil {
+			return nil, err
+		}
+		selections = append(selections, selection)
+	}
+	p.next++
+
+	return selections, nil
+}
+
+func (p *graphQLParser) selection() (graphQLSelection, error) {
+	if p.peek() == "..." {
+		p.next++
+
+		if p.peek() == "on" {
+			p.next += 2
+		} else if p.peek() != "@" && p.peek() != "{" {
+			spread := p.take()
+			return graphQLSelection{spread: spread}, p.directives()
+		}
+
+		if err := p.directives(); err != nil {
+			return graphQLSelection{}, err
+		}
+		selections, err := p.selectionSet()
+		return graphQLSelection{selections: selections}, err
+	}
+
+	selection := graphQLSelection{field: p.take()}
+	if p.peek() == ":" {
+		p.next++
+		selection.field = p.take()
+	}
+
+	if p.peek() == "(" {
+		p.next++
+		for p.peek() != ")" {
+			name := p.take()
+			if name == "" || p.take() != ":" {
+				return graphQLSelection{}, errGraphQLSyntax
+			}
+			value, err := p.value()
+			if err != nil {
+				return graphQLSelection{}, err
+			}
+			if name == "first" {
+				selection.first = value
+			}
+		}
+		p.next++
+	}
+
+	if err := p.directives(); err != nil {
+		return graphQLSelection{}, err
+	}
+
+	if p.peek() == "{" {
+		selections, err := p.selectionSet()
+		if err != nil {
+			return graphQLSelection{}, err
+		}
+		selection.selections = selections
+	}
+
+	return selection, nil
+}
+
+// value returns a scalar argument as written, with a leading "$" for
+// variables, and skips lists and input objects.
+func (p *graphQLParser) value() (string, error) {
+	switch p.peek() {
+	case "[":
+		return "", p.skipGroup("[", "]")
+	case "{":
+		return "", p.skipGroup("{", "}")
+	case "$":
+		p.next++
+		return "$" + p.take(), nil
+	case "", ")":
+		return "", errGraphQLSyntax
+	}
+
+	return p.take(), nil
+}
+
+func (p *graphQLParser) directives() error {
+	for p.peek() == "@" {
+		p.next += 2
+		if p.peek() == "(" {
+			if err := p.skipGroup("(", ")"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *graphQLParser) skipGroup(open string, close string) error {
+	depth := 0
+
+	for {
+		switch p.take() {
+		case open:
+			depth++
+		case close:
+			if depth--; depth == 0 {
+				return nil
+			}
+		case "":
+			return errGraphQLSyntax
+		}
+	}
+}
+
+// graphQLTokens splits a query into punctuators, names and numbers. Strings
+// become a single placeholder token; commas and comments are dropped.
+func graphQLTokens(query string) ([]string, error) {
+	tokens := []string{}
+
+	for i := 0; i < len(query); {
+		c := query[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			i++
+		case c == '#':
+			for i < len(query) && query[i] != '\n' && query[i] != '\r' {
+				i++
+			}
+		case strings.HasPrefix(query[i:], "..."):
+			tokens = append(tokens, "...")
+			i += 3
+		case strings.HasPrefix(query[i:], `"""`):
+			end := strings.Index(strings.ReplaceAll(query[i+3:], `\"""`, "xxxx"), `"""`)
+			if end < 0 {
+				return nil, errGraphQLSyntax
+			}
+			tokens = append(tokens, `""`)
+			i += 3 + end + 3
+		case c == '"':
+			i++
+			for i < len(query) && query[i] != '"' {
+				if query[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			if i >= len(query) {
+				return nil, errGraphQLSyntax
+			}
+			tokens = append(tokens, `""`)
+			i++
+		case strings.IndexByte("!$&():=@[]{}|", c) >= 0:
+			tokens = append(tokens, string(c))
+			i++
+		case c == '_' || isGraphQLLetter(c):
+			start := i
+			for i < len(query) && (query[i] == '_' || isGraphQLLetter(query[i]) || isGraphQLDigit(query[i])) {
+				i++
+			}
+			tokens = append(tokens, query[start:i])
+		case c == '-' || isGraphQLDigit(c):
+			start := i
+			for i++; i < len(query) && (isGraphQLDigit(query[i]) || strings.IndexByte(".eE+-", query[i]) >= 0); i++ {
+				if query[i] == '.' && strings.HasPrefix(query[i:], "...") {
+					break
+				}
+			}
+			tokens = append(tokens, query[start:i])
+		case strings.HasPrefix(query[i:], "\uFEFF"):
+			i += len("\uFEFF")
+		default:
+			return nil, errGraphQLSyntax
+		}
+	}
+
+	return tokens, nil
+}
+
+func isGraphQLLetter(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isGraphQLDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
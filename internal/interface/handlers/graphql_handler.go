@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"net/http"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/factory"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+)
+
+const (
+	GRAPHQL_MAX_DEPTH        = 8
+	GRAPHQL_MAX_QUERY_LENGTH = 64 << 10
+	GRAPHQL_MAX_BODY_SIZE    = 1 << 20
+)
+
+//go:embed graphql_schema.graphql
+var graphQLSchema string
+
+type GraphQLHandler struct {
+	expenseFactory    *factory.ExpenseFactory
+	categoryFactory   *factory.CategoryFactory
+	tagFactory        *factory.TagFactory
+	accountFactory    *factory.AccountFactory
+	merchantFactory   *factory.MerchantFactory
+	presentersFactory *factory.PresentersFactory
+	schema            *graphql.Schema
+}
+
+func NewGraphQLHandler(expenseFactory *factory.ExpenseFactory, categoryFactory *factory.CategoryFactory, tagFactory *factory.TagFactory, accountFactory *factory.AccountFactory, merchantFactory *factory.MerchantFactory, presentersFactory *factory.PresentersFactory) *GraphQLHandler {
+	handler := &GraphQLHandler{
+		expenseFactory:    expenseFactory,
+		categoryFactory:   categoryFactory,
+		tagFactory:        tagFactory,
+		accountFactory:    accountFactory,
+		merchantFactory:   merchantFactory,
+		presentersFactory: presentersFactory,
+	}
+
+	handler.schema = graphql.MustParseSchema(graphQLSchema, &graphQLResolver{handler: handler},
+		graphql.UseFieldResolvers(),
+		graphql.MaxDepth(GRAPHQL_MAX_DEPTH),
+		graphql.MaxQueryLength(GRAPHQL_MAX_QUERY_LENGTH),
+	)
+
+	return handler
+}
+
+// @Summary Run a GraphQL query
+// @Description Runs a GraphQL query or mutation over expenses, categories, tags, accounts, merchants and the dashboard aggregates. Bodies larger than 1 MB, queries longer than 64 KB, queries deeper than 8 levels and queries costing more than 5000 are rejected. A field costs 1 and the fields under a list count once per item: its first argument, or 20 for lists without one. The expenses of a category, tag, account or merchant require first, at most 100. Use case problems are returned in errors[].extensions
+// @Tags GraphQL
+// @Accept json
+// @Produce json
+// @Param GraphQLRequest body GraphQLRequest true "Query, variables and operation name"
+// @Success 200 {object} GraphQLResponse
+// @Failure 400 {object} util.ProblemDetails "Did not bind JSON"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 413 {object} util.ProblemDetails "Request body too large"
+// @Security BearerAuth
+// @Router /graphql [post]
+func (h *GraphQLHandler) Query(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, GRAPHQL_MAX_BODY_SIZE)
+
+	var request GraphQLRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			abortWithProblem(c, http.StatusRequestEntityTooLarge, util.ProblemDetails{
+				Type:     "Request Entity Too Large",
+				Title:    "Request body too large",
+				Status:   http.StatusRequestEntityTooLarge,
+				Detail:   "The request body must not exceed 1 MB",
+				Instance: util.RFC413,
+			})
+			return
+		}
+
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	if request.Query == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing query",
+			Status:   http.StatusBadRequest,
+			Detail:   "Query is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	if errs := h.schema.ValidateWithVariables(request.Query, request.Variables); len(errs) > 0 {
+		c.JSON(http.StatusOK, GraphQLResponse{Errors: errs})
+		return
+	}
+
+	cost, costErr := graphQLQueryCost(h.schema.AST(), request.Query, request.Variables)
+	if costErr != nil {
+		c.JSON(http.StatusOK, GraphQLResponse{Errors: []*gqlerrors.QueryError{gqlerrors.Errorf("%s", costErr)}})
+		return
+	} else if cost > GRAPHQL_MAX_COST {
+		c.JSON(http.StatusOK, GraphQLResponse{Errors: []*gqlerrors.QueryError{gqlerrors.Errorf("query is too expensive, its cost exceeds %d", GRAPHQL_MAX_COST)}})
+		return
+	}
+
+	ctx := context.WithValue(c.Request.Context(), graphQLContextKey{}, newGraphQLLoaders(h, userID, getLocale(c)))
+
+	response := h.schema.Exec(ctx, request.Query, request.OperationName, request.Variables)
+
+	c.JSON(http.StatusOK, GraphQLResponse{Data: response.Data, Errors: response.Errors})
+}
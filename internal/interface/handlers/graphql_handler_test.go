@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func graphQLTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.POST("/graphql", func(c *gin.Context) {
+		c.Set("userID", "user")
+	}, NewGraphQLHandler(nil, nil, nil, nil, nil, nil).Query)
+
+	return router
+}
+
+func postGraphQL(t *testing.T, router *gin.Engine, body []byte) (int, GraphQLResponse) {
+	t.Helper()
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(recorder, request)
+
+	var response GraphQLResponse
+	if recorder.Code == http.StatusOK {
+		if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+	}
+
+	return recorder.Code, response
+}
+
+func graphQLBody(t *testing.T, query string, variables map[string]interface{}) []byte {
+	t.Helper()
+
+	body, err := json.Marshal(GraphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		t.Fatalf("encode request: %v", err)
+	}
+
+	return body
+}
+
+func TestGraphQLRejectsLargeBodies(t *testing.T) {
+	query := strings.Repeat("{a", 3<<20) + strings.Repeat("}", 3<<20)
+
+	status, _ := postGraphQL(t, graphQLTestRouter(), graphQLBody(t, query, nil))
+	if status != http.StatusRequestEntityTooLarge {
+		t.Fatalf("status = %d, want %d", status, http.StatusRequestEntityTooLarge)
+	}
+}
+
+func TestGraphQLRejectsLargeQueries(t *testing.T) {
+	aliases := ""
+	for i := 0; i < 100; i++ {
+		aliases += "e" + strconv.Itoa(i) + ":expenses{id amount notes} "
+	}
+
+	tests := []struct {
+		name      string
+		query     string
+		variables map[string]interface{}
+	}{
+		{"parser nesting", strings.Repeat("{expenses", 5000) + strings.Repeat("}", 5000), nil},
+		{"query length", strings.Repeat(" ", GRAPHQL_MAX_QUERY_LENGTH) + "{expenses{id}}", nil},
+		{"field depth", "{expenses{category{expenses(first:1){category{expenses(first:1){category{expenses(first:1){category{id}}}}}}}}}", nil},
+		{"missing first", "{categories{expenses{id}}}", nil},
+		{"nested lists", "{categories{expenses(first:100){tags{expenses(first:100){id}}}}}", nil},
+		{"nested lists through variables", "query($n:Int!){categories{expenses(first:$n){category{expenses(first:$n){id}}}}}", map[string]interface{}{"n": 100}},
+		{"nested lists through fragments", "{categories{expenses(first:100){tags{...T}}}} fragment T on Tag{expenses(first:100){id notes}}", nil},
+		{"aliases", "{" + aliases + "}", nil},
+	}
+
+	router := graphQLTestRouter()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status, response := postGraphQL(t, router, graphQLBody(t, test.query, test.variables))
+			if status != http.StatusOK {
+				t.Fatalf("status = %d, want %d", status, http.StatusOK)
+			}
+			if len(response.Errors) == 0 || len(response.Data) > 0 {
+				t.Fatalf("response = %+v, want only errors", response)
+			}
+		})
+	}
+}
+
+func TestGraphQLQueryCost(t *testing.T) {
+	schema := NewGraphQLHandler(nil, nil, nil, nil, nil, nil).schema.AST()
+
+	tests := []struct {
+		name      string
+		query     string
+		variables map[string]interface{}
+		cost      int
+	}{
+		{"scalars", "{totalExpenses(startDate:\"01012026\", endDate:\"31012026\")}", nil, 1},
+		{"object", "{currentWeekTotal(compare:true){totalExpenses change}}", nil, 3},
+		{"list", "{expenses{id amount category{name color}}}", nil, 1 + GRAPHQL_LIST_COST*5},
+		{"first", "{categories{expenses(first:3){id notes}}}", nil, 1 + GRAPHQL_LIST_COST*(1+3*2)},
+		{"first from a variable", "query($n:Int!){categories{expenses(first:$n){id}}}", map[string]interface{}{"n": float64(5)}, 1 + GRAPHQL_LIST_COST*(1+5)},
+		{"first above the page size", "query($n:Int!){categories{expenses(first:$n){id}}}", map[string]interface{}{"n": float64(1000)}, 1 + GRAPHQL_LIST_COST*(1+GRAPHQL_MAX_PAGE_SIZE)},
+		{"fragments", "query Q { ...E expenses { ... on Expense { id } } } fragment E on Query { tags { id # comment\n name } }", nil, (1 + GRAPHQL_LIST_COST*2) + (1 + GRAPHQL_LIST_COST)},
+		{"mutation", "mutation { deleteTag(id: \"1\") { successMessage } }", nil, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cost, err := graphQLQueryCost(schema, test.query, test.variables)
+			if err != nil {
+				t.Fatalf("graphQLQueryCost: %v", err)
+			}
+			if cost != test.cost {
+				t.Fatalf("cost = %d, want %d", cost, test.cost)
+			}
+		})
+	}
+}
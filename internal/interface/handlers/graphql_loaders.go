@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+	"github.com/graph-gophers/dataloader/v7"
+)
+
+type graphQLContextKey struct{}
+
+// expenseOwner keys the expenses of a category, tag, account or merchant.
+type expenseOwner struct {
+	kind string
+	id   string
+}
+
+// graphQLLoaders is created for every GraphQL request. Its loaders collect
+// the keys the resolvers ask for and load each batch with one use case call,
+// so a list of expenses costs one query for all their accounts instead of one
+// per expense. Mutations clear them.
+type graphQLLoaders struct {
+	userID string
+	locale string
+
+	accounts  *dataloader.Loader[string, *entities.Account]
+	merchants *dataloader.Loader[string, *entities.Merchant]
+	expenses  *dataloader.Loader[expenseOwner, []entities.Expense]
+}
+
+func newGraphQLLoaders(h *GraphQLHandler, userID string, locale string) *graphQLLoaders {
+	loaders := &graphQLLoaders{
+		userID: userID,
+		locale: locale,
+	}
+
+	loaders.accounts = dataloader.NewBatchedLoader(func(ctx context.Context, ids []string) []*dataloader.Result[*entities.Account] {
+		output, errs := h.accountFactory.GetAccounts.Execute(usecases.GetAccountsInputDto{UserID: userID})
+		if len(errs) > 0 {
+			return graphQLFailedBatch[*entities.Account](len(ids), loaders.problem(errs))
+		}
+
+		accounts := map[string]*entities.Account{}
+		for i := range output.Accounts {
+			accounts[output.Accounts[i].ID] = &output.Accounts[i]
+		}
+
+		results := make([]*dataloader.Result[*entities.Account], len(ids))
+		for i, id := range ids {
+			results[i] = &dataloader.Result[*entities.Account]{Data: accounts[id]}
+		}
+		return results
+	})
+
+	loaders.merchants = dataloader.NewBatchedLoader(func(ctx context.Context, ids []string) []*dataloader.Result[*entities.Merchant] {
+		output, errs := h.merchantFactory.GetMerchants.Execute(usecases.GetMerchantsInputDto{UserID: userID})
+		if len(errs) > 0 {
+			return graphQLFailedBatch[*entities.Merchant](len(ids), loaders.problem(errs))
+		}
+
+		merchants := map[string]*entities.Merchant{}
+		for i := range output.Merchants {
+			merchants[output.Merchants[i].ID] = &output.Merchants[i]
+		}
+
+		results := make([]*dataloader.Result[*entities.Merchant], len(ids))
+		for i, id := range ids {
+			results[i] = &dataloader.Result[*entities.Merchant]{Data: merchants[id]}
+		}
+		return results
+	})
+
+	loaders.expenses = dataloader.NewBatchedLoader(func(ctx context.Context, owners []expenseOwner) []*dataloader.Result[[]entities.Expense] {
+		output, errs := h.expenseFactory.GetExpenses.Execute(usecases.GetExpensesInputDto{UserID: userID})
+		if len(errs) > 0 {
+			return graphQLFailedBatch[[]entities.Expense](len(owners), loaders.problem(errs))
+		}
+
+		grouped := map[expenseOwner][]entities.Expense{}
+		for _, expense := range output.Expenses {
+			owned := []expenseOwner{
+				{kind: "category", id: expense.CategoryID},
+				{kind: "account", id: expense.AccountID},
+				{kind: "merchant", id: expense.MerchantID},
+			}
+			for _, tagID := range expense.TagIDs {
+				owned = append(owned, expenseOwner{kind: "tag", id: tagID})
+			}
+
+			for _, owner := range owned {
+				grouped[owner] = append(grouped[owner], expense)
+			}
+		}
+
+		results := make([]*dataloader.Result[[]entities.Expense], len(owners))
+		for i, owner := range owners {
+			results[i] = &dataloader.Result[[]entities.Expense]{Data: grouped[owner]}
+		}
+		return results
+	})
+
+	return loaders
+}
+
+func graphQLFailedBatch[V any](size int, err error) []*dataloader.Result[V] {
+	results := make([]*dataloader.Result[V], size)
+	for i := range results {
+		results[i] = &dataloader.Result[V]{Error: err}
+	}
+	return results
+}
+
+func graphQLLoadersFrom(ctx context.Context) *graphQLLoaders {
+	return ctx.Value(graphQLContextKey{}).(*graphQLLoaders)
+}
+
+func (l *graphQLLoaders) reset() {
+	l.accounts.ClearAll()
+	l.merchants.ClearAll()
+	l.expenses.ClearAll()
+}
+
+func (l *graphQLLoaders) problem(errs []util.ProblemDetails) error {
+	return &graphQLProblem{problem: util.LocalizeProblemDetails(l.locale, errs[0])}
+}
+
+// graphQLProblem carries a use case problem into errors[].extensions.
+type graphQLProblem struct {
+	problem util.ProblemDetails
+}
+
+func (e *graphQLProblem) Error() string {
+	return e.problem.Detail
+}
+
+func (e *graphQLProblem) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"type":     e.problem.Type,
+		"title":    e.problem.Title,
+		"status":   e.problem.Status,
+		"instance": e.problem.Instance,
+	}
+}
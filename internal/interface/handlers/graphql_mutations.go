@@ -0,0 +1,312 @@
+package handlers
+
+import (
+	"context"
+
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"github.com/graph-gophers/graphql-go"
+)
+
+type graphQLCreateExpenseInput struct {
+	Amount        float64
+	ExpenseDate   string
+	CategoryID    graphql.ID
+	AccountID     *graphql.ID
+	MerchantID    *graphql.ID
+	Notes         *string
+	Tags          *[]graphql.ID
+	TaxCategory   *string
+	ProviderTaxID *string
+	ProviderName  *string
+	Latitude      *float64
+	Longitude     *float64
+	PlaceName     *string
+}
+
+type graphQLUpdateExpenseInput struct {
+	Amount        *float64
+	ExpenseDate   *string
+	CategoryID    *graphql.ID
+	AccountID     *graphql.ID
+	MerchantID    *graphql.ID
+	Notes         *string
+	Tags          *[]graphql.ID
+	TaxCategory   *string
+	ProviderTaxID *string
+	ProviderName  *string
+	Latitude      *float64
+	Longitude     *float64
+	PlaceName     *string
+}
+
+type graphQLCreateCategoryInput struct {
+	Name        string
+	Color       string
+	Kind        *string
+	TaxCategory *string
+}
+
+type graphQLUpdateCategoryInput struct {
+	Name        *string
+	Color       *string
+	TaxCategory *string
+}
+
+type graphQLCreateTagInput struct {
+	Name  string
+	Color string
+}
+
+type graphQLUpdateTagInput struct {
+	Name  *string
+	Color *string
+}
+
+// The payloads carry the use case messages and read the changed object back
+// after the change.
+
+type expensePayloadResolver struct {
+	root           *graphQLResolver
+	ExpenseID      graphql.ID
+	SuccessMessage string
+	ContentMessage string
+}
+
+func (r *expensePayloadResolver) Expense(ctx context.Context) (*expenseResolver, error) {
+	return r.root.expense(ctx, string(r.ExpenseID))
+}
+
+type categoryPayloadResolver struct {
+	root           *graphQLResolver
+	CategoryID     graphql.ID
+	SuccessMessage string
+	ContentMessage string
+}
+
+func (r *categoryPayloadResolver) Category(ctx context.Context) (*categoryResolver, error) {
+	return r.root.category(ctx, string(r.CategoryID))
+}
+
+type tagPayloadResolver struct {
+	root           *graphQLResolver
+	TagID          graphql.ID
+	SuccessMessage string
+	ContentMessage string
+}
+
+func (r *tagPayloadResolver) Tag(ctx context.Context) (*tagResolver, error) {
+	return r.root.tag(ctx, string(r.TagID))
+}
+
+type deletePayloadResolver struct {
+	SuccessMessage string
+	ContentMessage string
+}
+
+func (r *graphQLResolver) CreateExpense(ctx context.Context, args struct{ Input graphQLCreateExpenseInput }) (*expensePayloadResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	input := args.Input
+
+	output, errs := r.handler.expenseFactory.CreateExpense.Execute(usecases.CreateExpenseInputDto{
+		UserID:        loaders.userID,
+		Amount:        input.Amount,
+		ExpenseDate:   input.ExpenseDate,
+		CategoryID:    string(input.CategoryID),
+		AccountID:     graphQLIDString(input.AccountID),
+		MerchantID:    graphQLIDString(input.MerchantID),
+		Notes:         graphQLString(input.Notes),
+		Tags:          graphQLIDStrings(input.Tags),
+		TaxCategory:   graphQLString(input.TaxCategory),
+		ProviderTaxID: graphQLString(input.ProviderTaxID),
+		ProviderName:  graphQLString(input.ProviderName),
+		Latitude:      input.Latitude,
+		Longitude:     input.Longitude,
+		PlaceName:     graphQLString(input.PlaceName),
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+
+	loaders.reset()
+	return &expensePayloadResolver{
+		root:           r,
+		ExpenseID:      graphql.ID(output.ExpenseID),
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
+
+func (r *graphQLResolver) UpdateExpense(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input graphQLUpdateExpenseInput
+}) (*expensePayloadResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	input := args.Input
+
+	amount := 0.0
+	if input.Amount != nil {
+		amount = *input.Amount
+	}
+
+	output, errs := r.handler.expenseFactory.UpdateExpense.Execute(usecases.UpdateExpenseInputDto{
+		UserID:        loaders.userID,
+		ExpenseID:     string(args.ID),
+		Amount:        amount,
+		ExpenseDate:   graphQLString(input.ExpenseDate),
+		CategoryID:    graphQLIDString(input.CategoryID),
+		AccountID:     graphQLIDString(input.AccountID),
+		MerchantID:    graphQLIDString(input.MerchantID),
+		Notes:         graphQLString(input.Notes),
+		Tags:          graphQLIDStrings(input.Tags),
+		TaxCategory:   graphQLString(input.TaxCategory),
+		ProviderTaxID: graphQLString(input.ProviderTaxID),
+		ProviderName:  graphQLString(input.ProviderName),
+		Latitude:      input.Latitude,
+		Longitude:     input.Longitude,
+		PlaceName:     graphQLString(input.PlaceName),
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+
+	loaders.reset()
+	return &expensePayloadResolver{
+		root:           r,
+		ExpenseID:      graphql.ID(output.ExpenseID),
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
+
+func (r *graphQLResolver) DeleteExpense(ctx context.Context, args graphQLIDArgs) (*deletePayloadResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.expenseFactory.DeleteExpense.Execute(usecases.DeleteExpenseInputDto{
+		UserID:    loaders.userID,
+		ExpenseID: string(args.ID),
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+
+	loaders.reset()
+	return &deletePayloadResolver{SuccessMessage: output.SuccessMessage, ContentMessage: output.ContentMessage}, nil
+}
+
+func (r *graphQLResolver) CreateCategory(ctx context.Context, args struct{ Input graphQLCreateCategoryInput }) (*categoryPayloadResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.categoryFactory.CreateCategory.Execute(usecases.CreateCategoryInputDto{
+		UserID:      loaders.userID,
+		Name:        args.Input.Name,
+		Color:       args.Input.Color,
+		Kind:        graphQLString(args.Input.Kind),
+		TaxCategory: graphQLString(args.Input.TaxCategory),
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+
+	loaders.reset()
+	return &categoryPayloadResolver{
+		root:           r,
+		CategoryID:     graphql.ID(output.CategoryID),
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
+
+func (r *graphQLResolver) UpdateCategory(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input graphQLUpdateCategoryInput
+}) (*categoryPayloadResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.categoryFactory.UpdateCategory.Execute(usecases.UpdateCategoryInputDto{
+		UserID:      loaders.userID,
+		CategoryID:  string(args.ID),
+		Name:        graphQLString(args.Input.Name),
+		Color:       graphQLString(args.Input.Color),
+		TaxCategory: graphQLString(args.Input.TaxCategory),
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+
+	loaders.reset()
+	return &categoryPayloadResolver{
+		root:           r,
+		CategoryID:     graphql.ID(output.CategoryID),
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
+
+func (r *graphQLResolver) DeleteCategory(ctx context.Context, args graphQLIDArgs) (*deletePayloadResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.categoryFactory.DeleteCategory.Execute(usecases.DeleteCategoryInputDto{
+		UserID:     loaders.userID,
+		CategoryID: string(args.ID),
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+
+	loaders.reset()
+	return &deletePayloadResolver{SuccessMessage: output.SuccessMessage, ContentMessage: output.ContentMessage}, nil
+}
+
+func (r *graphQLResolver) CreateTag(ctx context.Context, args struct{ Input graphQLCreateTagInput }) (*tagPayloadResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.tagFactory.CreateTag.Execute(usecases.CreateTagInputDto{
+		UserID: loaders.userID,
+		Name:   args.Input.Name,
+		Color:  args.Input.Color,
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+
+	loaders.reset()
+	return &tagPayloadResolver{
+		root:           r,
+		TagID:          graphql.ID(output.TagID),
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
+
+func (r *graphQLResolver) UpdateTag(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input graphQLUpdateTagInput
+}) (*tagPayloadResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.tagFactory.UpdateTag.Execute(usecases.UpdateTagInputDto{
+		UserID: loaders.userID,
+		TagID:  string(args.ID),
+		Name:   graphQLString(args.Input.Name),
+		Color:  graphQLString(args.Input.Color),
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+
+	loaders.reset()
+	return &tagPayloadResolver{
+		root:           r,
+		TagID:          graphql.ID(output.TagID),
+		SuccessMessage: output.SuccessMessage,
+		ContentMessage: output.ContentMessage,
+	}, nil
+}
+
+func (r *graphQLResolver) DeleteTag(ctx context.Context, args graphQLIDArgs) (*deletePayloadResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.tagFactory.DeleteTag.Execute(usecases.DeleteTagInputDto{
+		UserID: loaders.userID,
+		TagID:  string(args.ID),
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+
+	loaders.reset()
+	return &deletePayloadResolver{SuccessMessage: output.SuccessMessage, ContentMessage: output.ContentMessage}, nil
+}
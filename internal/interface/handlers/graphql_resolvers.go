@@ -0,0 +1,769 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+	"github.com/graph-gophers/graphql-go"
+)
+
+// graphQLResolver resolves the Query and Mutation fields of the schema
+// through the same use cases as the REST routes. The user, the locale and
+// the request loaders come from the context.
+type graphQLResolver struct {
+	handler *GraphQLHandler
+}
+
+type graphQLIDArgs struct {
+	ID graphql.ID
+}
+
+type graphQLFirstArgs struct {
+	First int32
+}
+
+type graphQLPeriodArgs struct {
+	StartDate string
+	EndDate   string
+	Basis     *string
+}
+
+func graphQLString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func graphQLIDString(value *graphql.ID) string {
+	if value == nil {
+		return ""
+	}
+	return string(*value)
+}
+
+func graphQLIDStrings(values *[]graphql.ID) []string {
+	if values == nil {
+		return nil
+	}
+
+	ids := []string{}
+	for _, value := range *values {
+		ids = append(ids, string(value))
+	}
+	return ids
+}
+
+func graphQLOptionalID(id string) *graphql.ID {
+	if id == "" {
+		return nil
+	}
+	value := graphql.ID(id)
+	return &value
+}
+
+func graphQLOptionalInt(value int) *int32 {
+	if value == 0 {
+		return nil
+	}
+	number := int32(value)
+	return &number
+}
+
+func graphQLInts(values []int) []int32 {
+	numbers := make([]int32, len(values))
+	for i, value := range values {
+		numbers[i] = int32(value)
+	}
+	return numbers
+}
+
+// graphQLExpenses returns the first expenses of a category, tag, account or
+// merchant, as they come from the expenses loader.
+func graphQLExpenses(ctx context.Context, kind string, id string, first int32) ([]*expenseResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+
+	if first < 1 || first > GRAPHQL_MAX_PAGE_SIZE {
+		return nil, loaders.problem([]util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid first",
+				Status:   http.StatusBadRequest,
+				Detail:   "First must be between 1 and " + strconv.Itoa(GRAPHQL_MAX_PAGE_SIZE),
+				Instance: util.RFC400,
+			},
+		})
+	}
+
+	expenses, err := loaders.expenses.Load(ctx, expenseOwner{kind: kind, id: id})()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(expenses) > int(first) {
+		expenses = expenses[:first]
+	}
+
+	return newExpenseResolvers(expenses), nil
+}
+
+type expenseResolver struct {
+	expense entities.Expense
+}
+
+func newExpenseResolvers(expenses []entities.Expense) []*expenseResolver {
+	resolvers := make([]*expenseResolver, len(expenses))
+	for i, expense := range expenses {
+		resolvers[i] = &expenseResolver{expense: expense}
+	}
+	return resolvers
+}
+
+func (r *expenseResolver) ID() graphql.ID {
+	return graphql.ID(r.expense.ID)
+}
+
+func (r *expenseResolver) Amount() float64 {
+	return r.expense.Amount
+}
+
+func (r *expenseResolver) ExpenseDate() graphql.Time {
+	return graphql.Time{Time: r.expense.ExpenseDate}
+}
+
+func (r *expenseResolver) Notes() string {
+	return r.expense.Notes
+}
+
+func (r *expenseResolver) CategoryID() graphql.ID {
+	return graphql.ID(r.expense.CategoryID)
+}
+
+func (r *expenseResolver) AccountID() *graphql.ID {
+	return graphQLOptionalID(r.expense.AccountID)
+}
+
+func (r *expenseResolver) MerchantID() *graphql.ID {
+	return graphQLOptionalID(r.expense.MerchantID)
+}
+
+func (r *expenseResolver) InstallmentNumber() *int32 {
+	return graphQLOptionalInt(r.expense.InstallmentNumber)
+}
+
+func (r *expenseResolver) TaxCategory() *string {
+	return &r.expense.TaxCategory
+}
+
+func (r *expenseResolver) ProviderTaxID() *string {
+	return &r.expense.ProviderTaxID
+}
+
+func (r *expenseResolver) ProviderName() *string {
+	return &r.expense.ProviderName
+}
+
+func (r *expenseResolver) Latitude() *float64 {
+	return r.expense.Latitude
+}
+
+func (r *expenseResolver) Longitude() *float64 {
+	return r.expense.Longitude
+}
+
+func (r *expenseResolver) PlaceName() *string {
+	return &r.expense.PlaceName
+}
+
+func (r *expenseResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.expense.CreatedAt}
+}
+
+func (r *expenseResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: r.expense.UpdatedAt}
+}
+
+func (r *expenseResolver) StatementDate() *graphql.Time {
+	if r.expense.StatementDate == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.expense.StatementDate}
+}
+
+func (r *expenseResolver) Category() *categoryResolver {
+	if r.expense.Category.ID == "" {
+		return nil
+	}
+	return &categoryResolver{category: r.expense.Category}
+}
+
+func (r *expenseResolver) Tags() []*tagResolver {
+	return newTagResolvers(r.expense.Tags)
+}
+
+func (r *expenseResolver) Account(ctx context.Context) (*accountResolver, error) {
+	if r.expense.AccountID == "" {
+		return nil, nil
+	}
+
+	account, err := graphQLLoadersFrom(ctx).accounts.Load(ctx, r.expense.AccountID)()
+	if err != nil || account == nil {
+		return nil, err
+	}
+	return &accountResolver{account: *account}, nil
+}
+
+func (r *expenseResolver) Merchant(ctx context.Context) (*merchantResolver, error) {
+	return graphQLMerchant(ctx, r.expense.MerchantID)
+}
+
+func graphQLMerchant(ctx context.Context, merchantID string) (*merchantResolver, error) {
+	if merchantID == "" {
+		return nil, nil
+	}
+
+	merchant, err := graphQLLoadersFrom(ctx).merchants.Load(ctx, merchantID)()
+	if err != nil || merchant == nil {
+		return nil, err
+	}
+	return &merchantResolver{merchant: *merchant}, nil
+}
+
+type categoryResolver struct {
+	category entities.Category
+}
+
+func (r *categoryResolver) ID() graphql.ID {
+	return graphql.ID(r.category.ID)
+}
+
+func (r *categoryResolver) Name() string {
+	return r.category.Name
+}
+
+func (r *categoryResolver) Color() string {
+	return r.category.Color
+}
+
+func (r *categoryResolver) Kind() string {
+	return r.category.Kind
+}
+
+func (r *categoryResolver) TaxCategory() *string {
+	return &r.category.TaxCategory
+}
+
+func (r *categoryResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.category.CreatedAt}
+}
+
+func (r *categoryResolver) Expenses(ctx context.Context, args graphQLFirstArgs) ([]*expenseResolver, error) {
+	return graphQLExpenses(ctx, "category", r.category.ID, args.First)
+}
+
+type tagResolver struct {
+	tag entities.Tag
+}
+
+func newTagResolvers(tags []entities.Tag) []*tagResolver {
+	resolvers := make([]*tagResolver, len(tags))
+	for i, tag := range tags {
+		resolvers[i] = &tagResolver{tag: tag}
+	}
+	return resolvers
+}
+
+func (r *tagResolver) ID() graphql.ID {
+	return graphql.ID(r.tag.ID)
+}
+
+func (r *tagResolver) Name() string {
+	return r.tag.Name
+}
+
+func (r *tagResolver) Color() string {
+	return r.tag.Color
+}
+
+func (r *tagResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.tag.CreatedAt}
+}
+
+func (r *tagResolver) Expenses(ctx context.Context, args graphQLFirstArgs) ([]*expenseResolver, error) {
+	return graphQLExpenses(ctx, "tag", r.tag.ID, args.First)
+}
+
+type accountResolver struct {
+	account entities.Account
+}
+
+func (r *accountResolver) ID() graphql.ID {
+	return graphql.ID(r.account.ID)
+}
+
+func (r *accountResolver) Name() string {
+	return r.account.Name
+}
+
+func (r *accountResolver) Type() string {
+	return r.account.Type
+}
+
+func (r *accountResolver) Currency() string {
+	return r.account.Currency
+}
+
+func (r *accountResolver) OpeningBalance() float64 {
+	return r.account.OpeningBalance
+}
+
+func (r *accountResolver) ClosingDay() *int32 {
+	return graphQLOptionalInt(r.account.ClosingDay)
+}
+
+func (r *accountResolver) DueDay() *int32 {
+	return graphQLOptionalInt(r.account.DueDay)
+}
+
+func (r *accountResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.account.CreatedAt}
+}
+
+func (r *accountResolver) Expenses(ctx context.Context, args graphQLFirstArgs) ([]*expenseResolver, error) {
+	return graphQLExpenses(ctx, "account", r.account.ID, args.First)
+}
+
+type merchantResolver struct {
+	merchant entities.Merchant
+}
+
+func (r *merchantResolver) ID() graphql.ID {
+	return graphql.ID(r.merchant.ID)
+}
+
+func (r *merchantResolver) Name() string {
+	return r.merchant.Name
+}
+
+func (r *merchantResolver) Aliases() []string {
+	return r.merchant.Aliases
+}
+
+func (r *merchantResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.merchant.CreatedAt}
+}
+
+func (r *merchantResolver) Expenses(ctx context.Context, args graphQLFirstArgs) ([]*expenseResolver, error) {
+	return graphQLExpenses(ctx, "merchant", r.merchant.ID, args.First)
+}
+
+type categoryTagsTotalsResolver struct {
+	totals repositories.CategoryTagsTotals
+}
+
+func (r *categoryTagsTotalsResolver) Month() string {
+	return r.totals.Month
+}
+
+func (r *categoryTagsTotalsResolver) MonthNumber() int32 {
+	return int32(r.totals.MonthNumber)
+}
+
+func (r *categoryTagsTotalsResolver) Year() int32 {
+	return int32(r.totals.Year)
+}
+
+func (r *categoryTagsTotalsResolver) ExpensesAmount() float64 {
+	return r.totals.ExpensesAmount
+}
+
+func (r *categoryTagsTotalsResolver) AvailableYears() []int32 {
+	return graphQLInts(r.totals.AvailableYears)
+}
+
+func (r *categoryTagsTotalsResolver) Categories() []repositories.CategoryWithTags {
+	return r.totals.Categories
+}
+
+func (r *categoryTagsTotalsResolver) AvailableMonths() []repositories.MonthOption {
+	return r.totals.AvailableMonths
+}
+
+type yearTotalsResolver struct {
+	totals repositories.ExpensesMonthCurrentYear
+}
+
+func (r *yearTotalsResolver) Year() int32 {
+	return int32(r.totals.Year)
+}
+
+func (r *yearTotalsResolver) Total() float64 {
+	return r.totals.Total
+}
+
+func (r *yearTotalsResolver) AvailableYears() []int32 {
+	return graphQLInts(r.totals.AvailableYears)
+}
+
+func (r *yearTotalsResolver) Months() []*monthTotalResolver {
+	months := make([]*monthTotalResolver, len(r.totals.Months))
+	for i, month := range r.totals.Months {
+		months[i] = &monthTotalResolver{month: month}
+	}
+	return months
+}
+
+type monthTotalResolver struct {
+	month repositories.MonthCurrentYear
+}
+
+func (r *monthTotalResolver) Month() string {
+	return r.month.Month
+}
+
+func (r *monthTotalResolver) MonthNumber() int32 {
+	return int32(r.month.MonthNumber)
+}
+
+func (r *monthTotalResolver) Total() float64 {
+	return r.month.Total
+}
+
+type availableMonthsYearsResolver struct {
+	output presenters.GetAvailableMonthsYearsOutputDto
+}
+
+func (r *availableMonthsYearsResolver) AvailableYears() []int32 {
+	return graphQLInts(r.output.AvailableYears)
+}
+
+func (r *availableMonthsYearsResolver) AvailableMonths() []repositories.MonthOption {
+	return r.output.AvailableMonths
+}
+
+type currentWeekTotalResolver struct {
+	output presenters.GetTotalExpensesForCurrentWeekOutputDto
+}
+
+func (r *currentWeekTotalResolver) TotalExpenses() float64 {
+	return r.output.TotalExpenses
+}
+
+func (r *currentWeekTotalResolver) CurrentWeek() string {
+	return r.output.CurrentWeek
+}
+
+func (r *currentWeekTotalResolver) WeekStart() string {
+	return r.output.WeekStart
+}
+
+func (r *currentWeekTotalResolver) PreviousWeekTotal() *float64 {
+	return r.output.PreviousWeekTotal
+}
+
+func (r *currentWeekTotalResolver) Change() *float64 {
+	return r.output.Change
+}
+
+func (r *currentWeekTotalResolver) PreviousWeek() *string {
+	if r.output.PreviousWeek == "" {
+		return nil
+	}
+	return &r.output.PreviousWeek
+}
+
+type topMerchantsResolver struct {
+	output presenters.GetTopMerchantsOutputDto
+}
+
+func (r *topMerchantsResolver) Total() float64 {
+	return r.output.Total
+}
+
+func (r *topMerchantsResolver) Count() int32 {
+	return int32(r.output.Count)
+}
+
+func (r *topMerchantsResolver) Merchants() []*topMerchantResolver {
+	merchants := make([]*topMerchantResolver, len(r.output.Merchants))
+	for i, merchant := range r.output.Merchants {
+		merchants[i] = &topMerchantResolver{merchant: merchant}
+	}
+	return merchants
+}
+
+type topMerchantResolver struct {
+	merchant presenters.TopMerchant
+}
+
+func (r *topMerchantResolver) MerchantID() graphql.ID {
+	return graphql.ID(r.merchant.MerchantID)
+}
+
+func (r *topMerchantResolver) MerchantName() string {
+	return r.merchant.MerchantName
+}
+
+func (r *topMerchantResolver) Total() float64 {
+	return r.merchant.Total
+}
+
+func (r *topMerchantResolver) Count() int32 {
+	return int32(r.merchant.Count)
+}
+
+func (r *topMerchantResolver) Average() float64 {
+	return r.merchant.Average
+}
+
+func (r *topMerchantResolver) SharePercent() float64 {
+	return r.merchant.SharePercent
+}
+
+func (r *topMerchantResolver) Merchant(ctx context.Context) (*merchantResolver, error) {
+	return graphQLMerchant(ctx, r.merchant.MerchantID)
+}
+
+func (r *graphQLResolver) Expenses(ctx context.Context) (*[]*expenseResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.expenseFactory.GetExpenses.Execute(usecases.GetExpensesInputDto{UserID: loaders.userID})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+
+	expenses := newExpenseResolvers(output.Expenses)
+	return &expenses, nil
+}
+
+func (r *graphQLResolver) Expense(ctx context.Context, args graphQLIDArgs) (*expenseResolver, error) {
+	return r.expense(ctx, string(args.ID))
+}
+
+func (r *graphQLResolver) expense(ctx context.Context, expenseID string) (*expenseResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.expenseFactory.GetExpense.Execute(usecases.GetExpenseInputDto{
+		UserID:    loaders.userID,
+		ExpenseID: expenseID,
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+	return &expenseResolver{expense: output.Expense}, nil
+}
+
+func (r *graphQLResolver) Categories(ctx context.Context, args struct{ Kind *string }) (*[]*categoryResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.categoryFactory.GetCategories.Execute(usecases.GetCategoriesInputDto{
+		UserID: loaders.userID,
+		Kind:   graphQLString(args.Kind),
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+
+	categories := make([]*categoryResolver, len(output.Categories))
+	for i, category := range output.Categories {
+		categories[i] = &categoryResolver{category: category}
+	}
+	return &categories, nil
+}
+
+func (r *graphQLResolver) Category(ctx context.Context, args graphQLIDArgs) (*categoryResolver, error) {
+	return r.category(ctx, string(args.ID))
+}
+
+func (r *graphQLResolver) category(ctx context.Context, categoryID string) (*categoryResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.categoryFactory.GetCategory.Execute(usecases.GetCategoryInputDto{
+		UserID:     loaders.userID,
+		CategoryID: categoryID,
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+	return &categoryResolver{category: output.Category}, nil
+}
+
+func (r *graphQLResolver) Tags(ctx context.Context) (*[]*tagResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.tagFactory.GetTags.Execute(usecases.GetTagsInputDto{UserID: loaders.userID})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+
+	tags := newTagResolvers(output.Tags)
+	return &tags, nil
+}
+
+func (r *graphQLResolver) Tag(ctx context.Context, args graphQLIDArgs) (*tagResolver, error) {
+	return r.tag(ctx, string(args.ID))
+}
+
+func (r *graphQLResolver) tag(ctx context.Context, tagID string) (*tagResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.tagFactory.GetTag.Execute(usecases.GetTagInputDto{
+		UserID: loaders.userID,
+		TagID:  tagID,
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+	return &tagResolver{tag: output.Tag}, nil
+}
+
+func (r *graphQLResolver) Accounts(ctx context.Context) (*[]*accountResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.accountFactory.GetAccounts.Execute(usecases.GetAccountsInputDto{UserID: loaders.userID})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+
+	accounts := make([]*accountResolver, len(output.Accounts))
+	for i, account := range output.Accounts {
+		accounts[i] = &accountResolver{account: account}
+	}
+	return &accounts, nil
+}
+
+func (r *graphQLResolver) Merchants(ctx context.Context) (*[]*merchantResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.merchantFactory.GetMerchants.Execute(usecases.GetMerchantsInputDto{UserID: loaders.userID})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+
+	merchants := make([]*merchantResolver, len(output.Merchants))
+	for i, merchant := range output.Merchants {
+		merchants[i] = &merchantResolver{merchant: merchant}
+	}
+	return &merchants, nil
+}
+
+func (r *graphQLResolver) TotalExpenses(ctx context.Context, args graphQLPeriodArgs) (*float64, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.presentersFactory.GetTotalExpensesForPeriod.Execute(presenters.GetTotalExpensesForPeriodInputDto{
+		UserID:    loaders.userID,
+		StartDate: args.StartDate,
+		EndDate:   args.EndDate,
+		Basis:     graphQLString(args.Basis),
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+	return &output.Total, nil
+}
+
+func (r *graphQLResolver) ExpensesByCategory(ctx context.Context, args graphQLPeriodArgs) (*[]repositories.CategoryExpense, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.presentersFactory.GetExpensesByCategoryPeriod.Execute(presenters.GetExpensesByCategoryPeriodInputDto{
+		UserID:    loaders.userID,
+		StartDate: args.StartDate,
+		EndDate:   args.EndDate,
+		Basis:     graphQLString(args.Basis),
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+	return &output.Expenses, nil
+}
+
+func (r *graphQLResolver) CategoryTagsTotals(ctx context.Context, args struct {
+	Month int32
+	Year  int32
+}) (*categoryTagsTotalsResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.presentersFactory.GetCategoryTagsTotalsByMonthYear.Execute(presenters.GetCategoryTagsTotalsByMonthYearInputDto{
+		UserID: loaders.userID,
+		Month:  strconv.Itoa(int(args.Month)),
+		Year:   strconv.Itoa(int(args.Year)),
+		Locale: loaders.locale,
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+	return &categoryTagsTotalsResolver{totals: output.Expenses}, nil
+}
+
+func (r *graphQLResolver) MonthlyTotals(ctx context.Context, args struct {
+	Year  int32
+	Basis *string
+}) (*yearTotalsResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.presentersFactory.GetTotalExpensesMonthCurrentYear.Execute(presenters.GetTotalExpensesMonthCurrentYearInputDto{
+		UserID: loaders.userID,
+		Year:   strconv.Itoa(int(args.Year)),
+		Basis:  graphQLString(args.Basis),
+		Locale: loaders.locale,
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+	return &yearTotalsResolver{totals: output.ExpensesMonthCurrentYear}, nil
+}
+
+func (r *graphQLResolver) AvailableMonthsYears(ctx context.Context) (*availableMonthsYearsResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.presentersFactory.GetAvailableMonthsYears.Execute(presenters.GetAvailableMonthsYearsInputDto{
+		UserID: loaders.userID,
+		Locale: loaders.locale,
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+	return &availableMonthsYearsResolver{output: output}, nil
+}
+
+func (r *graphQLResolver) CurrentMonthTotal(ctx context.Context) (*presenters.GetTotalExpensesForCurrentMonthOutputDto, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.presentersFactory.GetTotalExpensesForCurrentMonth.Execute(presenters.GetTotalExpensesForCurrentMonthInputDto{
+		UserID: loaders.userID,
+		Locale: loaders.locale,
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+	return &output, nil
+}
+
+func (r *graphQLResolver) CurrentWeekTotal(ctx context.Context, args struct{ Compare bool }) (*currentWeekTotalResolver, error) {
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.presentersFactory.GetTotalExpensesForCurrentWeek.Execute(presenters.GetTotalExpensesForCurrentWeekInputDto{
+		UserID:  loaders.userID,
+		Compare: args.Compare,
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+	return &currentWeekTotalResolver{output: output}, nil
+}
+
+func (r *graphQLResolver) TopMerchants(ctx context.Context, args struct {
+	StartDate string
+	EndDate   string
+	Basis     *string
+	Limit     *int32
+}) (*topMerchantsResolver, error) {
+	limit := ""
+	if args.Limit != nil {
+		limit = strconv.Itoa(int(*args.Limit))
+	}
+
+	loaders := graphQLLoadersFrom(ctx)
+	output, errs := r.handler.presentersFactory.GetTopMerchants.Execute(presenters.GetTopMerchantsInputDto{
+		UserID:    loaders.userID,
+		StartDate: args.StartDate,
+		EndDate:   args.EndDate,
+		Limit:     limit,
+		Basis:     graphQLString(args.Basis),
+	})
+	if len(errs) > 0 {
+		return nil, loaders.problem(errs)
+	}
+	return &topMerchantsResolver{output: output}, nil
+}
@@ -0,0 +1,243 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/factory"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+type IncomeHandler struct {
+	incomeFactory *factory.IncomeFactory
+}
+
+func NewIncomeHandler(factory *factory.IncomeFactory) *IncomeHandler {
+	return &IncomeHandler{
+		incomeFactory: factory,
+	}
+}
+
+// @Summary      Create an income
+// @Description  Create a new income entry such as salary, freelance payments or refunds
+// @Tags         Incomes
+// @Accept       json
+// @Produce      json
+// @Param        request body CreateIncomeRequest true "Income data"
+// @Success      201 {object} usecases.CreateIncomeOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      404 {object} util.ProblemDetails "Category Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /incomes [post]
+func (h *IncomeHandler) CreateIncome(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request CreateIncomeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.CreateIncomeInputDto{
+		UserID:     userID,
+		Amount:     request.Amount,
+		IncomeDate: request.IncomeDate,
+		CategoryID: request.CategoryID,
+		AccountID:  request.AccountID,
+		Notes:      request.Notes,
+	}
+
+	output, errs := h.incomeFactory.CreateIncome.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+// @Summary      Get a specific income
+// @Description  Retrieve an income by its ID
+// @Tags         Incomes
+// @Accept       json
+// @Produce      json
+// @Param        income_id query string true "Income ID"
+// @Success      200 {object} usecases.GetIncomeOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Failure      404 {object} util.ProblemDetails "Income Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /incomes [get]
+func (h *IncomeHandler) GetIncome(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	incomeID := c.Query("income_id")
+	if incomeID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Income ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Income id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.GetIncomeInputDto{
+		UserID:   userID,
+		IncomeID: incomeID,
+	}
+
+	output, errs := h.incomeFactory.GetIncome.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Get all incomes
+// @Description  Retrieve all incomes for the authenticated user
+// @Tags         Incomes
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} usecases.GetIncomesOutputDto
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /incomes/all [get]
+func (h *IncomeHandler) GetIncomes(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := usecases.GetIncomesInputDto{
+		UserID: userID,
+	}
+
+	output, errs := h.incomeFactory.GetIncomes.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Update an income
+// @Description  Update an existing income
+// @Tags         Incomes
+// @Accept       json
+// @Produce      json
+// @Param        request body UpdateIncomeRequest true "Updated income data"
+// @Success      200 {object} usecases.UpdateIncomeOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Failure      404 {object} util.ProblemDetails "Income Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /incomes [patch]
+func (h *IncomeHandler) UpdateIncome(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request UpdateIncomeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.UpdateIncomeInputDto{
+		UserID:     userID,
+		IncomeID:   request.IncomeID,
+		Amount:     request.Amount,
+		IncomeDate: request.IncomeDate,
+		CategoryID: request.CategoryID,
+		AccountID:  request.AccountID,
+		Notes:      request.Notes,
+	}
+
+	output, errs := h.incomeFactory.UpdateIncome.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Delete an income
+// @Description  Delete an income by its ID
+// @Tags         Incomes
+// @Accept       json
+// @Produce      json
+// @Param        income_id query string true "Income ID"
+// @Success      200 {object} usecases.DeleteIncomeOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Failure      404 {object} util.ProblemDetails "Income Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /incomes [delete]
+func (h *IncomeHandler) DeleteIncome(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	incomeID := c.Query("income_id")
+	if incomeID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Income ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Income id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.DeleteIncomeInputDto{
+		UserID:   userID,
+		IncomeID: incomeID,
+	}
+
+	output, errs := h.incomeFactory.DeleteIncome.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
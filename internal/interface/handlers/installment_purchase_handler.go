@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/factory"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+type InstallmentPurchaseHandler struct {
+	installmentPurchaseFactory *factory.InstallmentPurchaseFactory
+}
+
+func NewInstallmentPurchaseHandler(factory *factory.InstallmentPurchaseFactory) *InstallmentPurchaseHandler {
+	return &InstallmentPurchaseHandler{
+		installmentPurchaseFactory: factory,
+	}
+}
+
+// @Summary      Create an installment purchase
+// @Description  Split a purchase into monthly installments (parcelamento). One linked expense is created per month, with the rounding remainder in the first or last installment
+// @Tags         Installments
+// @Accept       json
+// @Produce      json
+// @Param        request body CreateInstallmentPurchaseRequest true "Installment purchase data"
+// @Success      201 {object} usecases.CreateInstallmentPurchaseOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      404 {object} util.ProblemDetails "Account Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /installments [post]
+func (h *InstallmentPurchaseHandler) CreateInstallmentPurchase(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request CreateInstallmentPurchaseRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.CreateInstallmentPurchaseInputDto{
+		UserID:           userID,
+		TotalAmount:      request.TotalAmount,
+		InstallmentCount: request.InstallmentCount,
+		FirstDueDate:     request.FirstDueDate,
+		RemainderPolicy:  request.RemainderPolicy,
+		CategoryID:       request.CategoryID,
+		AccountID:        request.AccountID,
+		Notes:            request.Notes,
+	}
+
+	output, errs := h.installmentPurchaseFactory.CreateInstallmentPurchase.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+// @Summary      Get a specific installment purchase
+// @Description  Retrieve an installment purchase and its active installments by ID
+// @Tags         Installments
+// @Accept       json
+// @Produce      json
+// @Param        installment_purchase_id query string true "Installment purchase ID"
+// @Success      200 {object} usecases.GetInstallmentPurchaseOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Failure      404 {object} util.ProblemDetails "Installment Purchase Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /installments [get]
+func (h *InstallmentPurchaseHandler) GetInstallmentPurchase(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	purchaseID := c.Query("installment_purchase_id")
+	if purchaseID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Installment Purchase ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Installment purchase id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.GetInstallmentPurchaseInputDto{
+		UserID:                userID,
+		InstallmentPurchaseID: purchaseID,
+	}
+
+	output, errs := h.installmentPurchaseFactory.GetInstallmentPurchase.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Get all installment purchases
+// @Description  Retrieve all installment purchases for the authenticated user
+// @Tags         Installments
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} usecases.GetInstallmentPurchasesOutputDto
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /installments/all [get]
+func (h *InstallmentPurchaseHandler) GetInstallmentPurchases(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := usecases.GetInstallmentPurchasesInputDto{
+		UserID: userID,
+	}
+
+	output, errs := h.installmentPurchaseFactory.GetInstallmentPurchases.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Update an installment purchase
+// @Description  Update an installment purchase. Installments due from today on are regenerated; past installments are kept
+// @Tags         Installments
+// @Accept       json
+// @Produce      json
+// @Param        request body UpdateInstallmentPurchaseRequest true "Updated installment purchase data"
+// @Success      200 {object} usecases.UpdateInstallmentPurchaseOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Failure      404 {object} util.ProblemDetails "Installment Purchase Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /installments [patch]
+func (h *InstallmentPurchaseHandler) UpdateInstallmentPurchase(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request UpdateInstallmentPurchaseRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.UpdateInstallmentPurchaseInputDto{
+		UserID:                userID,
+		InstallmentPurchaseID: request.InstallmentPurchaseID,
+		TotalAmount:           request.TotalAmount,
+		InstallmentCount:      request.InstallmentCount,
+		FirstDueDate:          request.FirstDueDate,
+		RemainderPolicy:       request.RemainderPolicy,
+		CategoryID:            request.CategoryID,
+		AccountID:             request.AccountID,
+		Notes:                 request.Notes,
+	}
+
+	output, errs := h.installmentPurchaseFactory.UpdateInstallmentPurchase.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Cancel an installment purchase
+// @Description  Cancel an installment purchase and remove the installments due from today on
+// @Tags         Installments
+// @Accept       json
+// @Produce      json
+// @Param        installment_purchase_id query string true "Installment purchase ID"
+// @Success      200 {object} usecases.DeleteInstallmentPurchaseOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Failure      404 {object} util.ProblemDetails "Installment Purchase Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Router       /installments [delete]
+func (h *InstallmentPurchaseHandler) DeleteInstallmentPurchase(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	purchaseID := c.Query("installment_purchase_id")
+	if purchaseID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Installment Purchase ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Installment purchase id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.DeleteInstallmentPurchaseInputDto{
+		UserID:                userID,
+		InstallmentPurchaseID: purchaseID,
+	}
+
+	output, errs := h.installmentPurchaseFactory.DeleteInstallmentPurchase.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
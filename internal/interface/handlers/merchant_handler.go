@@ -0,0 +1,237 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/factory"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+type MerchantHandler struct {
+	merchantFactory *factory.MerchantFactory
+}
+
+func NewMerchantHandler(factory *factory.MerchantFactory) *MerchantHandler {
+	return &MerchantHandler{
+		merchantFactory: factory,
+	}
+}
+
+// @Summary      Create a merchant
+// @Description  Create a merchant with the aliases that match its raw descriptions, as in "UBER *TRIP". The name is always an alias
+// @Tags         Merchants
+// @Accept       json
+// @Produce      json
+// @Param        request body CreateMerchantRequest true "Merchant data"
+// @Success      201 {object} usecases.CreateMerchantOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      409 {object} util.ProblemDetails "Merchant already exists"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /merchants [post]
+func (h *MerchantHandler) CreateMerchant(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request CreateMerchantRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.CreateMerchantInputDto{
+		UserID:  userID,
+		Name:    request.Name,
+		Aliases: request.Aliases,
+	}
+
+	output, errs := h.merchantFactory.CreateMerchant.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+// @Summary      Get a merchant with its history
+// @Description  Retrieve a merchant with its expenses, newest first, its totals and the totals of each month it was paid in
+// @Tags         Merchants
+// @Accept       json
+// @Produce      json
+// @Param        merchant_id query string true "Merchant ID"
+// @Success      200 {object} usecases.GetMerchantOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      404 {object} util.ProblemDetails "Merchant Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /merchants [get]
+func (h *MerchantHandler) GetMerchant(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	merchantID := c.Query("merchant_id")
+	if merchantID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Merchant ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Merchant id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.GetMerchantInputDto{
+		UserID:     userID,
+		MerchantID: merchantID,
+	}
+
+	output, errs := h.merchantFactory.GetMerchant.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Get all merchants
+// @Description  Retrieve all merchants of the authenticated user, by name
+// @Tags         Merchants
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} usecases.GetMerchantsOutputDto
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /merchants/all [get]
+func (h *MerchantHandler) GetMerchants(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := usecases.GetMerchantsInputDto{
+		UserID: userID,
+	}
+
+	output, errs := h.merchantFactory.GetMerchants.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Update a merchant
+// @Description  Rename a merchant and, when aliases are sent, replace them. The previous name stays an alias
+// @Tags         Merchants
+// @Accept       json
+// @Produce      json
+// @Param        request body UpdateMerchantRequest true "Updated merchant data"
+// @Success      200 {object} usecases.UpdateMerchantOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      404 {object} util.ProblemDetails "Merchant Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /merchants [patch]
+func (h *MerchantHandler) UpdateMerchant(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request UpdateMerchantRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.UpdateMerchantInputDto{
+		UserID:     userID,
+		MerchantID: request.MerchantID,
+		Name:       request.Name,
+		Aliases:    request.Aliases,
+	}
+
+	output, errs := h.merchantFactory.UpdateMerchant.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Delete a merchant
+// @Description  Delete a merchant. Its expenses are kept without a merchant
+// @Tags         Merchants
+// @Accept       json
+// @Produce      json
+// @Param        merchant_id query string true "Merchant ID"
+// @Success      200 {object} usecases.DeleteMerchantOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      404 {object} util.ProblemDetails "Merchant Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /merchants [delete]
+func (h *MerchantHandler) DeleteMerchant(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	merchantID := c.Query("merchant_id")
+	if merchantID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Merchant ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Merchant id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.DeleteMerchantInputDto{
+		UserID:     userID,
+		MerchantID: merchantID,
+	}
+
+	output, errs := h.merchantFactory.DeleteMerchant.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/factory"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/presenters"
@@ -25,6 +26,7 @@ func NewPresentersHandler(factory *factory.PresentersFactory) *PresentersHandler
 // @Produce json
 // @Param start_date query string true "Start date (DDMMYYYY)"
 // @Param end_date query string true "End date (DDMMYYYY)"
+// @Param basis query string false "Expense date basis: purchase (default) or statement"
 // @Success 200 {object} presenters.GetTotalExpensesForPeriodOutputDto
 // @Failure 400 {object} util.ProblemDetails "Bad Request - Missing start date or end date"
 // @Failure 401 {object} util.ProblemDetails "Unauthorized"
@@ -34,31 +36,31 @@ func NewPresentersHandler(factory *factory.PresentersFactory) *PresentersHandler
 func (h *PresentersHandler) GetTotalExpensesForPeriod(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	startDate := c.Query("start_date")
 	if startDate == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing start date",
 			Status:   http.StatusBadRequest,
 			Detail:   "Start date is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
 	endDate := c.Query("end_date")
 	if endDate == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing end date",
 			Status:   http.StatusBadRequest,
 			Detail:   "End date is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
@@ -66,6 +68,7 @@ func (h *PresentersHandler) GetTotalExpensesForPeriod(c *gin.Context) {
 		UserID:    userID,
 		StartDate: startDate,
 		EndDate:   endDate,
+		Basis:     c.Query("basis"),
 	}
 
 	output, errs := h.presenterFactory.GetTotalExpensesForPeriod.Execute(input)
@@ -83,6 +86,7 @@ func (h *PresentersHandler) GetTotalExpensesForPeriod(c *gin.Context) {
 // @Produce json
 // @Param start_date query string true "Start date (DDMMYYYY)"
 // @Param end_date query string true "End date (DDMMYYYY)"
+// @Param basis query string false "Expense date basis: purchase (default) or statement"
 // @Success 200 {object} presenters.GetExpensesByCategoryPeriodOutputDto
 // @Failure 400 {object} util.ProblemDetails "Bad Request - Missing start date or end date"
 // @Failure 401 {object} util.ProblemDetails "Unauthorized"
@@ -92,31 +96,31 @@ func (h *PresentersHandler) GetTotalExpensesForPeriod(c *gin.Context) {
 func (h *PresentersHandler) GetExpensesByCategoryPeriod(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	startDate := c.Query("start_date")
 	if startDate == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing start date",
 			Status:   http.StatusBadRequest,
 			Detail:   "Start date is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
 	endDate := c.Query("end_date")
 	if endDate == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing end date",
 			Status:   http.StatusBadRequest,
 			Detail:   "End date is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
@@ -124,6 +128,7 @@ func (h *PresentersHandler) GetExpensesByCategoryPeriod(c *gin.Context) {
 		UserID:    userID,
 		StartDate: startDate,
 		EndDate:   endDate,
+		Basis:     c.Query("basis"),
 	}
 
 	output, errs := h.presenterFactory.GetExpensesByCategoryPeriod.Execute(input)
@@ -140,6 +145,7 @@ func (h *PresentersHandler) GetExpensesByCategoryPeriod(c *gin.Context) {
 // @Tags Presenters
 // @Produce json
 // @Param year query string true "Year (YYYY)"
+// @Param basis query string false "Expense date basis: purchase (default) or statement"
 // @Success 200 {object} presenters.GetMonthlyExpensesByCategoryYearOutputDto
 // @Failure 400 {object} util.ProblemDetails "Bad Request - Missing or invalid year"
 // @Failure 401 {object} util.ProblemDetails "Unauthorized"
@@ -149,25 +155,27 @@ func (h *PresentersHandler) GetExpensesByCategoryPeriod(c *gin.Context) {
 func (h *PresentersHandler) GetMonthlyExpensesByCategoryYear(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	year := c.Query("year")
 	if year == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing year date",
 			Status:   http.StatusBadRequest,
 			Detail:   "Year date is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
 	input := presenters.GetMonthlyExpensesByCategoryYearInputDto{
 		UserID: userID,
 		Year:   year,
+		Basis:  c.Query("basis"),
+		Locale: getLocale(c),
 	}
 
 	output, errs := h.presenterFactory.GetMonthlyExpensesByCategoryYear.Execute(input)
@@ -193,25 +201,26 @@ func (h *PresentersHandler) GetMonthlyExpensesByCategoryYear(c *gin.Context) {
 func (h *PresentersHandler) GetMonthlyExpensesByTagYear(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	year := c.Query("year")
 	if year == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing year date",
 			Status:   http.StatusBadRequest,
 			Detail:   "Year date is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
 	input := presenters.GetMonthlyExpensesByTagYearInputDto{
 		UserID: userID,
 		Year:   year,
+		Locale: getLocale(c),
 	}
 
 	output, errs := h.presenterFactory.GetMonthlyExpensesByTagYear.Execute(input)
@@ -236,12 +245,13 @@ func (h *PresentersHandler) GetMonthlyExpensesByTagYear(c *gin.Context) {
 func (h *PresentersHandler) GetTotalExpensesForCurrentMonth(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	input := presenters.GetTotalExpensesForCurrentMonthInputDto{
 		UserID: userID,
+		Locale: getLocale(c),
 	}
 
 	output, errs := h.presenterFactory.GetTotalExpensesForCurrentMonth.Execute(input)
@@ -268,31 +278,31 @@ func (h *PresentersHandler) GetTotalExpensesForCurrentMonth(c *gin.Context) {
 func (h *PresentersHandler) GetExpensesByMonthYear(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	year := c.Query("year")
 	if year == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing year date",
 			Status:   http.StatusBadRequest,
 			Detail:   "Year date is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
 	month := c.Query("month")
 	if month == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing month date",
 			Status:   http.StatusBadRequest,
 			Detail:   "Year date is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
@@ -300,6 +310,7 @@ func (h *PresentersHandler) GetExpensesByMonthYear(c *gin.Context) {
 		UserID: userID,
 		Year:   year,
 		Month:  month,
+		Locale: getLocale(c),
 	}
 
 	output, errs := h.presenterFactory.GetExpensesByMonthYear.Execute(input)
@@ -312,9 +323,10 @@ func (h *PresentersHandler) GetExpensesByMonthYear(c *gin.Context) {
 }
 
 // @Summary Get total expenses for the current week
-// @Description Retrieves the total expenses of a user for the current week
+// @Description Retrieves the total expenses of a user from the start of the current week, using the user's week start
 // @Tags Presenters
 // @Produce json
+// @Param compare query bool false "Also return the total up to the same point of the previous week"
 // @Success 200 {object} presenters.GetTotalExpensesForCurrentWeekOutputDto
 // @Failure 400 {object} util.ProblemDetails "Bad Request - Invalid parameters"
 // @Failure 401 {object} util.ProblemDetails "Unauthorized"
@@ -324,12 +336,13 @@ func (h *PresentersHandler) GetExpensesByMonthYear(c *gin.Context) {
 func (h *PresentersHandler) GetTotalExpensesForCurrentWeek(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	input := presenters.GetTotalExpensesForCurrentWeekInputDto{
-		UserID: userID,
+		UserID:  userID,
+		Compare: c.Query("compare") == "true",
 	}
 
 	output, errs := h.presenterFactory.GetTotalExpensesForCurrentWeek.Execute(input)
@@ -346,6 +359,7 @@ func (h *PresentersHandler) GetTotalExpensesForCurrentWeek(c *gin.Context) {
 // @Tags Presenters
 // @Produce json
 // @Param year query string true "Year for which to retrieve monthly expenses"
+// @Param basis query string false "Expense date basis: purchase (default) or statement"
 // @Success 200 {object} presenters.GetTotalExpensesMonthCurrentYearOutputDto
 // @Failure 400 {object} util.ProblemDetails "Bad Request - Missing or invalid year"
 // @Failure 401 {object} util.ProblemDetails "Unauthorized"
@@ -355,25 +369,27 @@ func (h *PresentersHandler) GetTotalExpensesForCurrentWeek(c *gin.Context) {
 func (h *PresentersHandler) GetTotalExpensesMonthCurrentYear(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	year := c.Query("year")
 	if year == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing year date",
 			Status:   http.StatusBadRequest,
 			Detail:   "Year date is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
 	input := presenters.GetTotalExpensesMonthCurrentYearInputDto{
 		UserID: userID,
 		Year:   year,
+		Basis:  c.Query("basis"),
+		Locale: getLocale(c),
 	}
 
 	output, errs := h.presenterFactory.GetTotalExpensesMonthCurrentYear.Execute(input)
@@ -400,31 +416,31 @@ func (h *PresentersHandler) GetTotalExpensesMonthCurrentYear(c *gin.Context) {
 func (h *PresentersHandler) GetCategoryTagsTotalsByMonthYear(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	year := c.Query("year")
 	if year == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing year date",
 			Status:   http.StatusBadRequest,
 			Detail:   "Year date is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
 	month := c.Query("month")
 	if month == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing month date",
 			Status:   http.StatusBadRequest,
 			Detail:   "Year date is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
@@ -432,6 +448,7 @@ func (h *PresentersHandler) GetCategoryTagsTotalsByMonthYear(c *gin.Context) {
 		UserID: userID,
 		Year:   year,
 		Month:  month,
+		Locale: getLocale(c),
 	}
 
 	output, errs := h.presenterFactory.GetCategoryTagsTotalsByMonthYear.Execute(input)
@@ -455,12 +472,13 @@ func (h *PresentersHandler) GetCategoryTagsTotalsByMonthYear(c *gin.Context) {
 func (h *PresentersHandler) GetAvailableMonthsYears(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	input := presenters.GetAvailableMonthsYearsInputDto{
 		UserID: userID,
+		Locale: getLocale(c),
 	}
 
 	output, errs := h.presenterFactory.GetAvailableMonthsYears.Execute(input)
@@ -484,31 +502,31 @@ func (h *PresentersHandler) GetAvailableMonthsYears(c *gin.Context) {
 func (h *PresentersHandler) GetDayToDayExpensesPeriod(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	startDate := c.Query("start_date")
 	if startDate == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing start date",
 			Status:   http.StatusBadRequest,
 			Detail:   "Start date is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
 	endDate := c.Query("end_date")
 	if endDate == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing end date",
 			Status:   http.StatusBadRequest,
 			Detail:   "End date is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
@@ -516,6 +534,7 @@ func (h *PresentersHandler) GetDayToDayExpensesPeriod(c *gin.Context) {
 		UserID:    userID,
 		StartDate: startDate,
 		EndDate:   endDate,
+		Locale:    getLocale(c),
 	}
 
 	output, errs := h.presenterFactory.GetDayToDayExpensesPeriod.Execute(input)
@@ -526,3 +545,1187 @@ func (h *PresentersHandler) GetDayToDayExpensesPeriod(c *gin.Context) {
 
 	c.JSON(http.StatusOK, output)
 }
+
+// @Summary Get net cash flow by month for a specific year
+// @Description Retrieves income, expenses, net cash flow and savings rate for each month of a year
+// @Tags Presenters
+// @Produce json
+// @Param year query string true "Year (YYYY)"
+// @Success 200 {object} presenters.GetNetCashFlowYearOutputDto
+// @Failure 400 {object} util.ProblemDetails "Bad Request - Missing or invalid year"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /cashflow/monthly/year [get]
+func (h *PresentersHandler) GetNetCashFlowYear(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	year := c.Query("year")
+	if year == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing year date",
+			Status:   http.StatusBadRequest,
+			Detail:   "Year date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := presenters.GetNetCashFlowYearInputDto{
+		UserID: userID,
+		Year:   year,
+		Locale: getLocale(c),
+	}
+
+	output, errs := h.presenterFactory.GetNetCashFlowYear.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Get income vs expense by category for a period
+// @Description Retrieves income and expense totals grouped by category, with net cash flow and savings rate, for a date range
+// @Tags Presenters
+// @Produce json
+// @Param start_date query string true "Start date (DDMMYYYY)"
+// @Param end_date query string true "End date (DDMMYYYY)"
+// @Success 200 {object} presenters.GetIncomeVsExpenseByCategoryPeriodOutputDto
+// @Failure 400 {object} util.ProblemDetails "Bad Request - Missing start date or end date"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /cashflow/categories [get]
+func (h *PresentersHandler) GetIncomeVsExpenseByCategoryPeriod(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	startDate := c.Query("start_date")
+	if startDate == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing start date",
+			Status:   http.StatusBadRequest,
+			Detail:   "Start date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	endDate := c.Query("end_date")
+	if endDate == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing end date",
+			Status:   http.StatusBadRequest,
+			Detail:   "End date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := presenters.GetIncomeVsExpenseByCategoryPeriodInputDto{
+		UserID:    userID,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+
+	output, errs := h.presenterFactory.GetIncomeVsExpenseByCategoryPeriod.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Get account balances
+// @Description Retrieves the balance of each account (opening balance, incomes, expenses and transfers) up to a date. Defaults to today
+// @Tags Presenters
+// @Produce json
+// @Param date query string false "Date (DDMMYYYY)"
+// @Success 200 {object} presenters.GetAccountBalancesOutputDto
+// @Failure 400 {object} util.ProblemDetails "Bad Request - Invalid date"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /accounts/balances [get]
+func (h *PresentersHandler) GetAccountBalances(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := presenters.GetAccountBalancesInputDto{
+		UserID: userID,
+		Date:   c.Query("date"),
+	}
+
+	output, errs := h.presenterFactory.GetAccountBalances.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Get running balance of an account
+// @Description Retrieves the day-by-day running balance of an account for a date range
+// @Tags Presenters
+// @Produce json
+// @Param account_id query string true "Account ID"
+// @Param start_date query string true "Start date (DDMMYYYY)"
+// @Param end_date query string true "End date (DDMMYYYY)"
+// @Success 200 {object} presenters.GetAccountRunningBalanceOutputDto
+// @Failure 400 {object} util.ProblemDetails "Bad Request - Missing account, start date or end date"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 404 {object} util.ProblemDetails "Account Not Found"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /accounts/balances/running [get]
+func (h *PresentersHandler) GetAccountRunningBalance(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	accountID := c.Query("account_id")
+	if accountID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Account ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Account id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	startDate := c.Query("start_date")
+	if startDate == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing start date",
+			Status:   http.StatusBadRequest,
+			Detail:   "Start date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	endDate := c.Query("end_date")
+	if endDate == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing end date",
+			Status:   http.StatusBadRequest,
+			Detail:   "End date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := presenters.GetAccountRunningBalanceInputDto{
+		UserID:    userID,
+		AccountID: accountID,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+
+	output, errs := h.presenterFactory.GetAccountRunningBalance.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Get expenses by account for a period
+// @Description Retrieves expenses grouped by account for a date range. Expenses without an account are grouped as Unassigned
+// @Tags Presenters
+// @Produce json
+// @Param start_date query string true "Start date (DDMMYYYY)"
+// @Param end_date query string true "End date (DDMMYYYY)"
+// @Success 200 {object} presenters.GetExpensesByAccountPeriodOutputDto
+// @Failure 400 {object} util.ProblemDetails "Bad Request - Missing start date or end date"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /expenses/accounts [get]
+func (h *PresentersHandler) GetExpensesByAccountPeriod(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	startDate := c.Query("start_date")
+	if startDate == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing start date",
+			Status:   http.StatusBadRequest,
+			Detail:   "Start date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	endDate := c.Query("end_date")
+	if endDate == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing end date",
+			Status:   http.StatusBadRequest,
+			Detail:   "End date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := presenters.GetExpensesByAccountPeriodInputDto{
+		UserID:    userID,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+
+	output, errs := h.presenterFactory.GetExpensesByAccountPeriod.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Get top merchants for a period
+// @Description Ranks merchants by their total in a date range, with expense counts, averages and their share of the spending with a merchant. Expenses without a merchant are left out
+// @Tags Presenters
+// @Produce json
+// @Param start_date query string true "Start date (DDMMYYYY)"
+// @Param end_date query string true "End date (DDMMYYYY)"
+// @Param limit query int false "Number of merchants (default 10, max 100)"
+// @Param basis query string false "Expense date basis: purchase (default) or statement"
+// @Success 200 {object} presenters.GetTopMerchantsOutputDto
+// @Failure 400 {object} util.ProblemDetails "Bad Request - Missing start date or end date"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /expenses/merchants [get]
+func (h *PresentersHandler) GetTopMerchants(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	startDate := c.Query("start_date")
+	if startDate == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing start date",
+			Status:   http.StatusBadRequest,
+			Detail:   "Start date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	endDate := c.Query("end_date")
+	if endDate == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing end date",
+			Status:   http.StatusBadRequest,
+			Detail:   "End date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := presenters.GetTopMerchantsInputDto{
+		UserID:    userID,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Limit:     c.Query("limit"),
+		Basis:     c.Query("basis"),
+	}
+
+	output, errs := h.presenterFactory.GetTopMerchants.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Get geotagged expenses for a period
+// @Description Lists the expenses with a location in a date range, optionally only those inside a bounding box. A box whose min_longitude is greater than its max_longitude crosses the antimeridian
+// @Tags Presenters
+// @Produce json
+// @Param start_date query string true "Start date (DDMMYYYY)"
+// @Param end_date query string true "End date (DDMMYYYY)"
+// @Param bbox query string false "Bounding box: min_longitude,min_latitude,max_longitude,max_latitude"
+// @Success 200 {object} presenters.GetExpenseLocationsOutputDto
+// @Failure 400 {object} util.ProblemDetails "Bad Request - Missing start date or end date"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /expenses/locations [get]
+func (h *PresentersHandler) GetExpenseLocations(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	startDate := c.Query("start_date")
+	if startDate == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing start date",
+			Status:   http.StatusBadRequest,
+			Detail:   "Start date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	endDate := c.Query("end_date")
+	if endDate == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing end date",
+			Status:   http.StatusBadRequest,
+			Detail:   "End date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := presenters.GetExpenseLocationsInputDto{
+		UserID:      userID,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		BoundingBox: c.Query("bbox"),
+	}
+
+	output, errs := h.presenterFactory.GetExpenseLocations.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Get expense clusters for a period
+// @Description Groups the geotagged expenses of a date range by geohash cell, with the total and count of each, largest total first
+// @Tags Presenters
+// @Produce json
+// @Param start_date query string true "Start date (DDMMYYYY)"
+// @Param end_date query string true "End date (DDMMYYYY)"
+// @Param bbox query string false "Bounding box: min_longitude,min_latitude,max_longitude,max_latitude"
+// @Param precision query int false "Geohash length from 1 to 9 (default 5, cells of about 5 km)"
+// @Success 200 {object} presenters.GetExpenseClustersOutputDto
+// @Failure 400 {object} util.ProblemDetails "Bad Request - Missing start date or end date"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /expenses/locations/clusters [get]
+func (h *PresentersHandler) GetExpenseClusters(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	startDate := c.Query("start_date")
+	if startDate == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing start date",
+			Status:   http.StatusBadRequest,
+			Detail:   "Start date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	endDate := c.Query("end_date")
+	if endDate == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing end date",
+			Status:   http.StatusBadRequest,
+			Detail:   "End date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := presenters.GetExpenseLocationsInputDto{
+		UserID:      userID,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		BoundingBox: c.Query("bbox"),
+	}
+
+	output, errs := h.presenterFactory.GetExpenseClusters.Execute(presenters.GetExpenseClustersInputDto{
+		Locations: input,
+		Precision: c.Query("precision"),
+	})
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Export geotagged expenses as GeoJSON
+// @Description Downloads the geotagged expenses of a date range as a GeoJSON FeatureCollection of points
+// @Tags Presenters
+// @Produce application/geo+json
+// @Param start_date query string true "Start date (DDMMYYYY)"
+// @Param end_date query string true "End date (DDMMYYYY)"
+// @Param bbox query string false "Bounding box: min_longitude,min_latitude,max_longitude,max_latitude"
+// @Success 200 {file} file "GeoJSON file"
+// @Failure 400 {object} util.ProblemDetails "Bad Request - Missing start date or end date"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /expenses/locations/geojson [get]
+func (h *PresentersHandler) GetExpensesGeoJSON(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	startDate := c.Query("start_date")
+	if startDate == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing start date",
+			Status:   http.StatusBadRequest,
+			Detail:   "Start date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	endDate := c.Query("end_date")
+	if endDate == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing end date",
+			Status:   http.StatusBadRequest,
+			Detail:   "End date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := presenters.GetExpenseLocationsInputDto{
+		UserID:      userID,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		BoundingBox: c.Query("bbox"),
+	}
+
+	output, errs := h.presenterFactory.GetExpensesGeoJSON.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename=\""+output.FileName+"\"")
+	c.Data(http.StatusOK, "application/geo+json", output.Content)
+}
+
+// @Summary Get committed installments by month
+// @Description Retrieves the installments already committed from the current month on, grouped by month
+// @Tags Presenters
+// @Produce json
+// @Success 200 {object} presenters.GetCommittedInstallmentsByMonthOutputDto
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /installments/committed [get]
+func (h *PresentersHandler) GetCommittedInstallmentsByMonth(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := presenters.GetCommittedInstallmentsByMonthInputDto{
+		UserID: userID,
+		Locale: getLocale(c),
+	}
+
+	output, errs := h.presenterFactory.GetCommittedInstallmentsByMonth.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Get credit card statements
+// @Description Retrieves the statement cycles of a credit card account for a year, each with its closing date, due date and invoice total. Statements are listed by due month
+// @Tags Presenters
+// @Produce json
+// @Param account_id query string true "Account ID"
+// @Param year query string false "Year (YYYY), defaults to the current year"
+// @Success 200 {object} presenters.GetCardStatementsOutputDto
+// @Failure 400 {object} util.ProblemDetails "Bad Request - Missing account, invalid year or account is not a credit card"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 404 {object} util.ProblemDetails "Account Not Found"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /accounts/statements [get]
+func (h *PresentersHandler) GetCardStatements(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	accountID := c.Query("account_id")
+	if accountID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Account ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Account id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := presenters.GetCardStatementsInputDto{
+		UserID:    userID,
+		AccountID: accountID,
+		Year:      c.Query("year"),
+	}
+
+	output, errs := h.presenterFactory.GetCardStatements.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Get savings goals progress
+// @Description Retrieves the progress of the user's savings goals: percent complete, the monthly contribution required to meet the deadline and a projected completion date based on the contribution history. Expenses in a goal's linked category count as contributions
+// @Tags Presenters
+// @Produce json
+// @Param goal_id query string false "Goal ID, omit to retrieve every goal"
+// @Success 200 {object} presenters.GetGoalsProgressOutputDto
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 404 {object} util.ProblemDetails "Goal Not Found"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /goals/progress [get]
+func (h *PresentersHandler) GetGoalsProgress(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := presenters.GetGoalsProgressInputDto{
+		UserID: userID,
+		GoalID: c.Query("goal_id"),
+	}
+
+	output, errs := h.presenterFactory.GetGoalsProgress.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Get month-end spending forecast
+// @Description Projects the total and per-category spending for the current month with low and high bands. Installments, future-dated expenses and recurring charges not billed yet are added as known amounts; the rest is extrapolated from the day-of-month distribution of the previous months, or from the pace so far when there is not enough history
+// @Tags Presenters
+// @Produce json
+// @Success 200 {object} presenters.GetMonthEndForecastOutputDto
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /expenses/forecast [get]
+func (h *PresentersHandler) GetMonthEndForecast(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := presenters.GetMonthEndForecastInputDto{
+		UserID: userID,
+		Locale: getLocale(c),
+	}
+
+	output, errs := h.presenterFactory.GetMonthEndForecast.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Compare spending between two periods
+// @Description Compares total, per-category and per-tag spending of a period against a comparison period, with absolute and percent changes and the categories that appeared or disappeared. Use a preset (mom, yoy or same_period_last_year) to derive the comparison period, or send both date ranges. Without dates, mom and same_period_last_year compare the month to date and yoy the year to date
+// @Tags Presenters
+// @Produce json
+// @Param preset query string false "Comparison preset: mom, yoy or same_period_last_year"
+// @Param start_date query string false "Start date (DDMMYYYY)"
+// @Param end_date query string false "End date (DDMMYYYY)"
+// @Param compare_start_date query string false "Comparison start date (DDMMYYYY), required without preset"
+// @Param compare_end_date query string false "Comparison end date (DDMMYYYY), required without preset"
+// @Param basis query string false "Expense date basis: purchase (default) or statement"
+// @Success 200 {object} presenters.GetPeriodComparisonOutputDto
+// @Failure 400 {object} util.ProblemDetails "Bad Request - Invalid preset or dates"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /expenses/comparison [get]
+func (h *PresentersHandler) GetPeriodComparison(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := presenters.GetPeriodComparisonInputDto{
+		UserID:           userID,
+		Preset:           c.Query("preset"),
+		StartDate:        c.Query("start_date"),
+		EndDate:          c.Query("end_date"),
+		CompareStartDate: c.Query("compare_start_date"),
+		CompareEndDate:   c.Query("compare_end_date"),
+		Basis:            c.Query("basis"),
+	}
+
+	output, errs := h.presenterFactory.GetPeriodComparison.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Get expenses as a time series
+// @Description Sums active expenses per day, week, month, quarter or year of the period, optionally split by category, tag, account or weekday. Every bucket of the period is returned, oldest first, with zero when there is no spending, and each series has one value per bucket. Weeks start on the user's week start. With group_by=tag an expense counts once for each of its tags. A period of more than 1000 buckets is rejected with 400
+// @Tags Presenters
+// @Produce json
+// @Param start_date query string true "Start date (DDMMYYYY)"
+// @Param end_date query string true "End date (DDMMYYYY)"
+// @Param granularity query string true "Bucket size: day, week, month, quarter or year"
+// @Param group_by query string false "Split into series by category, tag, account or weekday"
+// @Param category_id query []string false "Only expenses of these categories" collectionFormat(multi)
+// @Param tag_id query []string false "Only expenses with any of these tags" collectionFormat(multi)
+// @Param account_id query []string false "Only expenses of these accounts" collectionFormat(multi)
+// @Param basis query string false "Expense date basis: purchase (default) or statement"
+// @Success 200 {object} presenters.GetExpensesTimeSeriesOutputDto
+// @Failure 400 {object} util.ProblemDetails "Bad Request - Missing or invalid parameters"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /expenses/series [get]
+func (h *PresentersHandler) GetExpensesTimeSeries(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	startDate := c.Query("start_date")
+	if startDate == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing start date",
+			Status:   http.StatusBadRequest,
+			Detail:   "Start date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	endDate := c.Query("end_date")
+	if endDate == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing end date",
+			Status:   http.StatusBadRequest,
+			Detail:   "End date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := presenters.GetExpensesTimeSeriesInputDto{
+		UserID:      userID,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Granularity: c.Query("granularity"),
+		GroupBy:     c.Query("group_by"),
+		CategoryIDs: c.QueryArray("category_id"),
+		TagIDs:      c.QueryArray("tag_id"),
+		AccountIDs:  c.QueryArray("account_id"),
+		Basis:       c.Query("basis"),
+		Locale:      getLocale(c),
+	}
+
+	output, errs := h.presenterFactory.GetExpensesTimeSeries.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Get a pivot report of expenses
+// @Description Cross-tabulates active expenses of a period with any dimension as rows and, optionally, another as columns, including row, column and grand totals. Totals are computed over the expenses, so the total of an average is the average of all expenses in the row. With tags on either side an expense counts once for each of its tags in the tag cells and tag totals, and expenses without tags are reported as Untagged; the other totals count each expense once, so tag cells can add up to more than them. With month or week on either side, a period of more than 1000 months or weeks is rejected with 400. Send format=csv to download the table as CSV
+// @Tags Presenters
+// @Produce json,text/csv
+// @Param start_date query string true "Start date (DDMMYYYY)"
+// @Param end_date query string true "End date (DDMMYYYY)"
+// @Param rows query string true "Row dimension: category, tag, month, week, weekday or account"
+// @Param columns query string false "Column dimension, different from rows"
+// @Param measure query string false "sum (default), count, average, min or max"
+// @Param basis query string false "Expense date basis: purchase (default) or statement"
+// @Param format query string false "json (default) or csv"
+// @Success 200 {object} presenters.GetPivotReportOutputDto
+// @Failure 400 {object} util.ProblemDetails "Bad Request - Missing or invalid parameters"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /expenses/pivot [get]
+func (h *PresentersHandler) GetPivotReport(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	startDate := c.Query("start_date")
+	if startDate == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing start date",
+			Status:   http.StatusBadRequest,
+			Detail:   "Start date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	endDate := c.Query("end_date")
+	if endDate == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing end date",
+			Status:   http.StatusBadRequest,
+			Detail:   "End date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Invalid format",
+			Status:   http.StatusBadRequest,
+			Detail:   "Format must be json or csv",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := presenters.GetPivotReportInputDto{
+		UserID:    userID,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Rows:      c.Query("rows"),
+		Columns:   c.Query("columns"),
+		Measure:   c.Query("measure"),
+		Basis:     c.Query("basis"),
+		Locale:    getLocale(c),
+	}
+
+	output, errs := h.presenterFactory.GetPivotReport.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	if format == "csv" {
+		content, csvErr := presenters.PivotReportCSV(output, input.Locale)
+		if csvErr != nil {
+			abortWithProblem(c, http.StatusInternalServerError, util.ProblemDetails{
+				Type:     "Internal Server Error",
+				Title:    "Could not write CSV",
+				Status:   http.StatusInternalServerError,
+				Detail:   csvErr.Error(),
+				Instance: util.RFC500,
+			})
+			return
+		}
+
+		c.Header("Content-Disposition", "attachment; filename=\"pivot.csv\"")
+		c.Data(http.StatusOK, "text/csv; charset=utf-8", content)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Get a calendar heatmap of daily spending
+// @Description Returns one entry per calendar day of the range, or of the year when no range is given, up to today in the user's timezone, with the total, the number of expenses and an intensity level from 0 (no spending) to 4 based on the quartiles of the daily totals. Also returns the busiest days and the longest streaks without spending
+// @Tags Presenters
+// @Produce json
+// @Param year query string false "Year (defaults to the current year)"
+// @Param start_date query string false "Start date (DDMMYYYY), overrides year"
+// @Param end_date query string false "End date (DDMMYYYY), overrides year"
+// @Success 200 {object} presenters.GetSpendingHeatmapOutputDto
+// @Failure 400 {object} util.ProblemDetails "Bad Request - Invalid year or dates"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /expenses/heatmap [get]
+func (h *PresentersHandler) GetSpendingHeatmap(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := presenters.GetSpendingHeatmapInputDto{
+		UserID:    userID,
+		Year:      c.Query("year"),
+		StartDate: c.Query("start_date"),
+		EndDate:   c.Query("end_date"),
+		Locale:    getLocale(c),
+	}
+
+	output, errs := h.presenterFactory.GetSpendingHeatmap.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Download a PDF expense report
+// @Description Renders a PDF for a month, or for the whole year when no month is given, with the headline totals, category and tag breakdowns as bar charts, the week-by-week (or month-by-month) totals and the itemized expense list
+// @Tags Presenters
+// @Produce application/pdf
+// @Param year query string true "Year of the report"
+// @Param month query string false "Month of the report (1-12); the whole year when omitted"
+// @Success 200 {file} file "PDF report"
+// @Failure 400 {object} util.ProblemDetails "Bad Request - Missing or invalid year or month"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /expenses/report.pdf [get]
+func (h *PresentersHandler) GetExpenseReportPDF(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	year := c.Query("year")
+	if year == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing year date",
+			Status:   http.StatusBadRequest,
+			Detail:   "Year date is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := presenters.GetExpenseReportPDFInputDto{
+		UserID: userID,
+		Year:   year,
+		Month:  c.Query("month"),
+		Locale: getLocale(c),
+	}
+
+	output, errs := h.presenterFactory.GetExpenseReportPDF.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename=\""+output.FileName+"\"")
+	c.Data(http.StatusOK, "application/pdf", output.Content)
+}
+
+// @Summary Render an expense chart
+// @Description Renders the expenses by category or tag for a period, or their time series, as a bar, line, pie or stacked chart in SVG or PNG. Categories and tags are drawn with their stored colors; when there are more than ten slices or series the smallest ones are merged into "Others". A time series of more than 1000 buckets is rejected with 400
+// @Tags Presenters
+// @Produce image/svg+xml
+// @Produce image/png
+// @Param type query string false "Chart type: bar (default), line, pie or stacked"
+// @Param data query string false "Chart data: categories (default), tags or series"
+// @Param start_date query string true "Start date (DDMMYYYY)"
+// @Param end_date query string true "End date (DDMMYYYY)"
+// @Param granularity query string false "Bucket size of the series data: day, week, month (default), quarter or year"
+// @Param group_by query string false "Splits the series data by category, tag, account or weekday"
+// @Param basis query string false "Expense basis: cash (default) or accrual"
+// @Param format query string false "Image format: svg (default) or png"
+// @Param width query int false "Width in pixels, between 200 and 2000 (default 600)"
+// @Param height query int false "Height in pixels, between 200 and 2000 (default 400)"
+// @Param theme query string false "Theme: light (default) or dark"
+// @Param title query string false "Title drawn above the chart"
+// @Success 200 {file} file "Chart image"
+// @Failure 400 {object} util.ProblemDetails "Bad Request - Invalid chart options or dates"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /charts [get]
+func (h *PresentersHandler) GetExpenseChart(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := chartInput(c)
+	input.UserID = userID
+	input.Locale = getLocale(c)
+
+	output, errs := h.presenterFactory.GetExpenseChart.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.Data(http.StatusOK, output.ContentType, output.Content)
+}
+
+// @Summary Share an expense chart
+// @Description Returns a signed link to a chart that can be embedded without logging in until it expires. The link renders the chart with the options, dates and language it was shared with
+// @Tags Presenters
+// @Accept json
+// @Produce json
+// @Param request body ShareExpenseChartRequest true "Chart options, as in GET /charts, and the expiration in hours (default 24, at most 720)"
+// @Success 200 {object} presenters.ShareExpenseChartOutputDto
+// @Failure 400 {object} util.ProblemDetails "Bad Request - Invalid chart options, dates or expiration"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /charts/share [post]
+func (h *PresentersHandler) ShareExpenseChart(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request ShareExpenseChartRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+
+	input := presenters.ShareExpenseChartInputDto{
+		BaseURL: scheme + "://" + c.Request.Host,
+		Chart: presenters.GetExpenseChartInputDto{
+			UserID:      userID,
+			Type:        request.Type,
+			Data:        request.Data,
+			StartDate:   request.StartDate,
+			EndDate:     request.EndDate,
+			Granularity: request.Granularity,
+			GroupBy:     request.GroupBy,
+			Basis:       request.Basis,
+			Format:      request.Format,
+			Theme:       request.Theme,
+			Title:       request.Title,
+			Locale:      getLocale(c),
+		},
+	}
+
+	if request.Width != 0 {
+		input.Chart.Width = strconv.Itoa(request.Width)
+	}
+	if request.Height != 0 {
+		input.Chart.Height = strconv.Itoa(request.Height)
+	}
+	if request.ExpiresIn != 0 {
+		input.ExpiresIn = strconv.Itoa(request.ExpiresIn)
+	}
+
+	output, errs := h.presenterFactory.ShareExpenseChart.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Render a shared expense chart
+// @Description Renders a chart from a signed link created with POST /charts/share. No login is needed; the link is rejected when any of its parameters was changed or it has expired
+// @Tags Presenters
+// @Produce image/svg+xml
+// @Produce image/png
+// @Param expires query string true "Expiration of the link (Unix time)"
+// @Param signature query string true "Signature of the link"
+// @Success 200 {file} file "Chart image"
+// @Failure 403 {object} util.ProblemDetails "Forbidden - Invalid or expired link"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Router /charts/shared [get]
+func (h *PresentersHandler) GetSharedExpenseChart(c *gin.Context) {
+	input := presenters.GetSharedExpenseChartInputDto{
+		Chart:     chartInput(c),
+		Expires:   c.Query("expires"),
+		Signature: c.Query("signature"),
+	}
+	input.Chart.UserID = c.Query("user_id")
+	input.Chart.Locale = c.Query("locale")
+
+	output, errs := h.presenterFactory.GetSharedExpenseChart.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.Data(http.StatusOK, output.ContentType, output.Content)
+}
+
+func chartInput(c *gin.Context) presenters.GetExpenseChartInputDto {
+	return presenters.GetExpenseChartInputDto{
+		Type:        c.Query("type"),
+		Data:        c.Query("data"),
+		StartDate:   c.Query("start_date"),
+		EndDate:     c.Query("end_date"),
+		Granularity: c.Query("granularity"),
+		GroupBy:     c.Query("group_by"),
+		Basis:       c.Query("basis"),
+		Format:      c.Query("format"),
+		Width:       c.Query("width"),
+		Height:      c.Query("height"),
+		Theme:       c.Query("theme"),
+		Title:       c.Query("title"),
+	}
+}
+
+// @Summary Get the income tax deductions of a year
+// @Description Totals the deductible expenses of a calendar year by tax category (health, education, private_pension, alimony, donation) and provider, for the Brazilian income tax return (IRPF). An expense is deductible under its own tax category or, when it has none, under its category's; expenses with tax category none are left out. Send format=csv for the totals by provider or format=pdf for a printable report
+// @Tags Presenters
+// @Produce json,text/csv,application/pdf
+// @Param year query string false "Calendar year (defaults to the previous year)"
+// @Param format query string false "json (default), csv or pdf"
+// @Success 200 {object} presenters.GetTaxDeductionReportOutputDto
+// @Failure 400 {object} util.ProblemDetails "Bad Request - Invalid year or format"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Failure 500 {object} util.ProblemDetails "Internal Server Error"
+// @Security BearerAuth
+// @Router /expenses/tax-report [get]
+func (h *PresentersHandler) GetTaxDeductionReport(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" && format != "pdf" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Invalid format",
+			Status:   http.StatusBadRequest,
+			Detail:   "Format must be json, csv or pdf",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := presenters.GetTaxDeductionReportInputDto{
+		UserID: userID,
+		Year:   c.Query("year"),
+		Locale: getLocale(c),
+	}
+
+	output, errs := h.presenterFactory.GetTaxDeductionReport.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	if format == "json" {
+		c.JSON(http.StatusOK, output)
+		return
+	}
+
+	var content []byte
+	var exportErr error
+	contentType := "application/pdf"
+
+	if format == "csv" {
+		contentType = "text/csv; charset=utf-8"
+		content, exportErr = presenters.TaxDeductionReportCSV(output, input.Locale)
+	} else {
+		content, exportErr = presenters.TaxDeductionReportPDF(output, input.Locale)
+	}
+
+	if exportErr != nil {
+		abortWithProblem(c, http.StatusInternalServerError, util.ProblemDetails{
+			Type:     "Internal Server Error",
+			Title:    "Could not generate report",
+			Status:   http.StatusInternalServerError,
+			Detail:   exportErr.Error(),
+			Instance: util.RFC500,
+		})
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename=\"tax-deductions-"+strconv.Itoa(output.Year)+"."+format+"\"")
+	c.Data(http.StatusOK, contentType, content)
+}
@@ -0,0 +1,343 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/factory"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+type RuleHandler struct {
+	ruleFactory *factory.RuleFactory
+}
+
+func NewRuleHandler(factory *factory.RuleFactory) *RuleHandler {
+	return &RuleHandler{
+		ruleFactory: factory,
+	}
+}
+
+// CreateRule godoc
+// @Summary Create a new categorization rule
+// @Description Create a rule that sets the category, adds tags or rewrites notes of matching new expenses
+// @Tags Rules
+// @Accept json
+// @Produce json
+// @Success 201 {object} usecases.CreateRuleOutputDto
+// @Failure 400 {object} util.ProblemDetails
+// @Failure 404 {object} util.ProblemDetails
+// @Failure 500 {object} util.ProblemDetails
+// @Param request body CreateRuleRequest true "Request body to create a new rule"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /rules [post]
+func (h *RuleHandler) CreateRule(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request CreateRuleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.CreateRuleInputDto{
+		UserID:         userID,
+		Name:           request.Name,
+		Priority:       request.Priority,
+		StopProcessing: request.StopProcessing,
+		Conditions:     request.Conditions,
+		Actions:        request.Actions,
+	}
+
+	output, errs := h.ruleFactory.CreateRule.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+// GetRule godoc
+// @Summary Get rule details
+// @Description Get details of a rule by its ID
+// @Tags Rules
+// @Accept json
+// @Produce json
+// @Success 200 {object} usecases.GetRuleOutputDto
+// @Failure 400 {object} util.ProblemDetails
+// @Failure 404 {object} util.ProblemDetails
+// @Failure 500 {object} util.ProblemDetails
+// @Param rule_id query string true "Rule ID"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /rules [get]
+func (h *RuleHandler) GetRule(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	ruleID := c.Query("rule_id")
+	if ruleID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Rule ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Rule id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.GetRuleInputDto{
+		UserID: userID,
+		RuleID: ruleID,
+	}
+
+	output, errs := h.ruleFactory.GetRule.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// GetRules godoc
+// @Summary Get all rules
+// @Description Retrieve all rules for the authenticated user ordered by priority
+// @Tags Rules
+// @Accept json
+// @Produce json
+// @Success 200 {object} usecases.GetRulesOutputDto
+// @Failure 400 {object} util.ProblemDetails
+// @Failure 500 {object} util.ProblemDetails
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /rules/all [get]
+func (h *RuleHandler) GetRules(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := usecases.GetRulesInputDto{
+		UserID: userID,
+	}
+
+	output, errs := h.ruleFactory.GetRules.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// UpdateRule godoc
+// @Summary Update a rule
+// @Description Update the name, priority, conditions and actions of an existing rule
+// @Tags Rules
+// @Accept json
+// @Produce json
+// @Success 200 {object} usecases.UpdateRuleOutputDto
+// @Failure 400 {object} util.ProblemDetails
+// @Failure 404 {object} util.ProblemDetails
+// @Failure 500 {object} util.ProblemDetails
+// @Param request body UpdateRuleRequest true "Request body to update a rule"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /rules [patch]
+func (h *RuleHandler) UpdateRule(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request UpdateRuleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.UpdateRuleInputDto{
+		UserID:         userID,
+		RuleID:         request.RuleID,
+		Name:           request.Name,
+		Priority:       request.Priority,
+		StopProcessing: request.StopProcessing,
+		Conditions:     request.Conditions,
+		Actions:        request.Actions,
+	}
+
+	output, errs := h.ruleFactory.UpdateRule.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// DeleteRule godoc
+// @Summary Delete a rule
+// @Description Delete a rule by its ID
+// @Tags Rules
+// @Accept json
+// @Produce json
+// @Success 200 {object} usecases.DeleteRuleOutputDto
+// @Failure 400 {object} util.ProblemDetails
+// @Failure 404 {object} util.ProblemDetails
+// @Failure 500 {object} util.ProblemDetails
+// @Param rule_id query string true "Rule ID"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /rules [delete]
+func (h *RuleHandler) DeleteRule(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	ruleID := c.Query("rule_id")
+	if ruleID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Rule ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Rule id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.DeleteRuleInputDto{
+		UserID: userID,
+		RuleID: ruleID,
+	}
+
+	output, errs := h.ruleFactory.DeleteRule.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// PreviewRule godoc
+// @Summary Preview a rule
+// @Description List the existing expenses that a rule would match
+// @Tags Rules
+// @Accept json
+// @Produce json
+// @Success 200 {object} usecases.PreviewRuleOutputDto
+// @Failure 400 {object} util.ProblemDetails
+// @Failure 404 {object} util.ProblemDetails
+// @Failure 500 {object} util.ProblemDetails
+// @Param rule_id query string true "Rule ID"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /rules/preview [get]
+func (h *RuleHandler) PreviewRule(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	ruleID := c.Query("rule_id")
+	if ruleID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Rule ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Rule id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.PreviewRuleInputDto{
+		UserID: userID,
+		RuleID: ruleID,
+	}
+
+	output, errs := h.ruleFactory.PreviewRule.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// ApplyRule godoc
+// @Summary Apply a rule retroactively
+// @Description Apply the actions of a rule to every existing expense it matches
+// @Tags Rules
+// @Accept json
+// @Produce json
+// @Success 200 {object} usecases.ApplyRuleOutputDto
+// @Failure 400 {object} util.ProblemDetails
+// @Failure 404 {object} util.ProblemDetails
+// @Failure 500 {object} util.ProblemDetails
+// @Param rule_id query string true "Rule ID"
+// @Failure 401 {object} util.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /rules/apply [post]
+func (h *RuleHandler) ApplyRule(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	ruleID := c.Query("rule_id")
+	if ruleID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Rule ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Rule id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.ApplyRuleInputDto{
+		UserID: userID,
+		RuleID: ruleID,
+	}
+
+	output, errs := h.ruleFactory.ApplyRule.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
@@ -33,19 +33,19 @@ func NewTagHandler(factory *factory.TagFactory) *TagHandler {
 func (h *TagHandler) CreateTag(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	var request CreateTagRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Did not bind JSON",
 			Status:   http.StatusBadRequest,
 			Detail:   err.Error(),
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
@@ -77,19 +77,19 @@ func (h *TagHandler) CreateTag(c *gin.Context) {
 func (h *TagHandler) GetTag(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	tagID := c.Query("tag_id")
 	if tagID == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing Tag ID",
 			Status:   http.StatusBadRequest,
 			Detail:   "Tag id is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
@@ -118,7 +118,7 @@ func (h *TagHandler) GetTag(c *gin.Context) {
 func (h *TagHandler) GetTags(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
@@ -148,19 +148,19 @@ func (h *TagHandler) GetTags(c *gin.Context) {
 func (h *TagHandler) DeleteTag(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	tagID := c.Query("tag_id")
 	if tagID == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing Tag ID",
 			Status:   http.StatusBadRequest,
 			Detail:   "Tag id is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
@@ -192,19 +192,19 @@ func (h *TagHandler) DeleteTag(c *gin.Context) {
 func (h *TagHandler) UpdateTag(c *gin.Context) {
 	userID, err := getUserID(c)
 	if err != nil {
-		c.AbortWithStatusJSON(err.Status, gin.H{"error": err})
+		abortWithProblem(c, err.Status, *err)
 		return
 	}
 
 	var request UpdateTagRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Did not bind JSON",
 			Status:   http.StatusBadRequest,
 			Detail:   err.Error(),
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
@@ -31,13 +31,13 @@ func NewUserHandler(factory *factory.UserFactory) *UserHandler {
 func (h *UserHandler) CreateUser(c *gin.Context) {
 	var input usecases.CreateUserInputDto
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Did not bind JSON",
 			Status:   http.StatusBadRequest,
 			Detail:   err.Error(),
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
@@ -63,13 +63,13 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 func (h *UserHandler) GetUser(c *gin.Context) {
 	userID := c.Query("user_id")
 	if userID == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing User ID",
 			Status:   http.StatusBadRequest,
 			Detail:   "User id is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
@@ -119,13 +119,13 @@ func (h *UserHandler) GetUsers(c *gin.Context) {
 func (h *UserHandler) UpdateUser(c *gin.Context) {
 	var input usecases.UpdateUserInputDto
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Did not bind JSON",
 			Status:   http.StatusBadRequest,
 			Detail:   err.Error(),
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
@@ -151,13 +151,13 @@ func (h *UserHandler) UpdateUser(c *gin.Context) {
 func (h *UserHandler) DeleteUser(c *gin.Context) {
 	userID := c.Query("user_id")
 	if userID == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Missing User ID",
 			Status:   http.StatusBadRequest,
 			Detail:   "User id is required",
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
@@ -186,13 +186,13 @@ func (h *UserHandler) DeleteUser(c *gin.Context) {
 func (h *UserHandler) Login(c *gin.Context) {
 	var input usecases.LoginInputDto
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": util.ProblemDetails{
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
 			Type:     "Bad Request",
 			Title:    "Did not bind JSON",
 			Status:   http.StatusBadRequest,
 			Detail:   err.Error(),
 			Instance: util.RFC400,
-		}})
+		})
 		return
 	}
 
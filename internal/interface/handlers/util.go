@@ -1,26 +1,41 @@
 package handlers
 
 import (
+	"encoding/json"
 	"net/http"
 
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
 	"github.com/gin-gonic/gin"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 )
 
 func handleErrors(c *gin.Context, errs []util.ProblemDetails) {
 	if len(errs) > 0 {
 		for _, err := range errs {
 			if err.Status == 500 {
-				c.JSON(err.Status, gin.H{"error": err})
+				c.JSON(err.Status, gin.H{"error": util.LocalizeProblemDetails(getLocale(c), err)})
 				return
 			} else {
-				c.JSON(err.Status, gin.H{"error": err})
+				c.JSON(err.Status, gin.H{"error": util.LocalizeProblemDetails(getLocale(c), err)})
 				return
 			}
 		}
 	}
 }
 
+func abortWithProblem(c *gin.Context, status int, problem util.ProblemDetails) {
+	c.AbortWithStatusJSON(status, gin.H{"error": util.LocalizeProblemDetails(getLocale(c), problem)})
+}
+
+func getLocale(c *gin.Context) string {
+	if locale := c.GetString("locale"); locale != "" {
+		return locale
+	}
+
+	return util.ResolveLocale("", c.GetHeader("Accept-Language"))
+}
+
 func getUserID(c *gin.Context) (string, *util.ProblemDetails) {
 	userID, exists := c.Get("userID")
 	if !exists {
@@ -48,9 +63,10 @@ func getUserID(c *gin.Context) (string, *util.ProblemDetails) {
 }
 
 type UpdateCategoryRequest struct {
-	CategoryID string `json:"category_id"`
-	Name       string `json:"name"`
-	Color      string `json:"color"`
+	CategoryID  string `json:"category_id"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	TaxCategory string `json:"tax_category"`
 }
 
 type UpdateTagRequest struct {
@@ -60,28 +76,213 @@ type UpdateTagRequest struct {
 }
 
 type CreateCategoryRequest struct {
-	Name  string `json:"name"`
-	Color string `json:"color"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Kind        string `json:"kind"`
+	TaxCategory string `json:"tax_category"`
 }
 
 type CreateExpenseRequest struct {
-	Amount      float64  `json:"amount,string"`
-	ExpenseDate string   `json:"expense_date"`
-	CategoryID  string   `json:"category_id"`
-	Notes       string   `json:"notes"`
-	Tags        []string `json:"tags"`
+	Amount        float64  `json:"amount,string"`
+	ExpenseDate   string   `json:"expense_date"`
+	CategoryID    string   `json:"category_id"`
+	AccountID     string   `json:"account_id"`
+	MerchantID    string   `json:"merchant_id"`
+	Notes         string   `json:"notes"`
+	Tags          []string `json:"tags"`
+	TaxCategory   string   `json:"tax_category"`
+	ProviderTaxID string   `json:"provider_tax_id"`
+	ProviderName  string   `json:"provider_name"`
+	Latitude      *float64 `json:"latitude"`
+	Longitude     *float64 `json:"longitude"`
+	PlaceName     string   `json:"place_name"`
 }
 
 type UpdateExpenseRequest struct {
-	ExpenseID   string   `json:"expense_id"`
-	Amount      float64  `json:"amount,string"`
-	ExpenseDate string   `json:"expense_date"`
-	CategoryID  string   `json:"category_id"`
-	Notes       string   `json:"notes"`
-	Tags        []string `json:"tags"`
+	ExpenseID     string   `json:"expense_id"`
+	Amount        float64  `json:"amount,string"`
+	ExpenseDate   string   `json:"expense_date"`
+	CategoryID    string   `json:"category_id"`
+	AccountID     string   `json:"account_id"`
+	MerchantID    string   `json:"merchant_id"`
+	Notes         string   `json:"notes"`
+	Tags          []string `json:"tags"`
+	TaxCategory   string   `json:"tax_category"`
+	ProviderTaxID string   `json:"provider_tax_id"`
+	ProviderName  string   `json:"provider_name"`
+	Latitude      *float64 `json:"latitude"`
+	Longitude     *float64 `json:"longitude"`
+	PlaceName     string   `json:"place_name"`
+}
+
+type DismissExpenseAnomalyRequest struct {
+	AnomalyID string `json:"anomaly_id"`
 }
 
 type CreateTagRequest struct {
 	Name  string `json:"name"`
 	Color string `json:"color"`
 }
+
+type CreateMerchantRequest struct {
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+}
+
+type UpdateMerchantRequest struct {
+	MerchantID string   `json:"merchant_id"`
+	Name       string   `json:"name"`
+	Aliases    []string `json:"aliases"`
+}
+
+type CreateRuleRequest struct {
+	Name           string                  `json:"name"`
+	Priority       int                     `json:"priority"`
+	StopProcessing bool                    `json:"stop_processing"`
+	Conditions     entities.RuleConditions `json:"conditions"`
+	Actions        entities.RuleActions    `json:"actions"`
+}
+
+type UpdateRuleRequest struct {
+	RuleID         string                  `json:"rule_id"`
+	Name           string                  `json:"name"`
+	Priority       int                     `json:"priority"`
+	StopProcessing bool                    `json:"stop_processing"`
+	Conditions     entities.RuleConditions `json:"conditions"`
+	Actions        entities.RuleActions    `json:"actions"`
+}
+
+type CreateIncomeRequest struct {
+	Amount     float64 `json:"amount,string"`
+	IncomeDate string  `json:"income_date"`
+	CategoryID string  `json:"category_id"`
+	AccountID  string  `json:"account_id"`
+	Notes      string  `json:"notes"`
+}
+
+type UpdateIncomeRequest struct {
+	IncomeID   string  `json:"income_id"`
+	Amount     float64 `json:"amount,string"`
+	IncomeDate string  `json:"income_date"`
+	CategoryID string  `json:"category_id"`
+	AccountID  string  `json:"account_id"`
+	Notes      string  `json:"notes"`
+}
+
+type CreateAccountRequest struct {
+	Name           string  `json:"name"`
+	Type           string  `json:"type"`
+	Currency       string  `json:"currency"`
+	OpeningBalance float64 `json:"opening_balance,string"`
+	ClosingDay     int     `json:"closing_day"`
+	DueDay         int     `json:"due_day"`
+}
+
+type UpdateAccountRequest struct {
+	AccountID      string   `json:"account_id"`
+	Name           string   `json:"name"`
+	Type           string   `json:"type"`
+	Currency       string   `json:"currency"`
+	OpeningBalance *float64 `json:"opening_balance,string,omitempty"`
+	ClosingDay     int      `json:"closing_day"`
+	DueDay         int      `json:"due_day"`
+}
+
+type CreateTransferRequest struct {
+	FromAccountID string  `json:"from_account_id"`
+	ToAccountID   string  `json:"to_account_id"`
+	Amount        float64 `json:"amount,string"`
+	TransferDate  string  `json:"transfer_date"`
+	Notes         string  `json:"notes"`
+}
+
+type CreateGoalRequest struct {
+	Name         string  `json:"name"`
+	TargetAmount float64 `json:"target_amount,string"`
+	Deadline     string  `json:"deadline"`
+	CategoryID   string  `json:"category_id"`
+}
+
+type UpdateGoalRequest struct {
+	GoalID       string  `json:"goal_id"`
+	Name         string  `json:"name"`
+	TargetAmount float64 `json:"target_amount,string,omitempty"`
+	Deadline     string  `json:"deadline"`
+	CategoryID   string  `json:"category_id"`
+}
+
+type CreateGoalContributionRequest struct {
+	GoalID           string  `json:"goal_id"`
+	Amount           float64 `json:"amount,string"`
+	ContributionDate string  `json:"contribution_date"`
+	Notes            string  `json:"notes"`
+}
+
+type CreateInstallmentPurchaseRequest struct {
+	TotalAmount      float64 `json:"total_amount,string"`
+	InstallmentCount int     `json:"installment_count"`
+	FirstDueDate     string  `json:"first_due_date"`
+	RemainderPolicy  string  `json:"remainder_policy"`
+	CategoryID       string  `json:"category_id"`
+	AccountID        string  `json:"account_id"`
+	Notes            string  `json:"notes"`
+}
+
+type UpdateInstallmentPurchaseRequest struct {
+	InstallmentPurchaseID string  `json:"installment_purchase_id"`
+	TotalAmount           float64 `json:"total_amount,string"`
+	InstallmentCount      int     `json:"installment_count"`
+	FirstDueDate          string  `json:"first_due_date"`
+	RemainderPolicy       string  `json:"remainder_policy"`
+	CategoryID            string  `json:"category_id"`
+	AccountID             string  `json:"account_id"`
+	Notes                 string  `json:"notes"`
+}
+
+type CreateWebhookSubscriptionRequest struct {
+	URL        string   `json:"url"`
+	Secret     string   `json:"secret"`
+	EventTypes []string `json:"event_types"`
+}
+
+type UpdateWebhookSubscriptionRequest struct {
+	SubscriptionID string   `json:"subscription_id"`
+	URL            string   `json:"url"`
+	EventTypes     []string `json:"event_types"`
+}
+
+type SendTestWebhookRequest struct {
+	SubscriptionID string `json:"subscription_id"`
+}
+
+type CreateDigestSubscriptionRequest struct {
+	Email     string `json:"email"`
+	Frequency string `json:"frequency"`
+}
+
+type ShareExpenseChartRequest struct {
+	Type        string `json:"type"`
+	Data        string `json:"data"`
+	StartDate   string `json:"start_date"`
+	EndDate     string `json:"end_date"`
+	Granularity string `json:"granularity"`
+	GroupBy     string `json:"group_by"`
+	Basis       string `json:"basis"`
+	Format      string `json:"format"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	Theme       string `json:"theme"`
+	Title       string `json:"title"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+type GraphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+type GraphQLResponse struct {
+	Data   json.RawMessage         `json:"data,omitempty" swaggertype:"object"`
+	Errors []*gqlerrors.QueryError `json:"errors,omitempty" swaggertype:"array,object"`
+}
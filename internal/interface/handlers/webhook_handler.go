@@ -0,0 +1,270 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/factory"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookHandler struct {
+	webhookFactory *factory.WebhookFactory
+}
+
+func NewWebhookHandler(factory *factory.WebhookFactory) *WebhookHandler {
+	return &WebhookHandler{
+		webhookFactory: factory,
+	}
+}
+
+// @Summary      Create a webhook subscription
+// @Description  Subscribe a URL to expense, category and tag events. Event types accept exact names (expense.created) and wildcards (category.*, *). When no secret is given one is generated; it is only returned here and signs every payload in the X-Webhook-Signature header as sha256=<hex HMAC-SHA256 of the body>
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Param        request body CreateWebhookSubscriptionRequest true "Webhook subscription data"
+// @Success      201 {object} usecases.CreateWebhookSubscriptionOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /webhooks [post]
+func (h *WebhookHandler) CreateWebhookSubscription(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request CreateWebhookSubscriptionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.CreateWebhookSubscriptionInputDto{
+		UserID:     userID,
+		URL:        request.URL,
+		Secret:     request.Secret,
+		EventTypes: request.EventTypes,
+	}
+
+	output, errs := h.webhookFactory.CreateWebhookSubscription.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+// @Summary      Get all webhook subscriptions
+// @Description  Retrieve all webhook subscriptions for the authenticated user
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} usecases.GetWebhookSubscriptionsOutputDto
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /webhooks/all [get]
+func (h *WebhookHandler) GetWebhookSubscriptions(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := usecases.GetWebhookSubscriptionsInputDto{
+		UserID: userID,
+	}
+
+	output, errs := h.webhookFactory.GetWebhookSubscriptions.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Update a webhook subscription
+// @Description  Change the URL or the event types of a webhook subscription. Empty fields are left unchanged
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Param        request body UpdateWebhookSubscriptionRequest true "Webhook subscription data"
+// @Success      200 {object} usecases.UpdateWebhookSubscriptionOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      404 {object} util.ProblemDetails "Webhook Subscription Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /webhooks [patch]
+func (h *WebhookHandler) UpdateWebhookSubscription(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request UpdateWebhookSubscriptionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.UpdateWebhookSubscriptionInputDto{
+		UserID:         userID,
+		SubscriptionID: request.SubscriptionID,
+		URL:            request.URL,
+		EventTypes:     request.EventTypes,
+	}
+
+	output, errs := h.webhookFactory.UpdateWebhookSubscription.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Delete a webhook subscription
+// @Description  Delete a webhook subscription and cancel its pending deliveries
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Param        subscription_id query string true "Webhook subscription ID"
+// @Success      200 {object} usecases.DeleteWebhookSubscriptionOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      404 {object} util.ProblemDetails "Webhook Subscription Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /webhooks [delete]
+func (h *WebhookHandler) DeleteWebhookSubscription(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	subscriptionID := c.Query("subscription_id")
+	if subscriptionID == "" {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Missing Subscription ID",
+			Status:   http.StatusBadRequest,
+			Detail:   "Subscription id is required",
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.DeleteWebhookSubscriptionInputDto{
+		UserID:         userID,
+		SubscriptionID: subscriptionID,
+	}
+
+	output, errs := h.webhookFactory.DeleteWebhookSubscription.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Get webhook deliveries
+// @Description  Retrieve the latest 100 webhook deliveries with their status, attempts and last response, optionally for a single subscription
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Param        subscription_id query string false "Webhook subscription ID"
+// @Success      200 {object} usecases.GetWebhookDeliveriesOutputDto
+// @Failure      404 {object} util.ProblemDetails "Webhook Subscription Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /webhooks/deliveries [get]
+func (h *WebhookHandler) GetWebhookDeliveries(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	input := usecases.GetWebhookDeliveriesInputDto{
+		UserID:         userID,
+		SubscriptionID: c.Query("subscription_id"),
+	}
+
+	output, errs := h.webhookFactory.GetWebhookDeliveries.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary      Send a test webhook event
+// @Description  Immediately deliver a webhook.test event to the subscription URL and return the delivery result. Failed test deliveries are retried like any other event
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Param        request body SendTestWebhookRequest true "Webhook subscription"
+// @Success      200 {object} usecases.SendTestWebhookOutputDto
+// @Failure      400 {object} util.ProblemDetails "Bad Request"
+// @Failure      404 {object} util.ProblemDetails "Webhook Subscription Not Found"
+// @Failure      500 {object} util.ProblemDetails "Internal Server Error"
+// @Failure		 401 {object} util.ProblemDetails "Unauthorized"
+// @Security	 BearerAuth
+// @Router       /webhooks/test [post]
+func (h *WebhookHandler) SendTestWebhook(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		abortWithProblem(c, err.Status, *err)
+		return
+	}
+
+	var request SendTestWebhookRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		abortWithProblem(c, http.StatusBadRequest, util.ProblemDetails{
+			Type:     "Bad Request",
+			Title:    "Did not bind JSON",
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Instance: util.RFC400,
+		})
+		return
+	}
+
+	input := usecases.SendTestWebhookInputDto{
+		UserID:         userID,
+		SubscriptionID: request.SubscriptionID,
+	}
+
+	output, errs := h.webhookFactory.SendTestWebhook.Execute(input)
+	if len(errs) > 0 {
+		handleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,92 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetAccountBalancesInputDto struct {
+	UserID string `json:"user_id"`
+	Date   string `json:"date"`
+}
+
+type GetAccountBalancesOutputDto struct {
+	Balances []repositories.AccountBalance `json:"balances"`
+}
+
+type GetAccountBalancesUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetAccountBalancesUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetAccountBalancesUseCase {
+	return &GetAccountBalancesUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+func (c *GetAccountBalancesUseCase) Execute(input GetAccountBalancesInputDto) (GetAccountBalancesOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetAccountBalancesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetAccountBalancesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	date := time.Now()
+	if input.Date != "" {
+		parsedDate, err := util.ParseDate(input.Date, user.Timezone)
+		if err != nil {
+			return GetAccountBalancesOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Bad Request",
+					Title:    "Invalid date",
+					Status:   400,
+					Detail:   "Date is not in the correct format",
+					Instance: util.RFC400,
+				},
+			}
+		}
+
+		date = parsedDate.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	balances, err := c.PresentersRepository.GetAccountBalances(input.UserID, date)
+	if err != nil {
+		return GetAccountBalancesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not calculate account balances",
+				Status:   500,
+				Detail:   err.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetAccountBalancesOutputDto{
+		Balances: balances,
+	}, nil
+}
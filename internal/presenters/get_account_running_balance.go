@@ -0,0 +1,124 @@
+package presenters
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetAccountRunningBalanceInputDto struct {
+	UserID    string `json:"user_id"`
+	AccountID string `json:"account_id"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+type GetAccountRunningBalanceOutputDto struct {
+	RunningBalance repositories.AccountRunningBalance `json:"running_balance"`
+}
+
+type GetAccountRunningBalanceUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetAccountRunningBalanceUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetAccountRunningBalanceUseCase {
+	return &GetAccountRunningBalanceUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+func (c *GetAccountRunningBalanceUseCase) Execute(input GetAccountRunningBalanceInputDto) (GetAccountRunningBalanceOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetAccountRunningBalanceOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetAccountRunningBalanceOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	startDate, err := util.ParseDate(input.StartDate, user.Timezone)
+	if err != nil {
+		return GetAccountRunningBalanceOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid start date",
+				Status:   400,
+				Detail:   "Start date is not in the correct format",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	endDate, err := util.ParseDate(input.EndDate, user.Timezone)
+	if err != nil {
+		return GetAccountRunningBalanceOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid end date",
+				Status:   400,
+				Detail:   "End date is not in the correct format",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	if startDate.After(endDate) {
+		return GetAccountRunningBalanceOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid date range",
+				Status:   400,
+				Detail:   "Start date must be before end date",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	runningBalance, err := c.PresentersRepository.GetAccountRunningBalance(input.UserID, input.AccountID, startDate, endDate)
+	if err != nil {
+		if err.Error() == "account not found" {
+			return GetAccountRunningBalanceOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Not Found",
+					Title:    "Account not found",
+					Status:   404,
+					Detail:   err.Error(),
+					Instance: util.RFC404,
+				},
+			}
+		}
+
+		return GetAccountRunningBalanceOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not calculate account running balance",
+				Status:   500,
+				Detail:   err.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetAccountRunningBalanceOutputDto{
+		RunningBalance: runningBalance,
+	}, nil
+}
@@ -1,12 +1,16 @@
 package presenters
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
 )
 
 type GetAvailableMonthsYearsInputDto struct {
 	UserID string `json:"user_id"`
+	Locale string `json:"locale"`
 }
 
 type GetAvailableMonthsYearsOutputDto struct {
@@ -68,6 +72,18 @@ func (c *GetAvailableMonthsYearsUseCase) Execute(input GetAvailableMonthsYearsIn
 
 	return GetAvailableMonthsYearsOutputDto{
 		AvailableYears:  availableYears,
-		AvailableMonths: availableMonths,
+		AvailableMonths: localizeMonthOptions(availableMonths, input.Locale),
 	}, nil
 }
+
+// localizeMonthOptions relabels the options from their numeric value, which
+// stays the same in every locale.
+func localizeMonthOptions(options []repositories.MonthOption, locale string) []repositories.MonthOption {
+	for i := range options {
+		if month, err := strconv.Atoi(options[i].Value); err == nil {
+			options[i].Label = util.MonthName(time.Month(month), locale)
+		}
+	}
+
+	return options
+}
@@ -0,0 +1,116 @@
+package presenters
+
+import (
+	"strconv"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetCardStatementsInputDto struct {
+	UserID    string `json:"user_id"`
+	AccountID string `json:"account_id"`
+	Year      string `json:"year"`
+}
+
+type GetCardStatementsOutputDto struct {
+	CardStatements repositories.CardStatements `json:"card_statements"`
+}
+
+type GetCardStatementsUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetCardStatementsUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetCardStatementsUseCase {
+	return &GetCardStatementsUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+func (c *GetCardStatementsUseCase) Execute(input GetCardStatementsInputDto) (GetCardStatementsOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetCardStatementsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetCardStatementsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	year := util.Now(user.Timezone).Year()
+	if input.Year != "" {
+		parsedYear, errYear := strconv.Atoi(input.Year)
+		if errYear != nil || parsedYear < 1900 || parsedYear > 9999 {
+			return GetCardStatementsOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Bad Request",
+					Title:    "Invalid year",
+					Status:   400,
+					Detail:   "Year must be between 1900 and 9999",
+					Instance: util.RFC400,
+				},
+			}
+		}
+
+		year = parsedYear
+	}
+
+	cardStatements, err := c.PresentersRepository.GetCardStatements(input.UserID, input.AccountID, year)
+	if err != nil {
+		switch err.Error() {
+		case "account not found":
+			return GetCardStatementsOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Not Found",
+					Title:    "Account not found",
+					Status:   404,
+					Detail:   err.Error(),
+					Instance: util.RFC404,
+				},
+			}
+		case "account is not a credit card":
+			return GetCardStatementsOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Bad Request",
+					Title:    "Invalid account",
+					Status:   400,
+					Detail:   err.Error(),
+					Instance: util.RFC400,
+				},
+			}
+		}
+
+		return GetCardStatementsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not calculate card statements",
+				Status:   500,
+				Detail:   err.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetCardStatementsOutputDto{
+		CardStatements: cardStatements,
+	}, nil
+}
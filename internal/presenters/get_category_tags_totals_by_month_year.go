@@ -12,6 +12,7 @@ type GetCategoryTagsTotalsByMonthYearInputDto struct {
 	UserID string `json:"user_id"`
 	Month  string `json:"month"`
 	Year   string `json:"year"`
+	Locale string `json:"locale"`
 }
 
 type GetCategoryTagsTotalsByMonthYearOutputDto struct {
@@ -95,7 +96,7 @@ func (c *GetCategoryTagsTotalsByMonthYearUseCase) Execute(input GetCategoryTagsT
 		}
 	}
 
-	if year > time.Now().Year() {
+	if year > util.Now(user.Timezone).Year() {
 		return GetCategoryTagsTotalsByMonthYearOutputDto{}, []util.ProblemDetails{
 			{
 				Type:     "Bad Request",
@@ -120,6 +121,9 @@ func (c *GetCategoryTagsTotalsByMonthYearUseCase) Execute(input GetCategoryTagsT
 		}
 	}
 
+	expenses.Month = util.MonthName(time.Month(expenses.MonthNumber), input.Locale)
+	expenses.AvailableMonths = localizeMonthOptions(expenses.AvailableMonths, input.Locale)
+
 	return GetCategoryTagsTotalsByMonthYearOutputDto{
 		Expenses: expenses,
 	}, nil
@@ -0,0 +1,98 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetCommittedInstallmentsByMonthInputDto struct {
+	UserID string `json:"user_id"`
+	Locale string `json:"locale"`
+}
+
+type GetCommittedInstallmentsByMonthOutputDto struct {
+	Months []repositories.MonthCommittedInstallments `json:"months"`
+	Total  float64                                   `json:"total"`
+}
+
+type GetCommittedInstallmentsByMonthUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetCommittedInstallmentsByMonthUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetCommittedInstallmentsByMonthUseCase {
+	return &GetCommittedInstallmentsByMonthUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+func (c *GetCommittedInstallmentsByMonthUseCase) Execute(input GetCommittedInstallmentsByMonthInputDto) (GetCommittedInstallmentsByMonthOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetCommittedInstallmentsByMonthOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetCommittedInstallmentsByMonthOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	location, err := util.LoadLocation(user.Timezone)
+	if err != nil {
+		return GetCommittedInstallmentsByMonthOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not load timezone",
+				Status:   500,
+				Detail:   err.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	now := time.Now().In(location)
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, location)
+
+	months, err := c.PresentersRepository.GetCommittedInstallmentsByMonth(input.UserID, from)
+	if err != nil {
+		return GetCommittedInstallmentsByMonthOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not calculate committed installments",
+				Status:   500,
+				Detail:   err.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	total := 0.0
+	for i := range months {
+		months[i].Month = util.MonthName(time.Month(months[i].MonthNumber), input.Locale)
+		total += months[i].Total
+	}
+
+	return GetCommittedInstallmentsByMonthOutputDto{
+		Months: months,
+		Total:  total,
+	}, nil
+}
@@ -19,6 +19,7 @@ type GetDayToDayExpensesPeriodInputDto struct {
 	UserID    string `json:"user_id"`
 	StartDate string `json:"start_date"`
 	EndDate   string `json:"end_date"`
+	Locale    string `json:"locale"`
 }
 
 type GetDayToDayExpensesPeriodOutputDto struct {
@@ -64,7 +65,7 @@ func (c *GetDayToDayExpensesPeriodUseCase) Execute(input GetDayToDayExpensesPeri
 		}
 	}
 
-	startDate, err := util.ParseDate(input.StartDate)
+	startDate, err := util.ParseDate(input.StartDate, user.Timezone)
 	if err != nil {
 		return GetDayToDayExpensesPeriodOutputDto{}, []util.ProblemDetails{
 			{
@@ -77,7 +78,7 @@ func (c *GetDayToDayExpensesPeriodUseCase) Execute(input GetDayToDayExpensesPeri
 		}
 	}
 
-	endDate, err := util.ParseDate(input.EndDate)
+	endDate, err := util.ParseDate(input.EndDate, user.Timezone)
 	if err != nil {
 		return GetDayToDayExpensesPeriodOutputDto{}, []util.ProblemDetails{
 			{
@@ -118,11 +119,13 @@ func (c *GetDayToDayExpensesPeriodUseCase) Execute(input GetDayToDayExpensesPeri
 	var dayToDayExpenses []DayToDayExpense
 
 	for _, expense := range expenses {
+		expenseDate := expense.ExpenseDate.In(startDate.Location())
+
 		dayToDayExpenses = append(dayToDayExpenses, DayToDayExpense{
-			Day:     expense.ExpenseDate.Format("02"),
-			DayName: expense.ExpenseDate.Weekday().String(),
-			Month:   expense.ExpenseDate.Month().String(),
-			Year:    strconv.Itoa(expense.ExpenseDate.Year()),
+			Day:     expenseDate.Format("02"),
+			DayName: util.WeekdayName(expenseDate.Weekday(), input.Locale),
+			Month:   util.MonthName(expenseDate.Month(), input.Locale),
+			Year:    strconv.Itoa(expenseDate.Year()),
 			Amount:  expense.Amount,
 		})
 	}
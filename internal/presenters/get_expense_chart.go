@@ -0,0 +1,409 @@
+package presenters
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+const (
+	CHART_DATA_CATEGORIES = "categories"
+	CHART_DATA_TAGS       = "tags"
+	CHART_DATA_SERIES     = "series"
+)
+
+const (
+	CHART_DEFAULT_WIDTH  = 600
+	CHART_DEFAULT_HEIGHT = 400
+	CHART_MIN_SIZE       = 200
+	CHART_MAX_SIZE       = 2000
+	// CHART_MAX_ENTRIES caps the slices of a pie and the series of a chart;
+	// the smallest ones are merged into "Others" so the legend stays readable.
+	CHART_MAX_ENTRIES = 10
+)
+
+type GetExpenseChartInputDto struct {
+	UserID      string `json:"user_id"`
+	Type        string `json:"type"`
+	Data        string `json:"data"`
+	StartDate   string `json:"start_date"`
+	EndDate     string `json:"end_date"`
+	Granularity string `json:"granularity"`
+	GroupBy     string `json:"group_by"`
+	Basis       string `json:"basis"`
+	Format      string `json:"format"`
+	Width       string `json:"width"`
+	Height      string `json:"height"`
+	Theme       string `json:"theme"`
+	Title       string `json:"title"`
+	Locale      string `json:"locale"`
+}
+
+type GetExpenseChartOutputDto struct {
+	ContentType string `json:"content_type"`
+	Content     []byte `json:"content"`
+}
+
+type GetExpenseChartUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetExpenseChartUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetExpenseChartUseCase {
+	return &GetExpenseChartUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+func (c *GetExpenseChartUseCase) Execute(input GetExpenseChartInputDto) (GetExpenseChartOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetExpenseChartOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetExpenseChartOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	input, problems := normalizeChartInput(input)
+	if len(problems) > 0 {
+		return GetExpenseChartOutputDto{}, problems
+	}
+
+	startDate, err := util.ParseDate(input.StartDate, user.Timezone)
+	if err != nil {
+		return GetExpenseChartOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid start date",
+				Status:   400,
+				Detail:   "Start date is not in the correct format",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	endDate, err := util.ParseDate(input.EndDate, user.Timezone)
+	if err != nil {
+		return GetExpenseChartOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid end date",
+				Status:   400,
+				Detail:   "End date is not in the correct format",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	if startDate.After(endDate) {
+		return GetExpenseChartOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid date range",
+				Status:   400,
+				Detail:   "Start date must be before end date",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	if input.Data == CHART_DATA_SERIES && timeSeriesBucketCount(startDate, endDate, input.Granularity, user.FirstDayOfWeek()) > TIME_SERIES_MAX_BUCKETS {
+		return GetExpenseChartOutputDto{}, []util.ProblemDetails{timeSeriesRangeTooLong()}
+	}
+
+	basis, basisErr := parseExpenseBasis(input.Basis)
+	if len(basisErr) > 0 {
+		return GetExpenseChartOutputDto{}, basisErr
+	}
+
+	width, _ := strconv.Atoi(input.Width)
+	height, _ := strconv.Atoi(input.Height)
+
+	chart := util.Chart{
+		Type:       input.Type,
+		Title:      input.Title,
+		Width:      width,
+		Height:     height,
+		Theme:      input.Theme,
+		EmptyLabel: util.Translate(input.Locale, "No expenses in this period"),
+	}
+
+	endDate = endDate.AddDate(0, 0, 1).Add(-time.Nanosecond)
+
+	switch input.Data {
+	case CHART_DATA_CATEGORIES:
+		expenses, err := c.PresentersRepository.GetExpensesByCategoryPeriod(input.UserID, startDate, endDate, basis)
+		if err != nil {
+			return GetExpenseChartOutputDto{}, chartError(err)
+		}
+
+		values := []float64{}
+		for _, expense := range expenses {
+			chart.Labels = append(chart.Labels, expense.CategoryName)
+			chart.Colors = append(chart.Colors, expense.CategoryColor)
+			values = append(values, expense.Total)
+		}
+		chart.Series = []util.ChartSeries{{Name: util.Translate(input.Locale, "Total"), Values: values}}
+	case CHART_DATA_TAGS:
+		expenses, err := c.PresentersRepository.GetExpensesByTagPeriod(input.UserID, startDate, endDate, basis)
+		if err != nil {
+			return GetExpenseChartOutputDto{}, chartError(err)
+		}
+
+		values := []float64{}
+		for _, expense := range expenses {
+			chart.Labels = append(chart.Labels, expense.TagName)
+			chart.Colors = append(chart.Colors, expense.TagColor)
+			values = append(values, expense.Total)
+		}
+		chart.Series = []util.ChartSeries{{Name: util.Translate(input.Locale, "Total"), Values: values}}
+	case CHART_DATA_SERIES:
+		series, err := c.PresentersRepository.GetTimeSeries(input.UserID, repositories.TimeSeriesQuery{
+			StartDate:   startDate,
+			EndDate:     endDate,
+			Granularity: input.Granularity,
+			GroupBy:     input.GroupBy,
+			WeekStart:   user.FirstDayOfWeek(),
+			Basis:       basis,
+		})
+		if err != nil {
+			return GetExpenseChartOutputDto{}, chartError(err)
+		}
+
+		totals := []float64{}
+		for _, bucket := range series.Buckets {
+			chart.Labels = append(chart.Labels, timeSeriesLabel(bucket.StartDate, input.Granularity, input.Locale))
+			totals = append(totals, bucket.Total)
+		}
+
+		if input.GroupBy == "" {
+			chart.Series = []util.ChartSeries{{Name: util.Translate(input.Locale, "Total"), Values: totals}}
+		}
+
+		for _, group := range series.Groups {
+			chart.Series = append(chart.Series, util.ChartSeries{
+				Name:   timeSeriesGroupName(group, input.GroupBy, input.Locale),
+				Color:  group.Color,
+				Values: group.Totals,
+			})
+		}
+	}
+
+	chart = capChartEntries(chart, util.Translate(input.Locale, "Others"))
+
+	if input.Format == util.CHART_FORMAT_PNG {
+		content, err := util.RenderChartPNG(chart)
+		if err != nil {
+			return GetExpenseChartOutputDto{}, chartError(err)
+		}
+
+		return GetExpenseChartOutputDto{ContentType: "image/png", Content: content}, nil
+	}
+
+	return GetExpenseChartOutputDto{ContentType: "image/svg+xml", Content: util.RenderChartSVG(chart)}, nil
+}
+
+// normalizeChartInput validates the chart options and fills in the defaults,
+// so that a chart and its share link are built from the same values.
+func normalizeChartInput(input GetExpenseChartInputDto) (GetExpenseChartInputDto, []util.ProblemDetails) {
+	switch input.Type {
+	case "":
+		input.Type = util.CHART_BAR
+	case util.CHART_BAR, util.CHART_LINE, util.CHART_PIE, util.CHART_STACKED:
+	default:
+		return input, invalidChartOption("Invalid chart type", "Chart type must be bar, line, pie or stacked")
+	}
+
+	switch input.Data {
+	case "":
+		input.Data = CHART_DATA_CATEGORIES
+	case CHART_DATA_CATEGORIES, CHART_DATA_TAGS:
+	case CHART_DATA_SERIES:
+		switch input.Granularity {
+		case "":
+			input.Granularity = repositories.TIME_SERIES_MONTH
+		case repositories.TIME_SERIES_DAY, repositories.TIME_SERIES_WEEK, repositories.TIME_SERIES_MONTH, repositories.TIME_SERIES_QUARTER, repositories.TIME_SERIES_YEAR:
+		default:
+			return input, invalidChartOption("Invalid granularity", "Granularity must be day, week, month, quarter or year")
+		}
+
+		switch input.GroupBy {
+		case "", repositories.TIME_SERIES_GROUP_CATEGORY, repositories.TIME_SERIES_GROUP_TAG, repositories.TIME_SERIES_GROUP_ACCOUNT, repositories.TIME_SERIES_GROUP_WEEKDAY:
+		default:
+			return input, invalidChartOption("Invalid group by", "Group by must be category, tag, account or weekday")
+		}
+	default:
+		return input, invalidChartOption("Invalid chart data", "Chart data must be categories, tags or series")
+	}
+
+	if input.Data != CHART_DATA_SERIES {
+		input.Granularity = ""
+		input.GroupBy = ""
+	}
+
+	switch input.Format {
+	case "":
+		input.Format = util.CHART_FORMAT_SVG
+	case util.CHART_FORMAT_SVG, util.CHART_FORMAT_PNG:
+	default:
+		return input, invalidChartOption("Invalid chart format", "Chart format must be svg or png")
+	}
+
+	switch input.Theme {
+	case "":
+		input.Theme = util.CHART_THEME_LIGHT
+	case util.CHART_THEME_LIGHT, util.CHART_THEME_DARK:
+	default:
+		return input, invalidChartOption("Invalid chart theme", "Chart theme must be light or dark")
+	}
+
+	sizes := []*string{&input.Width, &input.Height}
+	for i, defaultSize := range []int{CHART_DEFAULT_WIDTH, CHART_DEFAULT_HEIGHT} {
+		if *sizes[i] == "" {
+			*sizes[i] = strconv.Itoa(defaultSize)
+			continue
+		}
+
+		size, err := strconv.Atoi(*sizes[i])
+		if err != nil || size < CHART_MIN_SIZE || size > CHART_MAX_SIZE {
+			return input, invalidChartOption("Invalid chart size", "Chart width and height must be between "+strconv.Itoa(CHART_MIN_SIZE)+" and "+strconv.Itoa(CHART_MAX_SIZE)+" pixels")
+		}
+		*sizes[i] = strconv.Itoa(size)
+	}
+
+	return input, nil
+}
+
+// chartQuery encodes the chart options, without the user, for a share link.
+func chartQuery(input GetExpenseChartInputDto) url.Values {
+	query := url.Values{}
+
+	for key, value := range map[string]string{
+		"type":        input.Type,
+		"data":        input.Data,
+		"start_date":  input.StartDate,
+		"end_date":    input.EndDate,
+		"granularity": input.Granularity,
+		"group_by":    input.GroupBy,
+		"basis":       input.Basis,
+		"format":      input.Format,
+		"width":       input.Width,
+		"height":      input.Height,
+		"theme":       input.Theme,
+		"title":       input.Title,
+		"locale":      input.Locale,
+	} {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+
+	return query
+}
+
+// capChartEntries merges the smallest pie slices, or the smallest series,
+// into one "Others" entry when there are more than CHART_MAX_ENTRIES.
+func capChartEntries(chart util.Chart, othersLabel string) util.Chart {
+	if chart.Type == util.CHART_PIE && len(chart.Series) == 1 && len(chart.Labels) > CHART_MAX_ENTRIES {
+		order := make([]int, len(chart.Labels))
+		for i := range order {
+			order[i] = i
+		}
+
+		values := chart.Series[0].Values
+		sort.SliceStable(order, func(a, b int) bool { return values[order[a]] > values[order[b]] })
+
+		capped := chart
+		capped.Labels, capped.Colors = nil, nil
+		cappedValues := []float64{}
+		others := 0.0
+
+		for rank, i := range order {
+			if rank < CHART_MAX_ENTRIES-1 {
+				capped.Labels = append(capped.Labels, chart.Labels[i])
+				capped.Colors = append(capped.Colors, chart.Colors[i])
+				cappedValues = append(cappedValues, values[i])
+			} else {
+				others += values[i]
+			}
+		}
+
+		capped.Labels = append(capped.Labels, othersLabel)
+		capped.Colors = append(capped.Colors, "")
+		capped.Series = []util.ChartSeries{{Name: chart.Series[0].Name, Values: append(cappedValues, others)}}
+
+		return capped
+	}
+
+	if len(chart.Series) > CHART_MAX_ENTRIES {
+		series := append([]util.ChartSeries{}, chart.Series...)
+		total := func(s util.ChartSeries) float64 {
+			sum := 0.0
+			for _, value := range s.Values {
+				sum += value
+			}
+			return sum
+		}
+		sort.SliceStable(series, func(a, b int) bool { return total(series[a]) > total(series[b]) })
+
+		others := util.ChartSeries{Name: othersLabel, Values: make([]float64, len(chart.Labels))}
+		for _, s := range series[CHART_MAX_ENTRIES-1:] {
+			for i := range others.Values {
+				if i < len(s.Values) {
+					others.Values[i] += s.Values[i]
+				}
+			}
+		}
+
+		chart.Series = append(series[:CHART_MAX_ENTRIES-1:CHART_MAX_ENTRIES-1], others)
+	}
+
+	return chart
+}
+
+func invalidChartOption(title string, detail string) []util.ProblemDetails {
+	return []util.ProblemDetails{
+		{
+			Type:     "Bad Request",
+			Title:    title,
+			Status:   400,
+			Detail:   detail,
+			Instance: util.RFC400,
+		},
+	}
+}
+
+func chartError(err error) []util.ProblemDetails {
+	return []util.ProblemDetails{
+		{
+			Type:     "Internal Server Error",
+			Title:    "Could not render chart",
+			Status:   500,
+			Detail:   err.Error(),
+			Instance: util.RFC500,
+		},
+	}
+}
@@ -0,0 +1,151 @@
+package presenters
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+const (
+	CLUSTER_DEFAULT_PRECISION = 5
+	CLUSTER_MAX_PRECISION     = 9
+)
+
+type GetExpenseClustersInputDto struct {
+	Locations GetExpenseLocationsInputDto `json:"locations"`
+	Precision string                      `json:"precision"`
+}
+
+// ExpenseCluster gathers the expenses of one geohash cell. Latitude and
+// Longitude are the mean of its expenses, which sits where the spending is
+// rather than in the middle of the cell.
+type ExpenseCluster struct {
+	Geohash   string                   `json:"geohash"`
+	Latitude  float64                  `json:"latitude"`
+	Longitude float64                  `json:"longitude"`
+	Bounds    repositories.BoundingBox `json:"bounds"`
+	PlaceName string                   `json:"place_name"`
+	Total     float64                  `json:"total"`
+	Count     int                      `json:"count"`
+}
+
+type GetExpenseClustersOutputDto struct {
+	Precision int              `json:"precision"`
+	Total     float64          `json:"total"`
+	Count     int              `json:"count"`
+	Clusters  []ExpenseCluster `json:"clusters"`
+}
+
+type GetExpenseClustersUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetExpenseClustersUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetExpenseClustersUseCase {
+	return &GetExpenseClustersUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+// Execute groups the geotagged expenses by geohash cell, largest total first.
+// The precision is the geohash length: 3 gives cells of about 150 km, 5 of
+// about 5 km and 7 of about 150 m.
+func (c *GetExpenseClustersUseCase) Execute(input GetExpenseClustersInputDto) (GetExpenseClustersOutputDto, []util.ProblemDetails) {
+	precision := CLUSTER_DEFAULT_PRECISION
+	if input.Precision != "" {
+		var err error
+		precision, err = strconv.Atoi(input.Precision)
+		if err != nil || precision < 1 || precision > CLUSTER_MAX_PRECISION {
+			return GetExpenseClustersOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Bad Request",
+					Title:    "Invalid precision",
+					Status:   400,
+					Detail:   "Precision must be between 1 and " + strconv.Itoa(CLUSTER_MAX_PRECISION),
+					Instance: util.RFC400,
+				},
+			}
+		}
+	}
+
+	locations, problems := NewGetExpenseLocationsUseCase(c.PresentersRepository, c.UserRepository).Execute(input.Locations)
+	if len(problems) > 0 {
+		return GetExpenseClustersOutputDto{}, problems
+	}
+
+	output := GetExpenseClustersOutputDto{
+		Precision: precision,
+		Total:     locations.Total,
+		Count:     locations.Count,
+		Clusters:  []ExpenseCluster{},
+	}
+
+	clusters := map[string]*ExpenseCluster{}
+	places := map[string]map[string]int{}
+
+	for _, expense := range locations.Expenses {
+		hash := util.EncodeGeohash(expense.Latitude, expense.Longitude, precision)
+
+		cluster, ok := clusters[hash]
+		if !ok {
+			minLatitude, minLongitude, maxLatitude, maxLongitude := util.GeohashBounds(hash)
+			cluster = &ExpenseCluster{
+				Geohash: hash,
+				Bounds: repositories.BoundingBox{
+					MinLatitude:  minLatitude,
+					MinLongitude: minLongitude,
+					MaxLatitude:  maxLatitude,
+					MaxLongitude: maxLongitude,
+				},
+			}
+			clusters[hash] = cluster
+			places[hash] = map[string]int{}
+		}
+
+		cluster.Latitude += expense.Latitude
+		cluster.Longitude += expense.Longitude
+		cluster.Total += expense.Amount
+		cluster.Count++
+
+		if expense.PlaceName != "" {
+			places[hash][expense.PlaceName]++
+		}
+	}
+
+	for hash, cluster := range clusters {
+		cluster.Latitude /= float64(cluster.Count)
+		cluster.Longitude /= float64(cluster.Count)
+		cluster.PlaceName = mostFrequentPlace(places[hash])
+
+		output.Clusters = append(output.Clusters, *cluster)
+	}
+
+	sort.Slice(output.Clusters, func(i, j int) bool {
+		if output.Clusters[i].Total != output.Clusters[j].Total {
+			return output.Clusters[i].Total > output.Clusters[j].Total
+		}
+		return output.Clusters[i].Geohash < output.Clusters[j].Geohash
+	})
+
+	return output, nil
+}
+
+// mostFrequentPlace names a cluster after its most used place name, breaking
+// ties alphabetically so the name does not change between requests.
+func mostFrequentPlace(places map[string]int) string {
+	name, count := "", 0
+
+	for place, placeCount := range places {
+		if placeCount > count || (placeCount == count && place < name) {
+			name, count = place, placeCount
+		}
+	}
+
+	return name
+}
@@ -0,0 +1,180 @@
+package presenters
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetExpenseLocationsInputDto struct {
+	UserID      string `json:"user_id"`
+	StartDate   string `json:"start_date"`
+	EndDate     string `json:"end_date"`
+	BoundingBox string `json:"bbox"`
+}
+
+type GetExpenseLocationsOutputDto struct {
+	Total       float64                         `json:"total"`
+	Count       int                             `json:"count"`
+	BoundingBox *repositories.BoundingBox       `json:"bbox,omitempty"`
+	Expenses    []repositories.GeotaggedExpense `json:"expenses"`
+}
+
+type GetExpenseLocationsUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetExpenseLocationsUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetExpenseLocationsUseCase {
+	return &GetExpenseLocationsUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+// Execute lists the expenses of the period that have a location, optionally
+// only those inside a bounding box.
+func (c *GetExpenseLocationsUseCase) Execute(input GetExpenseLocationsInputDto) (GetExpenseLocationsOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetExpenseLocationsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetExpenseLocationsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	startDate, err := util.ParseDate(input.StartDate, user.Timezone)
+	if err != nil {
+		return GetExpenseLocationsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid start date",
+				Status:   400,
+				Detail:   "Start date is not in the correct format",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	endDate, err := util.ParseDate(input.EndDate, user.Timezone)
+	if err != nil {
+		return GetExpenseLocationsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid end date",
+				Status:   400,
+				Detail:   "End date is not in the correct format",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	if startDate.After(endDate) {
+		return GetExpenseLocationsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid date range",
+				Status:   400,
+				Detail:   "Start date must be before end date",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	box, boxErr := parseBoundingBox(input.BoundingBox)
+	if len(boxErr) > 0 {
+		return GetExpenseLocationsOutputDto{}, boxErr
+	}
+
+	expenses, err := c.PresentersRepository.GetGeotaggedExpenses(input.UserID, startDate, endDate.AddDate(0, 0, 1).Add(-time.Nanosecond), box)
+	if err != nil {
+		return GetExpenseLocationsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not fetch expense locations",
+				Status:   500,
+				Detail:   err.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	output := GetExpenseLocationsOutputDto{
+		Count:       len(expenses),
+		BoundingBox: box,
+		Expenses:    expenses,
+	}
+
+	for _, expense := range expenses {
+		output.Total += expense.Amount
+	}
+
+	return output, nil
+}
+
+// parseBoundingBox reads a box in GeoJSON order: "min_longitude,min_latitude,
+// max_longitude,max_latitude". An empty value means no box.
+func parseBoundingBox(value string) (*repositories.BoundingBox, []util.ProblemDetails) {
+	if value == "" {
+		return nil, nil
+	}
+
+	invalid := []util.ProblemDetails{
+		{
+			Type:     "Bad Request",
+			Title:    "Invalid bounding box",
+			Status:   400,
+			Detail:   "Bounding box must be min_longitude,min_latitude,max_longitude,max_latitude",
+			Instance: util.RFC400,
+		},
+	}
+
+	parts := strings.Split(value, ",")
+	if len(parts) != 4 {
+		return nil, invalid
+	}
+
+	var corners [4]float64
+	for i, part := range parts {
+		corner, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return nil, invalid
+		}
+		corners[i] = corner
+	}
+
+	box := repositories.BoundingBox{
+		MinLongitude: corners[0],
+		MinLatitude:  corners[1],
+		MaxLongitude: corners[2],
+		MaxLatitude:  corners[3],
+	}
+
+	if !util.IsValidLongitude(box.MinLongitude) || !util.IsValidLongitude(box.MaxLongitude) ||
+		!util.IsValidLatitude(box.MinLatitude) || !util.IsValidLatitude(box.MaxLatitude) || box.MinLatitude > box.MaxLatitude {
+		return nil, invalid
+	}
+
+	return &box, nil
+}
@@ -0,0 +1,429 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+const (
+	REPORT_MARGIN          = 40.0
+	REPORT_TEXT_COLOR      = "#333333"
+	REPORT_MUTED_COLOR     = "#777777"
+	REPORT_RULE_COLOR      = "#DDDDDD"
+	REPORT_BAR_COLOR       = "#4A90D9"
+	REPORT_MAX_CHART_ITEMS = 12
+)
+
+type GetExpenseReportPDFInputDto struct {
+	UserID string `json:"user_id"`
+	Year   string `json:"year"`
+	Month  string `json:"month"`
+	Locale string `json:"locale"`
+}
+
+type GetExpenseReportPDFOutputDto struct {
+	FileName string `json:"file_name"`
+	Content  []byte `json:"content"`
+}
+
+type GetExpenseReportPDFUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetExpenseReportPDFUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetExpenseReportPDFUseCase {
+	return &GetExpenseReportPDFUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+// Execute renders the report of a month, or of a whole year when no month is
+// given: headline totals, category and tag breakdowns, the week-by-week (or
+// month-by-month) totals and every expense of the period.
+func (c *GetExpenseReportPDFUseCase) Execute(input GetExpenseReportPDFInputDto) (GetExpenseReportPDFOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetExpenseReportPDFOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetExpenseReportPDFOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	now := util.Now(user.Timezone)
+
+	year, errYear := strconv.Atoi(input.Year)
+	if errYear != nil {
+		return GetExpenseReportPDFOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid year",
+				Status:   400,
+				Detail:   errYear.Error(),
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	if year < 1900 || year > now.Year() {
+		return GetExpenseReportPDFOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid year",
+				Status:   400,
+				Detail:   "Year must be less than or equal to the current year",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	month := 0
+	if input.Month != "" {
+		month, err = strconv.Atoi(input.Month)
+		if err != nil || month < 1 || month > 12 {
+			return GetExpenseReportPDFOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Bad Request",
+					Title:    "Invalid month",
+					Status:   400,
+					Detail:   "Month must be between 1 and 12",
+					Instance: util.RFC400,
+				},
+			}
+		}
+	}
+
+	startDate := time.Date(year, time.January, 1, 0, 0, 0, 0, now.Location())
+	endDate := startDate.AddDate(1, 0, 0).Add(-time.Nanosecond)
+	period := strconv.Itoa(year)
+	fileName := "expenses-" + strconv.Itoa(year) + ".pdf"
+
+	if month > 0 {
+		startDate = time.Date(year, time.Month(month), 1, 0, 0, 0, 0, now.Location())
+		endDate = startDate.AddDate(0, 1, 0).Add(-time.Nanosecond)
+		period = util.MonthName(time.Month(month), input.Locale) + " " + strconv.Itoa(year)
+		fileName = "expenses-" + startDate.Format("2006-01") + ".pdf"
+	}
+
+	reportErr := func(err error) []util.ProblemDetails {
+		return []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not generate report",
+				Status:   500,
+				Detail:   err.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	total, err := c.PresentersRepository.GetTotalExpensesForPeriod(input.UserID, startDate, endDate, repositories.EXPENSE_BASIS_PURCHASE)
+	if err != nil {
+		return GetExpenseReportPDFOutputDto{}, reportErr(err)
+	}
+
+	categories, err := c.PresentersRepository.GetExpensesByCategoryPeriod(input.UserID, startDate, endDate, repositories.EXPENSE_BASIS_PURCHASE)
+	if err != nil {
+		return GetExpenseReportPDFOutputDto{}, reportErr(err)
+	}
+
+	tags, err := c.PresentersRepository.GetExpensesByTagPeriod(input.UserID, startDate, endDate, repositories.EXPENSE_BASIS_PURCHASE)
+	if err != nil {
+		return GetExpenseReportPDFOutputDto{}, reportErr(err)
+	}
+
+	items, err := c.PresentersRepository.GetExpenseItemsForPeriod(input.UserID, startDate, endDate)
+	if err != nil {
+		return GetExpenseReportPDFOutputDto{}, reportErr(err)
+	}
+
+	for i := range items {
+		items[i].ExpenseDate = items[i].ExpenseDate.In(now.Location())
+	}
+
+	var periodBars []reportBar
+	periodTitle := "Months"
+
+	if month > 0 {
+		periodTitle = "Weeks"
+
+		monthExpenses, err := c.PresentersRepository.GetExpensesByMonthYear(input.UserID, month, year, user.FirstDayOfWeek())
+		if err != nil {
+			return GetExpenseReportPDFOutputDto{}, reportErr(err)
+		}
+
+		for _, week := range monthExpenses.Weeks {
+			var weekTotal float64
+			for _, day := range week.Days {
+				weekTotal += day.Total
+			}
+
+			periodBars = append(periodBars, reportBar{
+				Label: util.Translate(input.Locale, "Week") + " " + strconv.Itoa(week.Week) + "  " + week.StartDate + " - " + week.EndDate,
+				Total: weekTotal,
+			})
+		}
+	} else {
+		series, err := c.PresentersRepository.GetTimeSeries(input.UserID, repositories.TimeSeriesQuery{
+			StartDate:   startDate,
+			EndDate:     endDate,
+			Granularity: repositories.TIME_SERIES_MONTH,
+			Basis:       repositories.EXPENSE_BASIS_PURCHASE,
+		})
+		if err != nil {
+			return GetExpenseReportPDFOutputDto{}, reportErr(err)
+		}
+
+		for _, bucket := range series.Buckets {
+			periodBars = append(periodBars, reportBar{
+				Label: util.MonthName(bucket.StartDate.Month(), input.Locale),
+				Total: bucket.Total,
+			})
+		}
+	}
+
+	elapsedEnd := endDate
+	if now.Before(elapsedEnd) {
+		elapsedEnd = now
+	}
+	elapsedDays := int(elapsedEnd.Sub(startDate).Hours()/24) + 1
+
+	var categoryBars []reportBar
+	for _, category := range categories {
+		categoryBars = append(categoryBars, reportBar{Label: category.CategoryName, Color: category.CategoryColor, Total: category.Total})
+	}
+
+	var tagBars []reportBar
+	for _, tag := range tags {
+		tagBars = append(tagBars, reportBar{Label: tag.TagName, Color: tag.TagColor, Total: tag.Total})
+	}
+
+	report := newReportLayout(input.Locale)
+
+	report.pdf.Text(REPORT_MARGIN, report.y, 20, true, REPORT_TEXT_COLOR, util.Translate(input.Locale, "Expense report")+" - "+period)
+	report.y += 18
+	report.pdf.Text(REPORT_MARGIN, report.y, 9, false, REPORT_MUTED_COLOR, util.Translate(input.Locale, "Generated on")+" "+now.Format("02/01/2006 15:04"))
+	report.y += 30
+
+	report.headline([][2]string{
+		{util.Translate(input.Locale, "Total spent"), util.FormatAmount(total, input.Locale)},
+		{util.Translate(input.Locale, "Expenses"), strconv.Itoa(len(items))},
+		{util.Translate(input.Locale, "Daily average"), util.FormatAmount(total/float64(max(elapsedDays, 1)), input.Locale)},
+	})
+
+	report.barChart(util.Translate(input.Locale, "Categories"), categoryBars, total)
+	report.barChart(util.Translate(input.Locale, "Tags"), tagBars, total)
+	report.barChart(util.Translate(input.Locale, periodTitle), periodBars, 0)
+	report.itemTable(items)
+
+	content, err := report.pdf.Bytes()
+	if err != nil {
+		return GetExpenseReportPDFOutputDto{}, reportErr(err)
+	}
+
+	return GetExpenseReportPDFOutputDto{
+		FileName: fileName,
+		Content:  content,
+	}, nil
+}
+
+type reportBar struct {
+	Label string
+	Color string
+	Total float64
+}
+
+// reportLayout places blocks one below the other, starting a new page when the
+// next block does not fit.
+type reportLayout struct {
+	pdf    *util.PDF
+	y      float64
+	locale string
+}
+
+func newReportLayout(locale string) *reportLayout {
+	report := &reportLayout{pdf: util.NewPDF(), locale: locale}
+	report.newPage()
+
+	return report
+}
+
+func (r *reportLayout) newPage() {
+	r.pdf.AddPage()
+	r.y = REPORT_MARGIN + 20
+
+	r.pdf.TextRight(util.PDF_PAGE_WIDTH-REPORT_MARGIN, util.PDF_PAGE_HEIGHT-REPORT_MARGIN/2, 8, false, REPORT_MUTED_COLOR,
+		util.Translate(r.locale, "Page")+" "+strconv.Itoa(r.pdf.PageCount()))
+}
+
+func (r *reportLayout) ensureSpace(height float64) {
+	if r.y+height > util.PDF_PAGE_HEIGHT-REPORT_MARGIN {
+		r.newPage()
+	}
+}
+
+func (r *reportLayout) section(title string) {
+	r.ensureSpace(40)
+	r.pdf.Text(REPORT_MARGIN, r.y, 13, true, REPORT_TEXT_COLOR, title)
+	r.y += 6
+	r.pdf.Line(REPORT_MARGIN, r.y, util.PDF_PAGE_WIDTH-REPORT_MARGIN, r.y, 0.5, REPORT_RULE_COLOR)
+	r.y += 16
+}
+
+func (r *reportLayout) headline(values [][2]string) {
+	width := (util.PDF_PAGE_WIDTH - 2*REPORT_MARGIN) / float64(len(values))
+
+	for i, value := range values {
+		x := REPORT_MARGIN + float64(i)*width
+		r.pdf.FillRect(x+2, r.y, width-4, 50, "#F3F6FA")
+		r.pdf.Text(x+12, r.y+18, 9, false, REPORT_MUTED_COLOR, value[0])
+		r.pdf.Text(x+12, r.y+38, 16, true, REPORT_TEXT_COLOR, value[1])
+	}
+
+	r.y += 74
+}
+
+// barChart draws one horizontal bar per item, scaled to the largest. With a
+// total the share of each item is printed next to its amount. Items past
+// REPORT_MAX_CHART_ITEMS are summed into a last bar.
+func (r *reportLayout) barChart(title string, bars []reportBar, total float64) {
+	r.section(title)
+
+	if len(bars) == 0 {
+		r.pdf.Text(REPORT_MARGIN, r.y, 9, false, REPORT_MUTED_COLOR, util.Translate(r.locale, "No expenses in this period"))
+		r.y += 24
+		return
+	}
+
+	if len(bars) > REPORT_MAX_CHART_ITEMS {
+		others := reportBar{Label: util.Translate(r.locale, "Others")}
+		for _, bar := range bars[REPORT_MAX_CHART_ITEMS-1:] {
+			others.Total += bar.Total
+		}
+		bars = append(bars[:REPORT_MAX_CHART_ITEMS-1:REPORT_MAX_CHART_ITEMS-1], others)
+	}
+
+	largest := 0.0
+	for _, bar := range bars {
+		largest = max(largest, bar.Total)
+	}
+
+	labelWidth := 170.0
+	valueWidth := 110.0
+	chartWidth := util.PDF_PAGE_WIDTH - 2*REPORT_MARGIN - labelWidth - valueWidth
+
+	for _, bar := range bars {
+		r.ensureSpace(16)
+
+		r.pdf.Text(REPORT_MARGIN, r.y, 9, false, REPORT_TEXT_COLOR, util.FitPDFText(bar.Label, 9, labelWidth-8))
+
+		color := bar.Color
+		if _, _, _, ok := util.HexColorToRGB(color); !ok {
+			color = REPORT_BAR_COLOR
+		}
+
+		if largest > 0 && bar.Total > 0 {
+			r.pdf.FillRect(REPORT_MARGIN+labelWidth, r.y-8, max(chartWidth*bar.Total/largest, 1), 10, color)
+		}
+
+		value := util.FormatAmount(bar.Total, r.locale)
+		if total > 0 {
+			value += "  (" + strconv.FormatFloat(bar.Total/total*100, 'f', 1, 64) + "%)"
+		}
+		r.pdf.TextRight(util.PDF_PAGE_WIDTH-REPORT_MARGIN, r.y, 9, false, REPORT_TEXT_COLOR, value)
+
+		r.y += 16
+	}
+
+	r.y += 14
+}
+
+func (r *reportLayout) itemTable(items []repositories.ExpenseItem) {
+	var rows [][]string
+	var amounts []float64
+
+	for _, item := range items {
+		rows = append(rows, []string{item.ExpenseDate.Format("02/01/2006"), item.CategoryName, item.Tags, item.Notes})
+		amounts = append(amounts, item.Amount)
+	}
+
+	r.table(util.Translate(r.locale, "Itemized expenses"), []reportColumn{
+		{util.Translate(r.locale, "Date"), 60},
+		{util.Translate(r.locale, "Category"), 110},
+		{util.Translate(r.locale, "Tags"), 110},
+		{util.Translate(r.locale, "Notes"), 155},
+	}, rows, amounts)
+}
+
+type reportColumn struct {
+	title string
+	width float64
+}
+
+// table lists one row per amount, with the amounts right-aligned in a last
+// column. The header is repeated on every page the table spans.
+func (r *reportLayout) table(title string, columns []reportColumn, rows [][]string, amounts []float64) {
+	r.section(title)
+
+	if len(rows) == 0 {
+		r.pdf.Text(REPORT_MARGIN, r.y, 9, false, REPORT_MUTED_COLOR, util.Translate(r.locale, "No expenses in this period"))
+		r.y += 24
+		return
+	}
+
+	amountTitle := util.Translate(r.locale, "Amount")
+	right := util.PDF_PAGE_WIDTH - REPORT_MARGIN
+
+	header := func() {
+		x := REPORT_MARGIN
+		for _, column := range columns {
+			r.pdf.Text(x, r.y, 8, true, REPORT_MUTED_COLOR, column.title)
+			x += column.width
+		}
+		r.pdf.TextRight(right, r.y, 8, true, REPORT_MUTED_COLOR, amountTitle)
+		r.y += 5
+		r.pdf.Line(REPORT_MARGIN, r.y, right, r.y, 0.5, REPORT_RULE_COLOR)
+		r.y += 12
+	}
+
+	header()
+
+	for i, row := range rows {
+		if r.y+14 > util.PDF_PAGE_HEIGHT-REPORT_MARGIN {
+			r.newPage()
+			header()
+		}
+
+		x := REPORT_MARGIN
+		for j, column := range columns {
+			r.pdf.Text(x, r.y, 8, false, REPORT_TEXT_COLOR, util.FitPDFText(row[j], 8, column.width-6))
+			x += column.width
+		}
+		r.pdf.TextRight(right, r.y, 8, false, REPORT_TEXT_COLOR, util.FormatAmount(amounts[i], r.locale))
+
+		r.y += 14
+	}
+
+	r.y += 14
+}
@@ -9,6 +9,7 @@ type GetExpensesByCategoryPeriodInputDto struct {
 	UserID    string `json:"user_id"`
 	StartDate string `json:"start_date"`
 	EndDate   string `json:"end_date"`
+	Basis     string `json:"basis"`
 }
 
 type GetExpensesByCategoryPeriodOutputDto struct {
@@ -54,7 +55,7 @@ func (c *GetExpensesByCategoryPeriodUseCase) Execute(input GetExpensesByCategory
 		}
 	}
 
-	startDate, err := util.ParseDate(input.StartDate)
+	startDate, err := util.ParseDate(input.StartDate, user.Timezone)
 	if err != nil {
 		return GetExpensesByCategoryPeriodOutputDto{}, []util.ProblemDetails{
 			{
@@ -67,7 +68,7 @@ func (c *GetExpensesByCategoryPeriodUseCase) Execute(input GetExpensesByCategory
 		}
 	}
 
-	endDate, err := util.ParseDate(input.EndDate)
+	endDate, err := util.ParseDate(input.EndDate, user.Timezone)
 	if err != nil {
 		return GetExpensesByCategoryPeriodOutputDto{}, []util.ProblemDetails{
 			{
@@ -92,7 +93,12 @@ func (c *GetExpensesByCategoryPeriodUseCase) Execute(input GetExpensesByCategory
 		}
 	}
 
-	expenses, err := c.PresentersRepository.GetExpensesByCategoryPeriod(input.UserID, startDate, endDate)
+	basis, basisErr := parseExpenseBasis(input.Basis)
+	if len(basisErr) > 0 {
+		return GetExpensesByCategoryPeriodOutputDto{}, basisErr
+	}
+
+	expenses, err := c.PresentersRepository.GetExpensesByCategoryPeriod(input.UserID, startDate, endDate, basis)
 	if err != nil {
 		return GetExpensesByCategoryPeriodOutputDto{}, []util.ProblemDetails{
 			{
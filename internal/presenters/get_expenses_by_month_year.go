@@ -12,6 +12,7 @@ type GetExpensesByMonthYearInputDto struct {
 	UserID string `json:"user_id"`
 	Month  string `json:"month"`
 	Year   string `json:"year"`
+	Locale string `json:"locale"`
 }
 
 type GetExpensesByMonthYearOutputDto struct {
@@ -95,7 +96,7 @@ func (c *GetExpensesByMonthYearUseCase) Execute(input GetExpensesByMonthYearInpu
 		}
 	}
 
-	if year > time.Now().Year() {
+	if year > util.Now(user.Timezone).Year() {
 		return GetExpensesByMonthYearOutputDto{}, []util.ProblemDetails{
 			{
 				Type:     "Bad Request",
@@ -107,7 +108,7 @@ func (c *GetExpensesByMonthYearUseCase) Execute(input GetExpensesByMonthYearInpu
 		}
 	}
 
-	expenses, getExpensesByMonthYearErr := c.PresentersRepository.GetExpensesByMonthYear(input.UserID, month, year)
+	expenses, getExpensesByMonthYearErr := c.PresentersRepository.GetExpensesByMonthYear(input.UserID, month, year, user.FirstDayOfWeek())
 	if getExpensesByMonthYearErr != nil {
 		return GetExpensesByMonthYearOutputDto{}, []util.ProblemDetails{
 			{
@@ -120,6 +121,14 @@ func (c *GetExpensesByMonthYearUseCase) Execute(input GetExpensesByMonthYearInpu
 		}
 	}
 
+	expenses.Month = util.MonthName(time.Month(expenses.MonthNumber), input.Locale)
+	for i := range expenses.Weeks {
+		for j := range expenses.Weeks[i].Days {
+			day := &expenses.Weeks[i].Days[j]
+			day.DayName = util.WeekdayName(time.Weekday(day.Weekday), input.Locale)
+		}
+	}
+
 	return GetExpensesByMonthYearOutputDto{
 		Expenses: expenses,
 	}, nil
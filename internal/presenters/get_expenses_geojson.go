@@ -0,0 +1,101 @@
+package presenters
+
+import (
+	"encoding/json"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GeoJSONGeometry struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
+type GeoJSONFeature struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id"`
+	Geometry   GeoJSONGeometry        `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type GeoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	BBox     []float64        `json:"bbox,omitempty"`
+	Features []GeoJSONFeature `json:"features"`
+}
+
+type GetExpensesGeoJSONOutputDto struct {
+	FileName string `json:"file_name"`
+	Content  []byte `json:"content"`
+}
+
+type GetExpensesGeoJSONUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetExpensesGeoJSONUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetExpensesGeoJSONUseCase {
+	return &GetExpensesGeoJSONUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+// Execute exports the geotagged expenses as a GeoJSON (RFC 7946) collection
+// of points. Coordinates are longitude first, as the format requires.
+func (c *GetExpensesGeoJSONUseCase) Execute(input GetExpenseLocationsInputDto) (GetExpensesGeoJSONOutputDto, []util.ProblemDetails) {
+	locations, problems := NewGetExpenseLocationsUseCase(c.PresentersRepository, c.UserRepository).Execute(input)
+	if len(problems) > 0 {
+		return GetExpensesGeoJSONOutputDto{}, problems
+	}
+
+	collection := GeoJSONFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: []GeoJSONFeature{},
+	}
+
+	if box := locations.BoundingBox; box != nil {
+		collection.BBox = []float64{box.MinLongitude, box.MinLatitude, box.MaxLongitude, box.MaxLatitude}
+	}
+
+	for _, expense := range locations.Expenses {
+		collection.Features = append(collection.Features, GeoJSONFeature{
+			Type: "Feature",
+			ID:   expense.ExpenseID,
+			Geometry: GeoJSONGeometry{
+				Type:        "Point",
+				Coordinates: []float64{expense.Longitude, expense.Latitude},
+			},
+			Properties: map[string]interface{}{
+				"expense_date":   expense.ExpenseDate,
+				"amount":         expense.Amount,
+				"place_name":     expense.PlaceName,
+				"category_name":  expense.CategoryName,
+				"category_color": expense.CategoryColor,
+				"notes":          expense.Notes,
+			},
+		})
+	}
+
+	content, err := json.Marshal(collection)
+	if err != nil {
+		return GetExpensesGeoJSONOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not generate GeoJSON",
+				Status:   500,
+				Detail:   err.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetExpensesGeoJSONOutputDto{
+		FileName: "expenses-" + input.StartDate + "-" + input.EndDate + ".geojson",
+		Content:  content,
+	}, nil
+}
@@ -0,0 +1,282 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+// TIME_SERIES_MAX_BUCKETS caps how many buckets a period may be split into.
+// Every bucket is returned even when there is no spending, so the size of
+// the response follows the range rather than the expenses. A thousand
+// buckets is almost three years by day.
+const TIME_SERIES_MAX_BUCKETS = 1000
+
+type GetExpensesTimeSeriesInputDto struct {
+	UserID      string   `json:"user_id"`
+	StartDate   string   `json:"start_date"`
+	EndDate     string   `json:"end_date"`
+	Granularity string   `json:"granularity"`
+	GroupBy     string   `json:"group_by"`
+	CategoryIDs []string `json:"category_ids"`
+	TagIDs      []string `json:"tag_ids"`
+	AccountIDs  []string `json:"account_ids"`
+	Basis       string   `json:"basis"`
+	Locale      string   `json:"locale"`
+}
+
+type TimeSeriesBucket struct {
+	Label     string  `json:"label"`
+	StartDate string  `json:"start_date"`
+	EndDate   string  `json:"end_date"`
+	Total     float64 `json:"total"`
+}
+
+type TimeSeriesLine struct {
+	Key    string    `json:"key"`
+	Name   string    `json:"name"`
+	Color  string    `json:"color"`
+	Values []float64 `json:"values"`
+	Total  float64   `json:"total"`
+}
+
+type GetExpensesTimeSeriesOutputDto struct {
+	Granularity string             `json:"granularity"`
+	GroupBy     string             `json:"group_by"`
+	Buckets     []TimeSeriesBucket `json:"buckets"`
+	Series      []TimeSeriesLine   `json:"series"`
+	Total       float64            `json:"total"`
+}
+
+type GetExpensesTimeSeriesUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetExpensesTimeSeriesUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetExpensesTimeSeriesUseCase {
+	return &GetExpensesTimeSeriesUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+func (c *GetExpensesTimeSeriesUseCase) Execute(input GetExpensesTimeSeriesInputDto) (GetExpensesTimeSeriesOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetExpensesTimeSeriesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetExpensesTimeSeriesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	startDate, err := util.ParseDate(input.StartDate, user.Timezone)
+	if err != nil {
+		return GetExpensesTimeSeriesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid start date",
+				Status:   400,
+				Detail:   "Start date is not in the correct format",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	endDate, err := util.ParseDate(input.EndDate, user.Timezone)
+	if err != nil {
+		return GetExpensesTimeSeriesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid end date",
+				Status:   400,
+				Detail:   "End date is not in the correct format",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	if startDate.After(endDate) {
+		return GetExpensesTimeSeriesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid date range",
+				Status:   400,
+				Detail:   "Start date must be before end date",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	switch input.Granularity {
+	case repositories.TIME_SERIES_DAY, repositories.TIME_SERIES_WEEK, repositories.TIME_SERIES_MONTH, repositories.TIME_SERIES_QUARTER, repositories.TIME_SERIES_YEAR:
+	default:
+		return GetExpensesTimeSeriesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid granularity",
+				Status:   400,
+				Detail:   "Granularity must be day, week, month, quarter or year",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	if timeSeriesBucketCount(startDate, endDate, input.Granularity, user.FirstDayOfWeek()) > TIME_SERIES_MAX_BUCKETS {
+		return GetExpensesTimeSeriesOutputDto{}, []util.ProblemDetails{timeSeriesRangeTooLong()}
+	}
+
+	switch input.GroupBy {
+	case "", repositories.TIME_SERIES_GROUP_CATEGORY, repositories.TIME_SERIES_GROUP_TAG, repositories.TIME_SERIES_GROUP_ACCOUNT, repositories.TIME_SERIES_GROUP_WEEKDAY:
+	default:
+		return GetExpensesTimeSeriesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid group by",
+				Status:   400,
+				Detail:   "Group by must be category, tag, account or weekday",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	basis, basisErr := parseExpenseBasis(input.Basis)
+	if len(basisErr) > 0 {
+		return GetExpensesTimeSeriesOutputDto{}, basisErr
+	}
+
+	series, err := c.PresentersRepository.GetTimeSeries(input.UserID, repositories.TimeSeriesQuery{
+		StartDate:   startDate,
+		EndDate:     endDate.AddDate(0, 0, 1).Add(-time.Nanosecond),
+		Granularity: input.Granularity,
+		GroupBy:     input.GroupBy,
+		WeekStart:   user.FirstDayOfWeek(),
+		Basis:       basis,
+		CategoryIDs: input.CategoryIDs,
+		TagIDs:      input.TagIDs,
+		AccountIDs:  input.AccountIDs,
+	})
+	if err != nil {
+		return GetExpensesTimeSeriesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not calculate total expenses",
+				Status:   500,
+				Detail:   err.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	output := GetExpensesTimeSeriesOutputDto{
+		Granularity: input.Granularity,
+		GroupBy:     input.GroupBy,
+		Buckets:     []TimeSeriesBucket{},
+		Series:      []TimeSeriesLine{},
+		Total:       series.Total,
+	}
+
+	for _, bucket := range series.Buckets {
+		output.Buckets = append(output.Buckets, TimeSeriesBucket{
+			Label:     timeSeriesLabel(bucket.StartDate, input.Granularity, input.Locale),
+			StartDate: bucket.StartDate.Format("02/01/2006"),
+			EndDate:   bucket.EndDate.Format("02/01/2006"),
+			Total:     bucket.Total,
+		})
+	}
+
+	for _, group := range series.Groups {
+		output.Series = append(output.Series, TimeSeriesLine{
+			Key:    group.Key,
+			Name:   timeSeriesGroupName(group, input.GroupBy, input.Locale),
+			Color:  group.Color,
+			Values: group.Totals,
+			Total:  group.Total,
+		})
+	}
+
+	return output, nil
+}
+
+// timeSeriesBucketCount counts the buckets from the one holding start to the
+// one holding end, both dates in the user's timezone.
+func timeSeriesBucketCount(start time.Time, end time.Time, granularity string, weekStart time.Weekday) int {
+	switch granularity {
+	case repositories.TIME_SERIES_WEEK:
+		return daysBetween(util.StartOfWeek(start, weekStart), end)/7 + 1
+	case repositories.TIME_SERIES_MONTH:
+		return (end.Year()-start.Year())*12 + int(end.Month()) - int(start.Month()) + 1
+	case repositories.TIME_SERIES_QUARTER:
+		return (end.Year()-start.Year())*4 + (int(end.Month())-1)/3 - (int(start.Month())-1)/3 + 1
+	case repositories.TIME_SERIES_YEAR:
+		return end.Year() - start.Year() + 1
+	}
+
+	return daysBetween(start, end) + 1
+}
+
+// daysBetween counts calendar days, so a daylight saving change in between
+// does not shorten the count.
+func daysBetween(start time.Time, end time.Time) int {
+	startDay := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	endDay := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+
+	return int(endDay.Sub(startDay).Hours() / 24)
+}
+
+func timeSeriesRangeTooLong() util.ProblemDetails {
+	return util.ProblemDetails{
+		Type:     "Bad Request",
+		Title:    "Date range too long",
+		Status:   400,
+		Detail:   "The period must span at most " + strconv.Itoa(TIME_SERIES_MAX_BUCKETS) + " buckets of the granularity",
+		Instance: util.RFC400,
+	}
+}
+
+func timeSeriesGroupName(group repositories.TimeSeriesGroup, groupBy string, locale string) string {
+	switch groupBy {
+	case repositories.TIME_SERIES_GROUP_WEEKDAY:
+		if day, err := strconv.Atoi(group.Key); err == nil {
+			return util.WeekdayName(time.Weekday(day), locale)
+		}
+	case repositories.TIME_SERIES_GROUP_ACCOUNT:
+		if group.Key == "" {
+			return util.Translate(locale, group.Name)
+		}
+	}
+
+	return group.Name
+}
+
+func timeSeriesLabel(start time.Time, granularity string, locale string) string {
+	switch granularity {
+	case repositories.TIME_SERIES_MONTH:
+		return util.MonthName(start.Month(), locale) + " " + strconv.Itoa(start.Year())
+	case repositories.TIME_SERIES_QUARTER:
+		return "Q" + strconv.Itoa(int(start.Month()-1)/3+1) + " " + strconv.Itoa(start.Year())
+	case repositories.TIME_SERIES_YEAR:
+		return strconv.Itoa(start.Year())
+	}
+
+	return start.Format("02/01/2006")
+}
@@ -0,0 +1,86 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetGoalsProgressInputDto struct {
+	UserID string `json:"user_id"`
+	GoalID string `json:"goal_id"`
+}
+
+type GetGoalsProgressOutputDto struct {
+	Goals []repositories.GoalProgress `json:"goals"`
+}
+
+type GetGoalsProgressUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetGoalsProgressUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetGoalsProgressUseCase {
+	return &GetGoalsProgressUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+func (c *GetGoalsProgressUseCase) Execute(input GetGoalsProgressInputDto) (GetGoalsProgressOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetGoalsProgressOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetGoalsProgressOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	goals, err := c.PresentersRepository.GetGoalsProgress(input.UserID, input.GoalID, time.Now())
+	if err != nil {
+		if err.Error() == "goal not found" {
+			return GetGoalsProgressOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Not Found",
+					Title:    "Goal not found",
+					Status:   404,
+					Detail:   err.Error(),
+					Instance: util.RFC404,
+				},
+			}
+		}
+
+		return GetGoalsProgressOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not calculate goals progress",
+				Status:   500,
+				Detail:   err.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetGoalsProgressOutputDto{
+		Goals: goals,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetMonthEndForecastInputDto struct {
+	UserID string `json:"user_id"`
+	Locale string `json:"locale"`
+}
+
+type GetMonthEndForecastOutputDto struct {
+	Forecast repositories.MonthForecast `json:"forecast"`
+}
+
+type GetMonthEndForecastUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetMonthEndForecastUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetMonthEndForecastUseCase {
+	return &GetMonthEndForecastUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+func (c *GetMonthEndForecastUseCase) Execute(input GetMonthEndForecastInputDto) (GetMonthEndForecastOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetMonthEndForecastOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetMonthEndForecastOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	forecast, err := c.PresentersRepository.GetMonthEndForecast(input.UserID, time.Now())
+	if err != nil {
+		return GetMonthEndForecastOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not calculate month-end forecast",
+				Status:   500,
+				Detail:   err.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	forecast.Month = util.MonthName(time.Month(forecast.MonthNumber), input.Locale)
+
+	return GetMonthEndForecastOutputDto{
+		Forecast: forecast,
+	}, nil
+}
@@ -11,6 +11,8 @@ import (
 type GetMonthlyExpensesByCategoryYearInputDto struct {
 	UserID string `json:"user_id"`
 	Year   string `json:"year"`
+	Basis  string `json:"basis"`
+	Locale string `json:"locale"`
 }
 
 type GetMonthlyExpensesByCategoryYearOutputDto struct {
@@ -82,7 +84,7 @@ func (c *GetMonthlyExpensesByCategoryYearUseCase) Execute(input GetMonthlyExpens
 		}
 	}
 
-	if year > time.Now().Year() {
+	if year > util.Now(user.Timezone).Year() {
 		return GetMonthlyExpensesByCategoryYearOutputDto{}, []util.ProblemDetails{
 			{
 				Type:     "Bad Request",
@@ -94,7 +96,12 @@ func (c *GetMonthlyExpensesByCategoryYearUseCase) Execute(input GetMonthlyExpens
 		}
 	}
 
-	expenses, availableYears, getMonthlyExpensesByCategoryYearErr := c.PresentersRepository.GetMonthlyExpensesByCategoryYear(input.UserID, year)
+	basis, basisErr := parseExpenseBasis(input.Basis)
+	if len(basisErr) > 0 {
+		return GetMonthlyExpensesByCategoryYearOutputDto{}, basisErr
+	}
+
+	expenses, availableYears, getMonthlyExpensesByCategoryYearErr := c.PresentersRepository.GetMonthlyExpensesByCategoryYear(input.UserID, year, basis)
 	if getMonthlyExpensesByCategoryYearErr != nil {
 		return GetMonthlyExpensesByCategoryYearOutputDto{}, []util.ProblemDetails{
 			{
@@ -107,6 +114,10 @@ func (c *GetMonthlyExpensesByCategoryYearUseCase) Execute(input GetMonthlyExpens
 		}
 	}
 
+	for i := range expenses {
+		expenses[i].Month = util.MonthName(time.Month(expenses[i].MonthNumber), input.Locale)
+	}
+
 	return GetMonthlyExpensesByCategoryYearOutputDto{
 		Expenses:       expenses,
 		AvailableYears: availableYears,
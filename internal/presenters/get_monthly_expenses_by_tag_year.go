@@ -11,6 +11,7 @@ import (
 type GetMonthlyExpensesByTagYearInputDto struct {
 	UserID string `json:"user_id"`
 	Year   string `json:"year"`
+	Locale string `json:"locale"`
 }
 
 type GetMonthlyExpensesByTagYearOutputDto struct {
@@ -82,7 +83,7 @@ func (c *GetMonthlyExpensesByTagYearUseCase) Execute(input GetMonthlyExpensesByT
 		}
 	}
 
-	if year > time.Now().Year() {
+	if year > util.Now(user.Timezone).Year() {
 		return GetMonthlyExpensesByTagYearOutputDto{}, []util.ProblemDetails{
 			{
 				Type:     "Bad Request",
@@ -107,6 +108,10 @@ func (c *GetMonthlyExpensesByTagYearUseCase) Execute(input GetMonthlyExpensesByT
 		}
 	}
 
+	for i := range expenses {
+		expenses[i].Month = util.MonthName(time.Month(expenses[i].MonthNumber), input.Locale)
+	}
+
 	return GetMonthlyExpensesByTagYearOutputDto{
 		Expenses:       expenses,
 		AvailableYears: availableYears,
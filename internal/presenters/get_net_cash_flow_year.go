@@ -0,0 +1,118 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetNetCashFlowYearInputDto struct {
+	UserID string `json:"user_id"`
+	Year   string `json:"year"`
+	Locale string `json:"locale"`
+}
+
+type GetNetCashFlowYearOutputDto struct {
+	CashFlow repositories.CashFlowYear `json:"cash_flow"`
+}
+
+type GetNetCashFlowYearUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetNetCashFlowYearUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetNetCashFlowYearUseCase {
+	return &GetNetCashFlowYearUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+func (c *GetNetCashFlowYearUseCase) Execute(input GetNetCashFlowYearInputDto) (GetNetCashFlowYearOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetNetCashFlowYearOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetNetCashFlowYearOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	year, errYear := strconv.Atoi(input.Year)
+	if errYear != nil {
+		return GetNetCashFlowYearOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid year",
+				Status:   400,
+				Detail:   errYear.Error(),
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	if year < 1900 || year > 99999 {
+		return GetNetCashFlowYearOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid year",
+				Status:   400,
+				Detail:   "Year must be between 1900 and 9999",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	if year > util.Now(user.Timezone).Year() {
+		return GetNetCashFlowYearOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid year",
+				Status:   400,
+				Detail:   "Year must be less than or equal to the current year",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	cashFlow, getNetCashFlowYearErr := c.PresentersRepository.GetNetCashFlowYear(input.UserID, year)
+	if getNetCashFlowYearErr != nil {
+		return GetNetCashFlowYearOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not calculate net cash flow",
+				Status:   500,
+				Detail:   getNetCashFlowYearErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	for i := range cashFlow.Months {
+		month := &cashFlow.Months[i]
+		month.Month = util.MonthName(time.Month(month.MonthNumber), input.Locale)
+	}
+
+	return GetNetCashFlowYearOutputDto{
+		CashFlow: cashFlow,
+	}, nil
+}
@@ -0,0 +1,349 @@
+package presenters
+
+import (
+	"sort"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+const (
+	COMPARISON_PRESET_MOM                   = "mom"
+	COMPARISON_PRESET_YOY                   = "yoy"
+	COMPARISON_PRESET_SAME_PERIOD_LAST_YEAR = "same_period_last_year"
+)
+
+const (
+	COMPARISON_STATUS_NEW      = "new"
+	COMPARISON_STATUS_GONE     = "gone"
+	COMPARISON_STATUS_CONTINUE = "continuing"
+)
+
+type GetPeriodComparisonInputDto struct {
+	UserID           string `json:"user_id"`
+	Preset           string `json:"preset"`
+	StartDate        string `json:"start_date"`
+	EndDate          string `json:"end_date"`
+	CompareStartDate string `json:"compare_start_date"`
+	CompareEndDate   string `json:"compare_end_date"`
+	Basis            string `json:"basis"`
+}
+
+type ComparisonPeriod struct {
+	StartDate string  `json:"start_date"`
+	EndDate   string  `json:"end_date"`
+	Total     float64 `json:"total"`
+}
+
+type ComparisonDelta struct {
+	Name          string   `json:"name"`
+	Color         string   `json:"color"`
+	Current       float64  `json:"current"`
+	Previous      float64  `json:"previous"`
+	Change        float64  `json:"change"`
+	ChangePercent *float64 `json:"change_percent,omitempty"`
+	Status        string   `json:"status"`
+}
+
+type GetPeriodComparisonOutputDto struct {
+	Current               ComparisonPeriod  `json:"current"`
+	Previous              ComparisonPeriod  `json:"previous"`
+	Change                float64           `json:"change"`
+	ChangePercent         *float64          `json:"change_percent,omitempty"`
+	Categories            []ComparisonDelta `json:"categories"`
+	Tags                  []ComparisonDelta `json:"tags"`
+	NewCategories         []string          `json:"new_categories"`
+	DisappearedCategories []string          `json:"disappeared_categories"`
+}
+
+type GetPeriodComparisonUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetPeriodComparisonUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetPeriodComparisonUseCase {
+	return &GetPeriodComparisonUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+// Execute compares two date ranges. With a preset the second range is the
+// first one moved back a month (mom) or a year (yoy, same_period_last_year);
+// without dates, mom and same_period_last_year use the month to date and yoy
+// the year to date. Both ranges include their end date.
+func (c *GetPeriodComparisonUseCase) Execute(input GetPeriodComparisonInputDto) (GetPeriodComparisonOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetPeriodComparisonOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetPeriodComparisonOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	basis, basisErr := parseExpenseBasis(input.Basis)
+	if len(basisErr) > 0 {
+		return GetPeriodComparisonOutputDto{}, basisErr
+	}
+
+	startDate, endDate, compareStartDate, compareEndDate, rangeErr := comparisonRanges(input, util.Now(user.Timezone), user.Timezone)
+	if len(rangeErr) > 0 {
+		return GetPeriodComparisonOutputDto{}, rangeErr
+	}
+
+	endOfDay := func(date time.Time) time.Time {
+		return date.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	currentTotal, err := c.PresentersRepository.GetTotalExpensesForPeriod(input.UserID, startDate, endOfDay(endDate), basis)
+	if err != nil {
+		return GetPeriodComparisonOutputDto{}, comparisonError(err)
+	}
+
+	previousTotal, err := c.PresentersRepository.GetTotalExpensesForPeriod(input.UserID, compareStartDate, endOfDay(compareEndDate), basis)
+	if err != nil {
+		return GetPeriodComparisonOutputDto{}, comparisonError(err)
+	}
+
+	currentCategories, err := c.PresentersRepository.GetExpensesByCategoryPeriod(input.UserID, startDate, endOfDay(endDate), basis)
+	if err != nil {
+		return GetPeriodComparisonOutputDto{}, comparisonError(err)
+	}
+
+	previousCategories, err := c.PresentersRepository.GetExpensesByCategoryPeriod(input.UserID, compareStartDate, endOfDay(compareEndDate), basis)
+	if err != nil {
+		return GetPeriodComparisonOutputDto{}, comparisonError(err)
+	}
+
+	currentTags, err := c.PresentersRepository.GetExpensesByTagPeriod(input.UserID, startDate, endOfDay(endDate), basis)
+	if err != nil {
+		return GetPeriodComparisonOutputDto{}, comparisonError(err)
+	}
+
+	previousTags, err := c.PresentersRepository.GetExpensesByTagPeriod(input.UserID, compareStartDate, endOfDay(compareEndDate), basis)
+	if err != nil {
+		return GetPeriodComparisonOutputDto{}, comparisonError(err)
+	}
+
+	var currentCategoryTotals, previousCategoryTotals []comparisonTotal
+	for _, category := range currentCategories {
+		currentCategoryTotals = append(currentCategoryTotals, comparisonTotal{category.CategoryName, category.CategoryColor, category.Total})
+	}
+	for _, category := range previousCategories {
+		previousCategoryTotals = append(previousCategoryTotals, comparisonTotal{category.CategoryName, category.CategoryColor, category.Total})
+	}
+
+	var currentTagTotals, previousTagTotals []comparisonTotal
+	for _, tag := range currentTags {
+		currentTagTotals = append(currentTagTotals, comparisonTotal{tag.TagName, tag.TagColor, tag.Total})
+	}
+	for _, tag := range previousTags {
+		previousTagTotals = append(previousTagTotals, comparisonTotal{tag.TagName, tag.TagColor, tag.Total})
+	}
+
+	output := GetPeriodComparisonOutputDto{
+		Current: ComparisonPeriod{
+			StartDate: startDate.Format("02/01/2006"),
+			EndDate:   endDate.Format("02/01/2006"),
+			Total:     currentTotal,
+		},
+		Previous: ComparisonPeriod{
+			StartDate: compareStartDate.Format("02/01/2006"),
+			EndDate:   compareEndDate.Format("02/01/2006"),
+			Total:     previousTotal,
+		},
+		Change:                currentTotal - previousTotal,
+		ChangePercent:         changePercent(currentTotal, previousTotal),
+		Categories:            compareTotals(currentCategoryTotals, previousCategoryTotals),
+		Tags:                  compareTotals(currentTagTotals, previousTagTotals),
+		NewCategories:         []string{},
+		DisappearedCategories: []string{},
+	}
+
+	for _, category := range output.Categories {
+		switch category.Status {
+		case COMPARISON_STATUS_NEW:
+			output.NewCategories = append(output.NewCategories, category.Name)
+		case COMPARISON_STATUS_GONE:
+			output.DisappearedCategories = append(output.DisappearedCategories, category.Name)
+		}
+	}
+
+	return output, nil
+}
+
+func comparisonRanges(input GetPeriodComparisonInputDto, now time.Time, timezone string) (time.Time, time.Time, time.Time, time.Time, []util.ProblemDetails) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	var startDate, endDate time.Time
+
+	if input.StartDate != "" || input.EndDate != "" {
+		parsedStartDate, err := util.ParseDate(input.StartDate, timezone)
+		if err != nil {
+			return time.Time{}, time.Time{}, time.Time{}, time.Time{}, invalidComparisonDate("Invalid start date", "Start date is not in the correct format")
+		}
+
+		parsedEndDate, err := util.ParseDate(input.EndDate, timezone)
+		if err != nil {
+			return time.Time{}, time.Time{}, time.Time{}, time.Time{}, invalidComparisonDate("Invalid end date", "End date is not in the correct format")
+		}
+
+		startDate, endDate = parsedStartDate, parsedEndDate
+	}
+
+	var shiftMonths int
+
+	switch input.Preset {
+	case "":
+		if startDate.IsZero() || input.CompareStartDate == "" || input.CompareEndDate == "" {
+			return time.Time{}, time.Time{}, time.Time{}, time.Time{}, invalidComparisonDate("Missing comparison period", "Provide a preset or both date ranges")
+		}
+
+		compareStartDate, err := util.ParseDate(input.CompareStartDate, timezone)
+		if err != nil {
+			return time.Time{}, time.Time{}, time.Time{}, time.Time{}, invalidComparisonDate("Invalid comparison start date", "Comparison start date is not in the correct format")
+		}
+
+		compareEndDate, err := util.ParseDate(input.CompareEndDate, timezone)
+		if err != nil {
+			return time.Time{}, time.Time{}, time.Time{}, time.Time{}, invalidComparisonDate("Invalid comparison end date", "Comparison end date is not in the correct format")
+		}
+
+		if startDate.After(endDate) || compareStartDate.After(compareEndDate) {
+			return time.Time{}, time.Time{}, time.Time{}, time.Time{}, invalidComparisonDate("Invalid date range", "Start date must be before end date")
+		}
+
+		return startDate, endDate, compareStartDate, compareEndDate, nil
+	case COMPARISON_PRESET_MOM:
+		shiftMonths = 1
+		if startDate.IsZero() {
+			startDate, endDate = time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location()), today
+		}
+	case COMPARISON_PRESET_YOY:
+		shiftMonths = 12
+		if startDate.IsZero() {
+			startDate, endDate = time.Date(today.Year(), time.January, 1, 0, 0, 0, 0, today.Location()), today
+		}
+	case COMPARISON_PRESET_SAME_PERIOD_LAST_YEAR:
+		shiftMonths = 12
+		if startDate.IsZero() {
+			startDate, endDate = time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location()), today
+		}
+	default:
+		return time.Time{}, time.Time{}, time.Time{}, time.Time{}, invalidComparisonDate("Invalid preset", "Preset must be mom, yoy or same_period_last_year")
+	}
+
+	if startDate.After(endDate) {
+		return time.Time{}, time.Time{}, time.Time{}, time.Time{}, invalidComparisonDate("Invalid date range", "Start date must be before end date")
+	}
+
+	return startDate, endDate, shiftDateMonths(startDate, -shiftMonths), shiftDateMonths(endDate, -shiftMonths), nil
+}
+
+// shiftDateMonths moves the date by whole months, clamping to the last day of
+// the target month. A date on the last day of its month stays on the last
+// day, so a full February compares with a full January.
+func shiftDateMonths(date time.Time, months int) time.Time {
+	firstOfTarget := time.Date(date.Year(), date.Month()+time.Month(months), 1, 0, 0, 0, 0, date.Location())
+	lastDayOfTarget := firstOfTarget.AddDate(0, 1, -1).Day()
+
+	day := date.Day()
+	if day > lastDayOfTarget || date.AddDate(0, 0, 1).Day() == 1 {
+		day = lastDayOfTarget
+	}
+
+	return time.Date(firstOfTarget.Year(), firstOfTarget.Month(), day, 0, 0, 0, 0, date.Location())
+}
+
+type comparisonTotal struct {
+	name  string
+	color string
+	total float64
+}
+
+func compareTotals(current []comparisonTotal, previous []comparisonTotal) []ComparisonDelta {
+	deltas := make(map[string]*ComparisonDelta)
+
+	for _, item := range current {
+		deltas[item.name] = &ComparisonDelta{Name: item.name, Color: item.color, Current: item.total, Status: COMPARISON_STATUS_NEW}
+	}
+
+	for _, item := range previous {
+		if delta, exists := deltas[item.name]; exists {
+			delta.Previous = item.total
+			delta.Status = COMPARISON_STATUS_CONTINUE
+			continue
+		}
+
+		deltas[item.name] = &ComparisonDelta{Name: item.name, Color: item.color, Previous: item.total, Status: COMPARISON_STATUS_GONE}
+	}
+
+	output := []ComparisonDelta{}
+	for _, delta := range deltas {
+		delta.Change = delta.Current - delta.Previous
+		delta.ChangePercent = changePercent(delta.Current, delta.Previous)
+		output = append(output, *delta)
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		if output[i].Change != output[j].Change {
+			return output[i].Change > output[j].Change
+		}
+		return output[i].Name < output[j].Name
+	})
+
+	return output
+}
+
+func changePercent(current float64, previous float64) *float64 {
+	if previous == 0 {
+		return nil
+	}
+
+	change := (current - previous) / previous * 100
+
+	return &change
+}
+
+func invalidComparisonDate(title string, detail string) []util.ProblemDetails {
+	return []util.ProblemDetails{
+		{
+			Type:     "Bad Request",
+			Title:    title,
+			Status:   400,
+			Detail:   detail,
+			Instance: util.RFC400,
+		},
+	}
+}
+
+func comparisonError(err error) []util.ProblemDetails {
+	return []util.ProblemDetails{
+		{
+			Type:     "Internal Server Error",
+			Title:    "Could not compare periods",
+			Status:   500,
+			Detail:   err.Error(),
+			Instance: util.RFC500,
+		},
+	}
+}
@@ -0,0 +1,292 @@
+package presenters
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetPivotReportInputDto struct {
+	UserID    string `json:"user_id"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	Rows      string `json:"rows"`
+	Columns   string `json:"columns"`
+	Measure   string `json:"measure"`
+	Basis     string `json:"basis"`
+	Locale    string `json:"locale"`
+}
+
+type GetPivotReportOutputDto struct {
+	Rows          string                     `json:"rows"`
+	Columns       string                     `json:"columns"`
+	Measure       string                     `json:"measure"`
+	RowHeaders    []repositories.PivotHeader `json:"row_headers"`
+	ColumnHeaders []repositories.PivotHeader `json:"column_headers"`
+	Cells         [][]*float64               `json:"cells"`
+	RowTotals     []*float64                 `json:"row_totals"`
+	ColumnTotals  []*float64                 `json:"column_totals"`
+	Total         *float64                   `json:"total"`
+}
+
+type GetPivotReportUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetPivotReportUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetPivotReportUseCase {
+	return &GetPivotReportUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+func (c *GetPivotReportUseCase) Execute(input GetPivotReportInputDto) (GetPivotReportOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetPivotReportOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetPivotReportOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	startDate, err := util.ParseDate(input.StartDate, user.Timezone)
+	if err != nil {
+		return GetPivotReportOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid start date",
+				Status:   400,
+				Detail:   "Start date is not in the correct format",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	endDate, err := util.ParseDate(input.EndDate, user.Timezone)
+	if err != nil {
+		return GetPivotReportOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid end date",
+				Status:   400,
+				Detail:   "End date is not in the correct format",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	if startDate.After(endDate) {
+		return GetPivotReportOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid date range",
+				Status:   400,
+				Detail:   "Start date must be before end date",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	if !isPivotDimension(input.Rows) {
+		return GetPivotReportOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid rows",
+				Status:   400,
+				Detail:   "Rows must be category, tag, month, week, weekday or account",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	if input.Columns != "" && (!isPivotDimension(input.Columns) || input.Columns == input.Rows) {
+		return GetPivotReportOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid columns",
+				Status:   400,
+				Detail:   "Columns must be a dimension other than the rows",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	for _, dimension := range []string{input.Rows, input.Columns} {
+		if (dimension == repositories.PIVOT_DIMENSION_MONTH || dimension == repositories.PIVOT_DIMENSION_WEEK) &&
+			timeSeriesBucketCount(startDate, endDate, dimension, user.FirstDayOfWeek()) > TIME_SERIES_MAX_BUCKETS {
+			return GetPivotReportOutputDto{}, []util.ProblemDetails{timeSeriesRangeTooLong()}
+		}
+	}
+
+	measure := input.Measure
+	switch measure {
+	case "":
+		measure = repositories.PIVOT_MEASURE_SUM
+	case repositories.PIVOT_MEASURE_SUM, repositories.PIVOT_MEASURE_COUNT, repositories.PIVOT_MEASURE_AVERAGE, repositories.PIVOT_MEASURE_MIN, repositories.PIVOT_MEASURE_MAX:
+	default:
+		return GetPivotReportOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid measure",
+				Status:   400,
+				Detail:   "Measure must be sum, count, average, min or max",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	basis, basisErr := parseExpenseBasis(input.Basis)
+	if len(basisErr) > 0 {
+		return GetPivotReportOutputDto{}, basisErr
+	}
+
+	pivot, err := c.PresentersRepository.GetPivot(input.UserID, repositories.PivotQuery{
+		StartDate: startDate,
+		EndDate:   endDate.AddDate(0, 0, 1).Add(-time.Nanosecond),
+		Rows:      input.Rows,
+		Columns:   input.Columns,
+		Measure:   measure,
+		WeekStart: user.FirstDayOfWeek(),
+		Basis:     basis,
+	})
+	if err != nil {
+		return GetPivotReportOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not build pivot report",
+				Status:   500,
+				Detail:   err.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetPivotReportOutputDto{
+		Rows:          input.Rows,
+		Columns:       input.Columns,
+		Measure:       measure,
+		RowHeaders:    localizePivotHeaders(pivot.Rows, input.Rows, input.Locale),
+		ColumnHeaders: localizePivotHeaders(pivot.Columns, input.Columns, input.Locale),
+		Cells:         pivot.Cells,
+		RowTotals:     pivot.RowTotals,
+		ColumnTotals:  pivot.ColumnTotals,
+		Total:         pivot.Total,
+	}, nil
+}
+
+func isPivotDimension(dimension string) bool {
+	switch dimension {
+	case repositories.PIVOT_DIMENSION_CATEGORY, repositories.PIVOT_DIMENSION_TAG, repositories.PIVOT_DIMENSION_MONTH,
+		repositories.PIVOT_DIMENSION_WEEK, repositories.PIVOT_DIMENSION_WEEKDAY, repositories.PIVOT_DIMENSION_ACCOUNT:
+		return true
+	}
+
+	return false
+}
+
+func localizePivotHeaders(headers []repositories.PivotHeader, dimension string, locale string) []repositories.PivotHeader {
+	for i := range headers {
+		header := &headers[i]
+
+		switch dimension {
+		case repositories.PIVOT_DIMENSION_MONTH:
+			if start, err := time.Parse("2006-01-02", header.Key); err == nil {
+				header.Name = util.MonthName(start.Month(), locale) + " " + strconv.Itoa(start.Year())
+			}
+		case repositories.PIVOT_DIMENSION_WEEKDAY:
+			if day, err := strconv.Atoi(header.Key); err == nil {
+				header.Name = util.WeekdayName(time.Weekday(day), locale)
+			}
+		case repositories.PIVOT_DIMENSION_TAG, repositories.PIVOT_DIMENSION_ACCOUNT:
+			if header.Key == "" {
+				header.Name = util.Translate(locale, header.Name)
+			}
+		}
+	}
+
+	return headers
+}
+
+// PivotReportCSV writes the report as a table: one line per row header with
+// its cells and total, then a line of column totals. Empty cells are left
+// blank and counts have no decimals.
+func PivotReportCSV(output GetPivotReportOutputDto, locale string) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+
+	total := util.Translate(locale, "Total")
+
+	header := []string{""}
+	for _, column := range output.ColumnHeaders {
+		header = append(header, column.Name)
+	}
+	header = append(header, total)
+
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+
+	for i, row := range output.RowHeaders {
+		line := []string{row.Name}
+		for _, cell := range output.Cells[i] {
+			line = append(line, formatPivotValue(cell, output.Measure))
+		}
+		line = append(line, formatPivotValue(output.RowTotals[i], output.Measure))
+
+		if err := writer.Write(line); err != nil {
+			return nil, err
+		}
+	}
+
+	line := []string{total}
+	for _, columnTotal := range output.ColumnTotals {
+		line = append(line, formatPivotValue(columnTotal, output.Measure))
+	}
+	line = append(line, formatPivotValue(output.Total, output.Measure))
+
+	if err := writer.Write(line); err != nil {
+		return nil, err
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func formatPivotValue(value *float64, measure string) string {
+	if value == nil {
+		return ""
+	}
+
+	if measure == repositories.PIVOT_MEASURE_COUNT {
+		return strconv.FormatFloat(*value, 'f', 0, 64)
+	}
+
+	return strconv.FormatFloat(*value, 'f', 2, 64)
+}
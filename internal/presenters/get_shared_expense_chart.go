@@ -0,0 +1,63 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetSharedExpenseChartInputDto struct {
+	Chart     GetExpenseChartInputDto `json:"chart"`
+	Expires   string                  `json:"expires"`
+	Signature string                  `json:"signature"`
+}
+
+type GetSharedExpenseChartUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetSharedExpenseChartUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetSharedExpenseChartUseCase {
+	return &GetSharedExpenseChartUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+func (c *GetSharedExpenseChartUseCase) Execute(input GetSharedExpenseChartInputDto) (GetExpenseChartOutputDto, []util.ProblemDetails) {
+	query := chartQuery(input.Chart)
+	query.Set("user_id", input.Chart.UserID)
+	query.Set("expires", input.Expires)
+
+	if input.Chart.UserID == "" || !util.VerifyHMACSignature("chart:"+query.Encode(), input.Signature) {
+		return GetExpenseChartOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "Invalid signature",
+				Status:   403,
+				Detail:   "The chart link is not valid",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	expires, err := strconv.ParseInt(input.Expires, 10, 64)
+	if err != nil || time.Now().After(time.Unix(expires, 0)) {
+		return GetExpenseChartOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "Link expired",
+				Status:   403,
+				Detail:   "The chart link has expired",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	return NewGetExpenseChartUseCase(c.PresentersRepository, c.UserRepository).Execute(input.Chart)
+}
@@ -0,0 +1,297 @@
+package presenters
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+const (
+	HEATMAP_BUSIEST_DAYS     = 5
+	HEATMAP_NO_SPEND_STREAKS = 3
+)
+
+type GetSpendingHeatmapInputDto struct {
+	UserID    string `json:"user_id"`
+	Year      string `json:"year"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	Locale    string `json:"locale"`
+}
+
+type HeatmapDay struct {
+	Date        string  `json:"date"`
+	Weekday     int     `json:"weekday"`
+	WeekdayName string  `json:"weekday_name"`
+	Total       float64 `json:"total"`
+	Count       int     `json:"count"`
+	Level       int     `json:"level"`
+}
+
+type NoSpendStreak struct {
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	Days      int    `json:"days"`
+}
+
+type GetSpendingHeatmapOutputDto struct {
+	StartDate      string          `json:"start_date"`
+	EndDate        string          `json:"end_date"`
+	Total          float64         `json:"total"`
+	SpendingDays   int             `json:"spending_days"`
+	NoSpendDays    int             `json:"no_spend_days"`
+	Thresholds     []float64       `json:"thresholds"`
+	Days           []HeatmapDay    `json:"days"`
+	BusiestDays    []HeatmapDay    `json:"busiest_days"`
+	NoSpendStreaks []NoSpendStreak `json:"no_spend_streaks"`
+}
+
+type GetSpendingHeatmapUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetSpendingHeatmapUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetSpendingHeatmapUseCase {
+	return &GetSpendingHeatmapUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+// Execute returns one entry per calendar day of the range, or of the year when
+// no range is given, stopping at today in the user's timezone. Days with
+// spending get a level from 1 to 4 by the quartiles of the daily totals of
+// the range, returned as thresholds; days without spending are level 0.
+func (c *GetSpendingHeatmapUseCase) Execute(input GetSpendingHeatmapInputDto) (GetSpendingHeatmapOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetSpendingHeatmapOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetSpendingHeatmapOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	now := util.Now(user.Timezone)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	var startDate, endDate time.Time
+
+	if input.StartDate != "" || input.EndDate != "" {
+		startDate, err = util.ParseDate(input.StartDate, user.Timezone)
+		if err != nil {
+			return GetSpendingHeatmapOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Bad Request",
+					Title:    "Invalid start date",
+					Status:   400,
+					Detail:   "Start date is not in the correct format",
+					Instance: util.RFC400,
+				},
+			}
+		}
+
+		endDate, err = util.ParseDate(input.EndDate, user.Timezone)
+		if err != nil {
+			return GetSpendingHeatmapOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Bad Request",
+					Title:    "Invalid end date",
+					Status:   400,
+					Detail:   "End date is not in the correct format",
+					Instance: util.RFC400,
+				},
+			}
+		}
+
+		if startDate.After(endDate) {
+			return GetSpendingHeatmapOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Bad Request",
+					Title:    "Invalid date range",
+					Status:   400,
+					Detail:   "Start date must be before end date",
+					Instance: util.RFC400,
+				},
+			}
+		}
+	} else {
+		year := today.Year()
+
+		if input.Year != "" {
+			year, err = strconv.Atoi(input.Year)
+			if err != nil {
+				return GetSpendingHeatmapOutputDto{}, []util.ProblemDetails{
+					{
+						Type:     "Bad Request",
+						Title:    "Invalid year",
+						Status:   400,
+						Detail:   err.Error(),
+						Instance: util.RFC400,
+					},
+				}
+			}
+
+			if year < 1900 || year > today.Year() {
+				return GetSpendingHeatmapOutputDto{}, []util.ProblemDetails{
+					{
+						Type:     "Bad Request",
+						Title:    "Invalid year",
+						Status:   400,
+						Detail:   "Year must be less than or equal to the current year",
+						Instance: util.RFC400,
+					},
+				}
+			}
+		}
+
+		startDate = time.Date(year, time.January, 1, 0, 0, 0, 0, today.Location())
+		endDate = time.Date(year, time.December, 31, 0, 0, 0, 0, today.Location())
+	}
+
+	if endDate.After(today) {
+		endDate = today
+	}
+
+	output := GetSpendingHeatmapOutputDto{
+		StartDate:      startDate.Format("02/01/2006"),
+		EndDate:        endDate.Format("02/01/2006"),
+		Thresholds:     []float64{},
+		Days:           []HeatmapDay{},
+		BusiestDays:    []HeatmapDay{},
+		NoSpendStreaks: []NoSpendStreak{},
+	}
+
+	if startDate.After(endDate) {
+		return output, nil
+	}
+
+	dailyTotals, err := c.PresentersRepository.GetDailyExpenseTotals(input.UserID, startDate, endDate.AddDate(0, 0, 1).Add(-time.Nanosecond))
+	if err != nil {
+		return GetSpendingHeatmapOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not calculate total expenses",
+				Status:   500,
+				Detail:   err.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	totalsByDay := make(map[string]repositories.DailyExpenseTotal)
+	var spending []float64
+	for _, dailyTotal := range dailyTotals {
+		totalsByDay[dailyTotal.Date.Format("2006-01-02")] = dailyTotal
+		if dailyTotal.Total > 0 {
+			spending = append(spending, dailyTotal.Total)
+		}
+	}
+
+	sort.Float64s(spending)
+	if len(spending) > 0 {
+		output.Thresholds = []float64{quantile(spending, 0.25), quantile(spending, 0.5), quantile(spending, 0.75)}
+	}
+
+	var streak *NoSpendStreak
+	for day := startDate; !day.After(endDate); day = day.AddDate(0, 0, 1) {
+		dailyTotal := totalsByDay[day.Format("2006-01-02")]
+
+		heatmapDay := HeatmapDay{
+			Date:        day.Format("02/01/2006"),
+			Weekday:     int(day.Weekday()),
+			WeekdayName: util.WeekdayName(day.Weekday(), input.Locale),
+			Total:       dailyTotal.Total,
+			Count:       dailyTotal.Count,
+			Level:       heatmapLevel(dailyTotal.Total, output.Thresholds),
+		}
+
+		output.Days = append(output.Days, heatmapDay)
+		output.Total += dailyTotal.Total
+
+		if dailyTotal.Total > 0 {
+			output.SpendingDays++
+			output.BusiestDays = append(output.BusiestDays, heatmapDay)
+
+			if streak != nil {
+				output.NoSpendStreaks = append(output.NoSpendStreaks, *streak)
+				streak = nil
+			}
+			continue
+		}
+
+		output.NoSpendDays++
+
+		if streak == nil {
+			streak = &NoSpendStreak{StartDate: heatmapDay.Date}
+		}
+		streak.EndDate = heatmapDay.Date
+		streak.Days++
+	}
+
+	if streak != nil {
+		output.NoSpendStreaks = append(output.NoSpendStreaks, *streak)
+	}
+
+	sort.SliceStable(output.BusiestDays, func(i, j int) bool {
+		return output.BusiestDays[i].Total > output.BusiestDays[j].Total
+	})
+	if len(output.BusiestDays) > HEATMAP_BUSIEST_DAYS {
+		output.BusiestDays = output.BusiestDays[:HEATMAP_BUSIEST_DAYS]
+	}
+
+	sort.SliceStable(output.NoSpendStreaks, func(i, j int) bool {
+		return output.NoSpendStreaks[i].Days > output.NoSpendStreaks[j].Days
+	})
+	if len(output.NoSpendStreaks) > HEATMAP_NO_SPEND_STREAKS {
+		output.NoSpendStreaks = output.NoSpendStreaks[:HEATMAP_NO_SPEND_STREAKS]
+	}
+
+	return output, nil
+}
+
+// quantile interpolates linearly between the closest ranks of sorted values.
+func quantile(sorted []float64, q float64) float64 {
+	position := q * float64(len(sorted)-1)
+	lower := int(position)
+
+	if lower+1 >= len(sorted) {
+		return sorted[lower]
+	}
+
+	return sorted[lower] + (sorted[lower+1]-sorted[lower])*(position-float64(lower))
+}
+
+func heatmapLevel(total float64, thresholds []float64) int {
+	if total <= 0 {
+		return 0
+	}
+
+	for i, threshold := range thresholds {
+		if total <= threshold {
+			return i + 1
+		}
+	}
+
+	return len(thresholds) + 1
+}
@@ -0,0 +1,330 @@
+package presenters
+
+import (
+	"bytes"
+	"encoding/csv"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+var taxCategoryNames = map[string]string{
+	entities.TAX_CATEGORY_HEALTH:          "Health",
+	entities.TAX_CATEGORY_EDUCATION:       "Education",
+	entities.TAX_CATEGORY_PRIVATE_PENSION: "Private pension",
+	entities.TAX_CATEGORY_ALIMONY:         "Alimony",
+	entities.TAX_CATEGORY_DONATION:        "Donations",
+}
+
+type GetTaxDeductionReportInputDto struct {
+	UserID string `json:"user_id"`
+	Year   string `json:"year"`
+	Locale string `json:"locale"`
+}
+
+type TaxProviderTotal struct {
+	TaxID string  `json:"tax_id"`
+	Name  string  `json:"name"`
+	Count int     `json:"count"`
+	Total float64 `json:"total"`
+}
+
+type TaxCategoryTotal struct {
+	TaxCategory string             `json:"tax_category"`
+	Name        string             `json:"name"`
+	Count       int                `json:"count"`
+	Total       float64            `json:"total"`
+	Providers   []TaxProviderTotal `json:"providers"`
+}
+
+type TaxDeductibleExpense struct {
+	ExpenseID       string  `json:"expense_id"`
+	ExpenseDate     string  `json:"expense_date"`
+	Amount          float64 `json:"amount"`
+	TaxCategory     string  `json:"tax_category"`
+	TaxCategoryName string  `json:"tax_category_name"`
+	CategoryName    string  `json:"category_name"`
+	ProviderTaxID   string  `json:"provider_tax_id"`
+	ProviderName    string  `json:"provider_name"`
+	Notes           string  `json:"notes"`
+}
+
+type GetTaxDeductionReportOutputDto struct {
+	Year                 int                    `json:"year"`
+	GeneratedAt          string                 `json:"generated_at"`
+	Total                float64                `json:"total"`
+	TaxCategories        []TaxCategoryTotal     `json:"tax_categories"`
+	MissingProviderCount int                    `json:"missing_provider_count"`
+	Expenses             []TaxDeductibleExpense `json:"expenses"`
+}
+
+type GetTaxDeductionReportUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetTaxDeductionReportUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetTaxDeductionReportUseCase {
+	return &GetTaxDeductionReportUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+// Execute totals the deductible expenses of a calendar year by tax category
+// and, within each, by provider, as the income tax return asks for them. The
+// year defaults to the previous one, which is the one being declared.
+func (c *GetTaxDeductionReportUseCase) Execute(input GetTaxDeductionReportInputDto) (GetTaxDeductionReportOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetTaxDeductionReportOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetTaxDeductionReportOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	now := util.Now(user.Timezone)
+
+	year := now.Year() - 1
+	if input.Year != "" {
+		year, err = strconv.Atoi(input.Year)
+		if err != nil || year < 1900 || year > now.Year() {
+			return GetTaxDeductionReportOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Bad Request",
+					Title:    "Invalid year",
+					Status:   400,
+					Detail:   "Year must be less than or equal to the current year",
+					Instance: util.RFC400,
+				},
+			}
+		}
+	}
+
+	startDate := time.Date(year, time.January, 1, 0, 0, 0, 0, now.Location())
+	endDate := startDate.AddDate(1, 0, 0).Add(-time.Nanosecond)
+
+	expenses, err := c.PresentersRepository.GetDeductibleExpenses(input.UserID, startDate, endDate)
+	if err != nil {
+		return GetTaxDeductionReportOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not generate report",
+				Status:   500,
+				Detail:   err.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	output := GetTaxDeductionReportOutputDto{
+		Year:          year,
+		GeneratedAt:   now.Format("02/01/2006 15:04"),
+		TaxCategories: []TaxCategoryTotal{},
+		Expenses:      []TaxDeductibleExpense{},
+	}
+
+	categories := map[string]*TaxCategoryTotal{}
+	providers := map[string]map[string]*TaxProviderTotal{}
+
+	for _, expense := range expenses {
+		category, ok := categories[expense.TaxCategory]
+		if !ok {
+			category = &TaxCategoryTotal{
+				TaxCategory: expense.TaxCategory,
+				Name:        taxCategoryName(expense.TaxCategory, input.Locale),
+				Providers:   []TaxProviderTotal{},
+			}
+			categories[expense.TaxCategory] = category
+			providers[expense.TaxCategory] = map[string]*TaxProviderTotal{}
+		}
+
+		category.Count++
+		category.Total += expense.Amount
+		output.Total += expense.Amount
+
+		provider, ok := providers[expense.TaxCategory][expense.ProviderTaxID]
+		if !ok {
+			provider = &TaxProviderTotal{TaxID: util.FormatTaxID(expense.ProviderTaxID)}
+			if expense.ProviderTaxID == "" {
+				provider.Name = util.Translate(input.Locale, "Provider not informed")
+			}
+			providers[expense.TaxCategory][expense.ProviderTaxID] = provider
+		}
+
+		// Expenses come oldest first, so the provider keeps its latest name.
+		if expense.ProviderTaxID != "" && expense.ProviderName != "" {
+			provider.Name = expense.ProviderName
+		}
+
+		provider.Count++
+		provider.Total += expense.Amount
+
+		if expense.ProviderTaxID == "" {
+			output.MissingProviderCount++
+		}
+
+		output.Expenses = append(output.Expenses, TaxDeductibleExpense{
+			ExpenseID:       expense.ExpenseID,
+			ExpenseDate:     expense.ExpenseDate.In(now.Location()).Format("02/01/2006"),
+			Amount:          expense.Amount,
+			TaxCategory:     expense.TaxCategory,
+			TaxCategoryName: category.Name,
+			CategoryName:    expense.CategoryName,
+			ProviderTaxID:   util.FormatTaxID(expense.ProviderTaxID),
+			ProviderName:    expense.ProviderName,
+			Notes:           expense.Notes,
+		})
+	}
+
+	for _, taxCategory := range entities.TaxCategories {
+		category, ok := categories[taxCategory]
+		if !ok {
+			continue
+		}
+
+		for _, provider := range providers[taxCategory] {
+			category.Providers = append(category.Providers, *provider)
+		}
+
+		sort.Slice(category.Providers, func(i, j int) bool {
+			if category.Providers[i].Total != category.Providers[j].Total {
+				return category.Providers[i].Total > category.Providers[j].Total
+			}
+			return category.Providers[i].TaxID < category.Providers[j].TaxID
+		})
+
+		output.TaxCategories = append(output.TaxCategories, *category)
+	}
+
+	return output, nil
+}
+
+func taxCategoryName(taxCategory string, locale string) string {
+	if name, ok := taxCategoryNames[taxCategory]; ok {
+		return util.Translate(locale, name)
+	}
+
+	return taxCategory
+}
+
+// TaxDeductionReportCSV writes one line per provider of each tax category.
+func TaxDeductionReportCSV(output GetTaxDeductionReportOutputDto, locale string) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+
+	if err := writer.Write([]string{
+		util.Translate(locale, "Tax category"),
+		util.Translate(locale, "CPF/CNPJ"),
+		util.Translate(locale, "Provider"),
+		util.Translate(locale, "Expenses"),
+		util.Translate(locale, "Total"),
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, category := range output.TaxCategories {
+		for _, provider := range category.Providers {
+			if err := writer.Write([]string{
+				category.Name,
+				provider.TaxID,
+				provider.Name,
+				strconv.Itoa(provider.Count),
+				strconv.FormatFloat(provider.Total, 'f', 2, 64),
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// TaxDeductionReportPDF lays out the totals by tax category, a provider table
+// for each one and the list of deductible expenses.
+func TaxDeductionReportPDF(output GetTaxDeductionReportOutputDto, locale string) ([]byte, error) {
+	report := newReportLayout(locale)
+
+	report.pdf.Text(REPORT_MARGIN, report.y, 20, true, REPORT_TEXT_COLOR, util.Translate(locale, "Income tax deductions")+" - "+strconv.Itoa(output.Year))
+	report.y += 18
+	report.pdf.Text(REPORT_MARGIN, report.y, 9, false, REPORT_MUTED_COLOR, util.Translate(locale, "Generated on")+" "+output.GeneratedAt)
+	report.y += 30
+
+	report.headline([][2]string{
+		{util.Translate(locale, "Deductible total"), util.FormatAmount(output.Total, locale)},
+		{util.Translate(locale, "Expenses"), strconv.Itoa(len(output.Expenses))},
+		{util.Translate(locale, "Provider not informed"), strconv.Itoa(output.MissingProviderCount)},
+	})
+
+	var bars []reportBar
+	for _, category := range output.TaxCategories {
+		bars = append(bars, reportBar{Label: category.Name, Total: category.Total})
+	}
+	report.barChart(util.Translate(locale, "Tax categories"), bars, output.Total)
+
+	providerColumns := []reportColumn{
+		{util.Translate(locale, "CPF/CNPJ"), 120},
+		{util.Translate(locale, "Provider"), 255},
+		{util.Translate(locale, "Expenses"), 60},
+	}
+
+	for _, category := range output.TaxCategories {
+		var rows [][]string
+		var amounts []float64
+
+		for _, provider := range category.Providers {
+			rows = append(rows, []string{provider.TaxID, provider.Name, strconv.Itoa(provider.Count)})
+			amounts = append(amounts, provider.Total)
+		}
+
+		report.table(category.Name, providerColumns, rows, amounts)
+	}
+
+	var rows [][]string
+	var amounts []float64
+
+	for _, expense := range output.Expenses {
+		provider := expense.ProviderName
+		if provider == "" {
+			provider = expense.ProviderTaxID
+		}
+
+		rows = append(rows, []string{expense.ExpenseDate, expense.TaxCategoryName, provider, expense.Notes})
+		amounts = append(amounts, expense.Amount)
+	}
+
+	report.table(util.Translate(locale, "Deductible expenses"), []reportColumn{
+		{util.Translate(locale, "Date"), 60},
+		{util.Translate(locale, "Tax category"), 95},
+		{util.Translate(locale, "Provider"), 140},
+		{util.Translate(locale, "Notes"), 140},
+	}, rows, amounts)
+
+	return report.pdf.Bytes()
+}
@@ -0,0 +1,185 @@
+package presenters
+
+import (
+	"strconv"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+const (
+	TOP_MERCHANTS_DEFAULT_LIMIT = 10
+	TOP_MERCHANTS_MAX_LIMIT     = 100
+)
+
+type GetTopMerchantsInputDto struct {
+	UserID    string `json:"user_id"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	Limit     string `json:"limit"`
+	Basis     string `json:"basis"`
+}
+
+type TopMerchant struct {
+	MerchantID   string  `json:"merchant_id"`
+	MerchantName string  `json:"merchant_name"`
+	Total        float64 `json:"total"`
+	Count        int     `json:"count"`
+	Average      float64 `json:"average"`
+	SharePercent float64 `json:"share_percent"`
+}
+
+type GetTopMerchantsOutputDto struct {
+	Total     float64       `json:"total"`
+	Count     int           `json:"count"`
+	Merchants []TopMerchant `json:"merchants"`
+}
+
+type GetTopMerchantsUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewGetTopMerchantsUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetTopMerchantsUseCase {
+	return &GetTopMerchantsUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+// Execute ranks the merchants by their total in the period. Total and Count
+// cover every merchant, so each share is of all the spending with a merchant,
+// not only of the ones listed.
+func (c *GetTopMerchantsUseCase) Execute(input GetTopMerchantsInputDto) (GetTopMerchantsOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetTopMerchantsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetTopMerchantsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	startDate, err := util.ParseDate(input.StartDate, user.Timezone)
+	if err != nil {
+		return GetTopMerchantsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid start date",
+				Status:   400,
+				Detail:   "Start date is not in the correct format",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	endDate, err := util.ParseDate(input.EndDate, user.Timezone)
+	if err != nil {
+		return GetTopMerchantsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid end date",
+				Status:   400,
+				Detail:   "End date is not in the correct format",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	if startDate.After(endDate) {
+		return GetTopMerchantsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid date range",
+				Status:   400,
+				Detail:   "Start date must be before end date",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	limit := TOP_MERCHANTS_DEFAULT_LIMIT
+	if input.Limit != "" {
+		limit, err = strconv.Atoi(input.Limit)
+		if err != nil || limit < 1 || limit > TOP_MERCHANTS_MAX_LIMIT {
+			return GetTopMerchantsOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Bad Request",
+					Title:    "Invalid limit",
+					Status:   400,
+					Detail:   "Limit must be between 1 and " + strconv.Itoa(TOP_MERCHANTS_MAX_LIMIT),
+					Instance: util.RFC400,
+				},
+			}
+		}
+	}
+
+	basis, basisErr := parseExpenseBasis(input.Basis)
+	if len(basisErr) > 0 {
+		return GetTopMerchantsOutputDto{}, basisErr
+	}
+
+	expenses, err := c.PresentersRepository.GetExpensesByMerchantPeriod(input.UserID, startDate, endDate, basis)
+	if err != nil {
+		return GetTopMerchantsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not calculate total expenses",
+				Status:   500,
+				Detail:   err.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	output := GetTopMerchantsOutputDto{
+		Merchants: []TopMerchant{},
+	}
+
+	for _, expense := range expenses {
+		output.Total += expense.Total
+		output.Count += expense.Count
+	}
+
+	for i, expense := range expenses {
+		if i == limit {
+			break
+		}
+
+		merchant := TopMerchant{
+			MerchantID:   expense.MerchantID,
+			MerchantName: expense.MerchantName,
+			Total:        expense.Total,
+			Count:        expense.Count,
+		}
+
+		if expense.Count > 0 {
+			merchant.Average = expense.Total / float64(expense.Count)
+		}
+
+		if output.Total > 0 {
+			merchant.SharePercent = expense.Total / output.Total * 100
+		}
+
+		output.Merchants = append(output.Merchants, merchant)
+	}
+
+	return output, nil
+}
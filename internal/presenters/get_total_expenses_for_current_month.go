@@ -7,6 +7,7 @@ import (
 
 type GetTotalExpensesForCurrentMonthInputDto struct {
 	UserID string `json:"user_id"`
+	Locale string `json:"locale"`
 }
 
 type GetTotalExpensesForCurrentMonthOutputDto struct {
@@ -68,6 +69,6 @@ func (c *GetTotalExpensesForCurrentMonthUseCase) Execute(input GetTotalExpensesF
 
 	return GetTotalExpensesForCurrentMonthOutputDto{
 		TotalExpenses: total,
-		CurrentMonth:  month,
+		CurrentMonth:  util.MonthName(month, input.Locale),
 	}, nil
 }
@@ -6,12 +6,17 @@ import (
 )
 
 type GetTotalExpensesForCurrentWeekInputDto struct {
-	UserID string `json:"user_id"`
+	UserID  string `json:"user_id"`
+	Compare bool   `json:"compare"`
 }
 
 type GetTotalExpensesForCurrentWeekOutputDto struct {
-	TotalExpenses float64 `json:"total_expenses"`
-	CurrentWeek   string  `json:"current_week"`
+	TotalExpenses     float64  `json:"total_expenses"`
+	CurrentWeek       string   `json:"current_week"`
+	WeekStart         string   `json:"week_start"`
+	PreviousWeekTotal *float64 `json:"previous_week_total,omitempty"`
+	PreviousWeek      string   `json:"previous_week,omitempty"`
+	Change            *float64 `json:"change,omitempty"`
 }
 
 type GetTotalExpensesForCurrentWeekUseCase struct {
@@ -53,7 +58,9 @@ func (c *GetTotalExpensesForCurrentWeekUseCase) Execute(input GetTotalExpensesFo
 		}
 	}
 
-	total, month, err := c.PresentersRepository.GetTotalExpensesForCurrentWeek(input.UserID)
+	now := util.Now(user.Timezone)
+
+	total, week, err := c.PresentersRepository.GetTotalExpensesForCurrentWeek(input.UserID, user.FirstDayOfWeek(), now)
 	if err != nil {
 		return GetTotalExpensesForCurrentWeekOutputDto{}, []util.ProblemDetails{
 			{
@@ -66,8 +73,34 @@ func (c *GetTotalExpensesForCurrentWeekUseCase) Execute(input GetTotalExpensesFo
 		}
 	}
 
-	return GetTotalExpensesForCurrentWeekOutputDto{
+	output := GetTotalExpensesForCurrentWeekOutputDto{
 		TotalExpenses: total,
-		CurrentWeek:   month,
-	}, nil
+		CurrentWeek:   week,
+		WeekStart:     user.WeekStart,
+	}
+
+	if input.Compare {
+		previousTotal, previousWeek, err := c.PresentersRepository.GetTotalExpensesForCurrentWeek(input.UserID, user.FirstDayOfWeek(), now.AddDate(0, 0, -7))
+		if err != nil {
+			return GetTotalExpensesForCurrentWeekOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Internal Server Error",
+					Title:    "Could not calculate total expenses",
+					Status:   500,
+					Detail:   err.Error(),
+					Instance: util.RFC500,
+				},
+			}
+		}
+
+		output.PreviousWeekTotal = &previousTotal
+		output.PreviousWeek = previousWeek
+
+		if previousTotal > 0 {
+			change := (total - previousTotal) / previousTotal * 100
+			output.Change = &change
+		}
+	}
+
+	return output, nil
 }
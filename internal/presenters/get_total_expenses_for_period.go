@@ -9,6 +9,7 @@ type GetTotalExpensesForPeriodInputDto struct {
 	UserID    string `json:"user_id"`
 	StartDate string `json:"start_date"`
 	EndDate   string `json:"end_date"`
+	Basis     string `json:"basis"`
 }
 
 type GetTotalExpensesForPeriodOutputDto struct {
@@ -54,7 +55,7 @@ func (c *GetTotalExpensesForPeriodUseCase) Execute(input GetTotalExpensesForPeri
 		}
 	}
 
-	startDate, err := util.ParseDate(input.StartDate)
+	startDate, err := util.ParseDate(input.StartDate, user.Timezone)
 	if err != nil {
 		return GetTotalExpensesForPeriodOutputDto{}, []util.ProblemDetails{
 			{
@@ -67,7 +68,7 @@ func (c *GetTotalExpensesForPeriodUseCase) Execute(input GetTotalExpensesForPeri
 		}
 	}
 
-	endDate, err := util.ParseDate(input.EndDate)
+	endDate, err := util.ParseDate(input.EndDate, user.Timezone)
 	if err != nil {
 		return GetTotalExpensesForPeriodOutputDto{}, []util.ProblemDetails{
 			{
@@ -92,7 +93,12 @@ func (c *GetTotalExpensesForPeriodUseCase) Execute(input GetTotalExpensesForPeri
 		}
 	}
 
-	total, err := c.PresentersRepository.GetTotalExpensesForPeriod(input.UserID, startDate, endDate)
+	basis, basisErr := parseExpenseBasis(input.Basis)
+	if len(basisErr) > 0 {
+		return GetTotalExpensesForPeriodOutputDto{}, basisErr
+	}
+
+	total, err := c.PresentersRepository.GetTotalExpensesForPeriod(input.UserID, startDate, endDate, basis)
 	if err != nil {
 		return GetTotalExpensesForPeriodOutputDto{}, []util.ProblemDetails{
 			{
@@ -11,6 +11,8 @@ import (
 type GetTotalExpensesMonthCurrentYearInputDto struct {
 	UserID string `json:"user_id"`
 	Year   string `json:"year"`
+	Basis  string `json:"basis"`
+	Locale string `json:"locale"`
 }
 
 type GetTotalExpensesMonthCurrentYearOutputDto struct {
@@ -81,7 +83,7 @@ func (c *GetTotalExpensesMonthCurrentYearUseCase) Execute(input GetTotalExpenses
 		}
 	}
 
-	if year > time.Now().Year() {
+	if year > util.Now(user.Timezone).Year() {
 		return GetTotalExpensesMonthCurrentYearOutputDto{}, []util.ProblemDetails{
 			{
 				Type:     "Bad Request",
@@ -93,7 +95,12 @@ func (c *GetTotalExpensesMonthCurrentYearUseCase) Execute(input GetTotalExpenses
 		}
 	}
 
-	expensesMonthCurrentYear, getTotalExpensesMonthCurrentYearErr := c.PresentersRepository.GetTotalExpensesMonthCurrentYear(input.UserID, year)
+	basis, basisErr := parseExpenseBasis(input.Basis)
+	if len(basisErr) > 0 {
+		return GetTotalExpensesMonthCurrentYearOutputDto{}, basisErr
+	}
+
+	expensesMonthCurrentYear, getTotalExpensesMonthCurrentYearErr := c.PresentersRepository.GetTotalExpensesMonthCurrentYear(input.UserID, year, basis)
 	if getTotalExpensesMonthCurrentYearErr != nil {
 		return GetTotalExpensesMonthCurrentYearOutputDto{}, []util.ProblemDetails{
 			{
@@ -106,7 +113,31 @@ func (c *GetTotalExpensesMonthCurrentYearUseCase) Execute(input GetTotalExpenses
 		}
 	}
 
+	for i := range expensesMonthCurrentYear.Months {
+		month := &expensesMonthCurrentYear.Months[i]
+		month.Month = util.MonthName(time.Month(month.MonthNumber), input.Locale)
+	}
+
 	return GetTotalExpensesMonthCurrentYearOutputDto{
 		ExpensesMonthCurrentYear: expensesMonthCurrentYear,
 	}, nil
 }
+
+func parseExpenseBasis(basis string) (string, []util.ProblemDetails) {
+	switch basis {
+	case "":
+		return repositories.EXPENSE_BASIS_PURCHASE, nil
+	case repositories.EXPENSE_BASIS_PURCHASE, repositories.EXPENSE_BASIS_STATEMENT:
+		return basis, nil
+	}
+
+	return "", []util.ProblemDetails{
+		{
+			Type:     "Bad Request",
+			Title:    "Invalid basis",
+			Status:   400,
+			Detail:   "Basis must be purchase or statement",
+			Instance: util.RFC400,
+		},
+	}
+}
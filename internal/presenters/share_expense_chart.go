@@ -0,0 +1,85 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+const (
+	CHART_SHARE_DEFAULT_HOURS = 24
+	CHART_SHARE_MAX_HOURS     = 30 * 24
+)
+
+type ShareExpenseChartInputDto struct {
+	Chart     GetExpenseChartInputDto `json:"chart"`
+	ExpiresIn string                  `json:"expires_in"`
+	BaseURL   string                  `json:"base_url"`
+}
+
+type ShareExpenseChartOutputDto struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// ShareExpenseChartUseCase signs a chart link that renders without a login
+// until it expires. The signature covers every chart option, the user and the
+// expiry, so none of them can be changed on the link.
+type ShareExpenseChartUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewShareExpenseChartUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *ShareExpenseChartUseCase {
+	return &ShareExpenseChartUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+func (c *ShareExpenseChartUseCase) Execute(input ShareExpenseChartInputDto) (ShareExpenseChartOutputDto, []util.ProblemDetails) {
+	hours := CHART_SHARE_DEFAULT_HOURS
+	if input.ExpiresIn != "" {
+		var err error
+		hours, err = strconv.Atoi(input.ExpiresIn)
+		if err != nil || hours < 1 || hours > CHART_SHARE_MAX_HOURS {
+			return ShareExpenseChartOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Bad Request",
+					Title:    "Invalid expiration",
+					Status:   400,
+					Detail:   "Expiration must be between 1 and " + strconv.Itoa(CHART_SHARE_MAX_HOURS) + " hours",
+					Instance: util.RFC400,
+				},
+			}
+		}
+	}
+
+	chart, problems := normalizeChartInput(input.Chart)
+	if len(problems) > 0 {
+		return ShareExpenseChartOutputDto{}, problems
+	}
+
+	// Rendering once checks the user and the dates before the link is handed out.
+	if _, problems := NewGetExpenseChartUseCase(c.PresentersRepository, c.UserRepository).Execute(chart); len(problems) > 0 {
+		return ShareExpenseChartOutputDto{}, problems
+	}
+
+	expiresAt := time.Now().Add(time.Duration(hours) * time.Hour).Truncate(time.Second)
+
+	query := chartQuery(chart)
+	query.Set("user_id", chart.UserID)
+	query.Set("expires", strconv.FormatInt(expiresAt.Unix(), 10))
+
+	signature := util.SignWithHMAC("chart:" + query.Encode())
+
+	return ShareExpenseChartOutputDto{
+		URL:       input.BaseURL + "/charts/shared?" + query.Encode() + "&signature=" + signature,
+		ExpiresAt: expiresAt,
+	}, nil
+}
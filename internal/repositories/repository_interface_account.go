@@ -0,0 +1,11 @@
+package repositories
+
+import "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+
+type AccountRepositoryInterface interface {
+	CreateAccount(account entities.Account) error
+	DeleteAccount(account entities.Account) error
+	GetAccounts(userID string) ([]entities.Account, error)
+	GetAccount(userID string, accountID string) (entities.Account, error)
+	UpdateAccount(account entities.Account) error
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+
+type DigestRepositoryInterface interface {
+	CreateDigestSubscription(subscription entities.DigestSubscription) error
+	DeleteDigestSubscription(subscription entities.DigestSubscription) error
+	VerifyDigestSubscription(subscription entities.DigestSubscription) error
+	GetDigestSubscriptions(userID string) ([]entities.DigestSubscription, error)
+	GetDigestSubscription(userID string, subscriptionID string) (entities.DigestSubscription, error)
+	GetAllDigestSubscriptions() ([]entities.DigestSubscription, error)
+	GetDigestDelivery(subscriptionID string, periodKey string) (entities.DigestDelivery, error)
+	CreateDigestDelivery(delivery entities.DigestDelivery) (bool, error)
+	RetryDigestDelivery(delivery entities.DigestDelivery) (bool, error)
+	UpdateDigestDelivery(delivery entities.DigestDelivery) error
+}
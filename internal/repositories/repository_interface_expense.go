@@ -1,11 +1,23 @@
 package repositories
 
-import "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+)
 
 type ExpenseRepositoryInterface interface {
 	CreateExpense(expense entities.Expense) error
 	DeleteExpense(expense entities.Expense) error
 	GetExpenses(userID string) ([]entities.Expense, error)
+	GetExpensesPage(userID string, afterID string, limit int) ([]entities.Expense, error)
 	GetExpense(userID string, expenseID string) (entities.Expense, error)
 	UpdateExpense(expense entities.Expense) error
+	UpdateExpenses(expenses []entities.Expense) error
+	GetAccountExpenses(userID string, accountID string) ([]entities.Expense, error)
+	UpdateExpenseStatements(expenses []entities.Expense) error
+	GetCategoryExpensesForPeriod(userID string, categoryID string, StartDate time.Time, EndDate time.Time) ([]entities.Expense, error)
+	GetExpenseAnomalies(userID string) ([]entities.Expense, error)
+	GetExpenseAnomaly(userID string, anomalyID string) (entities.ExpenseAnomaly, error)
+	DismissExpenseAnomaly(anomaly entities.ExpenseAnomaly) error
 }
@@ -0,0 +1,15 @@
+package repositories
+
+import "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+
+type GoalRepositoryInterface interface {
+	CreateGoal(goal entities.Goal) error
+	DeleteGoal(goal entities.Goal) error
+	GetGoals(userID string) ([]entities.Goal, error)
+	GetGoal(userID string, goalID string) (entities.Goal, error)
+	UpdateGoal(goal entities.Goal) error
+	CreateGoalContribution(contribution entities.GoalContribution) error
+	DeleteGoalContribution(contribution entities.GoalContribution) error
+	GetGoalContributions(userID string, goalID string) ([]entities.GoalContribution, error)
+	GetGoalContribution(userID string, contributionID string) (entities.GoalContribution, error)
+}
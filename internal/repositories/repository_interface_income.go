@@ -0,0 +1,11 @@
+package repositories
+
+import "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+
+type IncomeRepositoryInterface interface {
+	CreateIncome(income entities.Income) error
+	DeleteIncome(income entities.Income) error
+	GetIncomes(userID string) ([]entities.Income, error)
+	GetIncome(userID string, incomeID string) (entities.Income, error)
+	UpdateIncome(income entities.Income) error
+}
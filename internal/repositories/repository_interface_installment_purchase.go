@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+)
+
+type InstallmentPurchaseRepositoryInterface interface {
+	CreateInstallmentPurchase(purchase entities.InstallmentPurchase, installments []entities.Expense) error
+	DeleteInstallmentPurchase(purchase entities.InstallmentPurchase, from time.Time) error
+	GetInstallmentPurchases(userID string) ([]entities.InstallmentPurchase, error)
+	GetInstallmentPurchase(userID string, purchaseID string) (entities.InstallmentPurchase, error)
+	UpdateInstallmentPurchase(purchase entities.InstallmentPurchase, from time.Time, installments []entities.Expense) error
+}
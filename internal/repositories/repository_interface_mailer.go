@@ -0,0 +1,7 @@
+package repositories
+
+import "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+
+type MailerInterface interface {
+	SendEmail(message entities.EmailMessage) error
+}
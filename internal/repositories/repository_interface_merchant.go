@@ -0,0 +1,13 @@
+package repositories
+
+import "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+
+type MerchantRepositoryInterface interface {
+	CreateMerchant(merchant entities.Merchant) error
+	DeleteMerchant(merchant entities.Merchant) error
+	GetMerchants(userID string) ([]entities.Merchant, error)
+	GetMerchant(userID string, merchantID string) (entities.Merchant, error)
+	ThisMerchantExists(userID string, merchantName string) (bool, error)
+	UpdateMerchant(merchant entities.Merchant) error
+	GetMerchantExpenses(userID string, merchantID string) ([]entities.Expense, error)
+}
@@ -6,6 +6,48 @@ import (
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
 )
 
+const (
+	EXPENSE_BASIS_PURCHASE  = "purchase"
+	EXPENSE_BASIS_STATEMENT = "statement"
+)
+
+const (
+	FORECAST_METHOD_PACE                 = "pace"
+	FORECAST_METHOD_DAY_OF_MONTH_HISTORY = "day_of_month_history"
+)
+
+const (
+	TIME_SERIES_DAY     = "day"
+	TIME_SERIES_WEEK    = "week"
+	TIME_SERIES_MONTH   = "month"
+	TIME_SERIES_QUARTER = "quarter"
+	TIME_SERIES_YEAR    = "year"
+)
+
+const (
+	TIME_SERIES_GROUP_CATEGORY = "category"
+	TIME_SERIES_GROUP_TAG      = "tag"
+	TIME_SERIES_GROUP_ACCOUNT  = "account"
+	TIME_SERIES_GROUP_WEEKDAY  = "weekday"
+)
+
+const (
+	PIVOT_DIMENSION_CATEGORY = "category"
+	PIVOT_DIMENSION_TAG      = "tag"
+	PIVOT_DIMENSION_MONTH    = "month"
+	PIVOT_DIMENSION_WEEK     = "week"
+	PIVOT_DIMENSION_WEEKDAY  = "weekday"
+	PIVOT_DIMENSION_ACCOUNT  = "account"
+)
+
+const (
+	PIVOT_MEASURE_SUM     = "sum"
+	PIVOT_MEASURE_COUNT   = "count"
+	PIVOT_MEASURE_AVERAGE = "average"
+	PIVOT_MEASURE_MIN     = "min"
+	PIVOT_MEASURE_MAX     = "max"
+)
+
 type CategoryExpense struct {
 	CategoryName  string  `json:"category_name"`
 	CategoryColor string  `json:"category_color"`
@@ -19,21 +61,24 @@ type TagExpense struct {
 }
 
 type MonthlyCategoryExpense struct {
-	Month      string            `json:"month"`
-	Year       int               `json:"year"`
-	Categories []CategoryExpense `json:"categories"`
-	Total      float64           `json:"total"`
+	Month       string            `json:"month"`
+	MonthNumber int               `json:"month_number"`
+	Year        int               `json:"year"`
+	Categories  []CategoryExpense `json:"categories"`
+	Total       float64           `json:"total"`
 }
 
 type MonthlyTagExpense struct {
-	Month string       `json:"month"`
-	Year  int          `json:"year"`
-	Tags  []TagExpense `json:"tags"`
-	Total float64      `json:"total"`
+	Month       string       `json:"month"`
+	MonthNumber int          `json:"month_number"`
+	Year        int          `json:"year"`
+	Tags        []TagExpense `json:"tags"`
+	Total       float64      `json:"total"`
 }
 
 type MonthExpenses struct {
 	Month          string         `json:"month"`
+	MonthNumber    int            `json:"month_number"`
 	Year           int            `json:"year"`
 	TotalExpenses  float64        `json:"total_expenses"`
 	Weeks          []WeekExpenses `json:"weeks"`
@@ -41,13 +86,16 @@ type MonthExpenses struct {
 }
 
 type WeekExpenses struct {
-	Week int          `json:"week"`
-	Days []DayExpense `json:"days"`
+	Week      int          `json:"week"`
+	StartDate string       `json:"start_date"`
+	EndDate   string       `json:"end_date"`
+	Days      []DayExpense `json:"days"`
 }
 
 type DayExpense struct {
 	Day     string       `json:"day"`
 	DayName string       `json:"day_name"`
+	Weekday int          `json:"weekday"`
 	Total   float64      `json:"total"`
 	Tags    []ExpenseTag `json:"tags"`
 }
@@ -59,8 +107,9 @@ type ExpenseTag struct {
 }
 
 type MonthCurrentYear struct {
-	Month string  `json:"month"`
-	Total float64 `json:"total"`
+	Month       string  `json:"month"`
+	MonthNumber int     `json:"month_number"`
+	Total       float64 `json:"total"`
 }
 
 type ExpensesMonthCurrentYear struct {
@@ -90,6 +139,7 @@ type MonthOption struct {
 
 type CategoryTagsTotals struct {
 	Month           string             `json:"month"`
+	MonthNumber     int                `json:"month_number"`
 	Year            int                `json:"year"`
 	ExpensesAmount  float64            `json:"expenses_amount"`
 	Categories      []CategoryWithTags `json:"categories"`
@@ -97,16 +147,316 @@ type CategoryTagsTotals struct {
 	AvailableMonths []MonthOption      `json:"available_months"`
 }
 
+type MonthCashFlow struct {
+	Month       string  `json:"month"`
+	MonthNumber int     `json:"month_number"`
+	Income      float64 `json:"income"`
+	Expenses    float64 `json:"expenses"`
+	Net         float64 `json:"net"`
+	SavingsRate float64 `json:"savings_rate"`
+}
+
+type CashFlowYear struct {
+	Year           int             `json:"year"`
+	Income         float64         `json:"income"`
+	Expenses       float64         `json:"expenses"`
+	Net            float64         `json:"net"`
+	SavingsRate    float64         `json:"savings_rate"`
+	Months         []MonthCashFlow `json:"months"`
+	AvailableYears []int           `json:"available_years"`
+}
+
+type IncomeVsExpense struct {
+	Income            float64           `json:"income"`
+	Expenses          float64           `json:"expenses"`
+	Net               float64           `json:"net"`
+	SavingsRate       float64           `json:"savings_rate"`
+	IncomeCategories  []CategoryExpense `json:"income_categories"`
+	ExpenseCategories []CategoryExpense `json:"expense_categories"`
+}
+
+type AccountBalance struct {
+	AccountID      string  `json:"account_id"`
+	Name           string  `json:"name"`
+	Type           string  `json:"type"`
+	Currency       string  `json:"currency"`
+	OpeningBalance float64 `json:"opening_balance"`
+	Income         float64 `json:"income"`
+	Expenses       float64 `json:"expenses"`
+	TransfersIn    float64 `json:"transfers_in"`
+	TransfersOut   float64 `json:"transfers_out"`
+	Balance        float64 `json:"balance"`
+}
+
+type AccountBalanceDay struct {
+	Date    string  `json:"date"`
+	Inflow  float64 `json:"inflow"`
+	Outflow float64 `json:"outflow"`
+	Balance float64 `json:"balance"`
+}
+
+type AccountRunningBalance struct {
+	AccountID       string              `json:"account_id"`
+	Name            string              `json:"name"`
+	Type            string              `json:"type"`
+	Currency        string              `json:"currency"`
+	StartingBalance float64             `json:"starting_balance"`
+	EndingBalance   float64             `json:"ending_balance"`
+	Days            []AccountBalanceDay `json:"days"`
+}
+
+type AccountExpense struct {
+	AccountID   string  `json:"account_id"`
+	AccountName string  `json:"account_name"`
+	AccountType string  `json:"account_type"`
+	Total       float64 `json:"total"`
+}
+
+type CommittedInstallment struct {
+	InstallmentPurchaseID string  `json:"installment_purchase_id"`
+	Notes                 string  `json:"notes"`
+	InstallmentNumber     int     `json:"installment_number"`
+	InstallmentCount      int     `json:"installment_count"`
+	Amount                float64 `json:"amount"`
+}
+
+type MonthCommittedInstallments struct {
+	Month        string                 `json:"month"`
+	MonthNumber  int                    `json:"month_number"`
+	Year         int                    `json:"year"`
+	Total        float64                `json:"total"`
+	Installments []CommittedInstallment `json:"installments"`
+}
+
+type CardStatement struct {
+	StartDate    string  `json:"start_date"`
+	ClosingDate  string  `json:"closing_date"`
+	DueDate      string  `json:"due_date"`
+	ExpenseCount int     `json:"expense_count"`
+	Total        float64 `json:"total"`
+}
+
+type CardStatements struct {
+	AccountID      string          `json:"account_id"`
+	Name           string          `json:"name"`
+	Currency       string          `json:"currency"`
+	ClosingDay     int             `json:"closing_day"`
+	DueDay         int             `json:"due_day"`
+	Year           int             `json:"year"`
+	Total          float64         `json:"total"`
+	Statements     []CardStatement `json:"statements"`
+	AvailableYears []int           `json:"available_years"`
+}
+
+type GoalProgress struct {
+	GoalID                      string  `json:"goal_id"`
+	Name                        string  `json:"name"`
+	TargetAmount                float64 `json:"target_amount"`
+	Deadline                    string  `json:"deadline,omitempty"`
+	CategoryID                  string  `json:"category_id,omitempty"`
+	Saved                       float64 `json:"saved"`
+	Remaining                   float64 `json:"remaining"`
+	PercentComplete             float64 `json:"percent_complete"`
+	RequiredMonthlyContribution float64 `json:"required_monthly_contribution"`
+	AverageMonthlyContribution  float64 `json:"average_monthly_contribution"`
+	ProjectedCompletionDate     string  `json:"projected_completion_date,omitempty"`
+	Completed                   bool    `json:"completed"`
+	OnTrack                     bool    `json:"on_track"`
+}
+
+type ForecastCategory struct {
+	CategoryName  string  `json:"category_name"`
+	CategoryColor string  `json:"category_color"`
+	SpentSoFar    float64 `json:"spent_so_far"`
+	Scheduled     float64 `json:"scheduled"`
+	Estimate      float64 `json:"estimate"`
+	Low           float64 `json:"low"`
+	High          float64 `json:"high"`
+}
+
+type ForecastRecurringCharge struct {
+	Notes        string  `json:"notes"`
+	CategoryName string  `json:"category_name"`
+	Amount       float64 `json:"amount"`
+	ExpectedDate string  `json:"expected_date"`
+}
+
+type MonthForecast struct {
+	Month            string                    `json:"month"`
+	MonthNumber      int                       `json:"month_number"`
+	Year             int                       `json:"year"`
+	Day              int                       `json:"day"`
+	DaysInMonth      int                       `json:"days_in_month"`
+	Method           string                    `json:"method"`
+	HistoryMonths    int                       `json:"history_months"`
+	SpentSoFar       float64                   `json:"spent_so_far"`
+	Scheduled        float64                   `json:"scheduled"`
+	Estimate         float64                   `json:"estimate"`
+	Low              float64                   `json:"low"`
+	High             float64                   `json:"high"`
+	Categories       []ForecastCategory        `json:"categories"`
+	RecurringCharges []ForecastRecurringCharge `json:"recurring_charges"`
+}
+
+type LargestExpense struct {
+	ExpenseID     string    `json:"expense_id"`
+	ExpenseDate   time.Time `json:"expense_date"`
+	Amount        float64   `json:"amount"`
+	CategoryName  string    `json:"category_name"`
+	CategoryColor string    `json:"category_color"`
+	Notes         string    `json:"notes"`
+}
+
+// TimeSeriesQuery describes one aggregation of active expenses. GroupBy is
+// empty for a single series; empty ID lists do not filter.
+type TimeSeriesQuery struct {
+	StartDate   time.Time
+	EndDate     time.Time
+	Granularity string
+	GroupBy     string
+	WeekStart   time.Weekday
+	Basis       string
+	CategoryIDs []string
+	TagIDs      []string
+	AccountIDs  []string
+}
+
+type TimeSeriesBucket struct {
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	Total     float64   `json:"total"`
+}
+
+// TimeSeriesGroup holds one value per bucket of the series, in the same order.
+type TimeSeriesGroup struct {
+	Key    string    `json:"key"`
+	Name   string    `json:"name"`
+	Color  string    `json:"color"`
+	Totals []float64 `json:"totals"`
+	Total  float64   `json:"total"`
+}
+
+type TimeSeries struct {
+	Buckets []TimeSeriesBucket `json:"buckets"`
+	Groups  []TimeSeriesGroup  `json:"groups"`
+	Total   float64            `json:"total"`
+}
+
+// PivotQuery cross-tabulates active expenses. Columns may be empty for a
+// single-dimension report.
+type PivotQuery struct {
+	StartDate time.Time
+	EndDate   time.Time
+	Rows      string
+	Columns   string
+	Measure   string
+	WeekStart time.Weekday
+	Basis     string
+}
+
+type PivotHeader struct {
+	Key   string `json:"key"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// Pivot holds one cell per row and column, in the order of Rows and Columns.
+// Totals are computed over the expenses themselves, so the total of an
+// average is the average of all expenses in the row, not of the cells. Empty
+// cells are zero for sum and count and nil otherwise.
+type Pivot struct {
+	Rows         []PivotHeader `json:"rows"`
+	Columns      []PivotHeader `json:"columns"`
+	Cells        [][]*float64  `json:"cells"`
+	RowTotals    []*float64    `json:"row_totals"`
+	ColumnTotals []*float64    `json:"column_totals"`
+	Total        *float64      `json:"total"`
+}
+
+type DailyExpenseTotal struct {
+	Date  time.Time `json:"date"`
+	Total float64   `json:"total"`
+	Count int       `json:"count"`
+}
+
+type ExpenseItem struct {
+	ExpenseID    string    `json:"expense_id"`
+	ExpenseDate  time.Time `json:"expense_date"`
+	Amount       float64   `json:"amount"`
+	CategoryName string    `json:"category_name"`
+	Tags         string    `json:"tags"`
+	Notes        string    `json:"notes"`
+}
+
+// DeductibleExpense is an expense that is deductible under its own tax
+// category or, when it has none, under its category's.
+type DeductibleExpense struct {
+	ExpenseID     string    `json:"expense_id"`
+	ExpenseDate   time.Time `json:"expense_date"`
+	Amount        float64   `json:"amount"`
+	TaxCategory   string    `json:"tax_category"`
+	CategoryName  string    `json:"category_name"`
+	ProviderTaxID string    `json:"provider_tax_id"`
+	ProviderName  string    `json:"provider_name"`
+	Notes         string    `json:"notes"`
+}
+
+type MerchantExpense struct {
+	MerchantID   string  `json:"merchant_id"`
+	MerchantName string  `json:"merchant_name"`
+	Total        float64 `json:"total"`
+	Count        int     `json:"count"`
+}
+
+// BoundingBox is given by its south-west and north-east corners. A box whose
+// MinLongitude is greater than its MaxLongitude crosses the antimeridian.
+type BoundingBox struct {
+	MinLatitude  float64 `json:"min_latitude"`
+	MinLongitude float64 `json:"min_longitude"`
+	MaxLatitude  float64 `json:"max_latitude"`
+	MaxLongitude float64 `json:"max_longitude"`
+}
+
+type GeotaggedExpense struct {
+	ExpenseID     string    `json:"expense_id"`
+	ExpenseDate   time.Time `json:"expense_date"`
+	Amount        float64   `json:"amount"`
+	Latitude      float64   `json:"latitude"`
+	Longitude     float64   `json:"longitude"`
+	PlaceName     string    `json:"place_name"`
+	CategoryName  string    `json:"category_name"`
+	CategoryColor string    `json:"category_color"`
+	Notes         string    `json:"notes"`
+}
+
 type PresentersRepositoryInterface interface {
-	GetTotalExpensesForPeriod(userID string, StartDate time.Time, EndDate time.Time) (float64, error)
-	GetExpensesByCategoryPeriod(userID string, StartDate time.Time, EndDate time.Time) ([]CategoryExpense, error)
-	GetMonthlyExpensesByCategoryYear(userID string, Year int) ([]MonthlyCategoryExpense, []int, error)
+	GetTotalExpensesForPeriod(userID string, StartDate time.Time, EndDate time.Time, Basis string) (float64, error)
+	GetExpensesByCategoryPeriod(userID string, StartDate time.Time, EndDate time.Time, Basis string) ([]CategoryExpense, error)
+	GetExpensesByTagPeriod(userID string, StartDate time.Time, EndDate time.Time, Basis string) ([]TagExpense, error)
+	GetMonthlyExpensesByCategoryYear(userID string, Year int, Basis string) ([]MonthlyCategoryExpense, []int, error)
 	GetMonthlyExpensesByTagYear(userID string, Year int) ([]MonthlyTagExpense, []int, error)
-	GetTotalExpensesForCurrentMonth(userID string) (float64, string, error)
-	GetExpensesByMonthYear(userID string, month int, year int) (MonthExpenses, error)
-	GetTotalExpensesForCurrentWeek(userID string) (float64, string, error)
-	GetTotalExpensesMonthCurrentYear(userID string, year int) (ExpensesMonthCurrentYear, error)
+	GetTotalExpensesForCurrentMonth(userID string) (float64, time.Month, error)
+	GetExpensesByMonthYear(userID string, month int, year int, weekStart time.Weekday) (MonthExpenses, error)
+	GetTotalExpensesForCurrentWeek(userID string, weekStart time.Weekday, until time.Time) (float64, string, error)
+	GetTotalExpensesMonthCurrentYear(userID string, year int, basis string) (ExpensesMonthCurrentYear, error)
 	GetCategoryTagsTotalsByMonthYear(userID string, month int, year int) (CategoryTagsTotals, error)
 	GetAvailableMonthsYears(userID string) ([]int, []MonthOption, error)
 	GetDayToDayExpensesPeriod(userID string, StartDate time.Time, EndDate time.Time) ([]entities.Expense, error)
+	GetNetCashFlowYear(userID string, year int) (CashFlowYear, error)
+	GetIncomeVsExpenseByCategoryPeriod(userID string, StartDate time.Time, EndDate time.Time) (IncomeVsExpense, error)
+	GetAccountBalances(userID string, Date time.Time) ([]AccountBalance, error)
+	GetAccountRunningBalance(userID string, accountID string, StartDate time.Time, EndDate time.Time) (AccountRunningBalance, error)
+	GetExpensesByAccountPeriod(userID string, StartDate time.Time, EndDate time.Time) ([]AccountExpense, error)
+	GetCommittedInstallmentsByMonth(userID string, From time.Time) ([]MonthCommittedInstallments, error)
+	GetCardStatements(userID string, accountID string, year int) (CardStatements, error)
+	GetGoalsProgress(userID string, goalID string, Date time.Time) ([]GoalProgress, error)
+	GetMonthEndForecast(userID string, Date time.Time) (MonthForecast, error)
+	GetLargestExpensesForPeriod(userID string, StartDate time.Time, EndDate time.Time, Limit int) ([]LargestExpense, error)
+	GetTimeSeries(userID string, Query TimeSeriesQuery) (TimeSeries, error)
+	GetPivot(userID string, Query PivotQuery) (Pivot, error)
+	GetDailyExpenseTotals(userID string, StartDate time.Time, EndDate time.Time) ([]DailyExpenseTotal, error)
+	GetExpenseItemsForPeriod(userID string, StartDate time.Time, EndDate time.Time) ([]ExpenseItem, error)
+	GetDeductibleExpenses(userID string, StartDate time.Time, EndDate time.Time) ([]DeductibleExpense, error)
+	GetExpensesByMerchantPeriod(userID string, StartDate time.Time, EndDate time.Time, Basis string) ([]MerchantExpense, error)
+	GetGeotaggedExpenses(userID string, StartDate time.Time, EndDate time.Time, Box *BoundingBox) ([]GeotaggedExpense, error)
 }
@@ -0,0 +1,11 @@
+package repositories
+
+import "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+
+type RuleRepositoryInterface interface {
+	CreateRule(rule entities.Rule) error
+	DeleteRule(rule entities.Rule) error
+	GetRules(userID string) ([]entities.Rule, error)
+	GetRule(userID string, ruleID string) (entities.Rule, error)
+	UpdateRule(rule entities.Rule) error
+}
@@ -0,0 +1,10 @@
+package repositories
+
+import "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+
+type SuggestionRepositoryInterface interface {
+	GetSuggestionModel(userID string) (entities.SuggestionModel, error)
+	HasSuggestionModel(userID string) (bool, error)
+	SaveSuggestionModel(model entities.SuggestionModel) error
+	UpdateSuggestionModel(userID string, observation entities.SuggestionObservation, delta int) error
+}
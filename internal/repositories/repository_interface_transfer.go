@@ -0,0 +1,10 @@
+package repositories
+
+import "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+
+type TransferRepositoryInterface interface {
+	CreateTransfer(transfer entities.Transfer) error
+	DeleteTransfer(transfer entities.Transfer) error
+	GetTransfers(userID string) ([]entities.Transfer, error)
+	GetTransfer(userID string, transferID string) (entities.Transfer, error)
+}
@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"errors"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+)
+
+var ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+
+type WebhookRepositoryInterface interface {
+	CreateWebhookSubscription(subscription entities.WebhookSubscription) error
+	DeleteWebhookSubscription(subscription entities.WebhookSubscription) error
+	GetWebhookSubscriptions(userID string) ([]entities.WebhookSubscription, error)
+	GetWebhookSubscription(userID string, subscriptionID string) (entities.WebhookSubscription, error)
+	UpdateWebhookSubscription(subscription entities.WebhookSubscription) error
+	CreateWebhookDeliveries(deliveries []entities.WebhookDelivery) error
+	UpdateWebhookDelivery(delivery entities.WebhookDelivery) error
+	GetWebhookDeliveries(userID string, subscriptionID string) ([]entities.WebhookDelivery, error)
+	ClaimDueWebhookDeliveries(now time.Time, limit int) ([]entities.WebhookDelivery, error)
+}
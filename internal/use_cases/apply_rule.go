@@ -0,0 +1,147 @@
+package usecases
+
+import (
+	"strconv"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type ApplyRuleInputDto struct {
+	UserID string `json:"user_id"`
+	RuleID string `json:"rule_id"`
+}
+
+type ApplyRuleOutputDto struct {
+	RuleID          string   `json:"rule_id"`
+	UpdatedExpenses []string `json:"updated_expenses"`
+	SuccessMessage  string   `json:"success_message"`
+	ContentMessage  string   `json:"content_message"`
+}
+
+type ApplyRuleUseCase struct {
+	RuleRepository       repositories.RuleRepositoryInterface
+	ExpenseRepository    repositories.ExpenseRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+	SuggestionRepository repositories.SuggestionRepositoryInterface
+	WebhookRepository    repositories.WebhookRepositoryInterface
+}
+
+func NewApplyRuleUseCase(
+	RuleRepository repositories.RuleRepositoryInterface,
+	ExpenseRepository repositories.ExpenseRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+	SuggestionRepository repositories.SuggestionRepositoryInterface,
+	WebhookRepository repositories.WebhookRepositoryInterface,
+) *ApplyRuleUseCase {
+	return &ApplyRuleUseCase{
+		RuleRepository:       RuleRepository,
+		ExpenseRepository:    ExpenseRepository,
+		UserRepository:       UserRepository,
+		SuggestionRepository: SuggestionRepository,
+		WebhookRepository:    WebhookRepository,
+	}
+}
+
+func (c *ApplyRuleUseCase) Execute(input ApplyRuleInputDto) (ApplyRuleOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return ApplyRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return ApplyRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	rule, getRuleErr := c.RuleRepository.GetRule(input.UserID, input.RuleID)
+	if getRuleErr != nil {
+		return ApplyRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Rule not found",
+				Status:   404,
+				Detail:   getRuleErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	expenses, getExpensesErr := c.ExpenseRepository.GetExpenses(input.UserID)
+	if getExpensesErr != nil {
+		return ApplyRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving expenses",
+				Status:   500,
+				Detail:   getExpensesErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	var previousExpenses, matchedExpenses []entities.Expense
+	location := util.UserLocation(user.Timezone)
+
+	for _, expense := range expenses {
+		if !rule.Matches(expense, location) {
+			continue
+		}
+
+		previousExpense := expense
+
+		applyErr := rule.Apply(&expense)
+		if len(applyErr) > 0 {
+			return ApplyRuleOutputDto{}, applyErr
+		}
+
+		previousExpenses = append(previousExpenses, previousExpense)
+		matchedExpenses = append(matchedExpenses, expense)
+	}
+
+	if len(matchedExpenses) > 0 {
+		updateExpensesErr := c.ExpenseRepository.UpdateExpenses(matchedExpenses)
+		if updateExpensesErr != nil {
+			return ApplyRuleOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Internal Server Error",
+					Title:    "An error occurred while updating expense",
+					Status:   500,
+					Detail:   updateExpensesErr.Error(),
+					Instance: util.RFC500,
+				},
+			}
+		}
+	}
+
+	updatedExpenses := []string{}
+
+	for i, expense := range matchedExpenses {
+		observeExpense(c.SuggestionRepository, previousExpenses[i], -1, "ApplyRuleUseCase")
+		observeExpense(c.SuggestionRepository, expense, 1, "ApplyRuleUseCase")
+		publishWebhookEvent(c.WebhookRepository, input.UserID, entities.WEBHOOK_EVENT_EXPENSE_UPDATED, expense, "ApplyRuleUseCase")
+
+		updatedExpenses = append(updatedExpenses, expense.ID)
+	}
+
+	return ApplyRuleOutputDto{
+		RuleID:          rule.ID,
+		UpdatedExpenses: updatedExpenses,
+		SuccessMessage:  "Rule applied successfully",
+		ContentMessage:  strconv.Itoa(len(updatedExpenses)) + " expenses updated by rule " + rule.Name,
+	}, nil
+}
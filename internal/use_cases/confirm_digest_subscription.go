@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type ConfirmDigestSubscriptionInputDto struct {
+	UserID         string `json:"user_id"`
+	SubscriptionID string `json:"subscription_id"`
+	Signature      string `json:"signature"`
+}
+
+type ConfirmDigestSubscriptionOutputDto struct {
+	SubscriptionID string `json:"subscription_id"`
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type ConfirmDigestSubscriptionUseCase struct {
+	DigestRepository repositories.DigestRepositoryInterface
+}
+
+func NewConfirmDigestSubscriptionUseCase(
+	DigestRepository repositories.DigestRepositoryInterface,
+) *ConfirmDigestSubscriptionUseCase {
+	return &ConfirmDigestSubscriptionUseCase{
+		DigestRepository: DigestRepository,
+	}
+}
+
+// Execute verifies the subscription named by a confirmation link. The link
+// is signed over the address too, so it only confirms the address it was
+// sent to.
+func (c *ConfirmDigestSubscriptionUseCase) Execute(input ConfirmDigestSubscriptionInputDto) (ConfirmDigestSubscriptionOutputDto, []util.ProblemDetails) {
+	invalidLink := []util.ProblemDetails{
+		{
+			Type:     "Forbidden",
+			Title:    "Invalid signature",
+			Status:   403,
+			Detail:   "The confirmation link is not valid",
+			Instance: util.RFC403,
+		},
+	}
+
+	subscription, getSubscriptionErr := c.DigestRepository.GetDigestSubscription(input.UserID, input.SubscriptionID)
+	if getSubscriptionErr != nil {
+		if getSubscriptionErr.Error() == "digest subscription not found" {
+			return ConfirmDigestSubscriptionOutputDto{}, invalidLink
+		}
+
+		return ConfirmDigestSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving digest subscriptions",
+				Status:   500,
+				Detail:   getSubscriptionErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	if !util.VerifyHMACSignature(digestConfirmationValue(subscription), input.Signature) {
+		return ConfirmDigestSubscriptionOutputDto{}, invalidLink
+	}
+
+	if !subscription.Verified {
+		subscription.Verify()
+
+		verifySubscriptionErr := c.DigestRepository.VerifyDigestSubscription(subscription)
+		if verifySubscriptionErr != nil {
+			return ConfirmDigestSubscriptionOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Internal Server Error",
+					Title:    "Error confirming digest subscription",
+					Status:   500,
+					Detail:   verifySubscriptionErr.Error(),
+					Instance: util.RFC500,
+				},
+			}
+		}
+	}
+
+	return ConfirmDigestSubscriptionOutputDto{
+		SubscriptionID: subscription.ID,
+		SuccessMessage: "Digest subscription confirmed successfully",
+		ContentMessage: "A " + subscription.Frequency + " digest will be sent to " + subscription.Email,
+	}, nil
+}
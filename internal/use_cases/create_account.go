@@ -0,0 +1,108 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type CreateAccountInputDto struct {
+	UserID         string  `json:"user_id"`
+	Name           string  `json:"name"`
+	Type           string  `json:"type"`
+	Currency       string  `json:"currency"`
+	OpeningBalance float64 `json:"opening_balance,string"`
+	ClosingDay     int     `json:"closing_day"`
+	DueDay         int     `json:"due_day"`
+}
+
+type CreateAccountOutputDto struct {
+	AccountID      string `json:"account_id"`
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type CreateAccountUseCase struct {
+	AccountRepository repositories.AccountRepositoryInterface
+	UserRepository    repositories.UserRepositoryInterface
+}
+
+func NewCreateAccountUseCase(
+	AccountRepository repositories.AccountRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *CreateAccountUseCase {
+	return &CreateAccountUseCase{
+		AccountRepository: AccountRepository,
+		UserRepository:    UserRepository,
+	}
+}
+
+func (c *CreateAccountUseCase) Execute(input CreateAccountInputDto) (CreateAccountOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return CreateAccountOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return CreateAccountOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	newAccount, newAccountErr := entities.NewAccount(input.UserID, input.Name, input.Type, input.Currency, input.OpeningBalance, input.ClosingDay, input.DueDay)
+	if len(newAccountErr) > 0 {
+		return CreateAccountOutputDto{}, newAccountErr
+	}
+
+	createAccountErr := c.AccountRepository.CreateAccount(*newAccount)
+	if createAccountErr != nil {
+		return CreateAccountOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error creating new account",
+				Status:   500,
+				Detail:   createAccountErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return CreateAccountOutputDto{
+		AccountID:      newAccount.ID,
+		SuccessMessage: "Account created successfully",
+		ContentMessage: "Account " + newAccount.Name + " created",
+	}, nil
+}
+
+func checkAccount(accountRepository repositories.AccountRepositoryInterface, userID string, accountID string) (*entities.Account, []util.ProblemDetails) {
+	if accountID == "" {
+		return nil, nil
+	}
+
+	account, getAccountErr := accountRepository.GetAccount(userID, accountID)
+	if getAccountErr != nil {
+		return nil, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Account not found",
+				Status:   404,
+				Detail:   getAccountErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	return &account, nil
+}
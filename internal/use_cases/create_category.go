@@ -9,9 +9,11 @@ import (
 )
 
 type CreateCategoryInputDto struct {
-	UserID string `json:"user_id"`
-	Name   string `json:"name"`
-	Color  string `json:"color"`
+	UserID      string `json:"user_id"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Kind        string `json:"kind"`
+	TaxCategory string `json:"tax_category"`
 }
 
 type CreateCategoryOutputDto struct {
@@ -23,15 +25,18 @@ type CreateCategoryOutputDto struct {
 type CreateCategoryUseCase struct {
 	CategoryRepository repositories.CategoryRepositoryInterface
 	UserRepository     repositories.UserRepositoryInterface
+	WebhookRepository  repositories.WebhookRepositoryInterface
 }
 
 func NewCreateCategoryUseCase(
 	CategoryRepository repositories.CategoryRepositoryInterface,
 	UserRepository repositories.UserRepositoryInterface,
+	WebhookRepository repositories.WebhookRepositoryInterface,
 ) *CreateCategoryUseCase {
 	return &CreateCategoryUseCase{
 		CategoryRepository: CategoryRepository,
 		UserRepository:     UserRepository,
+		WebhookRepository:  WebhookRepository,
 	}
 }
 
@@ -84,11 +89,16 @@ func (c *CreateCategoryUseCase) Execute(input CreateCategoryInputDto) (CreateCat
 		}
 	}
 
-	newCategory, newCategoryErr := entities.NewCategory(user.ID, input.Name, input.Color)
+	newCategory, newCategoryErr := entities.NewCategory(user.ID, input.Name, input.Color, input.Kind)
 	if newCategoryErr != nil {
 		return CreateCategoryOutputDto{}, newCategoryErr
 	}
 
+	changeTaxCategoryErr := newCategory.ChangeTaxCategory(input.TaxCategory)
+	if len(changeTaxCategoryErr) > 0 {
+		return CreateCategoryOutputDto{}, changeTaxCategoryErr
+	}
+
 	CreateCategoryErr := c.CategoryRepository.CreateCategory(*newCategory)
 	if CreateCategoryErr != nil {
 		return CreateCategoryOutputDto{}, []util.ProblemDetails{
@@ -102,6 +112,8 @@ func (c *CreateCategoryUseCase) Execute(input CreateCategoryInputDto) (CreateCat
 		}
 	}
 
+	publishWebhookEvent(c.WebhookRepository, input.UserID, entities.WEBHOOK_EVENT_CATEGORY_CREATED, *newCategory, "CreateCategoryUseCase")
+
 	return CreateCategoryOutputDto{
 		CategoryID:     newCategory.ID,
 		SuccessMessage: "Category created successfully",
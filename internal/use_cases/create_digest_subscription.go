@@ -0,0 +1,167 @@
+package usecases
+
+import (
+	"html"
+	"net/url"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type CreateDigestSubscriptionInputDto struct {
+	UserID    string `json:"user_id"`
+	Email     string `json:"email"`
+	Frequency string `json:"frequency"`
+	BaseURL   string `json:"base_url"`
+}
+
+type CreateDigestSubscriptionOutputDto struct {
+	SubscriptionID string `json:"subscription_id"`
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type CreateDigestSubscriptionUseCase struct {
+	DigestRepository repositories.DigestRepositoryInterface
+	UserRepository   repositories.UserRepositoryInterface
+	Mailer           repositories.MailerInterface
+}
+
+func NewCreateDigestSubscriptionUseCase(
+	DigestRepository repositories.DigestRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+	Mailer repositories.MailerInterface,
+) *CreateDigestSubscriptionUseCase {
+	return &CreateDigestSubscriptionUseCase{
+		DigestRepository: DigestRepository,
+		UserRepository:   UserRepository,
+		Mailer:           Mailer,
+	}
+}
+
+// Execute subscribes an email address to a digest. The account's own address
+// is trusted right away; any other address gets a confirmation link and no
+// digest is sent to it until the link is followed.
+
+func (c *CreateDigestSubscriptionUseCase) Execute(input CreateDigestSubscriptionInputDto) (CreateDigestSubscriptionOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return CreateDigestSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return CreateDigestSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	subscriptions, getSubscriptionsErr := c.DigestRepository.GetDigestSubscriptions(input.UserID)
+	if getSubscriptionsErr != nil {
+		return CreateDigestSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving digest subscriptions",
+				Status:   500,
+				Detail:   getSubscriptionsErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	for _, subscription := range subscriptions {
+		if subscription.Frequency == input.Frequency {
+			return CreateDigestSubscriptionOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Conflict",
+					Title:    "Digest subscription already exists",
+					Status:   409,
+					Detail:   "There is already a " + input.Frequency + " digest subscription",
+					Instance: util.RFC409,
+				},
+			}
+		}
+	}
+
+	newSubscription, newSubscriptionErr := entities.NewDigestSubscription(input.UserID, input.Email, input.Frequency)
+	if len(newSubscriptionErr) > 0 {
+		return CreateDigestSubscriptionOutputDto{}, newSubscriptionErr
+	}
+
+	emailHash, _ := util.HashEmailWithHMAC(newSubscription.Email)
+	if emailHash == user.Login.Email {
+		newSubscription.Verify()
+	} else {
+		sendConfirmationErr := c.Mailer.SendEmail(renderDigestConfirmation(*newSubscription, input.BaseURL))
+		if sendConfirmationErr != nil {
+			util.NewLoggerError(500, sendConfirmationErr.Error(), "CreateDigestSubscriptionUseCase", "Use Cases", "Error")
+			return CreateDigestSubscriptionOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Internal Server Error",
+					Title:    "Error sending confirmation email",
+					Status:   500,
+					Detail:   sendConfirmationErr.Error(),
+					Instance: util.RFC500,
+				},
+			}
+		}
+	}
+
+	createSubscriptionErr := c.DigestRepository.CreateDigestSubscription(*newSubscription)
+	if createSubscriptionErr != nil {
+		return CreateDigestSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error creating new digest subscription",
+				Status:   500,
+				Detail:   createSubscriptionErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	contentMessage := "A " + newSubscription.Frequency + " digest will be sent to " + newSubscription.Email
+	if !newSubscription.Verified {
+		contentMessage = "A confirmation link was sent to " + newSubscription.Email + ", the " + newSubscription.Frequency + " digest starts once it is followed"
+	}
+
+	return CreateDigestSubscriptionOutputDto{
+		SubscriptionID: newSubscription.ID,
+		SuccessMessage: "Digest subscription created successfully",
+		ContentMessage: contentMessage,
+	}, nil
+}
+
+// digestConfirmationValue is what a confirmation link signs.
+func digestConfirmationValue(subscription entities.DigestSubscription) string {
+	return "digest:" + subscription.UserID + ":" + subscription.ID + ":" + subscription.Email
+}
+
+func renderDigestConfirmation(subscription entities.DigestSubscription, baseURL string) entities.EmailMessage {
+	query := url.Values{}
+	query.Set("user_id", subscription.UserID)
+	query.Set("subscription_id", subscription.ID)
+	query.Set("signature", util.SignWithHMAC(digestConfirmationValue(subscription)))
+
+	link := baseURL + "/digests/confirm?" + query.Encode()
+	text := "Someone asked to send a " + subscription.Frequency + " spending digest to this address. Follow the link below to start receiving it, or ignore this email."
+
+	return entities.EmailMessage{
+		To:       subscription.Email,
+		Subject:  "Confirm your " + subscription.Frequency + " spending digest",
+		HTMLBody: "<p>" + html.EscapeString(text) + "</p><p><a href=\"" + html.EscapeString(link) + "\">Confirm the subscription</a></p>",
+		TextBody: text + "\n\n" + link + "\n",
+	}
+}
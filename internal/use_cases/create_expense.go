@@ -10,32 +10,56 @@ import (
 )
 
 type CreateExpenseInputDto struct {
-	UserID      string   `json:"user_id"`
-	Amount      float64  `json:"amount,string"`
-	ExpenseDate string   `json:"expense_date"`
-	CategoryID  string   `json:"category_id"`
-	Notes       string   `json:"notes"`
-	Tags        []string `json:"tags"`
+	UserID        string   `json:"user_id"`
+	Amount        float64  `json:"amount,string"`
+	ExpenseDate   string   `json:"expense_date"`
+	CategoryID    string   `json:"category_id"`
+	AccountID     string   `json:"account_id"`
+	MerchantID    string   `json:"merchant_id"`
+	Notes         string   `json:"notes"`
+	Tags          []string `json:"tags"`
+	TaxCategory   string   `json:"tax_category"`
+	ProviderTaxID string   `json:"provider_tax_id"`
+	ProviderName  string   `json:"provider_name"`
+	Latitude      *float64 `json:"latitude"`
+	Longitude     *float64 `json:"longitude"`
+	PlaceName     string   `json:"place_name"`
 }
 
 type CreateExpenseOutputDto struct {
-	ExpenseID      string `json:"expense_id"`
-	SuccessMessage string `json:"success_message"`
-	ContentMessage string `json:"content_message"`
+	ExpenseID      string                    `json:"expense_id"`
+	SuccessMessage string                    `json:"success_message"`
+	ContentMessage string                    `json:"content_message"`
+	Anomalies      []entities.ExpenseAnomaly `json:"anomalies,omitempty"`
 }
 
 type CreateExpenseUseCase struct {
-	ExpenseRepository repositories.ExpenseRepositoryInterface
-	UserRepository    repositories.UserRepositoryInterface
+	ExpenseRepository    repositories.ExpenseRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+	RuleRepository       repositories.RuleRepositoryInterface
+	SuggestionRepository repositories.SuggestionRepositoryInterface
+	AccountRepository    repositories.AccountRepositoryInterface
+	WebhookRepository    repositories.WebhookRepositoryInterface
+	MerchantRepository   repositories.MerchantRepositoryInterface
 }
 
 func NewCreateExpenseUseCase(
 	ExpenseRepository repositories.ExpenseRepositoryInterface,
 	UserRepository repositories.UserRepositoryInterface,
+	RuleRepository repositories.RuleRepositoryInterface,
+	SuggestionRepository repositories.SuggestionRepositoryInterface,
+	AccountRepository repositories.AccountRepositoryInterface,
+	WebhookRepository repositories.WebhookRepositoryInterface,
+	MerchantRepository repositories.MerchantRepositoryInterface,
 ) *CreateExpenseUseCase {
 	return &CreateExpenseUseCase{
-		ExpenseRepository: ExpenseRepository,
-		UserRepository:    UserRepository,
+		ExpenseRepository:    ExpenseRepository,
+		UserRepository:       UserRepository,
+		RuleRepository:       RuleRepository,
+		SuggestionRepository: SuggestionRepository,
+		AccountRepository:    AccountRepository,
+		WebhookRepository:    WebhookRepository,
+		MerchantRepository:   MerchantRepository,
 	}
 }
 
@@ -65,7 +89,7 @@ func (c *CreateExpenseUseCase) Execute(input CreateExpenseInputDto) (CreateExpen
 		}
 	}
 
-	newExpenseDate, parseDateErr := util.ParseDate(input.ExpenseDate)
+	newExpenseDate, parseDateErr := util.ParseDate(input.ExpenseDate, user.Timezone)
 	if parseDateErr != nil {
 		validationErrors = append(validationErrors, util.ProblemDetails{
 			Type:     "Validation Error",
@@ -85,6 +109,34 @@ func (c *CreateExpenseUseCase) Execute(input CreateExpenseInputDto) (CreateExpen
 		return CreateExpenseOutputDto{}, newExpenseErr
 	}
 
+	account, accountErr := checkAccount(c.AccountRepository, input.UserID, input.AccountID)
+	if len(accountErr) > 0 {
+		return CreateExpenseOutputDto{}, accountErr
+	}
+
+	newExpense.ChangeAccount(input.AccountID)
+	newExpense.AssignStatement(account, util.UserLocation(user.Timezone))
+
+	merchantErr := checkMerchant(c.MerchantRepository, input.UserID, input.MerchantID)
+	if len(merchantErr) > 0 {
+		return CreateExpenseOutputDto{}, merchantErr
+	}
+
+	changeTaxCategoryErr := newExpense.ChangeTaxCategory(input.TaxCategory)
+	if len(changeTaxCategoryErr) > 0 {
+		return CreateExpenseOutputDto{}, changeTaxCategoryErr
+	}
+
+	changeProviderErr := newExpense.ChangeProvider(input.ProviderTaxID, input.ProviderName)
+	if len(changeProviderErr) > 0 {
+		return CreateExpenseOutputDto{}, changeProviderErr
+	}
+
+	changeLocationErr := newExpense.ChangeLocation(input.Latitude, input.Longitude, input.PlaceName)
+	if len(changeLocationErr) > 0 {
+		return CreateExpenseOutputDto{}, changeLocationErr
+	}
+
 	if len(input.Tags) > 0 {
 		for _, tag := range input.Tags {
 			addTagErr := newExpense.AddTagByID(tag)
@@ -94,6 +146,16 @@ func (c *CreateExpenseUseCase) Execute(input CreateExpenseInputDto) (CreateExpen
 		}
 	}
 
+	rules, getRulesErr := getRules(c.RuleRepository, input.UserID)
+	if len(getRulesErr) > 0 {
+		return CreateExpenseOutputDto{}, getRulesErr
+	}
+
+	prepareErr := prepareNewExpense(c.ExpenseRepository, c.MerchantRepository, rules, util.UserLocation(user.Timezone), newExpense, input.MerchantID, "CreateExpenseUseCase")
+	if len(prepareErr) > 0 {
+		return CreateExpenseOutputDto{}, prepareErr
+	}
+
 	createExpenseErr := c.ExpenseRepository.CreateExpense(*newExpense)
 	if createExpenseErr != nil {
 		return CreateExpenseOutputDto{}, []util.ProblemDetails{
@@ -107,9 +169,12 @@ func (c *CreateExpenseUseCase) Execute(input CreateExpenseInputDto) (CreateExpen
 		}
 	}
 
+	expenseCreated(c.SuggestionRepository, c.WebhookRepository, *newExpense, "CreateExpenseUseCase")
+
 	return CreateExpenseOutputDto{
 		ExpenseID:      newExpense.ID,
 		SuccessMessage: "Expense created successfully",
 		ContentMessage: fmt.Sprintf("Expense of %.2f added for %s", input.Amount, time.Time(newExpense.ExpenseDate).Format("02/01/2006")),
+		Anomalies:      newExpense.Anomalies,
 	}, nil
 }
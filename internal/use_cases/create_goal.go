@@ -0,0 +1,139 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type CreateGoalInputDto struct {
+	UserID       string  `json:"user_id"`
+	Name         string  `json:"name"`
+	TargetAmount float64 `json:"target_amount,string"`
+	Deadline     string  `json:"deadline"`
+	CategoryID   string  `json:"category_id"`
+}
+
+type CreateGoalOutputDto struct {
+	GoalID         string `json:"goal_id"`
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type CreateGoalUseCase struct {
+	GoalRepository repositories.GoalRepositoryInterface
+	UserRepository repositories.UserRepositoryInterface
+}
+
+func NewCreateGoalUseCase(
+	GoalRepository repositories.GoalRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *CreateGoalUseCase {
+	return &CreateGoalUseCase{
+		GoalRepository: GoalRepository,
+		UserRepository: UserRepository,
+	}
+}
+
+func (c *CreateGoalUseCase) Execute(input CreateGoalInputDto) (CreateGoalOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return CreateGoalOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return CreateGoalOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	var deadline *time.Time
+	if input.Deadline != "" {
+		parsedDeadline, deadlineErr := parseGoalDeadline(input.Deadline, user.Timezone)
+		if len(deadlineErr) > 0 {
+			return CreateGoalOutputDto{}, deadlineErr
+		}
+
+		deadline = parsedDeadline
+	}
+
+	newGoal, newGoalErr := entities.NewGoal(input.UserID, input.Name, input.TargetAmount, deadline, input.CategoryID)
+	if len(newGoalErr) > 0 {
+		return CreateGoalOutputDto{}, newGoalErr
+	}
+
+	createGoalErr := c.GoalRepository.CreateGoal(*newGoal)
+	if createGoalErr != nil {
+		return CreateGoalOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error creating new goal",
+				Status:   500,
+				Detail:   createGoalErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return CreateGoalOutputDto{
+		GoalID:         newGoal.ID,
+		SuccessMessage: "Goal created successfully",
+		ContentMessage: "Goal " + newGoal.Name + " created with target " + util.FloatToBRL(newGoal.TargetAmount),
+	}, nil
+}
+
+func parseGoalDeadline(deadline string, timezone string) (*time.Time, []util.ProblemDetails) {
+	parsedDeadline, parseDateErr := util.ParseDate(deadline, timezone)
+	if parseDateErr != nil {
+		return nil, []util.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Bad Request",
+				Status:   400,
+				Detail:   "Invalid deadline format",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	today, todayErr := startOfToday(timezone)
+	if todayErr != nil {
+		return nil, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not load timezone",
+				Status:   500,
+				Detail:   todayErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	if parsedDeadline.Before(today) {
+		return nil, []util.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Bad Request",
+				Status:   400,
+				Detail:   "Deadline cannot be in the past",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	return &parsedDeadline, nil
+}
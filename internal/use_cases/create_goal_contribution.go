@@ -0,0 +1,113 @@
+package usecases
+
+import (
+	"fmt"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type CreateGoalContributionInputDto struct {
+	UserID           string  `json:"user_id"`
+	GoalID           string  `json:"goal_id"`
+	Amount           float64 `json:"amount,string"`
+	ContributionDate string  `json:"contribution_date"`
+	Notes            string  `json:"notes"`
+}
+
+type CreateGoalContributionOutputDto struct {
+	ContributionID string `json:"contribution_id"`
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type CreateGoalContributionUseCase struct {
+	GoalRepository repositories.GoalRepositoryInterface
+	UserRepository repositories.UserRepositoryInterface
+}
+
+func NewCreateGoalContributionUseCase(
+	GoalRepository repositories.GoalRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *CreateGoalContributionUseCase {
+	return &CreateGoalContributionUseCase{
+		GoalRepository: GoalRepository,
+		UserRepository: UserRepository,
+	}
+}
+
+func (c *CreateGoalContributionUseCase) Execute(input CreateGoalContributionInputDto) (CreateGoalContributionOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return CreateGoalContributionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return CreateGoalContributionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	goal, getGoalErr := c.GoalRepository.GetGoal(input.UserID, input.GoalID)
+	if getGoalErr != nil {
+		return CreateGoalContributionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Goal not found",
+				Status:   404,
+				Detail:   getGoalErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	contributionDate, parseDateErr := util.ParseDate(input.ContributionDate, user.Timezone)
+	if parseDateErr != nil {
+		return CreateGoalContributionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Bad Request",
+				Status:   400,
+				Detail:   "Invalid contribution date format",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	newContribution, newContributionErr := entities.NewGoalContribution(input.UserID, goal.ID, input.Amount, contributionDate, input.Notes)
+	if len(newContributionErr) > 0 {
+		return CreateGoalContributionOutputDto{}, newContributionErr
+	}
+
+	createContributionErr := c.GoalRepository.CreateGoalContribution(*newContribution)
+	if createContributionErr != nil {
+		return CreateGoalContributionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error creating new goal contribution",
+				Status:   500,
+				Detail:   createContributionErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return CreateGoalContributionOutputDto{
+		ContributionID: newContribution.ID,
+		SuccessMessage: "Goal contribution created successfully",
+		ContentMessage: fmt.Sprintf("Contribution of %.2f added to %s", input.Amount, goal.Name),
+	}, nil
+}
@@ -0,0 +1,148 @@
+package usecases
+
+import (
+	"fmt"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type CreateIncomeInputDto struct {
+	UserID     string  `json:"user_id"`
+	Amount     float64 `json:"amount,string"`
+	IncomeDate string  `json:"income_date"`
+	CategoryID string  `json:"category_id"`
+	AccountID  string  `json:"account_id"`
+	Notes      string  `json:"notes"`
+}
+
+type CreateIncomeOutputDto struct {
+	IncomeID       string `json:"income_id"`
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type CreateIncomeUseCase struct {
+	IncomeRepository   repositories.IncomeRepositoryInterface
+	CategoryRepository repositories.CategoryRepositoryInterface
+	UserRepository     repositories.UserRepositoryInterface
+	AccountRepository  repositories.AccountRepositoryInterface
+}
+
+func NewCreateIncomeUseCase(
+	IncomeRepository repositories.IncomeRepositoryInterface,
+	CategoryRepository repositories.CategoryRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+	AccountRepository repositories.AccountRepositoryInterface,
+) *CreateIncomeUseCase {
+	return &CreateIncomeUseCase{
+		IncomeRepository:   IncomeRepository,
+		CategoryRepository: CategoryRepository,
+		UserRepository:     UserRepository,
+		AccountRepository:  AccountRepository,
+	}
+}
+
+func (c *CreateIncomeUseCase) Execute(input CreateIncomeInputDto) (CreateIncomeOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return CreateIncomeOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return CreateIncomeOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	newIncomeDate, parseDateErr := util.ParseDate(input.IncomeDate, user.Timezone)
+	if parseDateErr != nil {
+		return CreateIncomeOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Bad Request",
+				Status:   400,
+				Detail:   "Invalid income date format",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	newIncome, newIncomeErr := entities.NewIncome(input.UserID, input.Amount, newIncomeDate, input.CategoryID, input.Notes)
+	if len(newIncomeErr) > 0 {
+		return CreateIncomeOutputDto{}, newIncomeErr
+	}
+
+	categoryErr := checkIncomeCategory(c.CategoryRepository, input.UserID, input.CategoryID)
+	if len(categoryErr) > 0 {
+		return CreateIncomeOutputDto{}, categoryErr
+	}
+
+	_, accountErr := checkAccount(c.AccountRepository, input.UserID, input.AccountID)
+	if len(accountErr) > 0 {
+		return CreateIncomeOutputDto{}, accountErr
+	}
+
+	newIncome.ChangeAccount(input.AccountID)
+
+	createIncomeErr := c.IncomeRepository.CreateIncome(*newIncome)
+	if createIncomeErr != nil {
+		return CreateIncomeOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error creating new income",
+				Status:   500,
+				Detail:   createIncomeErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return CreateIncomeOutputDto{
+		IncomeID:       newIncome.ID,
+		SuccessMessage: "Income created successfully",
+		ContentMessage: fmt.Sprintf("Income of %.2f added for %s", input.Amount, newIncome.IncomeDate.Format("02/01/2006")),
+	}, nil
+}
+
+func checkIncomeCategory(categoryRepository repositories.CategoryRepositoryInterface, userID string, categoryID string) []util.ProblemDetails {
+	category, getCategoryErr := categoryRepository.GetCategory(userID, categoryID)
+	if getCategoryErr != nil {
+		return []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Category not found",
+				Status:   404,
+				Detail:   getCategoryErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	if category.Kind != entities.CATEGORY_KIND_INCOME {
+		return []util.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Invalid category",
+				Status:   400,
+				Detail:   "Incomes must use an income category",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	return nil
+}
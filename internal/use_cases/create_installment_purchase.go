@@ -0,0 +1,163 @@
+package usecases
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type CreateInstallmentPurchaseInputDto struct {
+	UserID           string  `json:"user_id"`
+	TotalAmount      float64 `json:"total_amount,string"`
+	InstallmentCount int     `json:"installment_count"`
+	FirstDueDate     string  `json:"first_due_date"`
+	RemainderPolicy  string  `json:"remainder_policy"`
+	CategoryID       string  `json:"category_id"`
+	AccountID        string  `json:"account_id"`
+	Notes            string  `json:"notes"`
+}
+
+type CreateInstallmentPurchaseOutputDto struct {
+	InstallmentPurchaseID string `json:"installment_purchase_id"`
+	SuccessMessage        string `json:"success_message"`
+	ContentMessage        string `json:"content_message"`
+}
+
+type CreateInstallmentPurchaseUseCase struct {
+	InstallmentPurchaseRepository repositories.InstallmentPurchaseRepositoryInterface
+	AccountRepository             repositories.AccountRepositoryInterface
+	UserRepository                repositories.UserRepositoryInterface
+	ExpenseRepository             repositories.ExpenseRepositoryInterface
+	MerchantRepository            repositories.MerchantRepositoryInterface
+	RuleRepository                repositories.RuleRepositoryInterface
+	SuggestionRepository          repositories.SuggestionRepositoryInterface
+	WebhookRepository             repositories.WebhookRepositoryInterface
+}
+
+func NewCreateInstallmentPurchaseUseCase(
+	InstallmentPurchaseRepository repositories.InstallmentPurchaseRepositoryInterface,
+	AccountRepository repositories.AccountRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+	ExpenseRepository repositories.ExpenseRepositoryInterface,
+	MerchantRepository repositories.MerchantRepositoryInterface,
+	RuleRepository repositories.RuleRepositoryInterface,
+	SuggestionRepository repositories.SuggestionRepositoryInterface,
+	WebhookRepository repositories.WebhookRepositoryInterface,
+) *CreateInstallmentPurchaseUseCase {
+	return &CreateInstallmentPurchaseUseCase{
+		InstallmentPurchaseRepository: InstallmentPurchaseRepository,
+		AccountRepository:             AccountRepository,
+		UserRepository:                UserRepository,
+		ExpenseRepository:             ExpenseRepository,
+		MerchantRepository:            MerchantRepository,
+		RuleRepository:                RuleRepository,
+		SuggestionRepository:          SuggestionRepository,
+		WebhookRepository:             WebhookRepository,
+	}
+}
+
+func (c *CreateInstallmentPurchaseUseCase) Execute(input CreateInstallmentPurchaseInputDto) (CreateInstallmentPurchaseOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return CreateInstallmentPurchaseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return CreateInstallmentPurchaseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	firstDueDate, parseDateErr := util.ParseDate(input.FirstDueDate, user.Timezone)
+	if parseDateErr != nil {
+		return CreateInstallmentPurchaseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Bad Request",
+				Status:   400,
+				Detail:   "Invalid first due date format",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	newPurchase, newPurchaseErr := entities.NewInstallmentPurchase(input.UserID, input.TotalAmount, input.InstallmentCount, firstDueDate, input.RemainderPolicy, input.CategoryID, input.AccountID, input.Notes)
+	if len(newPurchaseErr) > 0 {
+		return CreateInstallmentPurchaseOutputDto{}, newPurchaseErr
+	}
+
+	account, accountErr := checkAccount(c.AccountRepository, input.UserID, input.AccountID)
+	if len(accountErr) > 0 {
+		return CreateInstallmentPurchaseOutputDto{}, accountErr
+	}
+
+	installments, installmentsErr := newPurchase.GenerateInstallments(0, 0)
+	if len(installmentsErr) > 0 {
+		return CreateInstallmentPurchaseOutputDto{}, installmentsErr
+	}
+
+	rules, getRulesErr := getRules(c.RuleRepository, input.UserID)
+	if len(getRulesErr) > 0 {
+		return CreateInstallmentPurchaseOutputDto{}, getRulesErr
+	}
+
+	location := util.UserLocation(user.Timezone)
+
+	for i := range installments {
+		installments[i].AssignStatement(account, location)
+
+		prepareErr := prepareNewExpense(c.ExpenseRepository, c.MerchantRepository, rules, location, &installments[i], "", "CreateInstallmentPurchaseUseCase")
+		if len(prepareErr) > 0 {
+			return CreateInstallmentPurchaseOutputDto{}, prepareErr
+		}
+	}
+
+	createPurchaseErr := c.InstallmentPurchaseRepository.CreateInstallmentPurchase(*newPurchase, installments)
+	if createPurchaseErr != nil {
+		return CreateInstallmentPurchaseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error creating new installment purchase",
+				Status:   500,
+				Detail:   createPurchaseErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	for _, installment := range installments {
+		expenseCreated(c.SuggestionRepository, c.WebhookRepository, installment, "CreateInstallmentPurchaseUseCase")
+	}
+
+	return CreateInstallmentPurchaseOutputDto{
+		InstallmentPurchaseID: newPurchase.ID,
+		SuccessMessage:        "Installment purchase created successfully",
+		ContentMessage:        fmt.Sprintf("Purchase of %.2f split in %dx starting %s", input.TotalAmount, input.InstallmentCount, newPurchase.FirstDueDate.Format("02/01/2006")),
+	}, nil
+}
+
+func startOfToday(timezone string) (time.Time, error) {
+	location, err := util.LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	now := time.Now().In(location)
+
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location), nil
+}
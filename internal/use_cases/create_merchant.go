@@ -0,0 +1,166 @@
+package usecases
+
+import (
+	"strings"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type CreateMerchantInputDto struct {
+	UserID  string   `json:"user_id"`
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+}
+
+type CreateMerchantOutputDto struct {
+	MerchantID     string `json:"merchant_id"`
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type CreateMerchantUseCase struct {
+	MerchantRepository repositories.MerchantRepositoryInterface
+	UserRepository     repositories.UserRepositoryInterface
+}
+
+func NewCreateMerchantUseCase(
+	MerchantRepository repositories.MerchantRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *CreateMerchantUseCase {
+	return &CreateMerchantUseCase{
+		MerchantRepository: MerchantRepository,
+		UserRepository:     UserRepository,
+	}
+}
+
+func (c *CreateMerchantUseCase) Execute(input CreateMerchantInputDto) (CreateMerchantOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return CreateMerchantOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return CreateMerchantOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	existingMerchant, GetMerchantByNameErr := c.MerchantRepository.ThisMerchantExists(input.UserID, input.Name)
+	if GetMerchantByNameErr != nil && strings.Compare(GetMerchantByNameErr.Error(), "merchant not found") > 0 {
+		return CreateMerchantOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error fetching existing merchant",
+				Status:   500,
+				Detail:   GetMerchantByNameErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	if existingMerchant {
+		return CreateMerchantOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Merchant already exists",
+				Status:   409,
+				Detail:   "A merchant with this name already exists",
+				Instance: util.RFC409,
+			},
+		}
+	}
+
+	newMerchant, newMerchantErr := entities.NewMerchant(user.ID, input.Name, input.Aliases)
+	if newMerchantErr != nil {
+		return CreateMerchantOutputDto{}, newMerchantErr
+	}
+
+	CreateMerchantErr := c.MerchantRepository.CreateMerchant(*newMerchant)
+	if CreateMerchantErr != nil {
+		return CreateMerchantOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error creating new merchant",
+				Status:   500,
+				Detail:   CreateMerchantErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return CreateMerchantOutputDto{
+		MerchantID:     newMerchant.ID,
+		SuccessMessage: "Merchant created successfully",
+		ContentMessage: "The " + newMerchant.Name + " merchant was created",
+	}, nil
+}
+
+func checkMerchant(merchantRepository repositories.MerchantRepositoryInterface, userID string, merchantID string) []util.ProblemDetails {
+	if merchantID == "" {
+		return nil
+	}
+
+	if _, getMerchantErr := merchantRepository.GetMerchant(userID, merchantID); getMerchantErr != nil {
+		return []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Merchant not found",
+				Status:   404,
+				Detail:   getMerchantErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	return nil
+}
+
+// resolveMerchant returns the merchant of a raw description, matching it
+// against the names and aliases of the user's merchants and creating one
+// named after the description only when none matches. Its name is also its
+// alias, so later descriptions spelled differently but normalizing the same
+// resolve to it. Failures are logged and leave the expense without a
+// merchant rather than failing it.
+func resolveMerchant(merchantRepository repositories.MerchantRepositoryInterface, userID string, description string, from string) string {
+	name := entities.MerchantNameFromDescription(description)
+	if name == "" {
+		return ""
+	}
+
+	merchants, err := merchantRepository.GetMerchants(userID)
+	if err != nil {
+		util.NewLoggerError(500, err.Error(), from, "Use Cases", "Error")
+		return ""
+	}
+
+	if merchant := entities.MatchMerchant(merchants, description); merchant != nil {
+		return merchant.ID
+	}
+
+	newMerchant, newMerchantErr := entities.NewMerchant(userID, name, nil)
+	if len(newMerchantErr) > 0 {
+		util.NewLoggerError(400, newMerchantErr[0].Detail, from, "Use Cases", "Error")
+		return ""
+	}
+
+	if err := merchantRepository.CreateMerchant(*newMerchant); err != nil {
+		util.NewLoggerError(500, err.Error(), from, "Use Cases", "Error")
+		return ""
+	}
+
+	return newMerchant.ID
+}
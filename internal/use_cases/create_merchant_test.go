@@ -0,0 +1,98 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+)
+
+type memoryMerchantRepository struct {
+	repositories.MerchantRepositoryInterface
+	merchants []entities.Merchant
+}
+
+func (m *memoryMerchantRepository) CreateMerchant(merchant entities.Merchant) error {
+	m.merchants = append(m.merchants, merchant)
+	return nil
+}
+
+func (m *memoryMerchantRepository) GetMerchants(userID string) ([]entities.Merchant, error) {
+	merchants := []entities.Merchant{}
+	for _, merchant := range m.merchants {
+		if merchant.UserID == userID {
+			merchants = append(merchants, merchant)
+		}
+	}
+
+	return merchants, nil
+}
+
+func (m *memoryMerchantRepository) GetMerchant(userID string, merchantID string) (entities.Merchant, error) {
+	for _, merchant := range m.merchants {
+		if merchant.UserID == userID && merchant.ID == merchantID {
+			return merchant, nil
+		}
+	}
+
+	return entities.Merchant{}, errors.New("merchant not found")
+}
+
+func TestResolveMerchantReusesCreatedMerchant(t *testing.T) {
+	repository := &memoryMerchantRepository{}
+
+	created := resolveMerchant(repository, "user", "UBER *TRIP 4821", "test")
+	if created == "" {
+		t.Fatal("expected a merchant to be created")
+	}
+
+	merchant, _ := repository.GetMerchant("user", created)
+	if merchant.Name != "Uber" {
+		t.Errorf("created merchant name = %q, want %q", merchant.Name, "Uber")
+	}
+
+	for _, description := range []string{"Uber BV", "uber", "PAYPAL *UBER 99"} {
+		if got := resolveMerchant(repository, "user", description, "test"); got != created {
+			t.Errorf("resolveMerchant(%q) = %q, want the created merchant %q", description, got, created)
+		}
+	}
+
+	if len(repository.merchants) != 1 {
+		t.Errorf("created %d merchants, want 1", len(repository.merchants))
+	}
+
+	if other := resolveMerchant(repository, "other", "Uber BV", "test"); other == "" || other == created {
+		t.Errorf("resolveMerchant for another user = %q, want a merchant of their own", other)
+	}
+}
+
+func TestResolveMerchantTruncatedNameStillMatches(t *testing.T) {
+	repository := &memoryMerchantRepository{}
+	description := "Padaria e Confeitaria Pao Quente da Vila Madalena Ltda"
+
+	created := resolveMerchant(repository, "user", description, "test")
+
+	merchant, _ := repository.GetMerchant("user", created)
+	if len(merchant.Name) > entities.MERCHANT_AUTO_NAME_MAX_LENGTH {
+		t.Errorf("created merchant name %q is longer than %d", merchant.Name, entities.MERCHANT_AUTO_NAME_MAX_LENGTH)
+	}
+
+	if got := resolveMerchant(repository, "user", "PADARIA E CONFEITARIA PAO QUENTE DA VILA MADALENA", "test"); got != created {
+		t.Errorf("resolveMerchant after truncation = %q, want %q", got, created)
+	}
+}
+
+func TestResolveMerchantSkipsDescriptionsWithoutLetters(t *testing.T) {
+	repository := &memoryMerchantRepository{}
+
+	for _, description := range []string{"", "12345", "*** 00/12 ***", "MP*1234"} {
+		if got := resolveMerchant(repository, "user", description, "test"); got != "" {
+			t.Errorf("resolveMerchant(%q) = %q, want no merchant", description, got)
+		}
+	}
+
+	if len(repository.merchants) != 0 {
+		t.Errorf("created %d merchants, want none", len(repository.merchants))
+	}
+}
@@ -0,0 +1,130 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type CreateRuleInputDto struct {
+	UserID         string                  `json:"user_id"`
+	Name           string                  `json:"name"`
+	Priority       int                     `json:"priority"`
+	StopProcessing bool                    `json:"stop_processing"`
+	Conditions     entities.RuleConditions `json:"conditions"`
+	Actions        entities.RuleActions    `json:"actions"`
+}
+
+type CreateRuleOutputDto struct {
+	RuleID         string `json:"rule_id"`
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type CreateRuleUseCase struct {
+	RuleRepository     repositories.RuleRepositoryInterface
+	UserRepository     repositories.UserRepositoryInterface
+	CategoryRepository repositories.CategoryRepositoryInterface
+	TagRepository      repositories.TagRepositoryInterface
+}
+
+func NewCreateRuleUseCase(
+	RuleRepository repositories.RuleRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+	CategoryRepository repositories.CategoryRepositoryInterface,
+	TagRepository repositories.TagRepositoryInterface,
+) *CreateRuleUseCase {
+	return &CreateRuleUseCase{
+		RuleRepository:     RuleRepository,
+		UserRepository:     UserRepository,
+		CategoryRepository: CategoryRepository,
+		TagRepository:      TagRepository,
+	}
+}
+
+func (c *CreateRuleUseCase) Execute(input CreateRuleInputDto) (CreateRuleOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return CreateRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return CreateRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	if checkActionsErr := checkRuleActions(c.CategoryRepository, c.TagRepository, user.ID, input.Actions); len(checkActionsErr) > 0 {
+		return CreateRuleOutputDto{}, checkActionsErr
+	}
+
+	newRule, newRuleErr := entities.NewRule(user.ID, input.Name, input.Priority, input.StopProcessing, input.Conditions, input.Actions)
+	if len(newRuleErr) > 0 {
+		return CreateRuleOutputDto{}, newRuleErr
+	}
+
+	createRuleErr := c.RuleRepository.CreateRule(*newRule)
+	if createRuleErr != nil {
+		return CreateRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error creating new rule",
+				Status:   500,
+				Detail:   createRuleErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return CreateRuleOutputDto{
+		RuleID:         newRule.ID,
+		SuccessMessage: "Rule created successfully",
+		ContentMessage: "The " + newRule.Name + " rule was created",
+	}, nil
+}
+
+// checkRuleActions makes sure the category and tags a rule sets belong to the
+// user, since applying the rule writes them onto the user's expenses.
+func checkRuleActions(categoryRepository repositories.CategoryRepositoryInterface, tagRepository repositories.TagRepositoryInterface, userID string, actions entities.RuleActions) []util.ProblemDetails {
+	if actions.SetCategoryID != "" {
+		if _, getCategoryErr := categoryRepository.GetCategory(userID, actions.SetCategoryID); getCategoryErr != nil {
+			return []util.ProblemDetails{
+				{
+					Type:     "Not Found",
+					Title:    "Category not found",
+					Status:   404,
+					Detail:   getCategoryErr.Error(),
+					Instance: util.RFC404,
+				},
+			}
+		}
+	}
+
+	for _, tagID := range actions.AddTagIDs {
+		if _, getTagErr := tagRepository.GetTag(userID, tagID); getTagErr != nil {
+			return []util.ProblemDetails{
+				{
+					Type:     "Not Found",
+					Title:    "Tag not found",
+					Status:   404,
+					Detail:   getTagErr.Error(),
+					Instance: util.RFC404,
+				},
+			}
+		}
+	}
+
+	return nil
+}
@@ -21,17 +21,20 @@ type CreateTagOutputDto struct {
 }
 
 type CreateTagUseCase struct {
-	TagRepository  repositories.TagRepositoryInterface
-	UserRepository repositories.UserRepositoryInterface
+	TagRepository     repositories.TagRepositoryInterface
+	UserRepository    repositories.UserRepositoryInterface
+	WebhookRepository repositories.WebhookRepositoryInterface
 }
 
 func NewCreateTagUseCase(
 	TagRepository repositories.TagRepositoryInterface,
 	UserRepository repositories.UserRepositoryInterface,
+	WebhookRepository repositories.WebhookRepositoryInterface,
 ) *CreateTagUseCase {
 	return &CreateTagUseCase{
-		TagRepository:  TagRepository,
-		UserRepository: UserRepository,
+		TagRepository:     TagRepository,
+		UserRepository:    UserRepository,
+		WebhookRepository: WebhookRepository,
 	}
 }
 
@@ -102,6 +105,8 @@ func (c *CreateTagUseCase) Execute(input CreateTagInputDto) (CreateTagOutputDto,
 		}
 	}
 
+	publishWebhookEvent(c.WebhookRepository, input.UserID, entities.WEBHOOK_EVENT_TAG_CREATED, *newTag, "CreateTagUseCase")
+
 	return CreateTagOutputDto{
 		TagID:          newTag.ID,
 		SuccessMessage: "Tag created successfully",
@@ -0,0 +1,114 @@
+package usecases
+
+import (
+	"fmt"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type CreateTransferInputDto struct {
+	UserID        string  `json:"user_id"`
+	FromAccountID string  `json:"from_account_id"`
+	ToAccountID   string  `json:"to_account_id"`
+	Amount        float64 `json:"amount,string"`
+	TransferDate  string  `json:"transfer_date"`
+	Notes         string  `json:"notes"`
+}
+
+type CreateTransferOutputDto struct {
+	TransferID     string `json:"transfer_id"`
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type CreateTransferUseCase struct {
+	TransferRepository repositories.TransferRepositoryInterface
+	AccountRepository  repositories.AccountRepositoryInterface
+	UserRepository     repositories.UserRepositoryInterface
+}
+
+func NewCreateTransferUseCase(
+	TransferRepository repositories.TransferRepositoryInterface,
+	AccountRepository repositories.AccountRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *CreateTransferUseCase {
+	return &CreateTransferUseCase{
+		TransferRepository: TransferRepository,
+		AccountRepository:  AccountRepository,
+		UserRepository:     UserRepository,
+	}
+}
+
+func (c *CreateTransferUseCase) Execute(input CreateTransferInputDto) (CreateTransferOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return CreateTransferOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return CreateTransferOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	newTransferDate, parseDateErr := util.ParseDate(input.TransferDate, user.Timezone)
+	if parseDateErr != nil {
+		return CreateTransferOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Bad Request",
+				Status:   400,
+				Detail:   "Invalid transfer date format",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	newTransfer, newTransferErr := entities.NewTransfer(input.UserID, input.FromAccountID, input.ToAccountID, input.Amount, newTransferDate, input.Notes)
+	if len(newTransferErr) > 0 {
+		return CreateTransferOutputDto{}, newTransferErr
+	}
+
+	_, fromAccountErr := checkAccount(c.AccountRepository, input.UserID, input.FromAccountID)
+	if len(fromAccountErr) > 0 {
+		return CreateTransferOutputDto{}, fromAccountErr
+	}
+
+	_, toAccountErr := checkAccount(c.AccountRepository, input.UserID, input.ToAccountID)
+	if len(toAccountErr) > 0 {
+		return CreateTransferOutputDto{}, toAccountErr
+	}
+
+	createTransferErr := c.TransferRepository.CreateTransfer(*newTransfer)
+	if createTransferErr != nil {
+		return CreateTransferOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error creating new transfer",
+				Status:   500,
+				Detail:   createTransferErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return CreateTransferOutputDto{
+		TransferID:     newTransfer.ID,
+		SuccessMessage: "Transfer created successfully",
+		ContentMessage: fmt.Sprintf("Transfer of %.2f added for %s", input.Amount, newTransfer.TransferDate.Format("02/01/2006")),
+	}, nil
+}
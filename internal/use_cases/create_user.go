@@ -9,9 +9,12 @@ import (
 )
 
 type CreateUserInputDto struct {
-	Name     string `json:"name"`
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	Password  string `json:"password"`
+	Timezone  string `json:"timezone"`
+	Language  string `json:"language"`
+	WeekStart string `json:"week_start"`
 }
 
 type CreateUserOutputDto struct {
@@ -120,6 +123,27 @@ func (c *CreateUserUseCase) Execute(input CreateUserInputDto) (CreateUserOutputD
 		return CreateUserOutputDto{}, newUserErr
 	}
 
+	if input.Timezone != "" {
+		changeTimezoneErr := newUser.ChangeTimezone(input.Timezone)
+		if len(changeTimezoneErr) > 0 {
+			return CreateUserOutputDto{}, changeTimezoneErr
+		}
+	}
+
+	if input.Language != "" {
+		changeLanguageErr := newUser.ChangeLanguage(input.Language)
+		if len(changeLanguageErr) > 0 {
+			return CreateUserOutputDto{}, changeLanguageErr
+		}
+	}
+
+	if input.WeekStart != "" {
+		changeWeekStartErr := newUser.ChangeWeekStart(input.WeekStart)
+		if len(changeWeekStartErr) > 0 {
+			return CreateUserOutputDto{}, changeWeekStartErr
+		}
+	}
+
 	createUserErr := c.UserRepository.CreateUser(*newUser)
 	if createUserErr != nil {
 		return CreateUserOutputDto{}, []util.ProblemDetails{
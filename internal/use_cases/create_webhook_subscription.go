@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type CreateWebhookSubscriptionInputDto struct {
+	UserID     string   `json:"user_id"`
+	URL        string   `json:"url"`
+	Secret     string   `json:"secret"`
+	EventTypes []string `json:"event_types"`
+}
+
+type CreateWebhookSubscriptionOutputDto struct {
+	SubscriptionID string `json:"subscription_id"`
+	Secret         string `json:"secret"`
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type CreateWebhookSubscriptionUseCase struct {
+	WebhookRepository repositories.WebhookRepositoryInterface
+	UserRepository    repositories.UserRepositoryInterface
+}
+
+func NewCreateWebhookSubscriptionUseCase(
+	WebhookRepository repositories.WebhookRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *CreateWebhookSubscriptionUseCase {
+	return &CreateWebhookSubscriptionUseCase{
+		WebhookRepository: WebhookRepository,
+		UserRepository:    UserRepository,
+	}
+}
+
+func (c *CreateWebhookSubscriptionUseCase) Execute(input CreateWebhookSubscriptionInputDto) (CreateWebhookSubscriptionOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return CreateWebhookSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return CreateWebhookSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	newSubscription, newSubscriptionErr := entities.NewWebhookSubscription(input.UserID, input.URL, input.Secret, input.EventTypes)
+	if len(newSubscriptionErr) > 0 {
+		return CreateWebhookSubscriptionOutputDto{}, newSubscriptionErr
+	}
+
+	createSubscriptionErr := c.WebhookRepository.CreateWebhookSubscription(*newSubscription)
+	if createSubscriptionErr != nil {
+		return CreateWebhookSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error creating new webhook subscription",
+				Status:   500,
+				Detail:   createSubscriptionErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return CreateWebhookSubscriptionOutputDto{
+		SubscriptionID: newSubscription.ID,
+		Secret:         newSubscription.Secret,
+		SuccessMessage: "Webhook subscription created successfully",
+		ContentMessage: "Events will be delivered to " + newSubscription.URL,
+	}, nil
+}
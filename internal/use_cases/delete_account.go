@@ -0,0 +1,101 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type DeleteAccountInputDto struct {
+	UserID    string `json:"user_id"`
+	AccountID string `json:"account_id"`
+}
+
+type DeleteAccountOutputDto struct {
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type DeleteAccountUseCase struct {
+	AccountRepository repositories.AccountRepositoryInterface
+	UserRepository    repositories.UserRepositoryInterface
+}
+
+func NewDeleteAccountUseCase(
+	AccountRepository repositories.AccountRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *DeleteAccountUseCase {
+	return &DeleteAccountUseCase{
+		AccountRepository: AccountRepository,
+		UserRepository:    UserRepository,
+	}
+}
+
+func (c *DeleteAccountUseCase) Execute(input DeleteAccountInputDto) (DeleteAccountOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return DeleteAccountOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return DeleteAccountOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	accountToDelete, getAccountErr := c.AccountRepository.GetAccount(input.UserID, input.AccountID)
+	if getAccountErr != nil {
+		return DeleteAccountOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Account not found",
+				Status:   404,
+				Detail:   getAccountErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	accountToDelete.Deactivate()
+
+	deleteAccountErr := c.AccountRepository.DeleteAccount(accountToDelete)
+	if deleteAccountErr != nil {
+		if deleteAccountErr.Error() == "there are transactions associated with this account" {
+			return DeleteAccountOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Conflict",
+					Title:    "Account has transactions",
+					Status:   409,
+					Detail:   "Error: " + deleteAccountErr.Error(),
+					Instance: util.RFC409,
+				},
+			}
+		}
+
+		return DeleteAccountOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Err deleting account",
+				Status:   500,
+				Detail:   deleteAccountErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return DeleteAccountOutputDto{
+		SuccessMessage: "Account deleted successfully",
+		ContentMessage: "Account " + accountToDelete.Name + " deleted",
+	}, nil
+}
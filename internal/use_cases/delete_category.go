@@ -1,6 +1,7 @@
 package usecases
 
 import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
 )
@@ -18,15 +19,18 @@ type DeleteCategoryOutputDto struct {
 type DeleteCategoryUseCase struct {
 	CategoryRepository repositories.CategoryRepositoryInterface
 	UserRepository     repositories.UserRepositoryInterface
+	WebhookRepository  repositories.WebhookRepositoryInterface
 }
 
 func NewDeleteCategoryUseCase(
 	CategoryRepository repositories.CategoryRepositoryInterface,
 	UserRepository repositories.UserRepositoryInterface,
+	WebhookRepository repositories.WebhookRepositoryInterface,
 ) *DeleteCategoryUseCase {
 	return &DeleteCategoryUseCase{
 		CategoryRepository: CategoryRepository,
 		UserRepository:     UserRepository,
+		WebhookRepository:  WebhookRepository,
 	}
 }
 
@@ -71,7 +75,7 @@ func (c *DeleteCategoryUseCase) Execute(input DeleteCategoryInputDto) (DeleteCat
 
 	deleteCategoryErr := c.CategoryRepository.DeleteCategory(categoryToDelete)
 	if deleteCategoryErr != nil {
-		if deleteCategoryErr.Error() == "there are expenses associated with this category" {
+		if deleteCategoryErr.Error() == "there are expenses associated with this category" || deleteCategoryErr.Error() == "there are incomes associated with this category" {
 			return DeleteCategoryOutputDto{}, []util.ProblemDetails{
 				{
 					Type:     "Conflict",
@@ -94,6 +98,8 @@ func (c *DeleteCategoryUseCase) Execute(input DeleteCategoryInputDto) (DeleteCat
 		}
 	}
 
+	publishWebhookEvent(c.WebhookRepository, input.UserID, entities.WEBHOOK_EVENT_CATEGORY_DELETED, categoryToDelete, "DeleteCategoryUseCase")
+
 	return DeleteCategoryOutputDto{
 		SuccessMessage: "Category deleted successfully",
 		ContentMessage: "Category " + categoryToDelete.Name + " deleted",
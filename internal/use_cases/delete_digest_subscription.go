@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type DeleteDigestSubscriptionInputDto struct {
+	UserID         string `json:"user_id"`
+	SubscriptionID string `json:"subscription_id"`
+}
+
+type DeleteDigestSubscriptionOutputDto struct {
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type DeleteDigestSubscriptionUseCase struct {
+	DigestRepository repositories.DigestRepositoryInterface
+	UserRepository   repositories.UserRepositoryInterface
+}
+
+func NewDeleteDigestSubscriptionUseCase(
+	DigestRepository repositories.DigestRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *DeleteDigestSubscriptionUseCase {
+	return &DeleteDigestSubscriptionUseCase{
+		DigestRepository: DigestRepository,
+		UserRepository:   UserRepository,
+	}
+}
+
+func (c *DeleteDigestSubscriptionUseCase) Execute(input DeleteDigestSubscriptionInputDto) (DeleteDigestSubscriptionOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return DeleteDigestSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return DeleteDigestSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	subscription, getSubscriptionErr := c.DigestRepository.GetDigestSubscription(input.UserID, input.SubscriptionID)
+	if getSubscriptionErr != nil {
+		return DeleteDigestSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Digest subscription not found",
+				Status:   404,
+				Detail:   getSubscriptionErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	subscription.Deactivate()
+
+	deleteSubscriptionErr := c.DigestRepository.DeleteDigestSubscription(subscription)
+	if deleteSubscriptionErr != nil {
+		return DeleteDigestSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Err deleting digest subscription",
+				Status:   500,
+				Detail:   deleteSubscriptionErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return DeleteDigestSubscriptionOutputDto{
+		SuccessMessage: "Digest subscription deleted successfully",
+		ContentMessage: "The " + subscription.Frequency + " digest will no longer be sent",
+	}, nil
+}
@@ -1,6 +1,7 @@
 package usecases
 
 import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
 )
@@ -16,17 +17,23 @@ type DeleteExpenseOutputDto struct {
 }
 
 type DeleteExpenseUseCase struct {
-	ExpenseRepository repositories.ExpenseRepositoryInterface
-	UserRepository    repositories.UserRepositoryInterface
+	ExpenseRepository    repositories.ExpenseRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+	SuggestionRepository repositories.SuggestionRepositoryInterface
+	WebhookRepository    repositories.WebhookRepositoryInterface
 }
 
 func NewDeleteExpenseUseCase(
 	ExpenseRepository repositories.ExpenseRepositoryInterface,
 	UserRepository repositories.UserRepositoryInterface,
+	SuggestionRepository repositories.SuggestionRepositoryInterface,
+	WebhookRepository repositories.WebhookRepositoryInterface,
 ) *DeleteExpenseUseCase {
 	return &DeleteExpenseUseCase{
-		ExpenseRepository: ExpenseRepository,
-		UserRepository:    UserRepository,
+		ExpenseRepository:    ExpenseRepository,
+		UserRepository:       UserRepository,
+		SuggestionRepository: SuggestionRepository,
+		WebhookRepository:    WebhookRepository,
 	}
 }
 
@@ -82,6 +89,9 @@ func (c *DeleteExpenseUseCase) Execute(input DeleteExpenseInputDto) (DeleteExpen
 		}
 	}
 
+	observeExpense(c.SuggestionRepository, expenseToDelete, -1, "DeleteExpenseUseCase")
+	publishWebhookEvent(c.WebhookRepository, input.UserID, entities.WEBHOOK_EVENT_EXPENSE_DELETED, expenseToDelete, "DeleteExpenseUseCase")
+
 	return DeleteExpenseOutputDto{
 		SuccessMessage: "Expense deleted successfully",
 		ContentMessage: "Expense with amount " + util.FloatToBRL(expenseToDelete.Amount) + " deleted",
@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type DeleteGoalInputDto struct {
+	UserID string `json:"user_id"`
+	GoalID string `json:"goal_id"`
+}
+
+type DeleteGoalOutputDto struct {
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type DeleteGoalUseCase struct {
+	GoalRepository repositories.GoalRepositoryInterface
+	UserRepository repositories.UserRepositoryInterface
+}
+
+func NewDeleteGoalUseCase(
+	GoalRepository repositories.GoalRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *DeleteGoalUseCase {
+	return &DeleteGoalUseCase{
+		GoalRepository: GoalRepository,
+		UserRepository: UserRepository,
+	}
+}
+
+func (c *DeleteGoalUseCase) Execute(input DeleteGoalInputDto) (DeleteGoalOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return DeleteGoalOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return DeleteGoalOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	goalToDelete, getGoalErr := c.GoalRepository.GetGoal(input.UserID, input.GoalID)
+	if getGoalErr != nil {
+		return DeleteGoalOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Goal not found",
+				Status:   404,
+				Detail:   getGoalErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	goalToDelete.Deactivate()
+
+	deleteGoalErr := c.GoalRepository.DeleteGoal(goalToDelete)
+	if deleteGoalErr != nil {
+		return DeleteGoalOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Err deleting goal",
+				Status:   500,
+				Detail:   deleteGoalErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return DeleteGoalOutputDto{
+		SuccessMessage: "Goal deleted successfully",
+		ContentMessage: "Goal " + goalToDelete.Name + " deleted",
+	}, nil
+}
@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type DeleteGoalContributionInputDto struct {
+	UserID         string `json:"user_id"`
+	ContributionID string `json:"contribution_id"`
+}
+
+type DeleteGoalContributionOutputDto struct {
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type DeleteGoalContributionUseCase struct {
+	GoalRepository repositories.GoalRepositoryInterface
+	UserRepository repositories.UserRepositoryInterface
+}
+
+func NewDeleteGoalContributionUseCase(
+	GoalRepository repositories.GoalRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *DeleteGoalContributionUseCase {
+	return &DeleteGoalContributionUseCase{
+		GoalRepository: GoalRepository,
+		UserRepository: UserRepository,
+	}
+}
+
+func (c *DeleteGoalContributionUseCase) Execute(input DeleteGoalContributionInputDto) (DeleteGoalContributionOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return DeleteGoalContributionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return DeleteGoalContributionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	contributionToDelete, getContributionErr := c.GoalRepository.GetGoalContribution(input.UserID, input.ContributionID)
+	if getContributionErr != nil {
+		return DeleteGoalContributionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Goal contribution not found",
+				Status:   404,
+				Detail:   getContributionErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	contributionToDelete.Deactivate()
+
+	deleteContributionErr := c.GoalRepository.DeleteGoalContribution(contributionToDelete)
+	if deleteContributionErr != nil {
+		return DeleteGoalContributionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Err deleting goal contribution",
+				Status:   500,
+				Detail:   deleteContributionErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return DeleteGoalContributionOutputDto{
+		SuccessMessage: "Goal contribution deleted successfully",
+		ContentMessage: "Contribution with amount " + util.FloatToBRL(contributionToDelete.Amount) + " deleted",
+	}, nil
+}
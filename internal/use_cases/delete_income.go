@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type DeleteIncomeInputDto struct {
+	UserID   string `json:"user_id"`
+	IncomeID string `json:"income_id"`
+}
+
+type DeleteIncomeOutputDto struct {
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type DeleteIncomeUseCase struct {
+	IncomeRepository repositories.IncomeRepositoryInterface
+	UserRepository   repositories.UserRepositoryInterface
+}
+
+func NewDeleteIncomeUseCase(
+	IncomeRepository repositories.IncomeRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *DeleteIncomeUseCase {
+	return &DeleteIncomeUseCase{
+		IncomeRepository: IncomeRepository,
+		UserRepository:   UserRepository,
+	}
+}
+
+func (c *DeleteIncomeUseCase) Execute(input DeleteIncomeInputDto) (DeleteIncomeOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return DeleteIncomeOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return DeleteIncomeOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	incomeToDelete, getIncomeErr := c.IncomeRepository.GetIncome(input.UserID, input.IncomeID)
+	if getIncomeErr != nil {
+		return DeleteIncomeOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Income not found",
+				Status:   404,
+				Detail:   getIncomeErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	incomeToDelete.Deactivate()
+
+	deleteIncomeErr := c.IncomeRepository.DeleteIncome(incomeToDelete)
+	if deleteIncomeErr != nil {
+		return DeleteIncomeOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Err deleting income",
+				Status:   500,
+				Detail:   deleteIncomeErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return DeleteIncomeOutputDto{
+		SuccessMessage: "Income deleted successfully",
+		ContentMessage: "Income with amount " + util.FloatToBRL(incomeToDelete.Amount) + " deleted",
+	}, nil
+}
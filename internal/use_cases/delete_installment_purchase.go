@@ -0,0 +1,114 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type DeleteInstallmentPurchaseInputDto struct {
+	UserID                string `json:"user_id"`
+	InstallmentPurchaseID string `json:"installment_purchase_id"`
+}
+
+type DeleteInstallmentPurchaseOutputDto struct {
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type DeleteInstallmentPurchaseUseCase struct {
+	InstallmentPurchaseRepository repositories.InstallmentPurchaseRepositoryInterface
+	UserRepository                repositories.UserRepositoryInterface
+	SuggestionRepository          repositories.SuggestionRepositoryInterface
+	WebhookRepository             repositories.WebhookRepositoryInterface
+}
+
+func NewDeleteInstallmentPurchaseUseCase(
+	InstallmentPurchaseRepository repositories.InstallmentPurchaseRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+	SuggestionRepository repositories.SuggestionRepositoryInterface,
+	WebhookRepository repositories.WebhookRepositoryInterface,
+) *DeleteInstallmentPurchaseUseCase {
+	return &DeleteInstallmentPurchaseUseCase{
+		InstallmentPurchaseRepository: InstallmentPurchaseRepository,
+		UserRepository:                UserRepository,
+		SuggestionRepository:          SuggestionRepository,
+		WebhookRepository:             WebhookRepository,
+	}
+}
+
+func (c *DeleteInstallmentPurchaseUseCase) Execute(input DeleteInstallmentPurchaseInputDto) (DeleteInstallmentPurchaseOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return DeleteInstallmentPurchaseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return DeleteInstallmentPurchaseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	purchaseToDelete, getPurchaseErr := c.InstallmentPurchaseRepository.GetInstallmentPurchase(input.UserID, input.InstallmentPurchaseID)
+	if getPurchaseErr != nil {
+		return DeleteInstallmentPurchaseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Installment purchase not found",
+				Status:   404,
+				Detail:   getPurchaseErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	today, todayErr := startOfToday(user.Timezone)
+	if todayErr != nil {
+		return DeleteInstallmentPurchaseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not load timezone",
+				Status:   500,
+				Detail:   todayErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	purchaseToDelete.Deactivate()
+
+	deletePurchaseErr := c.InstallmentPurchaseRepository.DeleteInstallmentPurchase(purchaseToDelete, today)
+	if deletePurchaseErr != nil {
+		return DeleteInstallmentPurchaseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Err deleting installment purchase",
+				Status:   500,
+				Detail:   deletePurchaseErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	for _, installment := range purchaseToDelete.Installments {
+		if !installment.ExpenseDate.Before(today) {
+			expenseDeleted(c.SuggestionRepository, c.WebhookRepository, installment, "DeleteInstallmentPurchaseUseCase")
+		}
+	}
+
+	return DeleteInstallmentPurchaseOutputDto{
+		SuccessMessage: "Installment purchase cancelled successfully",
+		ContentMessage: "Future installments of " + util.FloatToBRL(purchaseToDelete.TotalAmount) + " removed",
+	}, nil
+}
@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type DeleteMerchantInputDto struct {
+	UserID     string `json:"user_id"`
+	MerchantID string `json:"merchant_id"`
+}
+
+type DeleteMerchantOutputDto struct {
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type DeleteMerchantUseCase struct {
+	MerchantRepository repositories.MerchantRepositoryInterface
+	UserRepository     repositories.UserRepositoryInterface
+}
+
+func NewDeleteMerchantUseCase(
+	MerchantRepository repositories.MerchantRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *DeleteMerchantUseCase {
+	return &DeleteMerchantUseCase{
+		MerchantRepository: MerchantRepository,
+		UserRepository:     UserRepository,
+	}
+}
+
+func (c *DeleteMerchantUseCase) Execute(input DeleteMerchantInputDto) (DeleteMerchantOutputDto, []util.ProblemDetails) {
+	user, GetUserErr := c.UserRepository.GetUser(input.UserID)
+	if GetUserErr != nil {
+		return DeleteMerchantOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   GetUserErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return DeleteMerchantOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	merchantToDelete, GetMerchantErr := c.MerchantRepository.GetMerchant(input.UserID, input.MerchantID)
+	if GetMerchantErr != nil {
+		return DeleteMerchantOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Merchant not found",
+				Status:   404,
+				Detail:   GetMerchantErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	merchantToDelete.Deactivate()
+
+	DeleteMerchantErr := c.MerchantRepository.DeleteMerchant(merchantToDelete)
+	if DeleteMerchantErr != nil {
+		return DeleteMerchantOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Err deleting merchant",
+				Status:   500,
+				Detail:   DeleteMerchantErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return DeleteMerchantOutputDto{
+		SuccessMessage: "Merchant deleted successfully",
+		ContentMessage: "Merchant " + merchantToDelete.Name + " deleted",
+	}, nil
+}
@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type DeleteRuleInputDto struct {
+	UserID string `json:"user_id"`
+	RuleID string `json:"rule_id"`
+}
+
+type DeleteRuleOutputDto struct {
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type DeleteRuleUseCase struct {
+	RuleRepository repositories.RuleRepositoryInterface
+	UserRepository repositories.UserRepositoryInterface
+}
+
+func NewDeleteRuleUseCase(
+	RuleRepository repositories.RuleRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *DeleteRuleUseCase {
+	return &DeleteRuleUseCase{
+		RuleRepository: RuleRepository,
+		UserRepository: UserRepository,
+	}
+}
+
+func (c *DeleteRuleUseCase) Execute(input DeleteRuleInputDto) (DeleteRuleOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return DeleteRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return DeleteRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	ruleToDelete, getRuleErr := c.RuleRepository.GetRule(input.UserID, input.RuleID)
+	if getRuleErr != nil {
+		return DeleteRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Rule not found",
+				Status:   404,
+				Detail:   getRuleErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	ruleToDelete.Deactivate()
+
+	deleteRuleErr := c.RuleRepository.DeleteRule(ruleToDelete)
+	if deleteRuleErr != nil {
+		return DeleteRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Err deleting rule",
+				Status:   500,
+				Detail:   deleteRuleErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return DeleteRuleOutputDto{
+		SuccessMessage: "Rule deleted successfully",
+		ContentMessage: "Rule " + ruleToDelete.Name + " deleted",
+	}, nil
+}
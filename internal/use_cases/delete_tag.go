@@ -1,6 +1,7 @@
 package usecases
 
 import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
 )
@@ -16,17 +17,20 @@ type DeleteTagOutputDto struct {
 }
 
 type DeleteTagUseCase struct {
-	TagRepository  repositories.TagRepositoryInterface
-	UserRepository repositories.UserRepositoryInterface
+	TagRepository     repositories.TagRepositoryInterface
+	UserRepository    repositories.UserRepositoryInterface
+	WebhookRepository repositories.WebhookRepositoryInterface
 }
 
 func NewDeleteTagUseCase(
 	TagRepository repositories.TagRepositoryInterface,
 	UserRepository repositories.UserRepositoryInterface,
+	WebhookRepository repositories.WebhookRepositoryInterface,
 ) *DeleteTagUseCase {
 	return &DeleteTagUseCase{
-		TagRepository:  TagRepository,
-		UserRepository: UserRepository,
+		TagRepository:     TagRepository,
+		UserRepository:    UserRepository,
+		WebhookRepository: WebhookRepository,
 	}
 }
 
@@ -82,6 +86,8 @@ func (c *DeleteTagUseCase) Execute(input DeleteTagInputDto) (DeleteTagOutputDto,
 		}
 	}
 
+	publishWebhookEvent(c.WebhookRepository, input.UserID, entities.WEBHOOK_EVENT_TAG_DELETED, tagToDelete, "DeleteTagUseCase")
+
 	return DeleteTagOutputDto{
 		SuccessMessage: "Tag deleted successfully",
 		ContentMessage: "Tag " + tagToDelete.Name + " deleted",
@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type DeleteTransferInputDto struct {
+	UserID     string `json:"user_id"`
+	TransferID string `json:"transfer_id"`
+}
+
+type DeleteTransferOutputDto struct {
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type DeleteTransferUseCase struct {
+	TransferRepository repositories.TransferRepositoryInterface
+	UserRepository     repositories.UserRepositoryInterface
+}
+
+func NewDeleteTransferUseCase(
+	TransferRepository repositories.TransferRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *DeleteTransferUseCase {
+	return &DeleteTransferUseCase{
+		TransferRepository: TransferRepository,
+		UserRepository:     UserRepository,
+	}
+}
+
+func (c *DeleteTransferUseCase) Execute(input DeleteTransferInputDto) (DeleteTransferOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return DeleteTransferOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return DeleteTransferOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	transferToDelete, getTransferErr := c.TransferRepository.GetTransfer(input.UserID, input.TransferID)
+	if getTransferErr != nil {
+		return DeleteTransferOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Transfer not found",
+				Status:   404,
+				Detail:   getTransferErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	transferToDelete.Deactivate()
+
+	deleteTransferErr := c.TransferRepository.DeleteTransfer(transferToDelete)
+	if deleteTransferErr != nil {
+		return DeleteTransferOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Err deleting transfer",
+				Status:   500,
+				Detail:   deleteTransferErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return DeleteTransferOutputDto{
+		SuccessMessage: "Transfer deleted successfully",
+		ContentMessage: "Transfer with amount " + util.FloatToBRL(transferToDelete.Amount) + " deleted",
+	}, nil
+}
@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type DeleteWebhookSubscriptionInputDto struct {
+	UserID         string `json:"user_id"`
+	SubscriptionID string `json:"subscription_id"`
+}
+
+type DeleteWebhookSubscriptionOutputDto struct {
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type DeleteWebhookSubscriptionUseCase struct {
+	WebhookRepository repositories.WebhookRepositoryInterface
+	UserRepository    repositories.UserRepositoryInterface
+}
+
+func NewDeleteWebhookSubscriptionUseCase(
+	WebhookRepository repositories.WebhookRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *DeleteWebhookSubscriptionUseCase {
+	return &DeleteWebhookSubscriptionUseCase{
+		WebhookRepository: WebhookRepository,
+		UserRepository:    UserRepository,
+	}
+}
+
+func (c *DeleteWebhookSubscriptionUseCase) Execute(input DeleteWebhookSubscriptionInputDto) (DeleteWebhookSubscriptionOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return DeleteWebhookSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return DeleteWebhookSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	subscription, getSubscriptionErr := c.WebhookRepository.GetWebhookSubscription(input.UserID, input.SubscriptionID)
+	if getSubscriptionErr != nil {
+		return DeleteWebhookSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Webhook subscription not found",
+				Status:   404,
+				Detail:   getSubscriptionErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	subscription.Deactivate()
+
+	deleteSubscriptionErr := c.WebhookRepository.DeleteWebhookSubscription(subscription)
+	if deleteSubscriptionErr != nil {
+		return DeleteWebhookSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Err deleting webhook subscription",
+				Status:   500,
+				Detail:   deleteSubscriptionErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return DeleteWebhookSubscriptionOutputDto{
+		SuccessMessage: "Webhook subscription deleted successfully",
+		ContentMessage: "Webhook to " + subscription.URL + " deleted",
+	}, nil
+}
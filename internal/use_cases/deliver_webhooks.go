@@ -0,0 +1,121 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+const DEFAULT_WEBHOOK_DELIVERY_BATCH = 50
+
+type DeliverWebhooksInputDto struct {
+	Limit int `json:"limit"`
+}
+
+type DeliverWebhooksOutputDto struct {
+	Attempted int `json:"attempted"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+}
+
+type DeliverWebhooksUseCase struct {
+	WebhookRepository repositories.WebhookRepositoryInterface
+	HTTPClient        *http.Client
+}
+
+func NewDeliverWebhooksUseCase(
+	WebhookRepository repositories.WebhookRepositoryInterface,
+	HTTPClient *http.Client,
+) *DeliverWebhooksUseCase {
+	return &DeliverWebhooksUseCase{
+		WebhookRepository: WebhookRepository,
+		HTTPClient:        HTTPClient,
+	}
+}
+
+// Execute claims the deliveries whose next attempt is due and sends them. It
+// is run periodically by the dispatcher started in main; the claim keeps
+// dispatchers on other instances from sending the same deliveries.
+func (c *DeliverWebhooksUseCase) Execute(input DeliverWebhooksInputDto) (DeliverWebhooksOutputDto, []util.ProblemDetails) {
+	limit := input.Limit
+	if limit <= 0 {
+		limit = DEFAULT_WEBHOOK_DELIVERY_BATCH
+	}
+
+	deliveries, getDeliveriesErr := c.WebhookRepository.ClaimDueWebhookDeliveries(time.Now(), limit)
+	if getDeliveriesErr != nil {
+		util.NewLoggerError(500, getDeliveriesErr.Error(), "DeliverWebhooksUseCase", "Use Cases", "Error")
+		return DeliverWebhooksOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving webhook deliveries",
+				Status:   500,
+				Detail:   getDeliveriesErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	output := DeliverWebhooksOutputDto{}
+
+	for _, delivery := range deliveries {
+		subscription, getSubscriptionErr := c.WebhookRepository.GetWebhookSubscription(delivery.UserID, delivery.SubscriptionID)
+		if getSubscriptionErr != nil {
+			if !errors.Is(getSubscriptionErr, repositories.ErrWebhookSubscriptionNotFound) {
+				util.NewLoggerError(500, getSubscriptionErr.Error(), "DeliverWebhooksUseCase", "Use Cases", "Error")
+				continue
+			}
+			delivery.Abandon(getSubscriptionErr.Error())
+		} else {
+			sendWebhookDelivery(c.HTTPClient, subscription, &delivery)
+			output.Attempted++
+		}
+
+		if delivery.Status == entities.WEBHOOK_DELIVERY_STATUS_SUCCEEDED {
+			output.Succeeded++
+		} else if delivery.Status == entities.WEBHOOK_DELIVERY_STATUS_FAILED {
+			output.Failed++
+		}
+
+		if updateDeliveryErr := c.WebhookRepository.UpdateWebhookDelivery(delivery); updateDeliveryErr != nil {
+			util.NewLoggerError(500, updateDeliveryErr.Error(), "DeliverWebhooksUseCase", "Use Cases", "Error")
+		}
+	}
+
+	return output, nil
+}
+
+func sendWebhookDelivery(client *http.Client, subscription entities.WebhookSubscription, delivery *entities.WebhookDelivery) {
+	request, err := http.NewRequest(http.MethodPost, subscription.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		delivery.RecordAttempt(0, err)
+		return
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(entities.WEBHOOK_EVENT_HEADER, delivery.EventType)
+	request.Header.Set(entities.WEBHOOK_DELIVERY_HEADER, delivery.ID)
+	request.Header.Set(entities.WEBHOOK_SIGNATURE_HEADER, entities.SignWebhookPayload(subscription.Secret, delivery.Payload))
+
+	response, err := client.Do(request)
+	if err != nil {
+		delivery.RecordAttempt(0, err)
+		return
+	}
+	defer response.Body.Close()
+
+	io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		err = fmt.Errorf("receiver responded with status %d", response.StatusCode)
+	}
+
+	delivery.RecordAttempt(response.StatusCode, err)
+}
@@ -0,0 +1,101 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type DismissExpenseAnomalyInputDto struct {
+	UserID    string `json:"user_id"`
+	AnomalyID string `json:"anomaly_id"`
+}
+
+type DismissExpenseAnomalyOutputDto struct {
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type DismissExpenseAnomalyUseCase struct {
+	ExpenseRepository repositories.ExpenseRepositoryInterface
+	UserRepository    repositories.UserRepositoryInterface
+}
+
+func NewDismissExpenseAnomalyUseCase(
+	ExpenseRepository repositories.ExpenseRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *DismissExpenseAnomalyUseCase {
+	return &DismissExpenseAnomalyUseCase{
+		ExpenseRepository: ExpenseRepository,
+		UserRepository:    UserRepository,
+	}
+}
+
+func (c *DismissExpenseAnomalyUseCase) Execute(input DismissExpenseAnomalyInputDto) (DismissExpenseAnomalyOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return DismissExpenseAnomalyOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return DismissExpenseAnomalyOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	anomaly, getAnomalyErr := c.ExpenseRepository.GetExpenseAnomaly(input.UserID, input.AnomalyID)
+	if getAnomalyErr != nil {
+		return DismissExpenseAnomalyOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Expense anomaly not found",
+				Status:   404,
+				Detail:   getAnomalyErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	if anomaly.Dismissed {
+		return DismissExpenseAnomalyOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Conflict",
+				Title:    "Expense anomaly already dismissed",
+				Status:   409,
+				Detail:   "This anomaly has already been dismissed",
+				Instance: util.RFC409,
+			},
+		}
+	}
+
+	anomaly.Dismiss()
+
+	dismissAnomalyErr := c.ExpenseRepository.DismissExpenseAnomaly(anomaly)
+	if dismissAnomalyErr != nil {
+		return DismissExpenseAnomalyOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while dismissing expense anomaly",
+				Status:   500,
+				Detail:   dismissAnomalyErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return DismissExpenseAnomalyOutputDto{
+		SuccessMessage: "Expense anomaly dismissed successfully",
+		ContentMessage: "Anomaly " + anomaly.ID + " on expense " + anomaly.ExpenseID + " dismissed",
+	}, nil
+}
@@ -0,0 +1,73 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetAccountInputDto struct {
+	UserID    string `json:"user_id"`
+	AccountID string `json:"account_id"`
+}
+
+type GetAccountOutputDto struct {
+	Account entities.Account `json:"account"`
+}
+
+type GetAccountUseCase struct {
+	AccountRepository repositories.AccountRepositoryInterface
+	UserRepository    repositories.UserRepositoryInterface
+}
+
+func NewGetAccountUseCase(
+	AccountRepository repositories.AccountRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetAccountUseCase {
+	return &GetAccountUseCase{
+		AccountRepository: AccountRepository,
+		UserRepository:    UserRepository,
+	}
+}
+
+func (c *GetAccountUseCase) Execute(input GetAccountInputDto) (GetAccountOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetAccountOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetAccountOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	account, getAccountErr := c.AccountRepository.GetAccount(input.UserID, input.AccountID)
+	if getAccountErr != nil {
+		return GetAccountOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Account not found",
+				Status:   404,
+				Detail:   getAccountErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	return GetAccountOutputDto{
+		Account: account,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetAccountsInputDto struct {
+	UserID string `json:"user_id"`
+}
+
+type GetAccountsOutputDto struct {
+	Accounts []entities.Account `json:"accounts"`
+}
+
+type GetAccountsUseCase struct {
+	AccountRepository repositories.AccountRepositoryInterface
+	UserRepository    repositories.UserRepositoryInterface
+}
+
+func NewGetAccountsUseCase(
+	AccountRepository repositories.AccountRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetAccountsUseCase {
+	return &GetAccountsUseCase{
+		AccountRepository: AccountRepository,
+		UserRepository:    UserRepository,
+	}
+}
+
+func (c *GetAccountsUseCase) Execute(input GetAccountsInputDto) (GetAccountsOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetAccountsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetAccountsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	accounts, getAccountsErr := c.AccountRepository.GetAccounts(input.UserID)
+	if getAccountsErr != nil {
+		return GetAccountsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving accounts",
+				Status:   500,
+				Detail:   getAccountsErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetAccountsOutputDto{
+		Accounts: accounts,
+	}, nil
+}
@@ -8,6 +8,7 @@ import (
 
 type GetCategoriesInputDto struct {
 	UserID string `json:"user_id"`
+	Kind   string `json:"kind"`
 }
 
 type GetCategoriesOutputDto struct {
@@ -66,6 +67,18 @@ func (c *GetCategoriesUseCase) Execute(input GetCategoriesInputDto) (GetCategori
 		}
 	}
 
+	if input.Kind != "" {
+		filteredCategories := []entities.Category{}
+
+		for _, category := range searchedsCategories {
+			if category.Kind == input.Kind {
+				filteredCategories = append(filteredCategories, category)
+			}
+		}
+
+		searchedsCategories = filteredCategories
+	}
+
 	return GetCategoriesOutputDto{
 		Categories: searchedsCategories,
 	}, nil
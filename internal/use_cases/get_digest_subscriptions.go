@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetDigestSubscriptionsInputDto struct {
+	UserID string `json:"user_id"`
+}
+
+type GetDigestSubscriptionsOutputDto struct {
+	Subscriptions []entities.DigestSubscription `json:"subscriptions"`
+}
+
+type GetDigestSubscriptionsUseCase struct {
+	DigestRepository repositories.DigestRepositoryInterface
+	UserRepository   repositories.UserRepositoryInterface
+}
+
+func NewGetDigestSubscriptionsUseCase(
+	DigestRepository repositories.DigestRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetDigestSubscriptionsUseCase {
+	return &GetDigestSubscriptionsUseCase{
+		DigestRepository: DigestRepository,
+		UserRepository:   UserRepository,
+	}
+}
+
+func (c *GetDigestSubscriptionsUseCase) Execute(input GetDigestSubscriptionsInputDto) (GetDigestSubscriptionsOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetDigestSubscriptionsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetDigestSubscriptionsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	subscriptions, getSubscriptionsErr := c.DigestRepository.GetDigestSubscriptions(input.UserID)
+	if getSubscriptionsErr != nil {
+		return GetDigestSubscriptionsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving digest subscriptions",
+				Status:   500,
+				Detail:   getSubscriptionsErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetDigestSubscriptionsOutputDto{
+		Subscriptions: subscriptions,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetExpenseAnomaliesInputDto struct {
+	UserID string `json:"user_id"`
+}
+
+type GetExpenseAnomaliesOutputDto struct {
+	Expenses []entities.Expense `json:"expenses"`
+}
+
+type GetExpenseAnomaliesUseCase struct {
+	ExpenseRepository repositories.ExpenseRepositoryInterface
+	UserRepository    repositories.UserRepositoryInterface
+}
+
+func NewGetExpenseAnomaliesUseCase(
+	ExpenseRepository repositories.ExpenseRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetExpenseAnomaliesUseCase {
+	return &GetExpenseAnomaliesUseCase{
+		ExpenseRepository: ExpenseRepository,
+		UserRepository:    UserRepository,
+	}
+}
+
+func (c *GetExpenseAnomaliesUseCase) Execute(input GetExpenseAnomaliesInputDto) (GetExpenseAnomaliesOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetExpenseAnomaliesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetExpenseAnomaliesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	expenses, getAnomaliesErr := c.ExpenseRepository.GetExpenseAnomalies(input.UserID)
+	if getAnomaliesErr != nil {
+		return GetExpenseAnomaliesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving expense anomalies",
+				Status:   500,
+				Detail:   getAnomaliesErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetExpenseAnomaliesOutputDto{
+		Expenses: expenses,
+	}, nil
+}
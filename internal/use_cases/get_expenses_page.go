@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetExpensesPageInputDto struct {
+	UserID  string `json:"user_id"`
+	AfterID string `json:"after_id"`
+	Limit   int    `json:"limit"`
+}
+
+type GetExpensesPageOutputDto struct {
+	Expenses []entities.Expense `json:"expenses"`
+}
+
+// GetExpensesPageUseCase reads the user's expenses a page at a time, in
+// creation order. Pass the ID of the last expense of a page as AfterID to
+// get the next one; a page shorter than Limit is the last.
+type GetExpensesPageUseCase struct {
+	ExpenseRepository repositories.ExpenseRepositoryInterface
+	UserRepository    repositories.UserRepositoryInterface
+}
+
+func NewGetExpensesPageUseCase(
+	ExpenseRepository repositories.ExpenseRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetExpensesPageUseCase {
+	return &GetExpensesPageUseCase{
+		ExpenseRepository: ExpenseRepository,
+		UserRepository:    UserRepository,
+	}
+}
+
+func (c *GetExpensesPageUseCase) Execute(input GetExpensesPageInputDto) (GetExpensesPageOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetExpensesPageOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetExpensesPageOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	if input.Limit <= 0 {
+		return GetExpensesPageOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Bad Request",
+				Title:    "Invalid page size",
+				Status:   400,
+				Detail:   "Page size must be greater than zero",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	searchedsExpenses, err := c.ExpenseRepository.GetExpensesPage(input.UserID, input.AfterID, input.Limit)
+	if err != nil {
+		return GetExpensesPageOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving expenses",
+				Status:   500,
+				Detail:   err.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetExpensesPageOutputDto{
+		Expenses: searchedsExpenses,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetGoalInputDto struct {
+	UserID string `json:"user_id"`
+	GoalID string `json:"goal_id"`
+}
+
+type GetGoalOutputDto struct {
+	Goal entities.Goal `json:"goal"`
+}
+
+type GetGoalUseCase struct {
+	GoalRepository repositories.GoalRepositoryInterface
+	UserRepository repositories.UserRepositoryInterface
+}
+
+func NewGetGoalUseCase(
+	GoalRepository repositories.GoalRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetGoalUseCase {
+	return &GetGoalUseCase{
+		GoalRepository: GoalRepository,
+		UserRepository: UserRepository,
+	}
+}
+
+func (c *GetGoalUseCase) Execute(input GetGoalInputDto) (GetGoalOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetGoalOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetGoalOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	goal, getGoalErr := c.GoalRepository.GetGoal(input.UserID, input.GoalID)
+	if getGoalErr != nil {
+		return GetGoalOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Goal not found",
+				Status:   404,
+				Detail:   getGoalErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	return GetGoalOutputDto{
+		Goal: goal,
+	}, nil
+}
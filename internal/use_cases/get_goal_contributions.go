@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetGoalContributionsInputDto struct {
+	UserID string `json:"user_id"`
+	GoalID string `json:"goal_id"`
+}
+
+type GetGoalContributionsOutputDto struct {
+	Contributions []entities.GoalContribution `json:"contributions"`
+}
+
+type GetGoalContributionsUseCase struct {
+	GoalRepository repositories.GoalRepositoryInterface
+	UserRepository repositories.UserRepositoryInterface
+}
+
+func NewGetGoalContributionsUseCase(
+	GoalRepository repositories.GoalRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetGoalContributionsUseCase {
+	return &GetGoalContributionsUseCase{
+		GoalRepository: GoalRepository,
+		UserRepository: UserRepository,
+	}
+}
+
+func (c *GetGoalContributionsUseCase) Execute(input GetGoalContributionsInputDto) (GetGoalContributionsOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetGoalContributionsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetGoalContributionsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	_, getGoalErr := c.GoalRepository.GetGoal(input.UserID, input.GoalID)
+	if getGoalErr != nil {
+		return GetGoalContributionsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Goal not found",
+				Status:   404,
+				Detail:   getGoalErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	contributions, getContributionsErr := c.GoalRepository.GetGoalContributions(input.UserID, input.GoalID)
+	if getContributionsErr != nil {
+		return GetGoalContributionsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving goal contributions",
+				Status:   500,
+				Detail:   getContributionsErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetGoalContributionsOutputDto{
+		Contributions: contributions,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetGoalsInputDto struct {
+	UserID string `json:"user_id"`
+}
+
+type GetGoalsOutputDto struct {
+	Goals []entities.Goal `json:"goals"`
+}
+
+type GetGoalsUseCase struct {
+	GoalRepository repositories.GoalRepositoryInterface
+	UserRepository repositories.UserRepositoryInterface
+}
+
+func NewGetGoalsUseCase(
+	GoalRepository repositories.GoalRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetGoalsUseCase {
+	return &GetGoalsUseCase{
+		GoalRepository: GoalRepository,
+		UserRepository: UserRepository,
+	}
+}
+
+func (c *GetGoalsUseCase) Execute(input GetGoalsInputDto) (GetGoalsOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetGoalsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetGoalsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	goals, getGoalsErr := c.GoalRepository.GetGoals(input.UserID)
+	if getGoalsErr != nil {
+		return GetGoalsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving goals",
+				Status:   500,
+				Detail:   getGoalsErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetGoalsOutputDto{
+		Goals: goals,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetIncomeInputDto struct {
+	UserID   string `json:"user_id"`
+	IncomeID string `json:"income_id"`
+}
+
+type GetIncomeOutputDto struct {
+	Income entities.Income `json:"income"`
+}
+
+type GetIncomeUseCase struct {
+	IncomeRepository repositories.IncomeRepositoryInterface
+	UserRepository   repositories.UserRepositoryInterface
+}
+
+func NewGetIncomeUseCase(
+	IncomeRepository repositories.IncomeRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetIncomeUseCase {
+	return &GetIncomeUseCase{
+		IncomeRepository: IncomeRepository,
+		UserRepository:   UserRepository,
+	}
+}
+
+func (c *GetIncomeUseCase) Execute(input GetIncomeInputDto) (GetIncomeOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetIncomeOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetIncomeOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	income, getIncomeErr := c.IncomeRepository.GetIncome(input.UserID, input.IncomeID)
+	if getIncomeErr != nil {
+		return GetIncomeOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Income not found",
+				Status:   404,
+				Detail:   getIncomeErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	return GetIncomeOutputDto{
+		Income: income,
+	}, nil
+}
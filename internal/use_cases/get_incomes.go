@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetIncomesInputDto struct {
+	UserID string `json:"user_id"`
+}
+
+type GetIncomesOutputDto struct {
+	Incomes []entities.Income `json:"incomes"`
+}
+
+type GetIncomesUseCase struct {
+	IncomeRepository repositories.IncomeRepositoryInterface
+	UserRepository   repositories.UserRepositoryInterface
+}
+
+func NewGetIncomesUseCase(
+	IncomeRepository repositories.IncomeRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetIncomesUseCase {
+	return &GetIncomesUseCase{
+		IncomeRepository: IncomeRepository,
+		UserRepository:   UserRepository,
+	}
+}
+
+func (c *GetIncomesUseCase) Execute(input GetIncomesInputDto) (GetIncomesOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetIncomesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetIncomesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	incomes, getIncomesErr := c.IncomeRepository.GetIncomes(input.UserID)
+	if getIncomesErr != nil {
+		return GetIncomesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving incomes",
+				Status:   500,
+				Detail:   getIncomesErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetIncomesOutputDto{
+		Incomes: incomes,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetInstallmentPurchaseInputDto struct {
+	UserID                string `json:"user_id"`
+	InstallmentPurchaseID string `json:"installment_purchase_id"`
+}
+
+type GetInstallmentPurchaseOutputDto struct {
+	InstallmentPurchase entities.InstallmentPurchase `json:"installment_purchase"`
+}
+
+type GetInstallmentPurchaseUseCase struct {
+	InstallmentPurchaseRepository repositories.InstallmentPurchaseRepositoryInterface
+	UserRepository                repositories.UserRepositoryInterface
+}
+
+func NewGetInstallmentPurchaseUseCase(
+	InstallmentPurchaseRepository repositories.InstallmentPurchaseRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetInstallmentPurchaseUseCase {
+	return &GetInstallmentPurchaseUseCase{
+		InstallmentPurchaseRepository: InstallmentPurchaseRepository,
+		UserRepository:                UserRepository,
+	}
+}
+
+func (c *GetInstallmentPurchaseUseCase) Execute(input GetInstallmentPurchaseInputDto) (GetInstallmentPurchaseOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetInstallmentPurchaseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetInstallmentPurchaseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	purchase, getPurchaseErr := c.InstallmentPurchaseRepository.GetInstallmentPurchase(input.UserID, input.InstallmentPurchaseID)
+	if getPurchaseErr != nil {
+		return GetInstallmentPurchaseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Installment purchase not found",
+				Status:   404,
+				Detail:   getPurchaseErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	return GetInstallmentPurchaseOutputDto{
+		InstallmentPurchase: purchase,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetInstallmentPurchasesInputDto struct {
+	UserID string `json:"user_id"`
+}
+
+type GetInstallmentPurchasesOutputDto struct {
+	InstallmentPurchases []entities.InstallmentPurchase `json:"installment_purchases"`
+}
+
+type GetInstallmentPurchasesUseCase struct {
+	InstallmentPurchaseRepository repositories.InstallmentPurchaseRepositoryInterface
+	UserRepository                repositories.UserRepositoryInterface
+}
+
+func NewGetInstallmentPurchasesUseCase(
+	InstallmentPurchaseRepository repositories.InstallmentPurchaseRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetInstallmentPurchasesUseCase {
+	return &GetInstallmentPurchasesUseCase{
+		InstallmentPurchaseRepository: InstallmentPurchaseRepository,
+		UserRepository:                UserRepository,
+	}
+}
+
+func (c *GetInstallmentPurchasesUseCase) Execute(input GetInstallmentPurchasesInputDto) (GetInstallmentPurchasesOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetInstallmentPurchasesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetInstallmentPurchasesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	purchases, getPurchasesErr := c.InstallmentPurchaseRepository.GetInstallmentPurchases(input.UserID)
+	if getPurchasesErr != nil {
+		return GetInstallmentPurchasesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving installment purchases",
+				Status:   500,
+				Detail:   getPurchasesErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetInstallmentPurchasesOutputDto{
+		InstallmentPurchases: purchases,
+	}, nil
+}
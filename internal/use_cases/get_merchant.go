@@ -0,0 +1,128 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetMerchantInputDto struct {
+	UserID     string `json:"user_id"`
+	MerchantID string `json:"merchant_id"`
+}
+
+type MerchantMonthTotal struct {
+	Month string  `json:"month"`
+	Total float64 `json:"total"`
+	Count int     `json:"count"`
+}
+
+type GetMerchantOutputDto struct {
+	Merchant         entities.Merchant    `json:"merchant"`
+	Total            float64              `json:"total"`
+	Count            int                  `json:"count"`
+	Average          float64              `json:"average"`
+	FirstExpenseDate *time.Time           `json:"first_expense_date,omitempty"`
+	LastExpenseDate  *time.Time           `json:"last_expense_date,omitempty"`
+	Months           []MerchantMonthTotal `json:"months"`
+	Expenses         []entities.Expense   `json:"expenses"`
+}
+
+type GetMerchantUseCase struct {
+	MerchantRepository repositories.MerchantRepositoryInterface
+	UserRepository     repositories.UserRepositoryInterface
+}
+
+func NewGetMerchantUseCase(
+	MerchantRepository repositories.MerchantRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetMerchantUseCase {
+	return &GetMerchantUseCase{
+		MerchantRepository: MerchantRepository,
+		UserRepository:     UserRepository,
+	}
+}
+
+// Execute returns the merchant with its expense history, newest first, and
+// the totals of each month it was paid in.
+func (c *GetMerchantUseCase) Execute(input GetMerchantInputDto) (GetMerchantOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetMerchantOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetMerchantOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	merchant, getMerchantErr := c.MerchantRepository.GetMerchant(input.UserID, input.MerchantID)
+	if getMerchantErr != nil {
+		return GetMerchantOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Merchant not found",
+				Status:   404,
+				Detail:   getMerchantErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	expenses, getExpensesErr := c.MerchantRepository.GetMerchantExpenses(input.UserID, input.MerchantID)
+	if getExpensesErr != nil {
+		return GetMerchantOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error fetching merchant expenses",
+				Status:   500,
+				Detail:   getExpensesErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	location := util.Now(user.Timezone).Location()
+
+	output := GetMerchantOutputDto{
+		Merchant: merchant,
+		Count:    len(expenses),
+		Months:   []MerchantMonthTotal{},
+		Expenses: expenses,
+	}
+
+	for _, expense := range expenses {
+		output.Total += expense.Amount
+
+		month := expense.ExpenseDate.In(location).Format("2006-01")
+		if last := len(output.Months) - 1; last < 0 || output.Months[last].Month != month {
+			output.Months = append(output.Months, MerchantMonthTotal{Month: month})
+		}
+
+		output.Months[len(output.Months)-1].Total += expense.Amount
+		output.Months[len(output.Months)-1].Count++
+	}
+
+	if len(expenses) > 0 {
+		output.Average = output.Total / float64(len(expenses))
+		output.LastExpenseDate = &expenses[0].ExpenseDate
+		output.FirstExpenseDate = &expenses[len(expenses)-1].ExpenseDate
+	}
+
+	return output, nil
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetMerchantsInputDto struct {
+	UserID string `json:"user_id"`
+}
+
+type GetMerchantsOutputDto struct {
+	Merchants []entities.Merchant `json:"merchants"`
+}
+
+type GetMerchantsUseCase struct {
+	MerchantRepository repositories.MerchantRepositoryInterface
+	UserRepository     repositories.UserRepositoryInterface
+}
+
+func NewGetMerchantsUseCase(
+	MerchantRepository repositories.MerchantRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetMerchantsUseCase {
+	return &GetMerchantsUseCase{
+		MerchantRepository: MerchantRepository,
+		UserRepository:     UserRepository,
+	}
+}
+
+func (c *GetMerchantsUseCase) Execute(input GetMerchantsInputDto) (GetMerchantsOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetMerchantsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetMerchantsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	searchedsMerchants, err := c.MerchantRepository.GetMerchants(input.UserID)
+	if err != nil {
+		return GetMerchantsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Err fetching merchants",
+				Status:   500,
+				Detail:   err.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetMerchantsOutputDto{
+		Merchants: searchedsMerchants,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetRuleInputDto struct {
+	UserID string `json:"user_id"`
+	RuleID string `json:"rule_id"`
+}
+
+type GetRuleOutputDto struct {
+	Rule entities.Rule `json:"rule"`
+}
+
+type GetRuleUseCase struct {
+	RuleRepository repositories.RuleRepositoryInterface
+	UserRepository repositories.UserRepositoryInterface
+}
+
+func NewGetRuleUseCase(
+	RuleRepository repositories.RuleRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetRuleUseCase {
+	return &GetRuleUseCase{
+		RuleRepository: RuleRepository,
+		UserRepository: UserRepository,
+	}
+}
+
+func (c *GetRuleUseCase) Execute(input GetRuleInputDto) (GetRuleOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	rule, getRuleErr := c.RuleRepository.GetRule(input.UserID, input.RuleID)
+	if getRuleErr != nil {
+		return GetRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Rule not found",
+				Status:   404,
+				Detail:   getRuleErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	return GetRuleOutputDto{
+		Rule: rule,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetRulesInputDto struct {
+	UserID string `json:"user_id"`
+}
+
+type GetRulesOutputDto struct {
+	Rules []entities.Rule `json:"rules"`
+}
+
+type GetRulesUseCase struct {
+	RuleRepository repositories.RuleRepositoryInterface
+	UserRepository repositories.UserRepositoryInterface
+}
+
+func NewGetRulesUseCase(
+	RuleRepository repositories.RuleRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetRulesUseCase {
+	return &GetRulesUseCase{
+		RuleRepository: RuleRepository,
+		UserRepository: UserRepository,
+	}
+}
+
+func (c *GetRulesUseCase) Execute(input GetRulesInputDto) (GetRulesOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetRulesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetRulesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	rules, getRulesErr := c.RuleRepository.GetRules(input.UserID)
+	if getRulesErr != nil {
+		return GetRulesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving rules",
+				Status:   500,
+				Detail:   getRulesErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetRulesOutputDto{
+		Rules: rules,
+	}, nil
+}
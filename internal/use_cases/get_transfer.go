@@ -0,0 +1,73 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetTransferInputDto struct {
+	UserID     string `json:"user_id"`
+	TransferID string `json:"transfer_id"`
+}
+
+type GetTransferOutputDto struct {
+	Transfer entities.Transfer `json:"transfer"`
+}
+
+type GetTransferUseCase struct {
+	TransferRepository repositories.TransferRepositoryInterface
+	UserRepository     repositories.UserRepositoryInterface
+}
+
+func NewGetTransferUseCase(
+	TransferRepository repositories.TransferRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetTransferUseCase {
+	return &GetTransferUseCase{
+		TransferRepository: TransferRepository,
+		UserRepository:     UserRepository,
+	}
+}
+
+func (c *GetTransferUseCase) Execute(input GetTransferInputDto) (GetTransferOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetTransferOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetTransferOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	transfer, getTransferErr := c.TransferRepository.GetTransfer(input.UserID, input.TransferID)
+	if getTransferErr != nil {
+		return GetTransferOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Transfer not found",
+				Status:   404,
+				Detail:   getTransferErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	return GetTransferOutputDto{
+		Transfer: transfer,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetTransfersInputDto struct {
+	UserID string `json:"user_id"`
+}
+
+type GetTransfersOutputDto struct {
+	Transfers []entities.Transfer `json:"transfers"`
+}
+
+type GetTransfersUseCase struct {
+	TransferRepository repositories.TransferRepositoryInterface
+	UserRepository     repositories.UserRepositoryInterface
+}
+
+func NewGetTransfersUseCase(
+	TransferRepository repositories.TransferRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetTransfersUseCase {
+	return &GetTransfersUseCase{
+		TransferRepository: TransferRepository,
+		UserRepository:     UserRepository,
+	}
+}
+
+func (c *GetTransfersUseCase) Execute(input GetTransfersInputDto) (GetTransfersOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetTransfersOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetTransfersOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	transfers, getTransfersErr := c.TransferRepository.GetTransfers(input.UserID)
+	if getTransfersErr != nil {
+		return GetTransfersOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving transfers",
+				Status:   500,
+				Detail:   getTransfersErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetTransfersOutputDto{
+		Transfers: transfers,
+	}, nil
+}
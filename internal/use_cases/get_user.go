@@ -8,7 +8,10 @@ import (
 
 type UserOutput struct {
 	entities.SharedEntity
-	Name string `json:"name"`
+	Name      string `json:"name"`
+	Timezone  string `json:"timezone"`
+	Language  string `json:"language"`
+	WeekStart string `json:"week_start"`
 }
 
 type GetUserInputDto struct {
@@ -49,6 +52,9 @@ func (c *GetUserUseCase) Execute(input GetUserInputDto) (GetUserOutputDto, []uti
 		User: UserOutput{
 			SharedEntity: searchedUser.SharedEntity,
 			Name:         searchedUser.Name,
+			Timezone:     searchedUser.Timezone,
+			Language:     searchedUser.Language,
+			WeekStart:    searchedUser.WeekStart,
 		},
 	}, nil
 }
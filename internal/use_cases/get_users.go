@@ -44,6 +44,9 @@ func (c *GetUsersUseCase) Execute(input GetUsersInputDto) (GetUsersOutputDto, []
 		output = append(output, UserOutput{
 			SharedEntity: user.SharedEntity,
 			Name:         user.Name,
+			Timezone:     user.Timezone,
+			Language:     user.Language,
+			WeekStart:    user.WeekStart,
 		})
 	}
 
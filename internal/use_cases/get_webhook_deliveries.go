@@ -0,0 +1,88 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetWebhookDeliveriesInputDto struct {
+	UserID         string `json:"user_id"`
+	SubscriptionID string `json:"subscription_id"`
+}
+
+type GetWebhookDeliveriesOutputDto struct {
+	Deliveries []entities.WebhookDelivery `json:"deliveries"`
+}
+
+type GetWebhookDeliveriesUseCase struct {
+	WebhookRepository repositories.WebhookRepositoryInterface
+	UserRepository    repositories.UserRepositoryInterface
+}
+
+func NewGetWebhookDeliveriesUseCase(
+	WebhookRepository repositories.WebhookRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetWebhookDeliveriesUseCase {
+	return &GetWebhookDeliveriesUseCase{
+		WebhookRepository: WebhookRepository,
+		UserRepository:    UserRepository,
+	}
+}
+
+func (c *GetWebhookDeliveriesUseCase) Execute(input GetWebhookDeliveriesInputDto) (GetWebhookDeliveriesOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetWebhookDeliveriesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetWebhookDeliveriesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	if input.SubscriptionID != "" {
+		_, getSubscriptionErr := c.WebhookRepository.GetWebhookSubscription(input.UserID, input.SubscriptionID)
+		if getSubscriptionErr != nil {
+			return GetWebhookDeliveriesOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Not Found",
+					Title:    "Webhook subscription not found",
+					Status:   404,
+					Detail:   getSubscriptionErr.Error(),
+					Instance: util.RFC404,
+				},
+			}
+		}
+	}
+
+	deliveries, getDeliveriesErr := c.WebhookRepository.GetWebhookDeliveries(input.UserID, input.SubscriptionID)
+	if getDeliveriesErr != nil {
+		return GetWebhookDeliveriesOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving webhook deliveries",
+				Status:   500,
+				Detail:   getDeliveriesErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetWebhookDeliveriesOutputDto{
+		Deliveries: deliveries,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type GetWebhookSubscriptionsInputDto struct {
+	UserID string `json:"user_id"`
+}
+
+type GetWebhookSubscriptionsOutputDto struct {
+	Subscriptions []entities.WebhookSubscription `json:"subscriptions"`
+}
+
+type GetWebhookSubscriptionsUseCase struct {
+	WebhookRepository repositories.WebhookRepositoryInterface
+	UserRepository    repositories.UserRepositoryInterface
+}
+
+func NewGetWebhookSubscriptionsUseCase(
+	WebhookRepository repositories.WebhookRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *GetWebhookSubscriptionsUseCase {
+	return &GetWebhookSubscriptionsUseCase{
+		WebhookRepository: WebhookRepository,
+		UserRepository:    UserRepository,
+	}
+}
+
+func (c *GetWebhookSubscriptionsUseCase) Execute(input GetWebhookSubscriptionsInputDto) (GetWebhookSubscriptionsOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return GetWebhookSubscriptionsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return GetWebhookSubscriptionsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	subscriptions, getSubscriptionsErr := c.WebhookRepository.GetWebhookSubscriptions(input.UserID)
+	if getSubscriptionsErr != nil {
+		return GetWebhookSubscriptionsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving webhook subscriptions",
+				Status:   500,
+				Detail:   getSubscriptionsErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return GetWebhookSubscriptionsOutputDto{
+		Subscriptions: subscriptions,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+// An untrained model is skipped: TrainSuggestionModels trains it from the full
+// history at startup, and the first suggestion request does for users who had
+// no model then.
+func observeExpense(suggestionRepository repositories.SuggestionRepositoryInterface, expense entities.Expense, delta int, from string) {
+	hasModel, err := suggestionRepository.HasSuggestionModel(expense.UserID)
+	if err != nil {
+		util.NewLoggerError(500, err.Error(), from, "Use Cases", "Error")
+		return
+	}
+
+	if !hasModel {
+		return
+	}
+
+	if err := suggestionRepository.UpdateSuggestionModel(expense.UserID, entities.NewSuggestionObservation(expense), delta); err != nil {
+		util.NewLoggerError(500, err.Error(), from, "Use Cases", "Error")
+	}
+}
@@ -0,0 +1,82 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+// prepareNewExpense runs what every new expense goes through before it is
+// saved, whether it is created directly or generated for an installment
+// purchase: the user's rules, then merchant resolution from the notes the
+// rules may have rewritten, then anomaly detection against the category
+// history.
+func prepareNewExpense(
+	expenseRepository repositories.ExpenseRepositoryInterface,
+	merchantRepository repositories.MerchantRepositoryInterface,
+	rules []entities.Rule,
+	location *time.Location,
+	expense *entities.Expense,
+	merchantID string,
+	from string,
+) []util.ProblemDetails {
+	_, applyRulesErr := entities.ApplyRules(rules, expense, location)
+	if len(applyRulesErr) > 0 {
+		return applyRulesErr
+	}
+
+	if merchantID != "" {
+		expense.ChangeMerchant(merchantID)
+	} else {
+		expense.ChangeMerchant(resolveMerchant(merchantRepository, expense.UserID, expense.Notes, from))
+	}
+
+	history, getHistoryErr := expenseRepository.GetCategoryExpensesForPeriod(expense.UserID, expense.CategoryID, expense.ExpenseDate.AddDate(0, 0, -entities.ANOMALY_WINDOW_DAYS), expense.ExpenseDate.AddDate(0, 0, 1).Add(-time.Nanosecond))
+	if getHistoryErr != nil {
+		return []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving category history",
+				Status:   500,
+				Detail:   getHistoryErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	expense.Anomalies = entities.DetectExpenseAnomalies(*expense, history, location)
+
+	return nil
+}
+
+// getRules loads the rules applied to a user's new expenses.
+func getRules(ruleRepository repositories.RuleRepositoryInterface, userID string) ([]entities.Rule, []util.ProblemDetails) {
+	rules, getRulesErr := ruleRepository.GetRules(userID)
+	if getRulesErr != nil {
+		return nil, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving rules",
+				Status:   500,
+				Detail:   getRulesErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return rules, nil
+}
+
+// expenseCreated and expenseDeleted keep the suggestion model current and
+// notify webhook subscribers once an expense has been saved or removed.
+func expenseCreated(suggestionRepository repositories.SuggestionRepositoryInterface, webhookRepository repositories.WebhookRepositoryInterface, expense entities.Expense, from string) {
+	observeExpense(suggestionRepository, expense, 1, from)
+	publishWebhookEvent(webhookRepository, expense.UserID, entities.WEBHOOK_EVENT_EXPENSE_CREATED, expense, from)
+}
+
+func expenseDeleted(suggestionRepository repositories.SuggestionRepositoryInterface, webhookRepository repositories.WebhookRepositoryInterface, expense entities.Expense, from string) {
+	observeExpense(suggestionRepository, expense, -1, from)
+	publishWebhookEvent(webhookRepository, expense.UserID, entities.WEBHOOK_EVENT_EXPENSE_DELETED, expense, from)
+}
@@ -0,0 +1,103 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type PreviewRuleInputDto struct {
+	UserID string `json:"user_id"`
+	RuleID string `json:"rule_id"`
+}
+
+type PreviewRuleOutputDto struct {
+	RuleID   string             `json:"rule_id"`
+	Matches  int                `json:"matches"`
+	Expenses []entities.Expense `json:"expenses"`
+}
+
+type PreviewRuleUseCase struct {
+	RuleRepository    repositories.RuleRepositoryInterface
+	ExpenseRepository repositories.ExpenseRepositoryInterface
+	UserRepository    repositories.UserRepositoryInterface
+}
+
+func NewPreviewRuleUseCase(
+	RuleRepository repositories.RuleRepositoryInterface,
+	ExpenseRepository repositories.ExpenseRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *PreviewRuleUseCase {
+	return &PreviewRuleUseCase{
+		RuleRepository:    RuleRepository,
+		ExpenseRepository: ExpenseRepository,
+		UserRepository:    UserRepository,
+	}
+}
+
+func (c *PreviewRuleUseCase) Execute(input PreviewRuleInputDto) (PreviewRuleOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return PreviewRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return PreviewRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	rule, getRuleErr := c.RuleRepository.GetRule(input.UserID, input.RuleID)
+	if getRuleErr != nil {
+		return PreviewRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Rule not found",
+				Status:   404,
+				Detail:   getRuleErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	expenses, getExpensesErr := c.ExpenseRepository.GetExpenses(input.UserID)
+	if getExpensesErr != nil {
+		return PreviewRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving expenses",
+				Status:   500,
+				Detail:   getExpensesErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	matchedExpenses := []entities.Expense{}
+
+	location := util.UserLocation(user.Timezone)
+
+	for _, expense := range expenses {
+		if rule.Matches(expense, location) {
+			matchedExpenses = append(matchedExpenses, expense)
+		}
+	}
+
+	return PreviewRuleOutputDto{
+		RuleID:   rule.ID,
+		Matches:  len(matchedExpenses),
+		Expenses: matchedExpenses,
+	}, nil
+}
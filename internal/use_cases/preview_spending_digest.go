@@ -0,0 +1,123 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type PreviewSpendingDigestInputDto struct {
+	UserID    string `json:"user_id"`
+	Frequency string `json:"frequency"`
+}
+
+type PreviewSpendingDigestOutputDto struct {
+	Digest  SpendingDigest `json:"digest"`
+	Subject string         `json:"subject"`
+	Text    string         `json:"text"`
+	HTML    string         `json:"html"`
+}
+
+type PreviewSpendingDigestUseCase struct {
+	PresentersRepository repositories.PresentersRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewPreviewSpendingDigestUseCase(
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *PreviewSpendingDigestUseCase {
+	return &PreviewSpendingDigestUseCase{
+		PresentersRepository: PresentersRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+// Execute renders the digest for the last complete period without sending it.
+func (c *PreviewSpendingDigestUseCase) Execute(input PreviewSpendingDigestInputDto) (PreviewSpendingDigestOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return PreviewSpendingDigestOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return PreviewSpendingDigestOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	if input.Frequency != entities.DIGEST_FREQUENCY_WEEKLY && input.Frequency != entities.DIGEST_FREQUENCY_MONTHLY {
+		return PreviewSpendingDigestOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Bad Request",
+				Status:   400,
+				Detail:   "Frequency must be weekly or monthly",
+				Instance: util.RFC400,
+			},
+		}
+	}
+
+	location, locationErr := util.LoadLocation(user.Timezone)
+	if locationErr != nil {
+		return PreviewSpendingDigestOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error loading timezone",
+				Status:   500,
+				Detail:   locationErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	subscription := entities.DigestSubscription{UserID: input.UserID, Frequency: input.Frequency}
+	periodStart, periodEnd := subscription.PreviousPeriod(time.Now().In(location), user.FirstDayOfWeek())
+
+	digest, buildDigestErr := buildSpendingDigest(c.PresentersRepository, subscription, periodStart, periodEnd)
+	if buildDigestErr != nil {
+		return PreviewSpendingDigestOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while building the digest",
+				Status:   500,
+				Detail:   buildDigestErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	message, renderErr := renderSpendingDigest("", digest)
+	if renderErr != nil {
+		return PreviewSpendingDigestOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while rendering the digest",
+				Status:   500,
+				Detail:   renderErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return PreviewSpendingDigestOutputDto{
+		Digest:  digest,
+		Subject: message.Subject,
+		Text:    message.TextBody,
+		HTML:    message.HTMLBody,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+// publishWebhookEvent queues one delivery per matching subscription. Delivery
+// happens in the background, so failures here are logged and never fail the
+// request that triggered the event.
+func publishWebhookEvent(webhookRepository repositories.WebhookRepositoryInterface, userID string, eventType string, data interface{}, from string) {
+	subscriptions, err := webhookRepository.GetWebhookSubscriptions(userID)
+	if err != nil {
+		util.NewLoggerError(500, err.Error(), from, "Use Cases", "Error")
+		return
+	}
+
+	var deliveries []entities.WebhookDelivery
+	for _, subscription := range subscriptions {
+		if !subscription.Subscribes(eventType) {
+			continue
+		}
+
+		delivery, err := entities.NewWebhookDelivery(subscription, eventType, data)
+		if err != nil {
+			util.NewLoggerError(500, err.Error(), from, "Use Cases", "Error")
+			continue
+		}
+
+		deliveries = append(deliveries, *delivery)
+	}
+
+	if err := webhookRepository.CreateWebhookDeliveries(deliveries); err != nil {
+		util.NewLoggerError(500, err.Error(), from, "Use Cases", "Error")
+	}
+}
@@ -0,0 +1,164 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type SendSpendingDigestsInputDto struct {
+	Date time.Time `json:"date"`
+}
+
+type SendSpendingDigestsOutputDto struct {
+	Sent    int `json:"sent"`
+	Failed  int `json:"failed"`
+	Skipped int `json:"skipped"`
+}
+
+type SendSpendingDigestsUseCase struct {
+	DigestRepository     repositories.DigestRepositoryInterface
+	PresentersRepository repositories.PresentersRepositoryInterface
+	Mailer               repositories.MailerInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewSendSpendingDigestsUseCase(
+	DigestRepository repositories.DigestRepositoryInterface,
+	PresentersRepository repositories.PresentersRepositoryInterface,
+	Mailer repositories.MailerInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *SendSpendingDigestsUseCase {
+	return &SendSpendingDigestsUseCase{
+		DigestRepository:     DigestRepository,
+		PresentersRepository: PresentersRepository,
+		Mailer:               Mailer,
+		UserRepository:       UserRepository,
+	}
+}
+
+// Execute sends the digest of the last complete period to every subscription
+// that has not received it yet. Each period is claimed in the database before
+// the email goes out, so overlapping runs never send the same digest twice;
+// only deliveries that failed are retried. Periods follow each user's
+// timezone but are keyed by calendar week or month, so changing the timezone
+// does not send a period again.
+func (c *SendSpendingDigestsUseCase) Execute(input SendSpendingDigestsInputDto) (SendSpendingDigestsOutputDto, []util.ProblemDetails) {
+	date := input.Date
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	subscriptions, getSubscriptionsErr := c.DigestRepository.GetAllDigestSubscriptions()
+	if getSubscriptionsErr != nil {
+		util.NewLoggerError(500, getSubscriptionsErr.Error(), "SendSpendingDigestsUseCase", "Use Cases", "Error")
+		return SendSpendingDigestsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving digest subscriptions",
+				Status:   500,
+				Detail:   getSubscriptionsErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	output := SendSpendingDigestsOutputDto{}
+
+	for _, subscription := range subscriptions {
+		user, location, locationErr := c.userLocation(subscription.UserID)
+		if locationErr != nil {
+			util.NewLoggerError(500, locationErr.Error(), "SendSpendingDigestsUseCase", "Use Cases", "Error")
+			output.Failed++
+			continue
+		}
+
+		periodStart, periodEnd := subscription.PreviousPeriod(date.In(location), user.FirstDayOfWeek())
+
+		delivery, claimed := c.claimDigestDelivery(subscription, periodStart, periodEnd)
+		if !claimed {
+			output.Skipped++
+			continue
+		}
+
+		sendErr := c.sendDigest(subscription, periodStart, periodEnd)
+		if sendErr != nil {
+			util.NewLoggerError(500, sendErr.Error(), "SendSpendingDigestsUseCase", "Use Cases", "Error")
+			delivery.MarkFailed(sendErr)
+			output.Failed++
+		} else {
+			delivery.MarkSent()
+			output.Sent++
+		}
+
+		if updateDeliveryErr := c.DigestRepository.UpdateDigestDelivery(delivery); updateDeliveryErr != nil {
+			util.NewLoggerError(500, updateDeliveryErr.Error(), "SendSpendingDigestsUseCase", "Use Cases", "Error")
+		}
+	}
+
+	return output, nil
+}
+
+func (c *SendSpendingDigestsUseCase) userLocation(userID string) (entities.User, *time.Location, error) {
+	user, err := c.UserRepository.GetUser(userID)
+	if err != nil {
+		return entities.User{}, nil, err
+	}
+
+	location, err := util.LoadLocation(user.Timezone)
+	if err != nil {
+		return entities.User{}, nil, err
+	}
+
+	return user, location, nil
+}
+
+func (c *SendSpendingDigestsUseCase) claimDigestDelivery(subscription entities.DigestSubscription, periodStart time.Time, periodEnd time.Time) (entities.DigestDelivery, bool) {
+	delivery, getDeliveryErr := c.DigestRepository.GetDigestDelivery(subscription.ID, subscription.PeriodKey(periodStart))
+	if getDeliveryErr == nil {
+		if !delivery.CanRetry() {
+			return delivery, false
+		}
+
+		delivery.Retry()
+
+		claimed, retryDeliveryErr := c.DigestRepository.RetryDigestDelivery(delivery)
+		if retryDeliveryErr != nil {
+			util.NewLoggerError(500, retryDeliveryErr.Error(), "SendSpendingDigestsUseCase", "Use Cases", "Error")
+			return entities.DigestDelivery{}, false
+		}
+
+		return delivery, claimed
+	}
+
+	if getDeliveryErr.Error() != "digest delivery not found" {
+		util.NewLoggerError(500, getDeliveryErr.Error(), "SendSpendingDigestsUseCase", "Use Cases", "Error")
+		return entities.DigestDelivery{}, false
+	}
+
+	newDelivery := entities.NewDigestDelivery(subscription, periodStart, periodEnd)
+
+	claimed, createDeliveryErr := c.DigestRepository.CreateDigestDelivery(*newDelivery)
+	if createDeliveryErr != nil {
+		util.NewLoggerError(500, createDeliveryErr.Error(), "SendSpendingDigestsUseCase", "Use Cases", "Error")
+		return entities.DigestDelivery{}, false
+	}
+
+	return *newDelivery, claimed
+}
+
+func (c *SendSpendingDigestsUseCase) sendDigest(subscription entities.DigestSubscription, periodStart time.Time, periodEnd time.Time) error {
+	digest, err := buildSpendingDigest(c.PresentersRepository, subscription, periodStart, periodEnd)
+	if err != nil {
+		return err
+	}
+
+	message, err := renderSpendingDigest(subscription.Email, digest)
+	if err != nil {
+		return err
+	}
+
+	return c.Mailer.SendEmail(message)
+}
@@ -0,0 +1,121 @@
+package usecases
+
+import (
+	"net/http"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type SendTestWebhookInputDto struct {
+	UserID         string `json:"user_id"`
+	SubscriptionID string `json:"subscription_id"`
+}
+
+type SendTestWebhookOutputDto struct {
+	Delivery       entities.WebhookDelivery `json:"delivery"`
+	SuccessMessage string                   `json:"success_message"`
+	ContentMessage string                   `json:"content_message"`
+}
+
+type SendTestWebhookUseCase struct {
+	WebhookRepository repositories.WebhookRepositoryInterface
+	UserRepository    repositories.UserRepositoryInterface
+	HTTPClient        *http.Client
+}
+
+func NewSendTestWebhookUseCase(
+	WebhookRepository repositories.WebhookRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+	HTTPClient *http.Client,
+) *SendTestWebhookUseCase {
+	return &SendTestWebhookUseCase{
+		WebhookRepository: WebhookRepository,
+		UserRepository:    UserRepository,
+		HTTPClient:        HTTPClient,
+	}
+}
+
+// Execute sends a webhook.test event right away so the receiver can be checked
+// from the request itself. A failed test is left in the queue and retried like
+// any other delivery.
+func (c *SendTestWebhookUseCase) Execute(input SendTestWebhookInputDto) (SendTestWebhookOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return SendTestWebhookOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return SendTestWebhookOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	subscription, getSubscriptionErr := c.WebhookRepository.GetWebhookSubscription(input.UserID, input.SubscriptionID)
+	if getSubscriptionErr != nil {
+		return SendTestWebhookOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Webhook subscription not found",
+				Status:   404,
+				Detail:   getSubscriptionErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	delivery, newDeliveryErr := entities.NewWebhookDelivery(subscription, entities.WEBHOOK_EVENT_TEST, map[string]string{
+		"subscription_id": subscription.ID,
+		"message":         "This is a test event",
+	})
+	if newDeliveryErr != nil {
+		return SendTestWebhookOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error creating test event",
+				Status:   500,
+				Detail:   newDeliveryErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	sendWebhookDelivery(c.HTTPClient, subscription, delivery)
+
+	createDeliveryErr := c.WebhookRepository.CreateWebhookDeliveries([]entities.WebhookDelivery{*delivery})
+	if createDeliveryErr != nil {
+		return SendTestWebhookOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error recording test delivery",
+				Status:   500,
+				Detail:   createDeliveryErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	contentMessage := "Receiver responded successfully"
+	if delivery.Status != entities.WEBHOOK_DELIVERY_STATUS_SUCCEEDED {
+		contentMessage = "Delivery failed and will be retried: " + delivery.LastError
+	}
+
+	return SendTestWebhookOutputDto{
+		Delivery:       *delivery,
+		SuccessMessage: "Test event sent",
+		ContentMessage: contentMessage,
+	}, nil
+}
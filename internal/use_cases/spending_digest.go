@@ -0,0 +1,117 @@
+package usecases
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+)
+
+const (
+	DIGEST_TOP_CATEGORIES   = 5
+	DIGEST_LARGEST_EXPENSES = 5
+)
+
+//go:embed templates/digest.html.tmpl templates/digest.txt.tmpl
+var digestTemplates embed.FS
+
+var digestTemplateFuncs = map[string]interface{}{
+	"money": func(value float64) string {
+		return strings.Replace(fmt.Sprintf("R$ %.2f", value), ".", ",", 1)
+	},
+	"date": func(date time.Time) string {
+		return date.Format("02/01/2006")
+	},
+	"change": func(change *float64) string {
+		if change == nil {
+			return "no spending to compare"
+		}
+		return fmt.Sprintf("%+.1f%%", *change)
+	},
+}
+
+var digestHTMLTemplate = htmltemplate.Must(htmltemplate.New("digest.html.tmpl").Funcs(digestTemplateFuncs).ParseFS(digestTemplates, "templates/digest.html.tmpl"))
+var digestTextTemplate = texttemplate.Must(texttemplate.New("digest.txt.tmpl").Funcs(digestTemplateFuncs).ParseFS(digestTemplates, "templates/digest.txt.tmpl"))
+
+type SpendingDigest struct {
+	Frequency       string                         `json:"frequency"`
+	PeriodLabel     string                         `json:"period_label"`
+	PeriodStart     time.Time                      `json:"period_start"`
+	PeriodEnd       time.Time                      `json:"period_end"`
+	Total           float64                        `json:"total"`
+	PriorTotal      float64                        `json:"prior_total"`
+	Change          *float64                       `json:"change,omitempty"`
+	TopCategories   []repositories.CategoryExpense `json:"top_categories"`
+	LargestExpenses []repositories.LargestExpense  `json:"largest_expenses"`
+}
+
+func buildSpendingDigest(presentersRepository repositories.PresentersRepositoryInterface, subscription entities.DigestSubscription, periodStart time.Time, periodEnd time.Time) (SpendingDigest, error) {
+	priorStart, priorEnd := subscription.PriorPeriod(periodStart)
+
+	total, err := presentersRepository.GetTotalExpensesForPeriod(subscription.UserID, periodStart, periodEnd, repositories.EXPENSE_BASIS_PURCHASE)
+	if err != nil {
+		return SpendingDigest{}, err
+	}
+
+	priorTotal, err := presentersRepository.GetTotalExpensesForPeriod(subscription.UserID, priorStart, priorEnd, repositories.EXPENSE_BASIS_PURCHASE)
+	if err != nil {
+		return SpendingDigest{}, err
+	}
+
+	categories, err := presentersRepository.GetExpensesByCategoryPeriod(subscription.UserID, periodStart, periodEnd, repositories.EXPENSE_BASIS_PURCHASE)
+	if err != nil {
+		return SpendingDigest{}, err
+	}
+
+	largestExpenses, err := presentersRepository.GetLargestExpensesForPeriod(subscription.UserID, periodStart, periodEnd, DIGEST_LARGEST_EXPENSES)
+	if err != nil {
+		return SpendingDigest{}, err
+	}
+
+	for i := range largestExpenses {
+		largestExpenses[i].ExpenseDate = largestExpenses[i].ExpenseDate.In(periodStart.Location())
+	}
+
+	digest := SpendingDigest{
+		Frequency:       subscription.Frequency,
+		PeriodLabel:     periodStart.Format("02/01/2006") + " - " + periodEnd.Format("02/01/2006"),
+		PeriodStart:     periodStart,
+		PeriodEnd:       periodEnd,
+		Total:           total,
+		PriorTotal:      priorTotal,
+		TopCategories:   categories[:min(len(categories), DIGEST_TOP_CATEGORIES)],
+		LargestExpenses: largestExpenses,
+	}
+
+	if priorTotal > 0 {
+		change := (total - priorTotal) / priorTotal * 100
+		digest.Change = &change
+	}
+
+	return digest, nil
+}
+
+func renderSpendingDigest(to string, digest SpendingDigest) (entities.EmailMessage, error) {
+	var htmlBody, textBody bytes.Buffer
+
+	if err := digestHTMLTemplate.Execute(&htmlBody, digest); err != nil {
+		return entities.EmailMessage{}, err
+	}
+
+	if err := digestTextTemplate.Execute(&textBody, digest); err != nil {
+		return entities.EmailMessage{}, err
+	}
+
+	return entities.EmailMessage{
+		To:       to,
+		Subject:  "Your " + digest.Frequency + " spending digest: " + digest.PeriodLabel,
+		HTMLBody: htmlBody.String(),
+		TextBody: textBody.String(),
+	}, nil
+}
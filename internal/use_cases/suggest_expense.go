@@ -0,0 +1,213 @@
+package usecases
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+const SUGGESTION_LIMIT = 5
+
+type SuggestExpenseInputDto struct {
+	UserID string `json:"user_id"`
+	Notes  string `json:"notes"`
+	Amount string `json:"amount"`
+}
+
+type SuggestedCategory struct {
+	CategoryID string  `json:"category_id"`
+	Name       string  `json:"name"`
+	Color      string  `json:"color"`
+	Confidence float64 `json:"confidence"`
+}
+
+type SuggestedTag struct {
+	TagID      string  `json:"tag_id"`
+	Name       string  `json:"name"`
+	Color      string  `json:"color"`
+	Confidence float64 `json:"confidence"`
+}
+
+type SuggestExpenseOutputDto struct {
+	Categories []SuggestedCategory `json:"categories"`
+	Tags       []SuggestedTag      `json:"tags"`
+}
+
+type SuggestExpenseUseCase struct {
+	SuggestionRepository repositories.SuggestionRepositoryInterface
+	ExpenseRepository    repositories.ExpenseRepositoryInterface
+	CategoryRepository   repositories.CategoryRepositoryInterface
+	TagRepository        repositories.TagRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewSuggestExpenseUseCase(
+	SuggestionRepository repositories.SuggestionRepositoryInterface,
+	ExpenseRepository repositories.ExpenseRepositoryInterface,
+	CategoryRepository repositories.CategoryRepositoryInterface,
+	TagRepository repositories.TagRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *SuggestExpenseUseCase {
+	return &SuggestExpenseUseCase{
+		SuggestionRepository: SuggestionRepository,
+		ExpenseRepository:    ExpenseRepository,
+		CategoryRepository:   CategoryRepository,
+		TagRepository:        TagRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+func (c *SuggestExpenseUseCase) Execute(input SuggestExpenseInputDto) (SuggestExpenseOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return SuggestExpenseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return SuggestExpenseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	var amount float64
+	if input.Amount != "" {
+		parsedAmount, parseAmountErr := strconv.ParseFloat(strings.Replace(input.Amount, ",", ".", 1), 64)
+		if parseAmountErr != nil || parsedAmount < 0 {
+			return SuggestExpenseOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Bad Request",
+					Title:    "Invalid amount",
+					Status:   400,
+					Detail:   "Amount must be a positive number",
+					Instance: util.RFC400,
+				},
+			}
+		}
+		amount = parsedAmount
+	}
+
+	model, getModelErr := c.SuggestionRepository.GetSuggestionModel(input.UserID)
+	if getModelErr != nil {
+		return SuggestExpenseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while loading the suggestion model",
+				Status:   500,
+				Detail:   getModelErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	if model.Documents <= 0 {
+		trainedModel, trainErr := trainSuggestionModel(c.SuggestionRepository, c.ExpenseRepository, input.UserID)
+		if trainErr != nil {
+			return SuggestExpenseOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Internal Server Error",
+					Title:    "An error occurred while training the suggestion model",
+					Status:   500,
+					Detail:   trainErr.Error(),
+					Instance: util.RFC500,
+				},
+			}
+		}
+		model = trainedModel
+	}
+
+	features := entities.SuggestionFeatures(input.Notes, amount)
+
+	categories, getCategoriesErr := c.CategoryRepository.GetCategories(input.UserID)
+	if getCategoriesErr != nil {
+		return SuggestExpenseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving categories",
+				Status:   500,
+				Detail:   getCategoriesErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	tags, getTagsErr := c.TagRepository.GetTags(input.UserID)
+	if getTagsErr != nil {
+		return SuggestExpenseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while retrieving tags",
+				Status:   500,
+				Detail:   getTagsErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	categoriesByID := make(map[string]entities.Category)
+	for _, category := range categories {
+		categoriesByID[category.ID] = category
+	}
+
+	tagsByID := make(map[string]entities.Tag)
+	for _, tag := range tags {
+		tagsByID[tag.ID] = tag
+	}
+
+	output := SuggestExpenseOutputDto{
+		Categories: []SuggestedCategory{},
+		Tags:       []SuggestedTag{},
+	}
+
+	for _, suggestion := range model.SuggestCategories(features, 0) {
+		category, exists := categoriesByID[suggestion.ID]
+		if !exists {
+			continue
+		}
+
+		output.Categories = append(output.Categories, SuggestedCategory{
+			CategoryID: category.ID,
+			Name:       category.Name,
+			Color:      category.Color,
+			Confidence: suggestion.Confidence,
+		})
+
+		if len(output.Categories) == SUGGESTION_LIMIT {
+			break
+		}
+	}
+
+	for _, suggestion := range model.SuggestTags(features, 0) {
+		tag, exists := tagsByID[suggestion.ID]
+		if !exists {
+			continue
+		}
+
+		output.Tags = append(output.Tags, SuggestedTag{
+			TagID:      tag.ID,
+			Name:       tag.Name,
+			Color:      tag.Color,
+			Confidence: suggestion.Confidence,
+		})
+
+		if len(output.Tags) == SUGGESTION_LIMIT {
+			break
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,105 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type TrainSuggestionModelsInputDto struct{}
+
+type TrainSuggestionModelsOutputDto struct {
+	Trained int `json:"trained"`
+	Failed  int `json:"failed"`
+	Skipped int `json:"skipped"`
+}
+
+type TrainSuggestionModelsUseCase struct {
+	SuggestionRepository repositories.SuggestionRepositoryInterface
+	ExpenseRepository    repositories.ExpenseRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+}
+
+func NewTrainSuggestionModelsUseCase(
+	SuggestionRepository repositories.SuggestionRepositoryInterface,
+	ExpenseRepository repositories.ExpenseRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *TrainSuggestionModelsUseCase {
+	return &TrainSuggestionModelsUseCase{
+		SuggestionRepository: SuggestionRepository,
+		ExpenseRepository:    ExpenseRepository,
+		UserRepository:       UserRepository,
+	}
+}
+
+// Execute trains the model of every user who does not have one yet, so the
+// first suggestion request does not pay for a scan of the full history.
+// Trained models are then kept current by observeExpense.
+func (c *TrainSuggestionModelsUseCase) Execute(input TrainSuggestionModelsInputDto) (TrainSuggestionModelsOutputDto, []util.ProblemDetails) {
+	users, getUsersErr := c.UserRepository.GetUsers()
+	if getUsersErr != nil {
+		util.NewLoggerError(500, getUsersErr.Error(), "TrainSuggestionModelsUseCase", "Use Cases", "Error")
+		return TrainSuggestionModelsOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error fetching users",
+				Status:   500,
+				Detail:   getUsersErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	output := TrainSuggestionModelsOutputDto{}
+
+	for _, user := range users {
+		if !user.Active {
+			output.Skipped++
+			continue
+		}
+
+		hasModel, err := c.SuggestionRepository.HasSuggestionModel(user.ID)
+		if err != nil {
+			util.NewLoggerError(500, err.Error(), "TrainSuggestionModelsUseCase", "Use Cases", "Error")
+			output.Failed++
+			continue
+		}
+
+		if hasModel {
+			output.Skipped++
+			continue
+		}
+
+		if _, err := trainSuggestionModel(c.SuggestionRepository, c.ExpenseRepository, user.ID); err != nil {
+			util.NewLoggerError(500, err.Error(), "TrainSuggestionModelsUseCase", "Use Cases", "Error")
+			output.Failed++
+			continue
+		}
+
+		output.Trained++
+	}
+
+	return output, nil
+}
+
+// trainSuggestionModel builds a user's model from the full expense history
+// and saves it when there is anything to learn from.
+func trainSuggestionModel(suggestionRepository repositories.SuggestionRepositoryInterface, expenseRepository repositories.ExpenseRepositoryInterface, userID string) (entities.SuggestionModel, error) {
+	expenses, err := expenseRepository.GetExpenses(userID)
+	if err != nil {
+		return entities.SuggestionModel{}, err
+	}
+
+	model := entities.NewSuggestionModel(userID)
+	for _, expense := range expenses {
+		model.Observe(entities.NewSuggestionObservation(expense), 1)
+	}
+
+	if model.Documents > 0 {
+		if err := suggestionRepository.SaveSuggestionModel(*model); err != nil {
+			return entities.SuggestionModel{}, err
+		}
+	}
+
+	return *model, nil
+}
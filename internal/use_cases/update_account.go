@@ -0,0 +1,175 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type UpdateAccountInputDto struct {
+	UserID         string   `json:"user_id"`
+	AccountID      string   `json:"account_id"`
+	Name           string   `json:"name"`
+	Type           string   `json:"type"`
+	Currency       string   `json:"currency"`
+	OpeningBalance *float64 `json:"opening_balance,string,omitempty"`
+	ClosingDay     int      `json:"closing_day"`
+	DueDay         int      `json:"due_day"`
+}
+
+type UpdateAccountOutputDto struct {
+	AccountID      string `json:"account_id"`
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type UpdateAccountUseCase struct {
+	AccountRepository repositories.AccountRepositoryInterface
+	ExpenseRepository repositories.ExpenseRepositoryInterface
+	UserRepository    repositories.UserRepositoryInterface
+}
+
+func NewUpdateAccountUseCase(
+	AccountRepository repositories.AccountRepositoryInterface,
+	ExpenseRepository repositories.ExpenseRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *UpdateAccountUseCase {
+	return &UpdateAccountUseCase{
+		AccountRepository: AccountRepository,
+		ExpenseRepository: ExpenseRepository,
+		UserRepository:    UserRepository,
+	}
+}
+
+func (c *UpdateAccountUseCase) Execute(input UpdateAccountInputDto) (UpdateAccountOutputDto, []util.ProblemDetails) {
+	var validationErrors []util.ProblemDetails
+
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return UpdateAccountOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return UpdateAccountOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	searchedAccount, getAccountErr := c.AccountRepository.GetAccount(input.UserID, input.AccountID)
+	if getAccountErr != nil {
+		return UpdateAccountOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Account not found",
+				Status:   404,
+				Detail:   getAccountErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	if input.Name != "" {
+		validationErrors = append(validationErrors, searchedAccount.ChangeName(input.Name)...)
+	}
+
+	accountType := searchedAccount.Type
+	if input.Type != "" {
+		accountType = input.Type
+	}
+
+	closingDay := searchedAccount.ClosingDay
+	if input.ClosingDay > 0 {
+		closingDay = input.ClosingDay
+	}
+
+	dueDay := searchedAccount.DueDay
+	if input.DueDay > 0 {
+		dueDay = input.DueDay
+	}
+
+	if accountType != entities.ACCOUNT_TYPE_CREDIT_CARD {
+		closingDay, dueDay = 0, 0
+	}
+
+	statementChanged := accountType != searchedAccount.Type || closingDay != searchedAccount.ClosingDay || dueDay != searchedAccount.DueDay
+	if statementChanged {
+		validationErrors = append(validationErrors, searchedAccount.ChangeType(accountType, closingDay, dueDay)...)
+	}
+
+	if input.Currency != "" {
+		validationErrors = append(validationErrors, searchedAccount.ChangeCurrency(input.Currency)...)
+	}
+
+	if input.OpeningBalance != nil {
+		searchedAccount.ChangeOpeningBalance(*input.OpeningBalance)
+	}
+
+	if len(validationErrors) > 0 {
+		return UpdateAccountOutputDto{}, validationErrors
+	}
+
+	updateAccountErr := c.AccountRepository.UpdateAccount(searchedAccount)
+	if updateAccountErr != nil {
+		return UpdateAccountOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while updating account",
+				Status:   500,
+				Detail:   updateAccountErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	if statementChanged {
+		expenses, getExpensesErr := c.ExpenseRepository.GetAccountExpenses(input.UserID, searchedAccount.ID)
+		if getExpensesErr != nil {
+			return UpdateAccountOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Internal Server Error",
+					Title:    "An error occurred while retrieving account expenses",
+					Status:   500,
+					Detail:   getExpensesErr.Error(),
+					Instance: util.RFC500,
+				},
+			}
+		}
+
+		location := util.UserLocation(user.Timezone)
+
+		for i := range expenses {
+			expenses[i].AssignStatement(&searchedAccount, location)
+		}
+
+		updateStatementsErr := c.ExpenseRepository.UpdateExpenseStatements(expenses)
+		if updateStatementsErr != nil {
+			return UpdateAccountOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Internal Server Error",
+					Title:    "An error occurred while reassigning card statements",
+					Status:   500,
+					Detail:   updateStatementsErr.Error(),
+					Instance: util.RFC500,
+				},
+			}
+		}
+	}
+
+	return UpdateAccountOutputDto{
+		AccountID:      searchedAccount.ID,
+		SuccessMessage: "Account updated successfully",
+		ContentMessage: "Account ID: " + searchedAccount.ID,
+	}, nil
+}
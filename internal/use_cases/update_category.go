@@ -1,15 +1,17 @@
 package usecases
 
 import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
 )
 
 type UpdateCategoryInputDto struct {
-	UserID     string `json:"user_id"`
-	CategoryID string `json:"category_id"`
-	Name       string `json:"name"`
-	Color      string `json:"color"`
+	UserID      string `json:"user_id"`
+	CategoryID  string `json:"category_id"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	TaxCategory string `json:"tax_category"`
 }
 
 type UpdateCategoryOutputDto struct {
@@ -21,15 +23,18 @@ type UpdateCategoryOutputDto struct {
 type UpdateCategoryUseCase struct {
 	CategoryRepository repositories.CategoryRepositoryInterface
 	UserRepository     repositories.UserRepositoryInterface
+	WebhookRepository  repositories.WebhookRepositoryInterface
 }
 
 func NewUpdateCategoryUseCase(
 	CategoryRepository repositories.CategoryRepositoryInterface,
 	UserRepository repositories.UserRepositoryInterface,
+	WebhookRepository repositories.WebhookRepositoryInterface,
 ) *UpdateCategoryUseCase {
 	return &UpdateCategoryUseCase{
 		CategoryRepository: CategoryRepository,
 		UserRepository:     UserRepository,
+		WebhookRepository:  WebhookRepository,
 	}
 }
 
@@ -80,6 +85,11 @@ func (c *UpdateCategoryUseCase) Execute(input UpdateCategoryInputDto) (UpdateCat
 		return UpdateCategoryOutputDto{}, changeColorErr
 	}
 
+	changeTaxCategoryErr := searchedCategory.ChangeTaxCategory(input.TaxCategory)
+	if len(changeTaxCategoryErr) > 0 {
+		return UpdateCategoryOutputDto{}, changeTaxCategoryErr
+	}
+
 	updateCategoryErr := c.CategoryRepository.UpdateCategory(searchedCategory)
 	if updateCategoryErr != nil {
 		return UpdateCategoryOutputDto{}, []util.ProblemDetails{
@@ -93,6 +103,8 @@ func (c *UpdateCategoryUseCase) Execute(input UpdateCategoryInputDto) (UpdateCat
 		}
 	}
 
+	publishWebhookEvent(c.WebhookRepository, input.UserID, entities.WEBHOOK_EVENT_CATEGORY_UPDATED, searchedCategory, "UpdateCategoryUseCase")
+
 	return UpdateCategoryOutputDto{
 		CategoryID:     searchedCategory.ID,
 		SuccessMessage: "Category updated successfully",
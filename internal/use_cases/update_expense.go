@@ -1,18 +1,27 @@
 package usecases
 
 import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
 )
 
 type UpdateExpenseInputDto struct {
-	UserID      string   `json:"user_id"`
-	ExpenseID   string   `json:"expense_id"`
-	Amount      float64  `json:"amount,string"`
-	ExpenseDate string   `json:"expense_date"`
-	CategoryID  string   `json:"category_id"`
-	Notes       string   `json:"notes"`
-	Tags        []string `json:"tags"`
+	UserID        string   `json:"user_id"`
+	ExpenseID     string   `json:"expense_id"`
+	Amount        float64  `json:"amount,string"`
+	ExpenseDate   string   `json:"expense_date"`
+	CategoryID    string   `json:"category_id"`
+	AccountID     string   `json:"account_id"`
+	MerchantID    string   `json:"merchant_id"`
+	Notes         string   `json:"notes"`
+	Tags          []string `json:"tags"`
+	TaxCategory   string   `json:"tax_category"`
+	ProviderTaxID string   `json:"provider_tax_id"`
+	ProviderName  string   `json:"provider_name"`
+	Latitude      *float64 `json:"latitude"`
+	Longitude     *float64 `json:"longitude"`
+	PlaceName     string   `json:"place_name"`
 }
 
 type UpdateExpenseOutputDto struct {
@@ -22,17 +31,29 @@ type UpdateExpenseOutputDto struct {
 }
 
 type UpdateExpenseUseCase struct {
-	ExpenseRepository repositories.ExpenseRepositoryInterface
-	UserRepository    repositories.UserRepositoryInterface
+	ExpenseRepository    repositories.ExpenseRepositoryInterface
+	UserRepository       repositories.UserRepositoryInterface
+	SuggestionRepository repositories.SuggestionRepositoryInterface
+	AccountRepository    repositories.AccountRepositoryInterface
+	WebhookRepository    repositories.WebhookRepositoryInterface
+	MerchantRepository   repositories.MerchantRepositoryInterface
 }
 
 func NewUpdateExpenseUseCase(
 	ExpenseRepository repositories.ExpenseRepositoryInterface,
 	UserRepository repositories.UserRepositoryInterface,
+	SuggestionRepository repositories.SuggestionRepositoryInterface,
+	AccountRepository repositories.AccountRepositoryInterface,
+	WebhookRepository repositories.WebhookRepositoryInterface,
+	MerchantRepository repositories.MerchantRepositoryInterface,
 ) *UpdateExpenseUseCase {
 	return &UpdateExpenseUseCase{
-		ExpenseRepository: ExpenseRepository,
-		UserRepository:    UserRepository,
+		ExpenseRepository:    ExpenseRepository,
+		UserRepository:       UserRepository,
+		SuggestionRepository: SuggestionRepository,
+		AccountRepository:    AccountRepository,
+		WebhookRepository:    WebhookRepository,
+		MerchantRepository:   MerchantRepository,
 	}
 }
 
@@ -98,6 +119,8 @@ func (c *UpdateExpenseUseCase) Execute(input UpdateExpenseInputDto) (UpdateExpen
 		}
 	}
 
+	previousExpense := searchedExpense
+
 	if input.Amount > 0 {
 		err := searchedExpense.ChangeAmount(input.Amount)
 		if len(err) > 0 {
@@ -106,7 +129,7 @@ func (c *UpdateExpenseUseCase) Execute(input UpdateExpenseInputDto) (UpdateExpen
 	}
 
 	if input.ExpenseDate != "" {
-		err := searchedExpense.ChangeExpenseDate(input.ExpenseDate)
+		err := searchedExpense.ChangeExpenseDate(input.ExpenseDate, user.Timezone)
 		if len(err) > 0 {
 			validationErrors = append(validationErrors, err...)
 		}
@@ -119,6 +142,26 @@ func (c *UpdateExpenseUseCase) Execute(input UpdateExpenseInputDto) (UpdateExpen
 		}
 	}
 
+	if input.AccountID != "" {
+		searchedExpense.ChangeAccount(input.AccountID)
+	}
+
+	account, accountErr := checkAccount(c.AccountRepository, input.UserID, searchedExpense.AccountID)
+	if len(accountErr) > 0 {
+		return UpdateExpenseOutputDto{}, accountErr
+	}
+
+	searchedExpense.AssignStatement(account, util.UserLocation(user.Timezone))
+
+	if input.MerchantID != "" {
+		merchantErr := checkMerchant(c.MerchantRepository, input.UserID, input.MerchantID)
+		if len(merchantErr) > 0 {
+			return UpdateExpenseOutputDto{}, merchantErr
+		}
+
+		searchedExpense.ChangeMerchant(input.MerchantID)
+	}
+
 	changeNotesErr := searchedExpense.ChangeNotes(input.Notes)
 	if len(changeNotesErr) > 0 {
 		validationErrors = append(validationErrors, changeNotesErr...)
@@ -129,6 +172,21 @@ func (c *UpdateExpenseUseCase) Execute(input UpdateExpenseInputDto) (UpdateExpen
 		validationErrors = append(validationErrors, changeTagsErr...)
 	}
 
+	changeTaxCategoryErr := searchedExpense.ChangeTaxCategory(input.TaxCategory)
+	if len(changeTaxCategoryErr) > 0 {
+		validationErrors = append(validationErrors, changeTaxCategoryErr...)
+	}
+
+	changeProviderErr := searchedExpense.ChangeProvider(input.ProviderTaxID, input.ProviderName)
+	if len(changeProviderErr) > 0 {
+		validationErrors = append(validationErrors, changeProviderErr...)
+	}
+
+	changeLocationErr := searchedExpense.ChangeLocation(input.Latitude, input.Longitude, input.PlaceName)
+	if len(changeLocationErr) > 0 {
+		validationErrors = append(validationErrors, changeLocationErr...)
+	}
+
 	if len(validationErrors) > 0 {
 		return UpdateExpenseOutputDto{}, validationErrors
 	}
@@ -146,6 +204,10 @@ func (c *UpdateExpenseUseCase) Execute(input UpdateExpenseInputDto) (UpdateExpen
 		}
 	}
 
+	observeExpense(c.SuggestionRepository, previousExpense, -1, "UpdateExpenseUseCase")
+	observeExpense(c.SuggestionRepository, searchedExpense, 1, "UpdateExpenseUseCase")
+	publishWebhookEvent(c.WebhookRepository, input.UserID, entities.WEBHOOK_EVENT_EXPENSE_UPDATED, searchedExpense, "UpdateExpenseUseCase")
+
 	return UpdateExpenseOutputDto{
 		ExpenseID:      input.ExpenseID,
 		SuccessMessage: "Expense updated successfully",
@@ -0,0 +1,120 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type UpdateGoalInputDto struct {
+	UserID       string  `json:"user_id"`
+	GoalID       string  `json:"goal_id"`
+	Name         string  `json:"name"`
+	TargetAmount float64 `json:"target_amount,string,omitempty"`
+	Deadline     string  `json:"deadline"`
+	CategoryID   string  `json:"category_id"`
+}
+
+type UpdateGoalOutputDto struct {
+	GoalID         string `json:"goal_id"`
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type UpdateGoalUseCase struct {
+	GoalRepository repositories.GoalRepositoryInterface
+	UserRepository repositories.UserRepositoryInterface
+}
+
+func NewUpdateGoalUseCase(
+	GoalRepository repositories.GoalRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *UpdateGoalUseCase {
+	return &UpdateGoalUseCase{
+		GoalRepository: GoalRepository,
+		UserRepository: UserRepository,
+	}
+}
+
+func (c *UpdateGoalUseCase) Execute(input UpdateGoalInputDto) (UpdateGoalOutputDto, []util.ProblemDetails) {
+	var validationErrors []util.ProblemDetails
+
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return UpdateGoalOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return UpdateGoalOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	searchedGoal, getGoalErr := c.GoalRepository.GetGoal(input.UserID, input.GoalID)
+	if getGoalErr != nil {
+		return UpdateGoalOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Goal not found",
+				Status:   404,
+				Detail:   getGoalErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	if input.Name != "" {
+		validationErrors = append(validationErrors, searchedGoal.ChangeName(input.Name)...)
+	}
+
+	if input.TargetAmount != 0 {
+		validationErrors = append(validationErrors, searchedGoal.ChangeTargetAmount(input.TargetAmount)...)
+	}
+
+	if input.Deadline != "" {
+		deadline, deadlineErr := parseGoalDeadline(input.Deadline, user.Timezone)
+		if len(deadlineErr) > 0 {
+			validationErrors = append(validationErrors, deadlineErr...)
+		} else {
+			searchedGoal.ChangeDeadline(deadline)
+		}
+	}
+
+	if input.CategoryID != "" {
+		searchedGoal.ChangeCategory(input.CategoryID)
+	}
+
+	if len(validationErrors) > 0 {
+		return UpdateGoalOutputDto{}, validationErrors
+	}
+
+	updateGoalErr := c.GoalRepository.UpdateGoal(searchedGoal)
+	if updateGoalErr != nil {
+		return UpdateGoalOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while updating goal",
+				Status:   500,
+				Detail:   updateGoalErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return UpdateGoalOutputDto{
+		GoalID:         searchedGoal.ID,
+		SuccessMessage: "Goal updated successfully",
+		ContentMessage: "Goal ID: " + searchedGoal.ID,
+	}, nil
+}
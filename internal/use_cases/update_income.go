@@ -0,0 +1,133 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type UpdateIncomeInputDto struct {
+	UserID     string  `json:"user_id"`
+	IncomeID   string  `json:"income_id"`
+	Amount     float64 `json:"amount,string"`
+	IncomeDate string  `json:"income_date"`
+	CategoryID string  `json:"category_id"`
+	AccountID  string  `json:"account_id"`
+	Notes      string  `json:"notes"`
+}
+
+type UpdateIncomeOutputDto struct {
+	IncomeID       string `json:"income_id"`
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type UpdateIncomeUseCase struct {
+	IncomeRepository   repositories.IncomeRepositoryInterface
+	CategoryRepository repositories.CategoryRepositoryInterface
+	UserRepository     repositories.UserRepositoryInterface
+	AccountRepository  repositories.AccountRepositoryInterface
+}
+
+func NewUpdateIncomeUseCase(
+	IncomeRepository repositories.IncomeRepositoryInterface,
+	CategoryRepository repositories.CategoryRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+	AccountRepository repositories.AccountRepositoryInterface,
+) *UpdateIncomeUseCase {
+	return &UpdateIncomeUseCase{
+		IncomeRepository:   IncomeRepository,
+		CategoryRepository: CategoryRepository,
+		UserRepository:     UserRepository,
+		AccountRepository:  AccountRepository,
+	}
+}
+
+func (c *UpdateIncomeUseCase) Execute(input UpdateIncomeInputDto) (UpdateIncomeOutputDto, []util.ProblemDetails) {
+	var validationErrors []util.ProblemDetails
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return UpdateIncomeOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return UpdateIncomeOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	searchedIncome, getIncomeErr := c.IncomeRepository.GetIncome(input.UserID, input.IncomeID)
+	if getIncomeErr != nil {
+		return UpdateIncomeOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Income not found",
+				Status:   404,
+				Detail:   getIncomeErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	if input.Amount > 0 {
+		validationErrors = append(validationErrors, searchedIncome.ChangeAmount(input.Amount)...)
+	}
+
+	if input.IncomeDate != "" {
+		validationErrors = append(validationErrors, searchedIncome.ChangeIncomeDate(input.IncomeDate, user.Timezone)...)
+	}
+
+	if input.CategoryID != "" && input.CategoryID != searchedIncome.CategoryID {
+		categoryErr := checkIncomeCategory(c.CategoryRepository, input.UserID, input.CategoryID)
+		if len(categoryErr) > 0 {
+			return UpdateIncomeOutputDto{}, categoryErr
+		}
+
+		validationErrors = append(validationErrors, searchedIncome.ChangeCategory(input.CategoryID)...)
+	}
+
+	if input.AccountID != "" && input.AccountID != searchedIncome.AccountID {
+		_, accountErr := checkAccount(c.AccountRepository, input.UserID, input.AccountID)
+		if len(accountErr) > 0 {
+			return UpdateIncomeOutputDto{}, accountErr
+		}
+
+		searchedIncome.ChangeAccount(input.AccountID)
+	}
+
+	validationErrors = append(validationErrors, searchedIncome.ChangeNotes(input.Notes)...)
+
+	if len(validationErrors) > 0 {
+		return UpdateIncomeOutputDto{}, validationErrors
+	}
+
+	updateIncomeErr := c.IncomeRepository.UpdateIncome(searchedIncome)
+	if updateIncomeErr != nil {
+		return UpdateIncomeOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while updating income",
+				Status:   500,
+				Detail:   updateIncomeErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return UpdateIncomeOutputDto{
+		IncomeID:       searchedIncome.ID,
+		SuccessMessage: "Income updated successfully",
+		ContentMessage: "Income ID: " + searchedIncome.ID,
+	}, nil
+}
@@ -0,0 +1,221 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type UpdateInstallmentPurchaseInputDto struct {
+	UserID                string  `json:"user_id"`
+	InstallmentPurchaseID string  `json:"installment_purchase_id"`
+	TotalAmount           float64 `json:"total_amount,string"`
+	InstallmentCount      int     `json:"installment_count"`
+	FirstDueDate          string  `json:"first_due_date"`
+	RemainderPolicy       string  `json:"remainder_policy"`
+	CategoryID            string  `json:"category_id"`
+	AccountID             string  `json:"account_id"`
+	Notes                 string  `json:"notes"`
+}
+
+type UpdateInstallmentPurchaseOutputDto struct {
+	InstallmentPurchaseID string `json:"installment_purchase_id"`
+	SuccessMessage        string `json:"success_message"`
+	ContentMessage        string `json:"content_message"`
+}
+
+type UpdateInstallmentPurchaseUseCase struct {
+	InstallmentPurchaseRepository repositories.InstallmentPurchaseRepositoryInterface
+	AccountRepository             repositories.AccountRepositoryInterface
+	UserRepository                repositories.UserRepositoryInterface
+	ExpenseRepository             repositories.ExpenseRepositoryInterface
+	MerchantRepository            repositories.MerchantRepositoryInterface
+	RuleRepository                repositories.RuleRepositoryInterface
+	SuggestionRepository          repositories.SuggestionRepositoryInterface
+	WebhookRepository             repositories.WebhookRepositoryInterface
+}
+
+func NewUpdateInstallmentPurchaseUseCase(
+	InstallmentPurchaseRepository repositories.InstallmentPurchaseRepositoryInterface,
+	AccountRepository repositories.AccountRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+	ExpenseRepository repositories.ExpenseRepositoryInterface,
+	MerchantRepository repositories.MerchantRepositoryInterface,
+	RuleRepository repositories.RuleRepositoryInterface,
+	SuggestionRepository repositories.SuggestionRepositoryInterface,
+	WebhookRepository repositories.WebhookRepositoryInterface,
+) *UpdateInstallmentPurchaseUseCase {
+	return &UpdateInstallmentPurchaseUseCase{
+		InstallmentPurchaseRepository: InstallmentPurchaseRepository,
+		AccountRepository:             AccountRepository,
+		UserRepository:                UserRepository,
+		ExpenseRepository:             ExpenseRepository,
+		MerchantRepository:            MerchantRepository,
+		RuleRepository:                RuleRepository,
+		SuggestionRepository:          SuggestionRepository,
+		WebhookRepository:             WebhookRepository,
+	}
+}
+
+func (c *UpdateInstallmentPurchaseUseCase) Execute(input UpdateInstallmentPurchaseInputDto) (UpdateInstallmentPurchaseOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return UpdateInstallmentPurchaseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return UpdateInstallmentPurchaseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	searchedPurchase, getPurchaseErr := c.InstallmentPurchaseRepository.GetInstallmentPurchase(input.UserID, input.InstallmentPurchaseID)
+	if getPurchaseErr != nil {
+		return UpdateInstallmentPurchaseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Installment purchase not found",
+				Status:   404,
+				Detail:   getPurchaseErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	totalAmount := searchedPurchase.TotalAmount
+	if input.TotalAmount > 0 {
+		totalAmount = input.TotalAmount
+	}
+
+	installmentCount := searchedPurchase.InstallmentCount
+	if input.InstallmentCount > 0 {
+		installmentCount = input.InstallmentCount
+	}
+
+	firstDueDate := searchedPurchase.FirstDueDate
+	if input.FirstDueDate != "" {
+		parsedDate, parseDateErr := util.ParseDate(input.FirstDueDate, user.Timezone)
+		if parseDateErr != nil {
+			return UpdateInstallmentPurchaseOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Validation Error",
+					Title:    "Bad Request",
+					Status:   400,
+					Detail:   "Invalid first due date format",
+					Instance: util.RFC400,
+				},
+			}
+		}
+		firstDueDate = parsedDate
+	}
+
+	remainderPolicy := searchedPurchase.RemainderPolicy
+	if input.RemainderPolicy != "" {
+		remainderPolicy = input.RemainderPolicy
+	}
+
+	categoryID := searchedPurchase.CategoryID
+	if input.CategoryID != "" {
+		categoryID = input.CategoryID
+	}
+
+	accountID := searchedPurchase.AccountID
+	if input.AccountID != "" {
+		accountID = input.AccountID
+	}
+
+	account, accountErr := checkAccount(c.AccountRepository, input.UserID, accountID)
+	if len(accountErr) > 0 {
+		return UpdateInstallmentPurchaseOutputDto{}, accountErr
+	}
+
+	changeErr := searchedPurchase.Change(totalAmount, installmentCount, firstDueDate, remainderPolicy, categoryID, accountID, input.Notes)
+	if len(changeErr) > 0 {
+		return UpdateInstallmentPurchaseOutputDto{}, changeErr
+	}
+
+	today, todayErr := startOfToday(user.Timezone)
+	if todayErr != nil {
+		return UpdateInstallmentPurchaseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Could not load timezone",
+				Status:   500,
+				Detail:   todayErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	paidCount := 0
+	paidAmount := 0.0
+	replacedInstallments := []entities.Expense{}
+	for _, installment := range searchedPurchase.Installments {
+		if installment.ExpenseDate.Before(today) {
+			paidCount++
+			paidAmount += installment.Amount
+		} else {
+			replacedInstallments = append(replacedInstallments, installment)
+		}
+	}
+
+	installments, installmentsErr := searchedPurchase.GenerateInstallments(paidCount, paidAmount)
+	if len(installmentsErr) > 0 {
+		return UpdateInstallmentPurchaseOutputDto{}, installmentsErr
+	}
+
+	rules, getRulesErr := getRules(c.RuleRepository, input.UserID)
+	if len(getRulesErr) > 0 {
+		return UpdateInstallmentPurchaseOutputDto{}, getRulesErr
+	}
+
+	location := util.UserLocation(user.Timezone)
+
+	for i := range installments {
+		installments[i].AssignStatement(account, location)
+
+		prepareErr := prepareNewExpense(c.ExpenseRepository, c.MerchantRepository, rules, location, &installments[i], "", "UpdateInstallmentPurchaseUseCase")
+		if len(prepareErr) > 0 {
+			return UpdateInstallmentPurchaseOutputDto{}, prepareErr
+		}
+	}
+
+	updatePurchaseErr := c.InstallmentPurchaseRepository.UpdateInstallmentPurchase(searchedPurchase, today, installments)
+	if updatePurchaseErr != nil {
+		return UpdateInstallmentPurchaseOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while updating installment purchase",
+				Status:   500,
+				Detail:   updatePurchaseErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	for _, installment := range replacedInstallments {
+		expenseDeleted(c.SuggestionRepository, c.WebhookRepository, installment, "UpdateInstallmentPurchaseUseCase")
+	}
+
+	for _, installment := range installments {
+		expenseCreated(c.SuggestionRepository, c.WebhookRepository, installment, "UpdateInstallmentPurchaseUseCase")
+	}
+
+	return UpdateInstallmentPurchaseOutputDto{
+		InstallmentPurchaseID: searchedPurchase.ID,
+		SuccessMessage:        "Installment purchase updated successfully",
+		ContentMessage:        "Installment purchase ID: " + searchedPurchase.ID,
+	}, nil
+}
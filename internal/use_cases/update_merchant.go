@@ -0,0 +1,102 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type UpdateMerchantInputDto struct {
+	UserID     string   `json:"user_id"`
+	MerchantID string   `json:"merchant_id"`
+	Name       string   `json:"name"`
+	Aliases    []string `json:"aliases"`
+}
+
+type UpdateMerchantOutputDto struct {
+	MerchantID     string `json:"merchant_id"`
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type UpdateMerchantUseCase struct {
+	MerchantRepository repositories.MerchantRepositoryInterface
+	UserRepository     repositories.UserRepositoryInterface
+}
+
+func NewUpdateMerchantUseCase(
+	MerchantRepository repositories.MerchantRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *UpdateMerchantUseCase {
+	return &UpdateMerchantUseCase{
+		MerchantRepository: MerchantRepository,
+		UserRepository:     UserRepository,
+	}
+}
+
+// Execute renames the merchant and, when aliases are sent, replaces them. The
+// name is always kept as an alias.
+func (c *UpdateMerchantUseCase) Execute(input UpdateMerchantInputDto) (UpdateMerchantOutputDto, []util.ProblemDetails) {
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return UpdateMerchantOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return UpdateMerchantOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	searchedMerchant, getMerchantErr := c.MerchantRepository.GetMerchant(input.UserID, input.MerchantID)
+	if getMerchantErr != nil {
+		return UpdateMerchantOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Merchant not found",
+				Status:   404,
+				Detail:   getMerchantErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	if input.Aliases != nil {
+		searchedMerchant.ChangeAliases(input.Aliases)
+	}
+
+	changeNameErr := searchedMerchant.ChangeName(input.Name)
+	if len(changeNameErr) > 0 {
+		return UpdateMerchantOutputDto{}, changeNameErr
+	}
+
+	updateMerchantErr := c.MerchantRepository.UpdateMerchant(searchedMerchant)
+	if updateMerchantErr != nil {
+		return UpdateMerchantOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error updating merchant",
+				Status:   500,
+				Detail:   updateMerchantErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return UpdateMerchantOutputDto{
+		MerchantID:     searchedMerchant.ID,
+		SuccessMessage: "Merchant updated successfully",
+		ContentMessage: "Merchant ID: " + searchedMerchant.ID,
+	}, nil
+}
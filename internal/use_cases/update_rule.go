@@ -0,0 +1,118 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type UpdateRuleInputDto struct {
+	UserID         string                  `json:"user_id"`
+	RuleID         string                  `json:"rule_id"`
+	Name           string                  `json:"name"`
+	Priority       int                     `json:"priority"`
+	StopProcessing bool                    `json:"stop_processing"`
+	Conditions     entities.RuleConditions `json:"conditions"`
+	Actions        entities.RuleActions    `json:"actions"`
+}
+
+type UpdateRuleOutputDto struct {
+	RuleID         string `json:"rule_id"`
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type UpdateRuleUseCase struct {
+	RuleRepository     repositories.RuleRepositoryInterface
+	UserRepository     repositories.UserRepositoryInterface
+	CategoryRepository repositories.CategoryRepositoryInterface
+	TagRepository      repositories.TagRepositoryInterface
+}
+
+func NewUpdateRuleUseCase(
+	RuleRepository repositories.RuleRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+	CategoryRepository repositories.CategoryRepositoryInterface,
+	TagRepository repositories.TagRepositoryInterface,
+) *UpdateRuleUseCase {
+	return &UpdateRuleUseCase{
+		RuleRepository:     RuleRepository,
+		UserRepository:     UserRepository,
+		CategoryRepository: CategoryRepository,
+		TagRepository:      TagRepository,
+	}
+}
+
+func (c *UpdateRuleUseCase) Execute(input UpdateRuleInputDto) (UpdateRuleOutputDto, []util.ProblemDetails) {
+	var validationErrors []util.ProblemDetails
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return UpdateRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return UpdateRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	searchedRule, getRuleErr := c.RuleRepository.GetRule(input.UserID, input.RuleID)
+	if getRuleErr != nil {
+		return UpdateRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Rule not found",
+				Status:   404,
+				Detail:   getRuleErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	if checkActionsErr := checkRuleActions(c.CategoryRepository, c.TagRepository, user.ID, input.Actions); len(checkActionsErr) > 0 {
+		return UpdateRuleOutputDto{}, checkActionsErr
+	}
+
+	if input.Name != "" {
+		validationErrors = append(validationErrors, searchedRule.ChangeName(input.Name)...)
+	}
+
+	validationErrors = append(validationErrors, searchedRule.ChangePriority(input.Priority, input.StopProcessing)...)
+	validationErrors = append(validationErrors, searchedRule.ChangeConditions(input.Conditions)...)
+	validationErrors = append(validationErrors, searchedRule.ChangeActions(input.Actions)...)
+
+	if len(validationErrors) > 0 {
+		return UpdateRuleOutputDto{}, validationErrors
+	}
+
+	updateRuleErr := c.RuleRepository.UpdateRule(searchedRule)
+	if updateRuleErr != nil {
+		return UpdateRuleOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while updating rule",
+				Status:   500,
+				Detail:   updateRuleErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return UpdateRuleOutputDto{
+		RuleID:         searchedRule.ID,
+		SuccessMessage: "Rule updated successfully",
+		ContentMessage: "Rule ID: " + searchedRule.ID,
+	}, nil
+}
@@ -1,6 +1,7 @@
 package usecases
 
 import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/entities"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
 )
@@ -19,17 +20,20 @@ type UpdateTagOutputDto struct {
 }
 
 type UpdateTagUseCase struct {
-	TagRepository  repositories.TagRepositoryInterface
-	UserRepository repositories.UserRepositoryInterface
+	TagRepository     repositories.TagRepositoryInterface
+	UserRepository    repositories.UserRepositoryInterface
+	WebhookRepository repositories.WebhookRepositoryInterface
 }
 
 func NewUpdateTagUseCase(
 	TagRepository repositories.TagRepositoryInterface,
 	UserRepository repositories.UserRepositoryInterface,
+	WebhookRepository repositories.WebhookRepositoryInterface,
 ) *UpdateTagUseCase {
 	return &UpdateTagUseCase{
-		TagRepository:  TagRepository,
-		UserRepository: UserRepository,
+		TagRepository:     TagRepository,
+		UserRepository:    UserRepository,
+		WebhookRepository: WebhookRepository,
 	}
 }
 
@@ -93,6 +97,8 @@ func (c *UpdateTagUseCase) Execute(input UpdateTagInputDto) (UpdateTagOutputDto,
 		}
 	}
 
+	publishWebhookEvent(c.WebhookRepository, input.UserID, entities.WEBHOOK_EVENT_TAG_UPDATED, searchedTag, "UpdateTagUseCase")
+
 	return UpdateTagOutputDto{
 		TagID:          searchedTag.ID,
 		SuccessMessage: "Tag updated successfully",
@@ -8,8 +8,11 @@ import (
 )
 
 type UpdateUserInputDto struct {
-	UserID string `json:"user_id"`
-	Name   string `json:"name"`
+	UserID    string  `json:"user_id"`
+	Name      string  `json:"name"`
+	Timezone  string  `json:"timezone"`
+	Language  *string `json:"language,omitempty"`
+	WeekStart string  `json:"week_start"`
 }
 
 type UpdateUserOutputDto struct {
@@ -54,31 +57,6 @@ func (c *UpdateUserUseCase) Execute(input UpdateUserInputDto) (UpdateUserOutputD
 		}
 	}
 
-	existingUser, GetUserByNameErr := c.UserRepository.ThisUserExists(input.Name)
-	if GetUserByNameErr != nil && strings.Compare(GetUserByNameErr.Error(), "user not found") > 0 {
-		return UpdateUserOutputDto{}, []util.ProblemDetails{
-			{
-				Type:     "Internal Server Error",
-				Title:    "Error fetching existing user",
-				Status:   500,
-				Detail:   GetUserByNameErr.Error(),
-				Instance: util.RFC500,
-			},
-		}
-	}
-
-	if existingUser {
-		return UpdateUserOutputDto{}, []util.ProblemDetails{
-			{
-				Type:     "Validation Error",
-				Title:    "User already exists",
-				Status:   409,
-				Detail:   "A user with this name already exists",
-				Instance: util.RFC409,
-			},
-		}
-	}
-
 	searchedUser, err := c.UserRepository.GetUser(input.UserID)
 	if err != nil {
 		return UpdateUserOutputDto{}, []util.ProblemDetails{
@@ -92,17 +70,12 @@ func (c *UpdateUserUseCase) Execute(input UpdateUserInputDto) (UpdateUserOutputD
 		}
 	}
 
-	if input.Name != searchedUser.Name {
-		err := searchedUser.ChangeName(input.Name)
-		if len(err) > 0 {
-			return UpdateUserOutputDto{}, err
-		}
+	nameChanged := input.Name != "" && input.Name != searchedUser.Name
+	timezoneChanged := input.Timezone != "" && input.Timezone != searchedUser.Timezone
+	languageChanged := input.Language != nil && *input.Language != searchedUser.Language
+	weekStartChanged := input.WeekStart != "" && input.WeekStart != searchedUser.WeekStart
 
-		UpdateUserErr := c.UserRepository.UpdateUser(searchedUser)
-		if UpdateUserErr != nil {
-			return UpdateUserOutputDto{}, err
-		}
-	} else {
+	if !nameChanged && !timezoneChanged && !languageChanged && !weekStartChanged {
 		return UpdateUserOutputDto{}, []util.ProblemDetails{
 			{
 				Type:     "No Changes Made",
@@ -114,9 +87,74 @@ func (c *UpdateUserUseCase) Execute(input UpdateUserInputDto) (UpdateUserOutputD
 		}
 	}
 
+	var validationErrors []util.ProblemDetails
+
+	if nameChanged {
+		existingUser, GetUserByNameErr := c.UserRepository.ThisUserExists(input.Name)
+		if GetUserByNameErr != nil && strings.Compare(GetUserByNameErr.Error(), "user not found") > 0 {
+			return UpdateUserOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Internal Server Error",
+					Title:    "Error fetching existing user",
+					Status:   500,
+					Detail:   GetUserByNameErr.Error(),
+					Instance: util.RFC500,
+				},
+			}
+		}
+
+		if existingUser {
+			return UpdateUserOutputDto{}, []util.ProblemDetails{
+				{
+					Type:     "Validation Error",
+					Title:    "User already exists",
+					Status:   409,
+					Detail:   "A user with this name already exists",
+					Instance: util.RFC409,
+				},
+			}
+		}
+
+		validationErrors = append(validationErrors, searchedUser.ChangeName(input.Name)...)
+	}
+
+	if timezoneChanged {
+		validationErrors = append(validationErrors, searchedUser.ChangeTimezone(input.Timezone)...)
+	}
+
+	if languageChanged {
+		validationErrors = append(validationErrors, searchedUser.ChangeLanguage(*input.Language)...)
+	}
+
+	if weekStartChanged {
+		validationErrors = append(validationErrors, searchedUser.ChangeWeekStart(input.WeekStart)...)
+	}
+
+	if len(validationErrors) > 0 {
+		return UpdateUserOutputDto{}, validationErrors
+	}
+
+	UpdateUserErr := c.UserRepository.UpdateUser(searchedUser)
+	if UpdateUserErr != nil {
+		return UpdateUserOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error updating user",
+				Status:   500,
+				Detail:   UpdateUserErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	contentMessage := "Your preferences were updated!"
+	if nameChanged {
+		contentMessage = "Your new name is " + searchedUser.Name + "!"
+	}
+
 	return UpdateUserOutputDto{
 		UserID:         searchedUser.ID,
 		SuccessMessage: "User updated successfully",
-		ContentMessage: "Your new name is " + searchedUser.Name + "!",
+		ContentMessage: contentMessage,
 	}, nil
 }
@@ -0,0 +1,105 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/repositories"
+	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
+)
+
+type UpdateWebhookSubscriptionInputDto struct {
+	UserID         string   `json:"user_id"`
+	SubscriptionID string   `json:"subscription_id"`
+	URL            string   `json:"url"`
+	EventTypes     []string `json:"event_types"`
+}
+
+type UpdateWebhookSubscriptionOutputDto struct {
+	SubscriptionID string `json:"subscription_id"`
+	SuccessMessage string `json:"success_message"`
+	ContentMessage string `json:"content_message"`
+}
+
+type UpdateWebhookSubscriptionUseCase struct {
+	WebhookRepository repositories.WebhookRepositoryInterface
+	UserRepository    repositories.UserRepositoryInterface
+}
+
+func NewUpdateWebhookSubscriptionUseCase(
+	WebhookRepository repositories.WebhookRepositoryInterface,
+	UserRepository repositories.UserRepositoryInterface,
+) *UpdateWebhookSubscriptionUseCase {
+	return &UpdateWebhookSubscriptionUseCase{
+		WebhookRepository: WebhookRepository,
+		UserRepository:    UserRepository,
+	}
+}
+
+func (c *UpdateWebhookSubscriptionUseCase) Execute(input UpdateWebhookSubscriptionInputDto) (UpdateWebhookSubscriptionOutputDto, []util.ProblemDetails) {
+	var validationErrors []util.ProblemDetails
+
+	user, err := c.UserRepository.GetUser(input.UserID)
+	if err != nil {
+		return UpdateWebhookSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   err.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	} else if !user.Active {
+		return UpdateWebhookSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "User is not active",
+				Status:   403,
+				Detail:   "User is not active",
+				Instance: util.RFC403,
+			},
+		}
+	}
+
+	subscription, getSubscriptionErr := c.WebhookRepository.GetWebhookSubscription(input.UserID, input.SubscriptionID)
+	if getSubscriptionErr != nil {
+		return UpdateWebhookSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Webhook subscription not found",
+				Status:   404,
+				Detail:   getSubscriptionErr.Error(),
+				Instance: util.RFC404,
+			},
+		}
+	}
+
+	if input.URL != "" {
+		validationErrors = append(validationErrors, subscription.ChangeURL(input.URL)...)
+	}
+
+	if len(input.EventTypes) > 0 {
+		validationErrors = append(validationErrors, subscription.ChangeEventTypes(input.EventTypes)...)
+	}
+
+	if len(validationErrors) > 0 {
+		return UpdateWebhookSubscriptionOutputDto{}, validationErrors
+	}
+
+	updateSubscriptionErr := c.WebhookRepository.UpdateWebhookSubscription(subscription)
+	if updateSubscriptionErr != nil {
+		return UpdateWebhookSubscriptionOutputDto{}, []util.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "An error occurred while updating webhook subscription",
+				Status:   500,
+				Detail:   updateSubscriptionErr.Error(),
+				Instance: util.RFC500,
+			},
+		}
+	}
+
+	return UpdateWebhookSubscriptionOutputDto{
+		SubscriptionID: subscription.ID,
+		SuccessMessage: "Webhook subscription updated successfully",
+		ContentMessage: "Webhook subscription ID: " + subscription.ID,
+	}, nil
+}
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -12,63 +13,104 @@ import (
 
 func AuthMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		authHeader := c.GetHeader("Authorization")
-
-		if authHeader == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": ProblemDetails{
-				Type:     "Unauthorized",
-				Title:    "Missing Authorization Header",
-				Status:   http.StatusUnauthorized,
-				Detail:   "Authorization header is required",
-				Instance: RFC401,
-			}})
+		userID, problem := ParseAuthToken(c.GetHeader("Authorization"))
+		if problem != nil {
+			c.AbortWithStatusJSON(problem.Status, gin.H{"error": LocalizeProblemDetails(c.GetString("locale"), *problem)})
 			return
 		}
 
-		tokenString := strings.Split(authHeader, "Bearer ")
-		if len(tokenString) != 2 {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": ProblemDetails{
-				Type:     "Unauthorized",
-				Title:    "Invalid Authorization Format",
-				Status:   http.StatusUnauthorized,
-				Detail:   "Authorization header must be in the format 'Bearer <token>'",
-				Instance: RFC401,
-			}})
-			return
+		c.Set("userID", userID)
+		c.Next()
+	}
+}
+
+// ParseAuthToken validates an Authorization value of the form
+// "Bearer <token>" and returns the user the token was issued to. It is shared
+// by AuthMiddleware and the gRPC auth interceptor so both accept the same
+// tokens.
+func ParseAuthToken(authHeader string) (string, *ProblemDetails) {
+	if authHeader == "" {
+		return "", &ProblemDetails{
+			Type:     "Unauthorized",
+			Title:    "Missing Authorization Header",
+			Status:   http.StatusUnauthorized,
+			Detail:   "Authorization header is required",
+			Instance: RFC401,
 		}
+	}
 
-		token, err := jwt.Parse(tokenString[1], func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
-			return []byte(config.SECRETS_VAR.JWT_SECRET), nil
-		})
+	tokenString := strings.Split(authHeader, "Bearer ")
+	if len(tokenString) != 2 {
+		return "", &ProblemDetails{
+			Type:     "Unauthorized",
+			Title:    "Invalid Authorization Format",
+			Status:   http.StatusUnauthorized,
+			Detail:   "Authorization header must be in the format 'Bearer <token>'",
+			Instance: RFC401,
+		}
+	}
 
-		if err != nil {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": ProblemDetails{
-				Type:     "Unauthorized",
-				Title:    "Invalid Token",
-				Status:   http.StatusUnauthorized,
-				Detail:   "Token could not be parsed or is invalid",
-				Instance: RFC401,
-			}})
-			return
+	token, err := jwt.Parse(tokenString[1], func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
+		return []byte(config.SECRETS_VAR.JWT_SECRET), nil
+	})
 
-		if !token.Valid {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": ProblemDetails{
-				Type:     "Unauthorized",
-				Title:    "Invalid Token",
-				Status:   http.StatusUnauthorized,
-				Detail:   "Token is not valid",
-				Instance: RFC401,
-			}})
-			return
+	if err != nil {
+		return "", &ProblemDetails{
+			Type:     "Unauthorized",
+			Title:    "Invalid Token",
+			Status:   http.StatusUnauthorized,
+			Detail:   "Token could not be parsed or is invalid",
+			Instance: RFC401,
 		}
+	}
 
-		claims := token.Claims.(jwt.MapClaims)
-		userID := claims["user_id"].(string)
-		c.Set("userID", userID)
-		c.Next()
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !token.Valid || !ok {
+		return "", &ProblemDetails{
+			Type:     "Unauthorized",
+			Title:    "Invalid Token",
+			Status:   http.StatusUnauthorized,
+			Detail:   "Token is not valid",
+			Instance: RFC401,
+		}
+	}
+
+	userID, ok := claims["user_id"].(string)
+	if !ok || userID == "" {
+		return "", &ProblemDetails{
+			Type:     "Unauthorized",
+			Title:    "Invalid Token",
+			Status:   http.StatusUnauthorized,
+			Detail:   "Token is not valid",
+			Instance: RFC401,
+		}
+	}
+
+	return userID, nil
+}
+
+// GRPCAuthInterceptor is the gRPC counterpart of AuthMiddleware. It reads the
+// token from the "authorization" metadata and sets "userID" on the context.
+// Public methods, such as signing up and logging in, pass without a token.
+func GRPCAuthInterceptor(publicMethods ...string) GRPCInterceptor {
+	public := map[string]bool{}
+	for _, method := range publicMethods {
+		public[method] = true
+	}
+
+	return func(ctx context.Context, method string) (context.Context, error) {
+		if public[method] {
+			return ctx, nil
+		}
+
+		userID, problem := ParseAuthToken(GRPCMetadata(ctx, "authorization"))
+		if problem != nil {
+			return nil, NewGRPCProblemError(GRPCContextString(ctx, "locale"), []ProblemDetails{*problem})
+		}
+
+		return GRPCContextWithString(ctx, "userID", userID), nil
 	}
 }
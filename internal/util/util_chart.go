@@ -0,0 +1,557 @@
+package util
+
+import (
+	"bytes"
+	"html"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	CHART_BAR     = "bar"
+	CHART_LINE    = "line"
+	CHART_PIE     = "pie"
+	CHART_STACKED = "stacked"
+)
+
+const (
+	CHART_THEME_LIGHT = "light"
+	CHART_THEME_DARK  = "dark"
+)
+
+const (
+	CHART_FORMAT_SVG = "svg"
+	CHART_FORMAT_PNG = "png"
+)
+
+var chartPalette = []string{"#4A90D9", "#E67E22", "#2ECC71", "#9B59B6", "#E74C3C", "#1ABC9C", "#F1C40F", "#34495E", "#D35400", "#7F8C8D"}
+
+type ChartSeries struct {
+	Name   string
+	Color  string
+	Values []float64
+}
+
+// Chart is drawn the same way as SVG and PNG. Bar, line and stacked charts
+// plot every series over Labels; a pie slices the labels of a single series,
+// or the series totals when there are several. Colors, one per label, color
+// the bars or slices of a single series. Invalid colors fall back to the
+// palette.
+type Chart struct {
+	Type       string
+	Title      string
+	Labels     []string
+	Colors     []string
+	Series     []ChartSeries
+	Width      int
+	Height     int
+	Theme      string
+	EmptyLabel string
+}
+
+type chartTheme struct {
+	background string
+	text       string
+	muted      string
+	grid       string
+}
+
+func chartThemeColors(theme string) chartTheme {
+	if theme == CHART_THEME_DARK {
+		return chartTheme{background: "#1E1E1E", text: "#E0E0E0", muted: "#A0A0A0", grid: "#3A3A3A"}
+	}
+
+	return chartTheme{background: "#FFFFFF", text: "#333333", muted: "#777777", grid: "#E5E5E5"}
+}
+
+// chartShape is one drawing operation. Wedge angles are in radians, clockwise
+// from twelve o'clock.
+type chartShape struct {
+	kind   string
+	x      float64
+	y      float64
+	width  float64
+	height float64
+	points [][2]float64
+	radius float64
+	start  float64
+	end    float64
+	size   float64
+	anchor string
+	color  string
+	text   string
+}
+
+func chartRect(x float64, y float64, width float64, height float64, color string) chartShape {
+	return chartShape{kind: "rect", x: x, y: y, width: width, height: height, color: color}
+}
+
+func chartLine(points [][2]float64, width float64, color string) chartShape {
+	return chartShape{kind: "line", points: points, width: width, color: color}
+}
+
+func chartText(x float64, y float64, size float64, anchor string, color string, text string) chartShape {
+	return chartShape{kind: "text", x: x, y: y, size: size, anchor: anchor, color: color, text: text}
+}
+
+func (c Chart) seriesColor(index int) string {
+	if _, _, _, ok := HexColorToRGB(c.Series[index].Color); ok {
+		return c.Series[index].Color
+	}
+
+	return chartPalette[index%len(chartPalette)]
+}
+
+func (c Chart) labelColor(index int) string {
+	if index < len(c.Colors) {
+		if _, _, _, ok := HexColorToRGB(c.Colors[index]); ok {
+			return c.Colors[index]
+		}
+	}
+
+	return chartPalette[index%len(chartPalette)]
+}
+
+func (c Chart) value(series int, label int) float64 {
+	if label >= len(c.Series[series].Values) {
+		return 0
+	}
+
+	return math.Max(c.Series[series].Values[label], 0)
+}
+
+// slices returns the names, values and colors a pie is drawn with.
+func (c Chart) slices() ([]string, []float64, []string) {
+	var names []string
+	var values []float64
+	var colors []string
+
+	if len(c.Series) == 1 {
+		for i, label := range c.Labels {
+			names = append(names, label)
+			values = append(values, c.value(0, i))
+			colors = append(colors, c.labelColor(i))
+		}
+
+		return names, values, colors
+	}
+
+	for j, series := range c.Series {
+		total := 0.0
+		for i := range c.Labels {
+			total += c.value(j, i)
+		}
+
+		names = append(names, series.Name)
+		values = append(values, total)
+		colors = append(colors, c.seriesColor(j))
+	}
+
+	return names, values, colors
+}
+
+func (c Chart) shapes() []chartShape {
+	theme := chartThemeColors(c.Theme)
+	width, height := float64(c.Width), float64(c.Height)
+	padding := 16.0
+
+	shapes := []chartShape{chartRect(0, 0, width, height, theme.background)}
+
+	top := padding
+	if c.Title != "" {
+		shapes = append(shapes, chartText(padding, top+14, 16, "start", theme.text, FitChartText(c.Title, 16, width-2*padding)))
+		top += 30
+	}
+
+	var legendNames []string
+	var legendColors []string
+
+	if c.Type == CHART_PIE {
+		names, values, colors := c.slices()
+		total := 0.0
+		for _, value := range values {
+			total += value
+		}
+
+		for i, name := range names {
+			if values[i] > 0 {
+				legendNames = append(legendNames, name+" ("+strconv.FormatFloat(values[i]/total*100, 'f', 1, 64)+"%)")
+				legendColors = append(legendColors, colors[i])
+			}
+		}
+	} else if len(c.Series) > 1 {
+		for j, series := range c.Series {
+			legendNames = append(legendNames, series.Name)
+			legendColors = append(legendColors, c.seriesColor(j))
+		}
+	}
+
+	legend, legendHeight := chartLegend(legendNames, legendColors, padding, width-padding, theme)
+	bottom := height - padding - legendHeight
+	for i := range legend {
+		legend[i].y += bottom + 4
+	}
+
+	if c.Type == CHART_PIE {
+		shapes = append(shapes, c.pieShapes(padding, top, width-padding, bottom, theme)...)
+	} else {
+		shapes = append(shapes, c.axisShapes(padding, top, width-padding, bottom, theme)...)
+	}
+
+	return append(shapes, legend...)
+}
+
+// chartLegend lays the entries out in rows starting at y 0.
+func chartLegend(names []string, colors []string, left float64, right float64, theme chartTheme) ([]chartShape, float64) {
+	if len(names) == 0 {
+		return nil, 0
+	}
+
+	var shapes []chartShape
+	x, y := left, 0.0
+
+	for i, name := range names {
+		name = FitChartText(name, 10, right-left-16)
+		entryWidth := 16 + ChartTextWidth(name, 10) + 14
+
+		if x > left && x+entryWidth > right {
+			x = left
+			y += 16
+		}
+
+		shapes = append(shapes, chartRect(x, y+2, 10, 10, colors[i]), chartText(x+14, y+11, 10, "start", theme.text, name))
+		x += entryWidth
+	}
+
+	return shapes, y + 20
+}
+
+func (c Chart) pieShapes(left float64, top float64, right float64, bottom float64, theme chartTheme) []chartShape {
+	_, values, colors := c.slices()
+
+	total := 0.0
+	for _, value := range values {
+		total += value
+	}
+
+	cx, cy := (left+right)/2, (top+bottom)/2
+	if total <= 0 {
+		return []chartShape{chartText(cx, cy, 12, "middle", theme.muted, c.EmptyLabel)}
+	}
+
+	radius := math.Max(math.Min(right-left, bottom-top)/2-4, 1)
+
+	var shapes []chartShape
+	angle := 0.0
+	for i, value := range values {
+		if value <= 0 {
+			continue
+		}
+
+		sweep := value / total * 2 * math.Pi
+		shapes = append(shapes, chartShape{kind: "wedge", x: cx, y: cy, radius: radius, start: angle, end: angle + sweep, color: colors[i]})
+		angle += sweep
+	}
+
+	return shapes
+}
+
+func (c Chart) axisShapes(left float64, top float64, right float64, bottom float64, theme chartTheme) []chartShape {
+	labelCount := len(c.Labels)
+	if labelCount == 0 || len(c.Series) == 0 {
+		return []chartShape{chartText((left+right)/2, (top+bottom)/2, 12, "middle", theme.muted, c.EmptyLabel)}
+	}
+
+	largest := 0.0
+	for i := range c.Labels {
+		stack := 0.0
+		for j := range c.Series {
+			stack += c.value(j, i)
+			largest = math.Max(largest, c.value(j, i))
+		}
+		if c.Type == CHART_STACKED {
+			largest = math.Max(largest, stack)
+		}
+	}
+
+	step := chartTickStep(largest)
+	ticks := int(math.Max(math.Ceil(largest/step), 1))
+	axisMax := step * float64(ticks)
+
+	tickWidth := 0.0
+	for t := 0; t <= ticks; t++ {
+		tickWidth = math.Max(tickWidth, ChartTextWidth(compactChartNumber(step*float64(t)), 10))
+	}
+
+	left += tickWidth + 8
+	bottom -= 18
+	plotHeight := math.Max(bottom-top, 1)
+	y := func(value float64) float64 {
+		return bottom - value/axisMax*plotHeight
+	}
+
+	var shapes []chartShape
+	for t := 0; t <= ticks; t++ {
+		tickY := y(step * float64(t))
+		shapes = append(shapes,
+			chartLine([][2]float64{{left, tickY}, {right, tickY}}, 1, theme.grid),
+			chartText(left-6, tickY+3, 10, "end", theme.muted, compactChartNumber(step*float64(t))))
+	}
+
+	slot := (right - left) / float64(labelCount)
+	every := int(math.Max(math.Ceil(48/slot), 1))
+	for i, label := range c.Labels {
+		if i%every == 0 {
+			shapes = append(shapes, chartText(left+slot*(float64(i)+0.5), bottom+14, 10, "middle", theme.muted, FitChartText(label, 10, slot*float64(every)-4)))
+		}
+	}
+
+	switch c.Type {
+	case CHART_LINE:
+		for j := range c.Series {
+			var points [][2]float64
+			for i := range c.Labels {
+				points = append(points, [2]float64{left + slot*(float64(i)+0.5), y(c.value(j, i))})
+			}
+
+			shapes = append(shapes, chartLine(points, 2, c.seriesColor(j)))
+			if labelCount <= 60 {
+				for _, point := range points {
+					shapes = append(shapes, chartRect(point[0]-2, point[1]-2, 4, 4, c.seriesColor(j)))
+				}
+			}
+		}
+	case CHART_STACKED:
+		barWidth := slot * 0.6
+		for i := range c.Labels {
+			stack := 0.0
+			for j := range c.Series {
+				value := c.value(j, i)
+				if value > 0 {
+					shapes = append(shapes, chartRect(left+slot*float64(i)+(slot-barWidth)/2, y(stack+value), barWidth, y(stack)-y(stack+value), c.seriesColor(j)))
+				}
+				stack += value
+			}
+		}
+	default:
+		groupWidth := slot * 0.7
+		barWidth := groupWidth / float64(len(c.Series))
+		for i := range c.Labels {
+			for j := range c.Series {
+				color := c.seriesColor(j)
+				if len(c.Series) == 1 {
+					color = c.labelColor(i)
+				}
+
+				value := c.value(j, i)
+				if value > 0 {
+					shapes = append(shapes, chartRect(left+slot*float64(i)+(slot-groupWidth)/2+barWidth*float64(j), y(value), barWidth, bottom-y(value), color))
+				}
+			}
+		}
+	}
+
+	return append(shapes, chartLine([][2]float64{{left, bottom}, {right, bottom}}, 1, theme.muted))
+}
+
+// chartTickStep picks a round step that splits the axis in about four.
+func chartTickStep(largest float64) float64 {
+	if largest <= 0 {
+		return 1
+	}
+
+	raw := largest / 4
+	magnitude := math.Pow(10, math.Floor(math.Log10(raw)))
+
+	switch fraction := raw / magnitude; {
+	case fraction <= 1:
+		return magnitude
+	case fraction <= 2:
+		return 2 * magnitude
+	case fraction <= 5:
+		return 5 * magnitude
+	}
+
+	return 10 * magnitude
+}
+
+func compactChartNumber(value float64) string {
+	switch {
+	case value >= 1e6:
+		return chartNumber(math.Round(value/1e5)/10) + "M"
+	case value >= 1e3:
+		return chartNumber(math.Round(value/1e2)/10) + "k"
+	}
+
+	return chartNumber(value)
+}
+
+func chartNumber(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+}
+
+// ChartTextWidth estimates the width of the text in pixels. It matches the
+// bitmap font of the PNG charts and is close enough for SVG fonts.
+func ChartTextWidth(text string, size float64) float64 {
+	return float64(len([]rune(text))) * size * 0.6
+}
+
+// FitChartText shortens the text with an ellipsis until it fits the width.
+func FitChartText(text string, size float64, width float64) string {
+	if ChartTextWidth(text, size) <= width {
+		return text
+	}
+
+	runes := []rune(text)
+	for len(runes) > 0 && ChartTextWidth(string(runes)+"...", size) > width {
+		runes = runes[:len(runes)-1]
+	}
+
+	if len(runes) == 0 {
+		return ""
+	}
+
+	return string(runes) + "..."
+}
+
+func RenderChartSVG(chart Chart) []byte {
+	var svg strings.Builder
+
+	svg.WriteString(`<svg xmlns="http://www.w3.org/2000/svg" width="` + strconv.Itoa(chart.Width) + `" height="` + strconv.Itoa(chart.Height) +
+		`" viewBox="0 0 ` + strconv.Itoa(chart.Width) + ` ` + strconv.Itoa(chart.Height) + `" font-family="Helvetica, Arial, sans-serif">` + "\n")
+
+	for _, shape := range chart.shapes() {
+		switch shape.kind {
+		case "rect":
+			svg.WriteString(`<rect x="` + chartNumber(shape.x) + `" y="` + chartNumber(shape.y) + `" width="` + chartNumber(shape.width) +
+				`" height="` + chartNumber(shape.height) + `" fill="` + shape.color + `"/>` + "\n")
+		case "line":
+			points := make([]string, len(shape.points))
+			for i, point := range shape.points {
+				points[i] = chartNumber(point[0]) + "," + chartNumber(point[1])
+			}
+			svg.WriteString(`<polyline points="` + strings.Join(points, " ") + `" fill="none" stroke="` + shape.color +
+				`" stroke-width="` + chartNumber(shape.width) + `" stroke-linejoin="round"/>` + "\n")
+		case "wedge":
+			if shape.end-shape.start >= 2*math.Pi-1e-9 {
+				svg.WriteString(`<circle cx="` + chartNumber(shape.x) + `" cy="` + chartNumber(shape.y) + `" r="` + chartNumber(shape.radius) +
+					`" fill="` + shape.color + `"/>` + "\n")
+				continue
+			}
+
+			largeArc := "0"
+			if shape.end-shape.start > math.Pi {
+				largeArc = "1"
+			}
+			svg.WriteString(`<path d="M` + chartNumber(shape.x) + `,` + chartNumber(shape.y) +
+				` L` + chartNumber(shape.x+shape.radius*math.Sin(shape.start)) + `,` + chartNumber(shape.y-shape.radius*math.Cos(shape.start)) +
+				` A` + chartNumber(shape.radius) + `,` + chartNumber(shape.radius) + ` 0 ` + largeArc + ` 1 ` +
+				chartNumber(shape.x+shape.radius*math.Sin(shape.end)) + `,` + chartNumber(shape.y-shape.radius*math.Cos(shape.end)) +
+				` Z" fill="` + shape.color + `"/>` + "\n")
+		case "text":
+			svg.WriteString(`<text x="` + chartNumber(shape.x) + `" y="` + chartNumber(shape.y) + `" font-size="` + chartNumber(shape.size) +
+				`" text-anchor="` + shape.anchor + `" fill="` + shape.color + `">` + html.EscapeString(shape.text) + `</text>` + "\n")
+		}
+	}
+
+	svg.WriteString("</svg>\n")
+
+	return []byte(svg.String())
+}
+
+// RenderChartPNG rasterizes the chart without anti-aliasing. Text uses the
+// 5x7 bitmap font scaled by whole pixels, so accents are dropped.
+func RenderChartPNG(chart Chart) ([]byte, error) {
+	img := image.NewRGBA(image.Rect(0, 0, chart.Width, chart.Height))
+
+	fill := func(x int, y int, c color.RGBA) {
+		if image.Pt(x, y).In(img.Rect) {
+			img.SetRGBA(x, y, c)
+		}
+	}
+
+	for _, shape := range chart.shapes() {
+		r, g, b, _ := HexColorToRGB(shape.color)
+		c := color.RGBA{R: r, G: g, B: b, A: 255}
+
+		switch shape.kind {
+		case "rect":
+			for y := int(math.Round(shape.y)); y < int(math.Round(shape.y+shape.height)); y++ {
+				for x := int(math.Round(shape.x)); x < int(math.Round(shape.x+shape.width)); x++ {
+					fill(x, y, c)
+				}
+			}
+		case "line":
+			half := math.Max(shape.width/2, 0.5)
+			for i := 1; i < len(shape.points); i++ {
+				from, to := shape.points[i-1], shape.points[i]
+				steps := int(math.Ceil(math.Hypot(to[0]-from[0], to[1]-from[1])*2)) + 1
+				for s := 0; s <= steps; s++ {
+					px := from[0] + (to[0]-from[0])*float64(s)/float64(steps)
+					py := from[1] + (to[1]-from[1])*float64(s)/float64(steps)
+					for y := int(math.Floor(py - half + 0.5)); y < int(math.Floor(py+half+0.5)); y++ {
+						for x := int(math.Floor(px - half + 0.5)); x < int(math.Floor(px+half+0.5)); x++ {
+							fill(x, y, c)
+						}
+					}
+				}
+			}
+		case "wedge":
+			for y := int(shape.y - shape.radius); y <= int(shape.y+shape.radius); y++ {
+				for x := int(shape.x - shape.radius); x <= int(shape.x+shape.radius); x++ {
+					dx, dy := float64(x)+0.5-shape.x, float64(y)+0.5-shape.y
+					if dx*dx+dy*dy > shape.radius*shape.radius {
+						continue
+					}
+
+					angle := math.Atan2(dx, -dy)
+					if angle < 0 {
+						angle += 2 * math.Pi
+					}
+					if angle >= shape.start && angle < shape.end {
+						fill(x, y, c)
+					}
+				}
+			}
+		case "text":
+			scale := int(math.Max(math.Round(shape.size/10), 1))
+			advance := (BITMAP_GLYPH_WIDTH + 1) * scale
+			width := len([]rune(shape.text))*advance - scale
+
+			x0 := int(math.Round(shape.x))
+			switch shape.anchor {
+			case "middle":
+				x0 -= width / 2
+			case "end":
+				x0 -= width
+			}
+			y0 := int(math.Round(shape.y)) - BITMAP_GLYPH_HEIGHT*scale
+
+			for i, char := range []rune(shape.text) {
+				for row, bits := range bitmapGlyph(char) {
+					for column, bit := range bits {
+						if bit != '#' {
+							continue
+						}
+						for sy := 0; sy < scale; sy++ {
+							for sx := 0; sx < scale; sx++ {
+								fill(x0+i*advance+column*scale+sx, y0+row*scale+sy, c)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	if err := png.Encode(&out, img); err != nil {
+		return nil, err
+	}
+
+	return out.Bytes(), nil
+}
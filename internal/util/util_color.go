@@ -1,8 +1,31 @@
 package util
 
-import "regexp"
+import (
+	"regexp"
+	"strconv"
+)
 
 func IsValidHexColor(hexColor string) bool {
 	regex := regexp.MustCompile(`^#([A-Fa-f0-9]{6}|[A-Fa-f0-9]{3})$`)
 	return regex.MatchString(hexColor)
 }
+
+// HexColorToRGB parses #RGB and #RRGGBB colors. Invalid colors are reported
+// with ok false.
+func HexColorToRGB(hexColor string) (r uint8, g uint8, b uint8, ok bool) {
+	if !IsValidHexColor(hexColor) {
+		return 0, 0, 0, false
+	}
+
+	hex := hexColor[1:]
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+
+	return uint8(value >> 16), uint8(value >> 8), uint8(value), true
+}
@@ -9,8 +9,18 @@ const (
 	DATEFORMAT = "02012006"
 )
 
-func ParseDate(date string) (time.Time, error) {
-	location, err := time.LoadLocation(TIMEZONE)
+// LoadLocation loads the IANA timezone, falling back to TIMEZONE for users
+// that never chose one.
+func LoadLocation(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		timezone = TIMEZONE
+	}
+
+	return time.LoadLocation(timezone)
+}
+
+func ParseDate(date string, timezone string) (time.Time, error) {
+	location, err := LoadLocation(timezone)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -22,3 +32,51 @@ func ParseDate(date string) (time.Time, error) {
 
 	return newDate, nil
 }
+
+// UserLocation returns the location dates of a user are compared in.
+// Timezones are validated when the user saves them, so an unknown one falls
+// back to TIMEZONE, and to UTC when the timezone database is missing too.
+func UserLocation(timezone string) *time.Location {
+	location, err := LoadLocation(timezone)
+	if err == nil {
+		return location
+	}
+
+	location, err = time.LoadLocation(TIMEZONE)
+	if err == nil {
+		return location
+	}
+
+	return time.UTC
+}
+
+// Now returns the current time in the timezone, see UserLocation.
+func Now(timezone string) time.Time {
+	return time.Now().In(UserLocation(timezone))
+}
+
+// StartOfWeek returns midnight of the first day of the week containing date,
+// in the date's location.
+func StartOfWeek(date time.Time, weekStart time.Weekday) time.Time {
+	offset := (int(date.Weekday()) - int(weekStart) + 7) % 7
+
+	return time.Date(date.Year(), date.Month(), date.Day()-offset, 0, 0, 0, 0, date.Location())
+}
+
+// WeekOfYear numbers the week containing date. Monday weeks follow ISO 8601;
+// Sunday weeks count the week holding January 1st as week 1, so the last days
+// of December may already belong to week 1 of the next year.
+func WeekOfYear(date time.Time, weekStart time.Weekday) (year int, week int) {
+	if weekStart == time.Monday {
+		return date.ISOWeek()
+	}
+
+	start := StartOfWeek(date, weekStart)
+	year = start.AddDate(0, 0, 6).Year()
+	firstWeek := StartOfWeek(time.Date(year, time.January, 1, 0, 0, 0, 0, date.Location()), weekStart)
+
+	days := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC).
+		Sub(time.Date(firstWeek.Year(), firstWeek.Month(), firstWeek.Day(), 0, 0, 0, 0, time.UTC)).Hours() / 24
+
+	return year, int(days)/7 + 1
+}
@@ -0,0 +1,161 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("load %s: %v", name, err)
+	}
+
+	return location
+}
+
+func TestParseDate(t *testing.T) {
+	saoPaulo := mustLoadLocation(t, "America/Sao_Paulo")
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+
+	tests := []struct {
+		name     string
+		date     string
+		timezone string
+		want     time.Time
+	}{
+		{"user timezone", "31012026", "Asia/Tokyo", time.Date(2026, time.January, 31, 0, 0, 0, 0, tokyo)},
+		{"default timezone", "31012026", "", time.Date(2026, time.January, 31, 0, 0, 0, 0, saoPaulo)},
+		{"new year in Sao Paulo", "01012026", "America/Sao_Paulo", time.Date(2026, time.January, 1, 3, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseDate(test.date, test.timezone)
+			if err != nil {
+				t.Fatalf("ParseDate: %v", err)
+			}
+
+			if !got.Equal(test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+
+			if got.Hour() != 0 || got.Minute() != 0 {
+				t.Errorf("got %v, want midnight in the user's timezone", got)
+			}
+		})
+	}
+
+	if _, err := ParseDate("31012026", "Not/AZone"); err == nil {
+		t.Error("expected an error for an unknown timezone")
+	}
+
+	if _, err := ParseDate("2026-01-31", "America/Sao_Paulo"); err == nil {
+		t.Error("expected an error for a date in another format")
+	}
+}
+
+func TestNow(t *testing.T) {
+	tests := []struct {
+		timezone string
+		want     string
+	}{
+		{"Asia/Tokyo", "Asia/Tokyo"},
+		{"", TIMEZONE},
+		{"Not/AZone", TIMEZONE},
+	}
+
+	for _, test := range tests {
+		now := Now(test.timezone)
+
+		if now.Location() == nil || now.Location().String() != test.want {
+			t.Errorf("Now(%q) is in %v, want %s", test.timezone, now.Location(), test.want)
+		}
+
+		if time.Since(now) > time.Minute || time.Since(now) < -time.Minute {
+			t.Errorf("Now(%q) = %v is not the current time", test.timezone, now)
+		}
+	}
+}
+
+func TestStartOfWeek(t *testing.T) {
+	saoPaulo := mustLoadLocation(t, "America/Sao_Paulo")
+	newYork := mustLoadLocation(t, "America/New_York")
+
+	tests := []struct {
+		name      string
+		date      time.Time
+		weekStart time.Weekday
+		want      time.Time
+	}{
+		{"monday week across new year", time.Date(2026, time.January, 1, 15, 0, 0, 0, saoPaulo), time.Monday, time.Date(2025, time.December, 29, 0, 0, 0, 0, saoPaulo)},
+		{"sunday week across new year", time.Date(2026, time.January, 1, 15, 0, 0, 0, saoPaulo), time.Sunday, time.Date(2025, time.December, 28, 0, 0, 0, 0, saoPaulo)},
+		{"monday week across month end", time.Date(2026, time.March, 1, 8, 0, 0, 0, saoPaulo), time.Monday, time.Date(2026, time.February, 23, 0, 0, 0, 0, saoPaulo)},
+		{"sunday on its own week start", time.Date(2026, time.March, 1, 23, 59, 0, 0, saoPaulo), time.Sunday, time.Date(2026, time.March, 1, 0, 0, 0, 0, saoPaulo)},
+		{"week holding a DST change", time.Date(2026, time.March, 10, 12, 0, 0, 0, newYork), time.Sunday, time.Date(2026, time.March, 8, 0, 0, 0, 0, newYork)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := StartOfWeek(test.date, test.weekStart); !got.Equal(test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestStartOfWeekFollowsTheDateLocation(t *testing.T) {
+	saoPaulo := mustLoadLocation(t, "America/Sao_Paulo")
+
+	// Monday 01:00 UTC is still Sunday evening in Sao Paulo.
+	instant := time.Date(2026, time.March, 2, 1, 0, 0, 0, time.UTC)
+
+	if got, want := StartOfWeek(instant, time.Monday), time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("in UTC got %v, want %v", got, want)
+	}
+
+	if got, want := StartOfWeek(instant.In(saoPaulo), time.Monday), time.Date(2026, time.February, 23, 0, 0, 0, 0, saoPaulo); !got.Equal(want) {
+		t.Errorf("in Sao Paulo got %v, want %v", got, want)
+	}
+}
+
+func TestWeekOfYear(t *testing.T) {
+	saoPaulo := mustLoadLocation(t, "America/Sao_Paulo")
+
+	tests := []struct {
+		name      string
+		date      time.Time
+		weekStart time.Weekday
+		wantYear  int
+		wantWeek  int
+	}{
+		{"monday week of december 29th", time.Date(2025, time.December, 29, 0, 0, 0, 0, saoPaulo), time.Monday, 2026, 1},
+		{"monday week of january 1st 2027", time.Date(2027, time.January, 1, 0, 0, 0, 0, saoPaulo), time.Monday, 2026, 53},
+		{"sunday week holding january 1st", time.Date(2025, time.December, 28, 0, 0, 0, 0, saoPaulo), time.Sunday, 2026, 1},
+		{"sunday week ending the first saturday", time.Date(2026, time.January, 3, 23, 0, 0, 0, saoPaulo), time.Sunday, 2026, 1},
+		{"sunday week after the first saturday", time.Date(2026, time.January, 4, 0, 0, 0, 0, saoPaulo), time.Sunday, 2026, 2},
+		{"sunday week at the end of december", time.Date(2026, time.December, 27, 0, 0, 0, 0, saoPaulo), time.Sunday, 2027, 1},
+		{"sunday week before the last one", time.Date(2026, time.December, 26, 0, 0, 0, 0, saoPaulo), time.Sunday, 2026, 52},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			year, week := WeekOfYear(test.date, test.weekStart)
+			if year != test.wantYear || week != test.wantWeek {
+				t.Errorf("got %d-%d, want %d-%d", year, week, test.wantYear, test.wantWeek)
+			}
+		})
+	}
+}
+
+func TestUserLocation(t *testing.T) {
+	if got := UserLocation("Asia/Tokyo"); got.String() != "Asia/Tokyo" {
+		t.Errorf("got %v, want Asia/Tokyo", got)
+	}
+
+	if got := UserLocation("Not/AZone"); got == nil || got.String() != TIMEZONE {
+		t.Errorf("got %v, want %s", got, TIMEZONE)
+	}
+}
@@ -10,3 +10,31 @@ func FloatToBRL(value float64) string {
 	valueToBRL := "R$ " + strings.Replace(valueToString, ".", ",", 1)
 	return valueToBRL
 }
+
+// FormatAmount writes the value with two decimals and thousands separators in
+// the convention of the locale: 1,234.56 in English, 1.234,56 otherwise.
+func FormatAmount(value float64, locale string) string {
+	thousands, decimal := ".", ","
+	if locale == LOCALE_EN {
+		thousands, decimal = ",", "."
+	}
+
+	sign := ""
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+
+	formatted := strconv.FormatFloat(value, 'f', 2, 64)
+	integer, fraction := formatted[:len(formatted)-3], formatted[len(formatted)-2:]
+
+	var grouped strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteString(thousands)
+		}
+		grouped.WriteRune(digit)
+	}
+
+	return sign + grouped.String() + decimal + fraction
+}
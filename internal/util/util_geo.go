@@ -0,0 +1,91 @@
+package util
+
+import "strings"
+
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+func IsValidLatitude(latitude float64) bool {
+	return latitude >= -90 && latitude <= 90
+}
+
+func IsValidLongitude(longitude float64) bool {
+	return longitude >= -180 && longitude <= 180
+}
+
+// EncodeGeohash returns the geohash cell of the given precision, from 1 (about
+// 5000 km wide) to 12 (a few centimeters), that contains the point.
+func EncodeGeohash(latitude float64, longitude float64, precision int) string {
+	minLatitude, maxLatitude := -90.0, 90.0
+	minLongitude, maxLongitude := -180.0, 180.0
+
+	var hash strings.Builder
+	bits, value := 0, 0
+	even := true
+
+	for hash.Len() < precision {
+		if even {
+			middle := (minLongitude + maxLongitude) / 2
+			if longitude >= middle {
+				value = value<<1 | 1
+				minLongitude = middle
+			} else {
+				value <<= 1
+				maxLongitude = middle
+			}
+		} else {
+			middle := (minLatitude + maxLatitude) / 2
+			if latitude >= middle {
+				value = value<<1 | 1
+				minLatitude = middle
+			} else {
+				value <<= 1
+				maxLatitude = middle
+			}
+		}
+
+		even = !even
+		if bits++; bits == 5 {
+			hash.WriteByte(geohashAlphabet[value])
+			bits, value = 0, 0
+		}
+	}
+
+	return hash.String()
+}
+
+// GeohashBounds returns the south-west and north-east corners of a geohash
+// cell. Characters outside the geohash alphabet are ignored.
+func GeohashBounds(hash string) (minLatitude float64, minLongitude float64, maxLatitude float64, maxLongitude float64) {
+	minLatitude, maxLatitude = -90.0, 90.0
+	minLongitude, maxLongitude = -180.0, 180.0
+	even := true
+
+	for _, r := range hash {
+		value := strings.IndexRune(geohashAlphabet, r)
+		if value < 0 {
+			continue
+		}
+
+		for bit := 4; bit >= 0; bit-- {
+			set := value>>bit&1 == 1
+			if even {
+				middle := (minLongitude + maxLongitude) / 2
+				if set {
+					minLongitude = middle
+				} else {
+					maxLongitude = middle
+				}
+			} else {
+				middle := (minLatitude + maxLatitude) / 2
+				if set {
+					minLatitude = middle
+				} else {
+					maxLatitude = middle
+				}
+			}
+			even = !even
+		}
+	}
+
+	return minLatitude, minLongitude, maxLatitude, maxLongitude
+}
@@ -0,0 +1,168 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	expensetrackerv1 "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/proto/expensetracker/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const GRPC_MAX_MESSAGE_SIZE = 4 << 20
+
+// NewGRPCProblemError turns use case problems into a status. The code comes
+// from the first problem's HTTP status and the message is its localized
+// detail. Every problem is attached as an expensetracker.v1.ProblemDetails
+// detail, so clients get the same problem details the REST API returns.
+func NewGRPCProblemError(locale string, problems []ProblemDetails) error {
+	if len(problems) == 0 {
+		return status.Error(codes.Internal, "internal server error")
+	}
+
+	first := LocalizeProblemDetails(locale, problems[0])
+	problemStatus := status.New(GRPCCodeFromHTTPStatus(first.Status), first.Detail)
+
+	for _, problem := range problems {
+		localized := LocalizeProblemDetails(locale, problem)
+
+		withDetails, err := problemStatus.WithDetails(&expensetrackerv1.ProblemDetails{
+			Type:     localized.Type,
+			Title:    localized.Title,
+			Status:   int32(localized.Status),
+			Detail:   localized.Detail,
+			Instance: localized.Instance,
+		})
+		if err != nil {
+			return problemStatus.Err()
+		}
+
+		problemStatus = withDetails
+	}
+
+	return problemStatus.Err()
+}
+
+func GRPCCodeFromHTTPStatus(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusPreconditionFailed:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	case http.StatusInternalServerError:
+		return codes.Internal
+	}
+
+	return codes.Unknown
+}
+
+type grpcContextKey string
+
+// GRPCContextWithString stores a value the way c.Set does on a gin.Context,
+// so "userID" and "locale" mean the same in both APIs.
+func GRPCContextWithString(ctx context.Context, key string, value string) context.Context {
+	return context.WithValue(ctx, grpcContextKey(key), value)
+}
+
+func GRPCContextString(ctx context.Context, key string) string {
+	value, _ := ctx.Value(grpcContextKey(key)).(string)
+	return value
+}
+
+// GRPCMetadata returns the first value of an incoming metadata key.
+func GRPCMetadata(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// GRPCInterceptor runs before every handler, unary or streaming, and returns
+// the context the call goes on with. Returning an error ends the call with
+// that status.
+type GRPCInterceptor func(ctx context.Context, method string) (context.Context, error)
+
+// NewGRPCServer creates a server that runs the interceptors in order before
+// every call and turns a panicking handler into an Internal status.
+func NewGRPCServer(interceptors ...GRPCInterceptor) *grpc.Server {
+	intercept := func(ctx context.Context, method string) (context.Context, error) {
+		for _, interceptor := range interceptors {
+			var err error
+			if ctx, err = interceptor(ctx, method); err != nil {
+				return nil, err
+			}
+		}
+
+		return ctx, nil
+	}
+
+	unary := func(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (response any, err error) {
+		defer recoverGRPC(info.FullMethod, &err)
+
+		if ctx, err = intercept(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, request)
+	}
+
+	stream := func(server any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer recoverGRPC(info.FullMethod, &err)
+
+		ctx, err := intercept(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(server, &grpcServerStream{ServerStream: stream, ctx: ctx})
+	}
+
+	return grpc.NewServer(
+		grpc.MaxRecvMsgSize(GRPC_MAX_MESSAGE_SIZE),
+		grpc.ChainUnaryInterceptor(unary),
+		grpc.ChainStreamInterceptor(stream),
+	)
+}
+
+func recoverGRPC(method string, err *error) {
+	if recovered := recover(); recovered != nil {
+		NewLoggerError(http.StatusInternalServerError, fmt.Sprint(recovered), method, "gRPC", "Error")
+		*err = status.Error(codes.Internal, "internal server error")
+	}
+}
+
+// grpcServerStream hands the intercepted context to streaming handlers.
+type grpcServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *grpcServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -15,3 +15,16 @@ func HashEmailWithHMAC(email string) (string, []ProblemDetails) {
 
 	return hex.EncodeToString(h.Sum(nil)), nil
 }
+
+// SignWithHMAC signs a value with the application secret so it can be handed
+// out, for example in a link, and trusted when it comes back.
+func SignWithHMAC(value string) string {
+	h := hmac.New(sha256.New, []byte(config.SECRETS_VAR.JWT_SECRET))
+	h.Write([]byte(value))
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func VerifyHMACSignature(value string, signature string) bool {
+	return hmac.Equal([]byte(SignWithHMAC(value)), []byte(signature))
+}
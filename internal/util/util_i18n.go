@@ -0,0 +1,212 @@
+package util
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	LOCALE_EN    = "en"
+	LOCALE_PT_BR = "pt-BR"
+	LOCALE_ES    = "es"
+
+	DEFAULT_LOCALE = LOCALE_EN
+)
+
+var LOCALES = []string{LOCALE_EN, LOCALE_PT_BR, LOCALE_ES}
+
+//go:embed locales/*.json
+var localeFiles embed.FS
+
+// Catalog holds the labels and messages of one locale. Messages are keyed by
+// their English text, so code keeps building ProblemDetails in English and
+// they are translated on the way out.
+type Catalog struct {
+	Months   []string          `json:"months"`
+	Weekdays []string          `json:"weekdays"`
+	Messages map[string]string `json:"messages"`
+}
+
+var catalogs = loadCatalogs()
+
+func loadCatalogs() map[string]Catalog {
+	loaded := make(map[string]Catalog, len(LOCALES))
+
+	for _, locale := range LOCALES {
+		content, err := localeFiles.ReadFile("locales/" + locale + ".json")
+		if err != nil {
+			panic("failed to read locale " + locale + ": " + err.Error())
+		}
+
+		var catalog Catalog
+		if err := json.Unmarshal(content, &catalog); err != nil {
+			panic("failed to parse locale " + locale + ": " + err.Error())
+		}
+
+		if len(catalog.Months) != 12 || len(catalog.Weekdays) != 7 {
+			panic("locale " + locale + " must have 12 months and 7 weekdays")
+		}
+
+		loaded[locale] = catalog
+	}
+
+	return loaded
+}
+
+func IsSupportedLocale(locale string) bool {
+	_, ok := catalogs[locale]
+	return ok
+}
+
+// ResolveLocale picks the user's saved language when there is one, otherwise
+// the best supported match of the Accept-Language header, otherwise
+// DEFAULT_LOCALE.
+func ResolveLocale(preference string, acceptLanguage string) string {
+	if IsSupportedLocale(preference) {
+		return preference
+	}
+
+	for _, tag := range parseAcceptLanguage(acceptLanguage) {
+		if locale := matchLocale(tag); locale != "" {
+			return locale
+		}
+	}
+
+	return DEFAULT_LOCALE
+}
+
+func parseAcceptLanguage(header string) []string {
+	type weightedTag struct {
+		tag    string
+		weight float64
+	}
+
+	var tags []weightedTag
+
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.TrimSpace(fields[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		weight := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); err == nil {
+					weight = q
+				}
+			}
+		}
+
+		if weight > 0 {
+			tags = append(tags, weightedTag{tag: tag, weight: weight})
+		}
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].weight > tags[j].weight
+	})
+
+	ordered := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		ordered = append(ordered, tag.tag)
+	}
+
+	return ordered
+}
+
+func matchLocale(tag string) string {
+	for _, locale := range LOCALES {
+		if strings.EqualFold(tag, locale) {
+			return locale
+		}
+	}
+
+	primary, _, _ := strings.Cut(tag, "-")
+	for _, locale := range LOCALES {
+		localePrimary, _, _ := strings.Cut(locale, "-")
+		if strings.EqualFold(primary, localePrimary) {
+			return locale
+		}
+	}
+
+	return ""
+}
+
+func catalogFor(locale string) Catalog {
+	if catalog, ok := catalogs[locale]; ok {
+		return catalog
+	}
+
+	return catalogs[DEFAULT_LOCALE]
+}
+
+func MonthName(month time.Month, locale string) string {
+	if month < time.January || month > time.December {
+		return ""
+	}
+
+	return catalogFor(locale).Months[month-1]
+}
+
+func WeekdayName(day time.Weekday, locale string) string {
+	if day < time.Sunday || day > time.Saturday {
+		return ""
+	}
+
+	return catalogFor(locale).Weekdays[day]
+}
+
+// Translate returns the message in the locale, or the message itself when the
+// catalog has no entry for it.
+func Translate(locale string, message string) string {
+	if translated, ok := catalogFor(locale).Messages[message]; ok {
+		return translated
+	}
+
+	return message
+}
+
+func LocalizeProblemDetails(locale string, problem ProblemDetails) ProblemDetails {
+	problem.Title = Translate(locale, problem.Title)
+	problem.Detail = Translate(locale, problem.Detail)
+
+	return problem
+}
+
+// LocaleMiddleware stores the request locale under "locale". Behind
+// AuthMiddleware, preferredLanguage looks up the language saved by the user;
+// it may be nil on public routes.
+func LocaleMiddleware(preferredLanguage func(userID string) string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		preference := ""
+		if userID := c.GetString("userID"); userID != "" && preferredLanguage != nil {
+			preference = preferredLanguage(userID)
+		}
+
+		c.Set("locale", ResolveLocale(preference, c.GetHeader("Accept-Language")))
+		c.Next()
+	}
+}
+
+// GRPCLocaleInterceptor is the gRPC counterpart of LocaleMiddleware, reading
+// the "accept-language" metadata.
+func GRPCLocaleInterceptor(preferredLanguage func(userID string) string) GRPCInterceptor {
+	return func(ctx context.Context, method string) (context.Context, error) {
+		preference := ""
+		if userID := GRPCContextString(ctx, "userID"); userID != "" && preferredLanguage != nil {
+			preference = preferredLanguage(userID)
+		}
+
+		return GRPCContextWithString(ctx, "locale", ResolveLocale(preference, GRPCMetadata(ctx, "accept-language"))), nil
+	}
+}
@@ -0,0 +1,215 @@
+package util
+
+import (
+	"bytes"
+	"compress/zlib"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// A4 in points.
+const (
+	PDF_PAGE_WIDTH  = 595.28
+	PDF_PAGE_HEIGHT = 841.89
+)
+
+// helveticaWidths are the widths of the printable ASCII characters of
+// Helvetica in thousandths of the font size, from the standard font metrics.
+// Other characters are measured as a digit.
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+// PDF writes simple documents with the standard Helvetica fonts, text, lines
+// and filled rectangles, so reports can be rendered without external tools.
+// Coordinates are in points from the top left corner of the page, and text
+// is placed by its baseline. Only characters of WinAnsiEncoding (Latin-1 plus
+// a few symbols such as the euro sign) are printed; others become "?".
+type PDF struct {
+	pages []*bytes.Buffer
+}
+
+func NewPDF() *PDF {
+	return &PDF{}
+}
+
+func (p *PDF) AddPage() {
+	p.pages = append(p.pages, &bytes.Buffer{})
+}
+
+func (p *PDF) PageCount() int {
+	return len(p.pages)
+}
+
+func (p *PDF) page() *bytes.Buffer {
+	if len(p.pages) == 0 {
+		p.AddPage()
+	}
+
+	return p.pages[len(p.pages)-1]
+}
+
+func (p *PDF) Text(x float64, y float64, size float64, bold bool, color string, text string) {
+	font := "/F1"
+	if bold {
+		font = "/F2"
+	}
+
+	page := p.page()
+	page.WriteString("BT " + pdfColor(color, "rg") + " " + font + " " + pdfNumber(size) + " Tf ")
+	page.WriteString(pdfNumber(x) + " " + pdfNumber(PDF_PAGE_HEIGHT-y) + " Td (" + pdfEscape(text) + ") Tj ET\n")
+}
+
+// TextRight places the text so that it ends at x.
+func (p *PDF) TextRight(x float64, y float64, size float64, bold bool, color string, text string) {
+	p.Text(x-PDFTextWidth(text, size), y, size, bold, color, text)
+}
+
+func (p *PDF) FillRect(x float64, y float64, width float64, height float64, color string) {
+	p.page().WriteString(pdfColor(color, "rg") + " " + pdfNumber(x) + " " + pdfNumber(PDF_PAGE_HEIGHT-y-height) + " " +
+		pdfNumber(width) + " " + pdfNumber(height) + " re f\n")
+}
+
+func (p *PDF) Line(x1 float64, y1 float64, x2 float64, y2 float64, width float64, color string) {
+	p.page().WriteString(pdfColor(color, "RG") + " " + pdfNumber(width) + " w " + pdfNumber(x1) + " " + pdfNumber(PDF_PAGE_HEIGHT-y1) + " m " +
+		pdfNumber(x2) + " " + pdfNumber(PDF_PAGE_HEIGHT-y2) + " l S\n")
+}
+
+// Bytes assembles the document. Page contents are deflated.
+func (p *PDF) Bytes() ([]byte, error) {
+	if len(p.pages) == 0 {
+		p.AddPage()
+	}
+
+	var out bytes.Buffer
+	var offsets []int
+
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		out.WriteString(strconv.Itoa(len(offsets)) + " 0 obj\n" + body + "\nendobj\n")
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	kids := make([]string, len(p.pages))
+	for i := range p.pages {
+		kids[i] = strconv.Itoa(5+2*i) + " 0 R"
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object("<< /Type /Pages /Kids [" + strings.Join(kids, " ") + "] /Count " + strconv.Itoa(len(p.pages)) + " >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range p.pages {
+		var content bytes.Buffer
+		writer := zlib.NewWriter(&content)
+		if _, err := writer.Write(page.Bytes()); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+
+		object("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 " + pdfNumber(PDF_PAGE_WIDTH) + " " + pdfNumber(PDF_PAGE_HEIGHT) + "] " +
+			"/Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents " + strconv.Itoa(6+2*i) + " 0 R >>")
+		object("<< /Length " + strconv.Itoa(content.Len()) + " /Filter /FlateDecode >>\nstream\n" + content.String() + "\nendstream")
+	}
+
+	xref := out.Len()
+	out.WriteString("xref\n0 " + strconv.Itoa(len(offsets)+1) + "\n0000000000 65535 f \n")
+	for _, offset := range offsets {
+		out.WriteString(leftPad(strconv.Itoa(offset), 10) + " 00000 n \n")
+	}
+	out.WriteString("trailer\n<< /Size " + strconv.Itoa(len(offsets)+1) + " /Root 1 0 R >>\nstartxref\n" + strconv.Itoa(xref) + "\n%%EOF\n")
+
+	return out.Bytes(), nil
+}
+
+// PDFTextWidth measures the text in points. Bold text is slightly wider than
+// this, which the layouts leave room for.
+func PDFTextWidth(text string, size float64) float64 {
+	width := 0
+	for _, r := range text {
+		if r >= 32 && r <= 126 {
+			width += helveticaWidths[r-32]
+		} else {
+			width += 556
+		}
+	}
+
+	return float64(width) * size / 1000
+}
+
+// FitPDFText shortens the text with an ellipsis until it fits the width.
+func FitPDFText(text string, size float64, width float64) string {
+	if PDFTextWidth(text, size) <= width {
+		return text
+	}
+
+	runes := []rune(text)
+	for len(runes) > 0 && PDFTextWidth(string(runes)+"...", size) > width {
+		runes = runes[:len(runes)-1]
+	}
+
+	return string(runes) + "..."
+}
+
+// winAnsiExtras are the WinAnsiEncoding codes of the characters it adds to
+// Latin-1.
+var winAnsiExtras = map[rune]byte{
+	'€': 0x80, '‚': 0x82, '„': 0x84, '…': 0x85, '‘': 0x91, '’': 0x92,
+	'“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97, '™': 0x99,
+}
+
+func pdfEscape(text string) string {
+	var escaped bytes.Buffer
+
+	for _, r := range text {
+		if code, ok := winAnsiExtras[r]; ok {
+			escaped.WriteString("\\" + strconv.FormatInt(int64(code), 8))
+			continue
+		}
+
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			escaped.WriteByte('\\')
+			escaped.WriteByte(byte(r))
+		case r >= 32 && r <= 126:
+			escaped.WriteByte(byte(r))
+		case r >= 0xA0 && r <= 0xFF:
+			escaped.WriteString("\\" + leftPad(strconv.FormatInt(int64(r), 8), 3))
+		default:
+			escaped.WriteByte('?')
+		}
+	}
+
+	return escaped.String()
+}
+
+func pdfColor(color string, operator string) string {
+	r, g, b, ok := HexColorToRGB(color)
+	if !ok {
+		return "0 0 0 " + operator
+	}
+
+	return pdfNumber(float64(r)/255) + " " + pdfNumber(float64(g)/255) + " " + pdfNumber(float64(b)/255) + " " + operator
+}
+
+func pdfNumber(value float64) string {
+	return strconv.FormatFloat(math.Round(value*1000)/1000, 'f', -1, 64)
+}
+
+func leftPad(value string, width int) string {
+	if len(value) >= width {
+		return value
+	}
+
+	return strings.Repeat("0", width-len(value)) + value
+}
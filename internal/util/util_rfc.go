@@ -7,6 +7,7 @@ const (
 	RFC403 = "https://datatracker.ietf.org/doc/html/rfc7231#section-6.5.3"
 	RFC404 = "https://datatracker.ietf.org/doc/html/rfc7231#section-6.5.4"
 	RFC409 = "https://datatracker.ietf.org/doc/html/rfc7231#section-6.5.8"
+	RFC413 = "https://datatracker.ietf.org/doc/html/rfc7231#section-6.5.11"
 	RFC500 = "https://datatracker.ietf.org/doc/html/rfc7231#section-6.6.1"
 	RFC503 = "https://datatracker.ietf.org/doc/html/rfc7231#section-6.6.4"
 )
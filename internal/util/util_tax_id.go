@@ -0,0 +1,94 @@
+package util
+
+import "strings"
+
+// NormalizeTaxID strips the punctuation of a formatted CPF or CNPJ
+// (123.456.789-09, 12.345.678/0001-95) and upper-cases it.
+func NormalizeTaxID(taxID string) string {
+	return strings.ToUpper(strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '-', '/', ' ':
+			return -1
+		}
+		return r
+	}, taxID))
+}
+
+// IsValidCPF checks the two check digits of a normalized CPF.
+func IsValidCPF(cpf string) bool {
+	if len(cpf) != 11 || strings.Count(cpf, cpf[:1]) == 11 {
+		return false
+	}
+
+	values := make([]int, 11)
+	for i, r := range cpf {
+		if r < '0' || r > '9' {
+			return false
+		}
+		values[i] = int(r - '0')
+	}
+
+	for length := 9; length <= 10; length++ {
+		sum := 0
+		for i := 0; i < length; i++ {
+			sum += values[i] * (length + 1 - i)
+		}
+
+		if (sum*10)%11%10 != values[length] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsValidCNPJ checks the two check digits of a normalized CNPJ. The first
+// twelve characters may be letters, as in the alphanumeric CNPJ; each counts
+// as its ASCII code minus 48.
+func IsValidCNPJ(cnpj string) bool {
+	if len(cnpj) != 14 || strings.Count(cnpj, cnpj[:1]) == 14 {
+		return false
+	}
+
+	values := make([]int, 14)
+	for i, r := range cnpj {
+		switch {
+		case r >= '0' && r <= '9':
+		case r >= 'A' && r <= 'Z' && i < 12:
+		default:
+			return false
+		}
+		values[i] = int(r - '0')
+	}
+
+	for length := 12; length <= 13; length++ {
+		sum := 0
+		for i := 0; i < length; i++ {
+			sum += values[i] * (2 + (length-1-i)%8)
+		}
+
+		digit := 0
+		if sum%11 >= 2 {
+			digit = 11 - sum%11
+		}
+
+		if digit != values[length] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FormatTaxID punctuates a normalized CPF or CNPJ; anything else is returned
+// unchanged.
+func FormatTaxID(taxID string) string {
+	switch len(taxID) {
+	case 11:
+		return taxID[:3] + "." + taxID[3:6] + "." + taxID[6:9] + "-" + taxID[9:]
+	case 14:
+		return taxID[:2] + "." + taxID[2:5] + "." + taxID[5:8] + "/" + taxID[8:12] + "-" + taxID[12:]
+	}
+
+	return taxID
+}
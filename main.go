@@ -2,13 +2,17 @@ package main
 
 import (
 	"fmt"
+	"net"
+	"net/http"
 	"time"
 
 	_ "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/api"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/config"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/factory"
 	repositoriesgorm "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/infra/repositories_gorm"
+	grpcserver "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/interface/grpc_server"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/interface/handlers"
+	usecases "github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/use_cases"
 	"github.com/GuilhermeDeOliveiraAmorim/expense-tracker/internal/util"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -48,12 +52,14 @@ func main() {
 	r.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{config.FRONT_END_URL_VAR.FRONT_END_URL_DEV, config.FRONT_END_URL_VAR.FRONT_END_URL_PROD},
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "PATCH"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", "Accept-Language"},
 		ExposeHeaders:    []string{"Content-Length"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
 
+	r.Use(util.LocaleMiddleware(nil))
+
 	categoryFactory := factory.NewCategoryFactory(db)
 	categoryHandler := handlers.NewCategoryHandler(categoryFactory)
 
@@ -63,21 +69,92 @@ func main() {
 	expenseFactory := factory.NewExpenseFactory(db)
 	expenseHandler := handlers.NewExpenseHandler(expenseFactory)
 
+	go expenseFactory.TrainSuggestionModels.Execute(usecases.TrainSuggestionModelsInputDto{})
+
 	userFactory := factory.NewUserFactory(db)
 	userHandler := handlers.NewUserHandler(userFactory)
 
+	incomeFactory := factory.NewIncomeFactory(db)
+	incomeHandler := handlers.NewIncomeHandler(incomeFactory)
+
+	accountFactory := factory.NewAccountFactory(db)
+	accountHandler := handlers.NewAccountHandler(accountFactory)
+
+	installmentPurchaseFactory := factory.NewInstallmentPurchaseFactory(db)
+	installmentPurchaseHandler := handlers.NewInstallmentPurchaseHandler(installmentPurchaseFactory)
+
+	merchantFactory := factory.NewMerchantFactory(db)
+	merchantHandler := handlers.NewMerchantHandler(merchantFactory)
+
+	goalFactory := factory.NewGoalFactory(db)
+	goalHandler := handlers.NewGoalHandler(goalFactory)
+
+	ruleFactory := factory.NewRuleFactory(db)
+	ruleHandler := handlers.NewRuleHandler(ruleFactory)
+
+	webhookFactory := factory.NewWebhookFactory(db)
+	webhookHandler := handlers.NewWebhookHandler(webhookFactory)
+
+	go func() {
+		ticker := time.NewTicker(15 * time.Second)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			webhookFactory.DeliverWebhooks.Execute(usecases.DeliverWebhooksInputDto{})
+		}
+	}()
+
+	digestFactory := factory.NewDigestFactory(db)
+	digestHandler := handlers.NewDigestHandler(digestFactory)
+
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			digestFactory.SendSpendingDigests.Execute(usecases.SendSpendingDigestsInputDto{})
+		}
+	}()
+
 	presentersFactory := factory.NewPresentersFactory(db)
 	presentersHandler := handlers.NewPresentersHandler(presentersFactory)
 
+	graphQLHandler := handlers.NewGraphQLHandler(expenseFactory, categoryFactory, tagFactory, accountFactory, merchantFactory, presentersFactory)
+
 	public := r.Group("/")
 	{
 		public.POST("/signup", userHandler.CreateUser)
 		public.POST("/login", userHandler.Login)
+		public.GET("/charts/shared", presentersHandler.GetSharedExpenseChart)
+		public.GET("/digests/confirm", digestHandler.ConfirmDigestSubscription)
 
 		public.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 	}
 
-	protected := r.Group("/").Use(util.AuthMiddleware())
+	preferredLanguage := func(userID string) string {
+		output, errs := userFactory.GetUser.Execute(usecases.GetUserInputDto{UserID: userID})
+		if len(errs) > 0 {
+			return ""
+		}
+
+		return output.User.Language
+	}
+
+	grpcServer := grpcserver.NewServer(categoryFactory, tagFactory, expenseFactory, userFactory, presentersFactory, preferredLanguage)
+
+	go func() {
+		listener, err := net.Listen("tcp", ":"+config.GRPC_VAR.GRPC_PORT)
+		if err != nil {
+			util.NewLoggerError(http.StatusInternalServerError, "Failed to start gRPC server: "+err.Error(), "main", "gRPC", "Error")
+			return
+		}
+
+		if err := grpcServer.Serve(listener); err != nil {
+			util.NewLoggerError(http.StatusInternalServerError, "gRPC server stopped: "+err.Error(), "main", "gRPC", "Error")
+		}
+	}()
+
+	protected := r.Group("/").Use(util.AuthMiddleware(), util.LocaleMiddleware(preferredLanguage))
 	{
 		protected.POST("/categories", categoryHandler.CreateCategory)
 		protected.GET("/categories", categoryHandler.GetCategory)
@@ -94,9 +171,71 @@ func main() {
 		protected.POST("/expenses", expenseHandler.CreateExpense)
 		protected.GET("/expenses", expenseHandler.GetExpense)
 		protected.GET("/expenses/all", expenseHandler.GetExpenses)
+		protected.GET("/expenses/suggest", expenseHandler.SuggestExpense)
+		protected.GET("/expenses/anomalies", expenseHandler.GetExpenseAnomalies)
+		protected.PATCH("/expenses/anomalies", expenseHandler.DismissExpenseAnomaly)
 		protected.PATCH("/expenses", expenseHandler.UpdateExpense)
 		protected.DELETE("/expenses", expenseHandler.DeleteExpense)
 
+		protected.POST("/incomes", incomeHandler.CreateIncome)
+		protected.GET("/incomes", incomeHandler.GetIncome)
+		protected.GET("/incomes/all", incomeHandler.GetIncomes)
+		protected.PATCH("/incomes", incomeHandler.UpdateIncome)
+		protected.DELETE("/incomes", incomeHandler.DeleteIncome)
+
+		protected.POST("/accounts", accountHandler.CreateAccount)
+		protected.GET("/accounts", accountHandler.GetAccount)
+		protected.GET("/accounts/all", accountHandler.GetAccounts)
+		protected.PATCH("/accounts", accountHandler.UpdateAccount)
+		protected.DELETE("/accounts", accountHandler.DeleteAccount)
+
+		protected.POST("/transfers", accountHandler.CreateTransfer)
+		protected.GET("/transfers", accountHandler.GetTransfer)
+		protected.GET("/transfers/all", accountHandler.GetTransfers)
+		protected.DELETE("/transfers", accountHandler.DeleteTransfer)
+
+		protected.POST("/installments", installmentPurchaseHandler.CreateInstallmentPurchase)
+		protected.GET("/installments", installmentPurchaseHandler.GetInstallmentPurchase)
+		protected.GET("/installments/all", installmentPurchaseHandler.GetInstallmentPurchases)
+		protected.PATCH("/installments", installmentPurchaseHandler.UpdateInstallmentPurchase)
+		protected.DELETE("/installments", installmentPurchaseHandler.DeleteInstallmentPurchase)
+
+		protected.POST("/goals", goalHandler.CreateGoal)
+		protected.GET("/goals", goalHandler.GetGoal)
+		protected.GET("/goals/all", goalHandler.GetGoals)
+		protected.PATCH("/goals", goalHandler.UpdateGoal)
+		protected.DELETE("/goals", goalHandler.DeleteGoal)
+
+		protected.POST("/goals/contributions", goalHandler.CreateGoalContribution)
+		protected.GET("/goals/contributions", goalHandler.GetGoalContributions)
+		protected.DELETE("/goals/contributions", goalHandler.DeleteGoalContribution)
+
+		protected.POST("/merchants", merchantHandler.CreateMerchant)
+		protected.GET("/merchants", merchantHandler.GetMerchant)
+		protected.GET("/merchants/all", merchantHandler.GetMerchants)
+		protected.PATCH("/merchants", merchantHandler.UpdateMerchant)
+		protected.DELETE("/merchants", merchantHandler.DeleteMerchant)
+
+		protected.POST("/rules", ruleHandler.CreateRule)
+		protected.GET("/rules", ruleHandler.GetRule)
+		protected.GET("/rules/all", ruleHandler.GetRules)
+		protected.PATCH("/rules", ruleHandler.UpdateRule)
+		protected.DELETE("/rules", ruleHandler.DeleteRule)
+		protected.GET("/rules/preview", ruleHandler.PreviewRule)
+		protected.POST("/rules/apply", ruleHandler.ApplyRule)
+
+		protected.POST("/webhooks", webhookHandler.CreateWebhookSubscription)
+		protected.GET("/webhooks/all", webhookHandler.GetWebhookSubscriptions)
+		protected.PATCH("/webhooks", webhookHandler.UpdateWebhookSubscription)
+		protected.DELETE("/webhooks", webhookHandler.DeleteWebhookSubscription)
+		protected.GET("/webhooks/deliveries", webhookHandler.GetWebhookDeliveries)
+		protected.POST("/webhooks/test", webhookHandler.SendTestWebhook)
+
+		protected.POST("/digests", digestHandler.CreateDigestSubscription)
+		protected.GET("/digests/all", digestHandler.GetDigestSubscriptions)
+		protected.DELETE("/digests", digestHandler.DeleteDigestSubscription)
+		protected.GET("/digests/preview", digestHandler.PreviewSpendingDigest)
+
 		protected.GET("/users", userHandler.GetUser)
 		protected.GET("/users/all", userHandler.GetUsers)
 		protected.PATCH("/users", userHandler.UpdateUser)
@@ -112,8 +251,35 @@ func main() {
 		protected.GET("/expenses/total/monthly/year", presentersHandler.GetTotalExpensesMonthCurrentYear)
 		protected.GET("/expenses/tags/monthly/total", presentersHandler.GetCategoryTagsTotalsByMonthYear)
 		protected.GET("/expenses/day/day/period", presentersHandler.GetDayToDayExpensesPeriod)
+		protected.GET("/expenses/forecast", presentersHandler.GetMonthEndForecast)
+		protected.GET("/expenses/comparison", presentersHandler.GetPeriodComparison)
+		protected.GET("/expenses/series", presentersHandler.GetExpensesTimeSeries)
+		protected.GET("/expenses/pivot", presentersHandler.GetPivotReport)
+		protected.GET("/expenses/heatmap", presentersHandler.GetSpendingHeatmap)
+		protected.GET("/expenses/report.pdf", presentersHandler.GetExpenseReportPDF)
+		protected.GET("/expenses/tax-report", presentersHandler.GetTaxDeductionReport)
+		protected.GET("/charts", presentersHandler.GetExpenseChart)
+		protected.POST("/charts/share", presentersHandler.ShareExpenseChart)
+
+		protected.GET("/cashflow/monthly/year", presentersHandler.GetNetCashFlowYear)
+		protected.GET("/cashflow/categories", presentersHandler.GetIncomeVsExpenseByCategoryPeriod)
+
+		protected.GET("/accounts/balances", presentersHandler.GetAccountBalances)
+		protected.GET("/accounts/balances/running", presentersHandler.GetAccountRunningBalance)
+		protected.GET("/accounts/statements", presentersHandler.GetCardStatements)
+		protected.GET("/expenses/accounts", presentersHandler.GetExpensesByAccountPeriod)
+		protected.GET("/expenses/merchants", presentersHandler.GetTopMerchants)
+		protected.GET("/expenses/locations", presentersHandler.GetExpenseLocations)
+		protected.GET("/expenses/locations/clusters", presentersHandler.GetExpenseClusters)
+		protected.GET("/expenses/locations/geojson", presentersHandler.GetExpensesGeoJSON)
+
+		protected.GET("/installments/committed", presentersHandler.GetCommittedInstallmentsByMonth)
+
+		protected.GET("/goals/progress", presentersHandler.GetGoalsProgress)
 
 		protected.GET("/util/months/years", presentersHandler.GetAvailableMonthsYears)
+
+		protected.POST("/graphql", graphQLHandler.Query)
 	}
 
 	r.Run(":8080")
@@ -0,0 +1,778 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: expensetracker/v1/category.proto
+
+package expensetrackerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	TaxCategory   string                 `protobuf:"bytes,5,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_expensetracker_v1_category_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_category_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_category_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Category) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Category) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Category) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
+func (x *Category) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	TaxCategory   string                 `protobuf:"bytes,4,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_expensetracker_v1_category_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_category_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_category_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
+type CreateCategoryResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId     string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	SuccessMessage string                 `protobuf:"bytes,2,opt,name=success_message,json=successMessage,proto3" json:"success_message,omitempty"`
+	ContentMessage string                 `protobuf:"bytes,3,opt,name=content_message,json=contentMessage,proto3" json:"content_message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_expensetracker_v1_category_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_category_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_category_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateCategoryResponse) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateCategoryResponse) GetSuccessMessage() string {
+	if x != nil {
+		return x.SuccessMessage
+	}
+	return ""
+}
+
+func (x *CreateCategoryResponse) GetContentMessage() string {
+	if x != nil {
+		return x.ContentMessage
+	}
+	return ""
+}
+
+type GetCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_expensetracker_v1_category_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRequest) ProtoMessage() {}
+
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_category_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_category_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCategoryRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+type GetCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryResponse) Reset() {
+	*x = GetCategoryResponse{}
+	mi := &file_expensetracker_v1_category_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryResponse) ProtoMessage() {}
+
+func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_category_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_category_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type GetCategoriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filters by kind, "expense" or "income". Empty returns every category.
+	Kind          string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoriesRequest) Reset() {
+	*x = GetCategoriesRequest{}
+	mi := &file_expensetracker_v1_category_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoriesRequest) ProtoMessage() {}
+
+func (x *GetCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_category_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_category_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCategoriesRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type GetCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoriesResponse) Reset() {
+	*x = GetCategoriesResponse{}
+	mi := &file_expensetracker_v1_category_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoriesResponse) ProtoMessage() {}
+
+func (x *GetCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_category_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_category_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// Empty fields are left unchanged.
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	TaxCategory   string                 `protobuf:"bytes,4,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_expensetracker_v1_category_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_category_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_category_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateCategoryRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
+type UpdateCategoryResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId     string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	SuccessMessage string                 `protobuf:"bytes,2,opt,name=success_message,json=successMessage,proto3" json:"success_message,omitempty"`
+	ContentMessage string                 `protobuf:"bytes,3,opt,name=content_message,json=contentMessage,proto3" json:"content_message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_expensetracker_v1_category_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryResponse) ProtoMessage() {}
+
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_category_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_category_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateCategoryResponse) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *UpdateCategoryResponse) GetSuccessMessage() string {
+	if x != nil {
+		return x.SuccessMessage
+	}
+	return ""
+}
+
+func (x *UpdateCategoryResponse) GetContentMessage() string {
+	if x != nil {
+		return x.ContentMessage
+	}
+	return ""
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_expensetracker_v1_category_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_category_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_category_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteCategoryRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+type DeleteCategoryResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SuccessMessage string                 `protobuf:"bytes,1,opt,name=success_message,json=successMessage,proto3" json:"success_message,omitempty"`
+	ContentMessage string                 `protobuf:"bytes,2,opt,name=content_message,json=contentMessage,proto3" json:"content_message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_expensetracker_v1_category_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_category_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_category_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteCategoryResponse) GetSuccessMessage() string {
+	if x != nil {
+		return x.SuccessMessage
+	}
+	return ""
+}
+
+func (x *DeleteCategoryResponse) GetContentMessage() string {
+	if x != nil {
+		return x.ContentMessage
+	}
+	return ""
+}
+
+var File_expensetracker_v1_category_proto protoreflect.FileDescriptor
+
+const file_expensetracker_v1_category_proto_rawDesc = "" +
+	"\n" +
+	" expensetracker/v1/category.proto\x12\x11expensetracker.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf1\x01\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12!\n" +
+	"\ftax_category\x18\x05 \x01(\tR\vtaxCategory\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"x\n" +
+	"\x15CreateCategoryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x02 \x01(\tR\x05color\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12!\n" +
+	"\ftax_category\x18\x04 \x01(\tR\vtaxCategory\"\x8b\x01\n" +
+	"\x16CreateCategoryResponse\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12'\n" +
+	"\x0fsuccess_message\x18\x02 \x01(\tR\x0esuccessMessage\x12'\n" +
+	"\x0fcontent_message\x18\x03 \x01(\tR\x0econtentMessage\"5\n" +
+	"\x12GetCategoryRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\"N\n" +
+	"\x13GetCategoryResponse\x127\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1b.expensetracker.v1.CategoryR\bcategory\"*\n" +
+	"\x14GetCategoriesRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\"T\n" +
+	"\x15GetCategoriesResponse\x12;\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x1b.expensetracker.v1.CategoryR\n" +
+	"categories\"\x85\x01\n" +
+	"\x15UpdateCategoryRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12!\n" +
+	"\ftax_category\x18\x04 \x01(\tR\vtaxCategory\"\x8b\x01\n" +
+	"\x16UpdateCategoryResponse\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12'\n" +
+	"\x0fsuccess_message\x18\x02 \x01(\tR\x0esuccessMessage\x12'\n" +
+	"\x0fcontent_message\x18\x03 \x01(\tR\x0econtentMessage\"8\n" +
+	"\x15DeleteCategoryRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\"j\n" +
+	"\x16DeleteCategoryResponse\x12'\n" +
+	"\x0fsuccess_message\x18\x01 \x01(\tR\x0esuccessMessage\x12'\n" +
+	"\x0fcontent_message\x18\x02 \x01(\tR\x0econtentMessage2\x88\x04\n" +
+	"\x0fCategoryService\x12e\n" +
+	"\x0eCreateCategory\x12(.expensetracker.v1.CreateCategoryRequest\x1a).expensetracker.v1.CreateCategoryResponse\x12\\\n" +
+	"\vGetCategory\x12%.expensetracker.v1.GetCategoryRequest\x1a&.expensetracker.v1.GetCategoryResponse\x12b\n" +
+	"\rGetCategories\x12'.expensetracker.v1.GetCategoriesRequest\x1a(.expensetracker.v1.GetCategoriesResponse\x12e\n" +
+	"\x0eUpdateCategory\x12(.expensetracker.v1.UpdateCategoryRequest\x1a).expensetracker.v1.UpdateCategoryResponse\x12e\n" +
+	"\x0eDeleteCategory\x12(.expensetracker.v1.DeleteCategoryRequest\x1a).expensetracker.v1.DeleteCategoryResponseB_Z]github.com/GuilhermeDeOliveiraAmorim/expense-tracker/proto/expensetracker/v1;expensetrackerv1b\x06proto3"
+
+var (
+	file_expensetracker_v1_category_proto_rawDescOnce sync.Once
+	file_expensetracker_v1_category_proto_rawDescData []byte
+)
+
+func file_expensetracker_v1_category_proto_rawDescGZIP() []byte {
+	file_expensetracker_v1_category_proto_rawDescOnce.Do(func() {
+		file_expensetracker_v1_category_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_expensetracker_v1_category_proto_rawDesc), len(file_expensetracker_v1_category_proto_rawDesc)))
+	})
+	return file_expensetracker_v1_category_proto_rawDescData
+}
+
+var file_expensetracker_v1_category_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_expensetracker_v1_category_proto_goTypes = []any{
+	(*Category)(nil),               // 0: expensetracker.v1.Category
+	(*CreateCategoryRequest)(nil),  // 1: expensetracker.v1.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil), // 2: expensetracker.v1.CreateCategoryResponse
+	(*GetCategoryRequest)(nil),     // 3: expensetracker.v1.GetCategoryRequest
+	(*GetCategoryResponse)(nil),    // 4: expensetracker.v1.GetCategoryResponse
+	(*GetCategoriesRequest)(nil),   // 5: expensetracker.v1.GetCategoriesRequest
+	(*GetCategoriesResponse)(nil),  // 6: expensetracker.v1.GetCategoriesResponse
+	(*UpdateCategoryRequest)(nil),  // 7: expensetracker.v1.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil), // 8: expensetracker.v1.UpdateCategoryResponse
+	(*DeleteCategoryRequest)(nil),  // 9: expensetracker.v1.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil), // 10: expensetracker.v1.DeleteCategoryResponse
+	(*timestamppb.Timestamp)(nil),  // 11: google.protobuf.Timestamp
+}
+var file_expensetracker_v1_category_proto_depIdxs = []int32{
+	11, // 0: expensetracker.v1.Category.created_at:type_name -> google.protobuf.Timestamp
+	11, // 1: expensetracker.v1.Category.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: expensetracker.v1.GetCategoryResponse.category:type_name -> expensetracker.v1.Category
+	0,  // 3: expensetracker.v1.GetCategoriesResponse.categories:type_name -> expensetracker.v1.Category
+	1,  // 4: expensetracker.v1.CategoryService.CreateCategory:input_type -> expensetracker.v1.CreateCategoryRequest
+	3,  // 5: expensetracker.v1.CategoryService.GetCategory:input_type -> expensetracker.v1.GetCategoryRequest
+	5,  // 6: expensetracker.v1.CategoryService.GetCategories:input_type -> expensetracker.v1.GetCategoriesRequest
+	7,  // 7: expensetracker.v1.CategoryService.UpdateCategory:input_type -> expensetracker.v1.UpdateCategoryRequest
+	9,  // 8: expensetracker.v1.CategoryService.DeleteCategory:input_type -> expensetracker.v1.DeleteCategoryRequest
+	2,  // 9: expensetracker.v1.CategoryService.CreateCategory:output_type -> expensetracker.v1.CreateCategoryResponse
+	4,  // 10: expensetracker.v1.CategoryService.GetCategory:output_type -> expensetracker.v1.GetCategoryResponse
+	6,  // 11: expensetracker.v1.CategoryService.GetCategories:output_type -> expensetracker.v1.GetCategoriesResponse
+	8,  // 12: expensetracker.v1.CategoryService.UpdateCategory:output_type -> expensetracker.v1.UpdateCategoryResponse
+	10, // 13: expensetracker.v1.CategoryService.DeleteCategory:output_type -> expensetracker.v1.DeleteCategoryResponse
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_expensetracker_v1_category_proto_init() }
+func file_expensetracker_v1_category_proto_init() {
+	if File_expensetracker_v1_category_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_expensetracker_v1_category_proto_rawDesc), len(file_expensetracker_v1_category_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_expensetracker_v1_category_proto_goTypes,
+		DependencyIndexes: file_expensetracker_v1_category_proto_depIdxs,
+		MessageInfos:      file_expensetracker_v1_category_proto_msgTypes,
+	}.Build()
+	File_expensetracker_v1_category_proto = out.File
+	file_expensetracker_v1_category_proto_goTypes = nil
+	file_expensetracker_v1_category_proto_depIdxs = nil
+}
@@ -0,0 +1,1141 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: expensetracker/v1/expense.proto
+
+package expensetrackerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Expense struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount                float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExpenseDate           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expense_date,json=expenseDate,proto3" json:"expense_date,omitempty"`
+	CategoryId            string                 `protobuf:"bytes,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	AccountId             string                 `protobuf:"bytes,5,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	MerchantId            string                 `protobuf:"bytes,6,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Notes                 string                 `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	TagIds                []string               `protobuf:"bytes,8,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	TaxCategory           string                 `protobuf:"bytes,9,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
+	ProviderTaxId         string                 `protobuf:"bytes,10,opt,name=provider_tax_id,json=providerTaxId,proto3" json:"provider_tax_id,omitempty"`
+	ProviderName          string                 `protobuf:"bytes,11,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+	Latitude              *float64               `protobuf:"fixed64,12,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude             *float64               `protobuf:"fixed64,13,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	PlaceName             string                 `protobuf:"bytes,14,opt,name=place_name,json=placeName,proto3" json:"place_name,omitempty"`
+	Category              *Category              `protobuf:"bytes,15,opt,name=category,proto3" json:"category,omitempty"`
+	Tags                  []*Tag                 `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
+	InstallmentPurchaseId string                 `protobuf:"bytes,17,opt,name=installment_purchase_id,json=installmentPurchaseId,proto3" json:"installment_purchase_id,omitempty"`
+	InstallmentNumber     int32                  `protobuf:"varint,18,opt,name=installment_number,json=installmentNumber,proto3" json:"installment_number,omitempty"`
+	StatementDate         *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=statement_date,json=statementDate,proto3" json:"statement_date,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Expense) Reset() {
+	*x = Expense{}
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Expense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Expense) ProtoMessage() {}
+
+func (x *Expense) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Expense.ProtoReflect.Descriptor instead.
+func (*Expense) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_expense_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Expense) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Expense) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Expense) GetExpenseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpenseDate
+	}
+	return nil
+}
+
+func (x *Expense) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *Expense) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Expense) GetMerchantId() string {
+	if x != nil {
+		return x.MerchantId
+	}
+	return ""
+}
+
+func (x *Expense) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Expense) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *Expense) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
+func (x *Expense) GetProviderTaxId() string {
+	if x != nil {
+		return x.ProviderTaxId
+	}
+	return ""
+}
+
+func (x *Expense) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (x *Expense) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *Expense) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *Expense) GetPlaceName() string {
+	if x != nil {
+		return x.PlaceName
+	}
+	return ""
+}
+
+func (x *Expense) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *Expense) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Expense) GetInstallmentPurchaseId() string {
+	if x != nil {
+		return x.InstallmentPurchaseId
+	}
+	return ""
+}
+
+func (x *Expense) GetInstallmentNumber() int32 {
+	if x != nil {
+		return x.InstallmentNumber
+	}
+	return 0
+}
+
+func (x *Expense) GetStatementDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatementDate
+	}
+	return nil
+}
+
+func (x *Expense) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Expense) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateExpenseRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Amount float64                `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Date in the user's timezone, DDMMYYYY.
+	ExpenseDate   string   `protobuf:"bytes,2,opt,name=expense_date,json=expenseDate,proto3" json:"expense_date,omitempty"`
+	CategoryId    string   `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	AccountId     string   `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	MerchantId    string   `protobuf:"bytes,5,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Notes         string   `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	Tags          []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	TaxCategory   string   `protobuf:"bytes,8,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
+	ProviderTaxId string   `protobuf:"bytes,9,opt,name=provider_tax_id,json=providerTaxId,proto3" json:"provider_tax_id,omitempty"`
+	ProviderName  string   `protobuf:"bytes,10,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+	Latitude      *float64 `protobuf:"fixed64,11,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude     *float64 `protobuf:"fixed64,12,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	PlaceName     string   `protobuf:"bytes,13,opt,name=place_name,json=placeName,proto3" json:"place_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExpenseRequest) Reset() {
+	*x = CreateExpenseRequest{}
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExpenseRequest) ProtoMessage() {}
+
+func (x *CreateExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExpenseRequest.ProtoReflect.Descriptor instead.
+func (*CreateExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_expense_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateExpenseRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateExpenseRequest) GetExpenseDate() string {
+	if x != nil {
+		return x.ExpenseDate
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetMerchantId() string {
+	if x != nil {
+		return x.MerchantId
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateExpenseRequest) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetProviderTaxId() string {
+	if x != nil {
+		return x.ProviderTaxId
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *CreateExpenseRequest) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *CreateExpenseRequest) GetPlaceName() string {
+	if x != nil {
+		return x.PlaceName
+	}
+	return ""
+}
+
+type CreateExpenseResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId      string                 `protobuf:"bytes,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	SuccessMessage string                 `protobuf:"bytes,2,opt,name=success_message,json=successMessage,proto3" json:"success_message,omitempty"`
+	ContentMessage string                 `protobuf:"bytes,3,opt,name=content_message,json=contentMessage,proto3" json:"content_message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateExpenseResponse) Reset() {
+	*x = CreateExpenseResponse{}
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExpenseResponse) ProtoMessage() {}
+
+func (x *CreateExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExpenseResponse.ProtoReflect.Descriptor instead.
+func (*CreateExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_expense_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateExpenseResponse) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+func (x *CreateExpenseResponse) GetSuccessMessage() string {
+	if x != nil {
+		return x.SuccessMessage
+	}
+	return ""
+}
+
+func (x *CreateExpenseResponse) GetContentMessage() string {
+	if x != nil {
+		return x.ContentMessage
+	}
+	return ""
+}
+
+type GetExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId     string                 `protobuf:"bytes,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExpenseRequest) Reset() {
+	*x = GetExpenseRequest{}
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpenseRequest) ProtoMessage() {}
+
+func (x *GetExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpenseRequest.ProtoReflect.Descriptor instead.
+func (*GetExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_expense_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetExpenseRequest) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+type GetExpenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expense       *Expense               `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExpenseResponse) Reset() {
+	*x = GetExpenseResponse{}
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpenseResponse) ProtoMessage() {}
+
+func (x *GetExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpenseResponse.ProtoReflect.Descriptor instead.
+func (*GetExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_expense_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetExpenseResponse) GetExpense() *Expense {
+	if x != nil {
+		return x.Expense
+	}
+	return nil
+}
+
+type GetExpensesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExpensesRequest) Reset() {
+	*x = GetExpensesRequest{}
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExpensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpensesRequest) ProtoMessage() {}
+
+func (x *GetExpensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpensesRequest.ProtoReflect.Descriptor instead.
+func (*GetExpensesRequest) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_expense_proto_rawDescGZIP(), []int{5}
+}
+
+type GetExpensesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expenses      []*Expense             `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExpensesResponse) Reset() {
+	*x = GetExpensesResponse{}
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExpensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpensesResponse) ProtoMessage() {}
+
+func (x *GetExpensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpensesResponse.ProtoReflect.Descriptor instead.
+func (*GetExpensesResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_expense_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetExpensesResponse) GetExpenses() []*Expense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+type StreamExpensesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamExpensesRequest) Reset() {
+	*x = StreamExpensesRequest{}
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamExpensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamExpensesRequest) ProtoMessage() {}
+
+func (x *StreamExpensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamExpensesRequest.ProtoReflect.Descriptor instead.
+func (*StreamExpensesRequest) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_expense_proto_rawDescGZIP(), []int{7}
+}
+
+// Zero and empty fields are left unchanged.
+type UpdateExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId     string                 `protobuf:"bytes,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExpenseDate   string                 `protobuf:"bytes,3,opt,name=expense_date,json=expenseDate,proto3" json:"expense_date,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,5,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	MerchantId    string                 `protobuf:"bytes,6,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Notes         string                 `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	TaxCategory   string                 `protobuf:"bytes,9,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
+	ProviderTaxId string                 `protobuf:"bytes,10,opt,name=provider_tax_id,json=providerTaxId,proto3" json:"provider_tax_id,omitempty"`
+	ProviderName  string                 `protobuf:"bytes,11,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+	Latitude      *float64               `protobuf:"fixed64,12,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude     *float64               `protobuf:"fixed64,13,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	PlaceName     string                 `protobuf:"bytes,14,opt,name=place_name,json=placeName,proto3" json:"place_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExpenseRequest) Reset() {
+	*x = UpdateExpenseRequest{}
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExpenseRequest) ProtoMessage() {}
+
+func (x *UpdateExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExpenseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_expense_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateExpenseRequest) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+func (x *UpdateExpenseRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UpdateExpenseRequest) GetExpenseDate() string {
+	if x != nil {
+		return x.ExpenseDate
+	}
+	return ""
+}
+
+func (x *UpdateExpenseRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *UpdateExpenseRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *UpdateExpenseRequest) GetMerchantId() string {
+	if x != nil {
+		return x.MerchantId
+	}
+	return ""
+}
+
+func (x *UpdateExpenseRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateExpenseRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateExpenseRequest) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
+func (x *UpdateExpenseRequest) GetProviderTaxId() string {
+	if x != nil {
+		return x.ProviderTaxId
+	}
+	return ""
+}
+
+func (x *UpdateExpenseRequest) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (x *UpdateExpenseRequest) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *UpdateExpenseRequest) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *UpdateExpenseRequest) GetPlaceName() string {
+	if x != nil {
+		return x.PlaceName
+	}
+	return ""
+}
+
+type UpdateExpenseResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId      string                 `protobuf:"bytes,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	SuccessMessage string                 `protobuf:"bytes,2,opt,name=success_message,json=successMessage,proto3" json:"success_message,omitempty"`
+	ContentMessage string                 `protobuf:"bytes,3,opt,name=content_message,json=contentMessage,proto3" json:"content_message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateExpenseResponse) Reset() {
+	*x = UpdateExpenseResponse{}
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExpenseResponse) ProtoMessage() {}
+
+func (x *UpdateExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExpenseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_expense_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateExpenseResponse) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+func (x *UpdateExpenseResponse) GetSuccessMessage() string {
+	if x != nil {
+		return x.SuccessMessage
+	}
+	return ""
+}
+
+func (x *UpdateExpenseResponse) GetContentMessage() string {
+	if x != nil {
+		return x.ContentMessage
+	}
+	return ""
+}
+
+type DeleteExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId     string                 `protobuf:"bytes,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExpenseRequest) Reset() {
+	*x = DeleteExpenseRequest{}
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpenseRequest) ProtoMessage() {}
+
+func (x *DeleteExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpenseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_expense_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteExpenseRequest) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+type DeleteExpenseResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SuccessMessage string                 `protobuf:"bytes,1,opt,name=success_message,json=successMessage,proto3" json:"success_message,omitempty"`
+	ContentMessage string                 `protobuf:"bytes,2,opt,name=content_message,json=contentMessage,proto3" json:"content_message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteExpenseResponse) Reset() {
+	*x = DeleteExpenseResponse{}
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpenseResponse) ProtoMessage() {}
+
+func (x *DeleteExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_expense_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpenseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_expense_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteExpenseResponse) GetSuccessMessage() string {
+	if x != nil {
+		return x.SuccessMessage
+	}
+	return ""
+}
+
+func (x *DeleteExpenseResponse) GetContentMessage() string {
+	if x != nil {
+		return x.ContentMessage
+	}
+	return ""
+}
+
+var File_expensetracker_v1_expense_proto protoreflect.FileDescriptor
+
+const file_expensetracker_v1_expense_proto_rawDesc = "" +
+	"\n" +
+	"\x1fexpensetracker/v1/expense.proto\x12\x11expensetracker.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a expensetracker/v1/category.proto\x1a\x1bexpensetracker/v1/tag.proto\"\xf3\x06\n" +
+	"\aExpense\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12=\n" +
+	"\fexpense_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vexpenseDate\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\tR\n" +
+	"categoryId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x05 \x01(\tR\taccountId\x12\x1f\n" +
+	"\vmerchant_id\x18\x06 \x01(\tR\n" +
+	"merchantId\x12\x14\n" +
+	"\x05notes\x18\a \x01(\tR\x05notes\x12\x17\n" +
+	"\atag_ids\x18\b \x03(\tR\x06tagIds\x12!\n" +
+	"\ftax_category\x18\t \x01(\tR\vtaxCategory\x12&\n" +
+	"\x0fprovider_tax_id\x18\n" +
+	" \x01(\tR\rproviderTaxId\x12#\n" +
+	"\rprovider_name\x18\v \x01(\tR\fproviderName\x12\x1f\n" +
+	"\blatitude\x18\f \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\r \x01(\x01H\x01R\tlongitude\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"place_name\x18\x0e \x01(\tR\tplaceName\x127\n" +
+	"\bcategory\x18\x0f \x01(\v2\x1b.expensetracker.v1.CategoryR\bcategory\x12*\n" +
+	"\x04tags\x18\x10 \x03(\v2\x16.expensetracker.v1.TagR\x04tags\x126\n" +
+	"\x17installment_purchase_id\x18\x11 \x01(\tR\x15installmentPurchaseId\x12-\n" +
+	"\x12installment_number\x18\x12 \x01(\x05R\x11installmentNumber\x12A\n" +
+	"\x0estatement_date\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\rstatementDate\x129\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"\xca\x03\n" +
+	"\x14CreateExpenseRequest\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x01R\x06amount\x12!\n" +
+	"\fexpense_date\x18\x02 \x01(\tR\vexpenseDate\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\tR\n" +
+	"categoryId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x04 \x01(\tR\taccountId\x12\x1f\n" +
+	"\vmerchant_id\x18\x05 \x01(\tR\n" +
+	"merchantId\x12\x14\n" +
+	"\x05notes\x18\x06 \x01(\tR\x05notes\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12!\n" +
+	"\ftax_category\x18\b \x01(\tR\vtaxCategory\x12&\n" +
+	"\x0fprovider_tax_id\x18\t \x01(\tR\rproviderTaxId\x12#\n" +
+	"\rprovider_name\x18\n" +
+	" \x01(\tR\fproviderName\x12\x1f\n" +
+	"\blatitude\x18\v \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\f \x01(\x01H\x01R\tlongitude\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"place_name\x18\r \x01(\tR\tplaceNameB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"\x88\x01\n" +
+	"\x15CreateExpenseResponse\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\tR\texpenseId\x12'\n" +
+	"\x0fsuccess_message\x18\x02 \x01(\tR\x0esuccessMessage\x12'\n" +
+	"\x0fcontent_message\x18\x03 \x01(\tR\x0econtentMessage\"2\n" +
+	"\x11GetExpenseRequest\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\tR\texpenseId\"J\n" +
+	"\x12GetExpenseResponse\x124\n" +
+	"\aexpense\x18\x01 \x01(\v2\x1a.expensetracker.v1.ExpenseR\aexpense\"\x14\n" +
+	"\x12GetExpensesRequest\"M\n" +
+	"\x13GetExpensesResponse\x126\n" +
+	"\bexpenses\x18\x01 \x03(\v2\x1a.expensetracker.v1.ExpenseR\bexpenses\"\x17\n" +
+	"\x15StreamExpensesRequest\"\xe9\x03\n" +
+	"\x14UpdateExpenseRequest\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\tR\texpenseId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12!\n" +
+	"\fexpense_date\x18\x03 \x01(\tR\vexpenseDate\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\tR\n" +
+	"categoryId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x05 \x01(\tR\taccountId\x12\x1f\n" +
+	"\vmerchant_id\x18\x06 \x01(\tR\n" +
+	"merchantId\x12\x14\n" +
+	"\x05notes\x18\a \x01(\tR\x05notes\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12!\n" +
+	"\ftax_category\x18\t \x01(\tR\vtaxCategory\x12&\n" +
+	"\x0fprovider_tax_id\x18\n" +
+	" \x01(\tR\rproviderTaxId\x12#\n" +
+	"\rprovider_name\x18\v \x01(\tR\fproviderName\x12\x1f\n" +
+	"\blatitude\x18\f \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\r \x01(\x01H\x01R\tlongitude\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"place_name\x18\x0e \x01(\tR\tplaceNameB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"\x88\x01\n" +
+	"\x15UpdateExpenseResponse\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\tR\texpenseId\x12'\n" +
+	"\x0fsuccess_message\x18\x02 \x01(\tR\x0esuccessMessage\x12'\n" +
+	"\x0fcontent_message\x18\x03 \x01(\tR\x0econtentMessage\"5\n" +
+	"\x14DeleteExpenseRequest\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\tR\texpenseId\"i\n" +
+	"\x15DeleteExpenseResponse\x12'\n" +
+	"\x0fsuccess_message\x18\x01 \x01(\tR\x0esuccessMessage\x12'\n" +
+	"\x0fcontent_message\x18\x02 \x01(\tR\x0econtentMessage2\xcf\x04\n" +
+	"\x0eExpenseService\x12b\n" +
+	"\rCreateExpense\x12'.expensetracker.v1.CreateExpenseRequest\x1a(.expensetracker.v1.CreateExpenseResponse\x12Y\n" +
+	"\n" +
+	"GetExpense\x12$.expensetracker.v1.GetExpenseRequest\x1a%.expensetracker.v1.GetExpenseResponse\x12\\\n" +
+	"\vGetExpenses\x12%.expensetracker.v1.GetExpensesRequest\x1a&.expensetracker.v1.GetExpensesResponse\x12X\n" +
+	"\x0eStreamExpenses\x12(.expensetracker.v1.StreamExpensesRequest\x1a\x1a.expensetracker.v1.Expense0\x01\x12b\n" +
+	"\rUpdateExpense\x12'.expensetracker.v1.UpdateExpenseRequest\x1a(.expensetracker.v1.UpdateExpenseResponse\x12b\n" +
+	"\rDeleteExpense\x12'.expensetracker.v1.DeleteExpenseRequest\x1a(.expensetracker.v1.DeleteExpenseResponseB_Z]github.com/GuilhermeDeOliveiraAmorim/expense-tracker/proto/expensetracker/v1;expensetrackerv1b\x06proto3"
+
+var (
+	file_expensetracker_v1_expense_proto_rawDescOnce sync.Once
+	file_expensetracker_v1_expense_proto_rawDescData []byte
+)
+
+func file_expensetracker_v1_expense_proto_rawDescGZIP() []byte {
+	file_expensetracker_v1_expense_proto_rawDescOnce.Do(func() {
+		file_expensetracker_v1_expense_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_expensetracker_v1_expense_proto_rawDesc), len(file_expensetracker_v1_expense_proto_rawDesc)))
+	})
+	return file_expensetracker_v1_expense_proto_rawDescData
+}
+
+var file_expensetracker_v1_expense_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_expensetracker_v1_expense_proto_goTypes = []any{
+	(*Expense)(nil),               // 0: expensetracker.v1.Expense
+	(*CreateExpenseRequest)(nil),  // 1: expensetracker.v1.CreateExpenseRequest
+	(*CreateExpenseResponse)(nil), // 2: expensetracker.v1.CreateExpenseResponse
+	(*GetExpenseRequest)(nil),     // 3: expensetracker.v1.GetExpenseRequest
+	(*GetExpenseResponse)(nil),    // 4: expensetracker.v1.GetExpenseResponse
+	(*GetExpensesRequest)(nil),    // 5: expensetracker.v1.GetExpensesRequest
+	(*GetExpensesResponse)(nil),   // 6: expensetracker.v1.GetExpensesResponse
+	(*StreamExpensesRequest)(nil), // 7: expensetracker.v1.StreamExpensesRequest
+	(*UpdateExpenseRequest)(nil),  // 8: expensetracker.v1.UpdateExpenseRequest
+	(*UpdateExpenseResponse)(nil), // 9: expensetracker.v1.UpdateExpenseResponse
+	(*DeleteExpenseRequest)(nil),  // 10: expensetracker.v1.DeleteExpenseRequest
+	(*DeleteExpenseResponse)(nil), // 11: expensetracker.v1.DeleteExpenseResponse
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+	(*Category)(nil),              // 13: expensetracker.v1.Category
+	(*Tag)(nil),                   // 14: expensetracker.v1.Tag
+}
+var file_expensetracker_v1_expense_proto_depIdxs = []int32{
+	12, // 0: expensetracker.v1.Expense.expense_date:type_name -> google.protobuf.Timestamp
+	13, // 1: expensetracker.v1.Expense.category:type_name -> expensetracker.v1.Category
+	14, // 2: expensetracker.v1.Expense.tags:type_name -> expensetracker.v1.Tag
+	12, // 3: expensetracker.v1.Expense.statement_date:type_name -> google.protobuf.Timestamp
+	12, // 4: expensetracker.v1.Expense.created_at:type_name -> google.protobuf.Timestamp
+	12, // 5: expensetracker.v1.Expense.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: expensetracker.v1.GetExpenseResponse.expense:type_name -> expensetracker.v1.Expense
+	0,  // 7: expensetracker.v1.GetExpensesResponse.expenses:type_name -> expensetracker.v1.Expense
+	1,  // 8: expensetracker.v1.ExpenseService.CreateExpense:input_type -> expensetracker.v1.CreateExpenseRequest
+	3,  // 9: expensetracker.v1.ExpenseService.GetExpense:input_type -> expensetracker.v1.GetExpenseRequest
+	5,  // 10: expensetracker.v1.ExpenseService.GetExpenses:input_type -> expensetracker.v1.GetExpensesRequest
+	7,  // 11: expensetracker.v1.ExpenseService.StreamExpenses:input_type -> expensetracker.v1.StreamExpensesRequest
+	8,  // 12: expensetracker.v1.ExpenseService.UpdateExpense:input_type -> expensetracker.v1.UpdateExpenseRequest
+	10, // 13: expensetracker.v1.ExpenseService.DeleteExpense:input_type -> expensetracker.v1.DeleteExpenseRequest
+	2,  // 14: expensetracker.v1.ExpenseService.CreateExpense:output_type -> expensetracker.v1.CreateExpenseResponse
+	4,  // 15: expensetracker.v1.ExpenseService.GetExpense:output_type -> expensetracker.v1.GetExpenseResponse
+	6,  // 16: expensetracker.v1.ExpenseService.GetExpenses:output_type -> expensetracker.v1.GetExpensesResponse
+	0,  // 17: expensetracker.v1.ExpenseService.StreamExpenses:output_type -> expensetracker.v1.Expense
+	9,  // 18: expensetracker.v1.ExpenseService.UpdateExpense:output_type -> expensetracker.v1.UpdateExpenseResponse
+	11, // 19: expensetracker.v1.ExpenseService.DeleteExpense:output_type -> expensetracker.v1.DeleteExpenseResponse
+	14, // [14:20] is the sub-list for method output_type
+	8,  // [8:14] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_expensetracker_v1_expense_proto_init() }
+func file_expensetracker_v1_expense_proto_init() {
+	if File_expensetracker_v1_expense_proto != nil {
+		return
+	}
+	file_expensetracker_v1_category_proto_init()
+	file_expensetracker_v1_tag_proto_init()
+	file_expensetracker_v1_expense_proto_msgTypes[0].OneofWrappers = []any{}
+	file_expensetracker_v1_expense_proto_msgTypes[1].OneofWrappers = []any{}
+	file_expensetracker_v1_expense_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_expensetracker_v1_expense_proto_rawDesc), len(file_expensetracker_v1_expense_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_expensetracker_v1_expense_proto_goTypes,
+		DependencyIndexes: file_expensetracker_v1_expense_proto_depIdxs,
+		MessageInfos:      file_expensetracker_v1_expense_proto_msgTypes,
+	}.Build()
+	File_expensetracker_v1_expense_proto = out.File
+	file_expensetracker_v1_expense_proto_goTypes = nil
+	file_expensetracker_v1_expense_proto_depIdxs = nil
+}
@@ -0,0 +1,1939 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: expensetracker/v1/presenters.proto
+
+package expensetrackerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Basis         string                 `protobuf:"bytes,3,opt,name=basis,proto3" json:"basis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeriodRequest) Reset() {
+	*x = PeriodRequest{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodRequest) ProtoMessage() {}
+
+func (x *PeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodRequest.ProtoReflect.Descriptor instead.
+func (*PeriodRequest) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PeriodRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *PeriodRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *PeriodRequest) GetBasis() string {
+	if x != nil {
+		return x.Basis
+	}
+	return ""
+}
+
+type YearRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Basis         string                 `protobuf:"bytes,2,opt,name=basis,proto3" json:"basis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *YearRequest) Reset() {
+	*x = YearRequest{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *YearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*YearRequest) ProtoMessage() {}
+
+func (x *YearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use YearRequest.ProtoReflect.Descriptor instead.
+func (*YearRequest) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *YearRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *YearRequest) GetBasis() string {
+	if x != nil {
+		return x.Basis
+	}
+	return ""
+}
+
+type MonthYearRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         int32                  `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonthYearRequest) Reset() {
+	*x = MonthYearRequest{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthYearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthYearRequest) ProtoMessage() {}
+
+func (x *MonthYearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthYearRequest.ProtoReflect.Descriptor instead.
+func (*MonthYearRequest) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MonthYearRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *MonthYearRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type MonthOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonthOption) Reset() {
+	*x = MonthOption{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthOption) ProtoMessage() {}
+
+func (x *MonthOption) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthOption.ProtoReflect.Descriptor instead.
+func (*MonthOption) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MonthOption) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MonthOption) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type CategoryExpense struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryName  string                 `protobuf:"bytes,1,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	CategoryColor string                 `protobuf:"bytes,2,opt,name=category_color,json=categoryColor,proto3" json:"category_color,omitempty"`
+	Total         float64                `protobuf:"fixed64,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryExpense) Reset() {
+	*x = CategoryExpense{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryExpense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryExpense) ProtoMessage() {}
+
+func (x *CategoryExpense) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryExpense.ProtoReflect.Descriptor instead.
+func (*CategoryExpense) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CategoryExpense) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryExpense) GetCategoryColor() string {
+	if x != nil {
+		return x.CategoryColor
+	}
+	return ""
+}
+
+func (x *CategoryExpense) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type TagExpense struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagName       string                 `protobuf:"bytes,1,opt,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`
+	TagColor      string                 `protobuf:"bytes,2,opt,name=tag_color,json=tagColor,proto3" json:"tag_color,omitempty"`
+	Total         float64                `protobuf:"fixed64,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagExpense) Reset() {
+	*x = TagExpense{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagExpense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagExpense) ProtoMessage() {}
+
+func (x *TagExpense) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagExpense.ProtoReflect.Descriptor instead.
+func (*TagExpense) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TagExpense) GetTagName() string {
+	if x != nil {
+		return x.TagName
+	}
+	return ""
+}
+
+func (x *TagExpense) GetTagColor() string {
+	if x != nil {
+		return x.TagColor
+	}
+	return ""
+}
+
+func (x *TagExpense) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetTotalExpensesForPeriodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         float64                `protobuf:"fixed64,1,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTotalExpensesForPeriodResponse) Reset() {
+	*x = GetTotalExpensesForPeriodResponse{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTotalExpensesForPeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTotalExpensesForPeriodResponse) ProtoMessage() {}
+
+func (x *GetTotalExpensesForPeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTotalExpensesForPeriodResponse.ProtoReflect.Descriptor instead.
+func (*GetTotalExpensesForPeriodResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTotalExpensesForPeriodResponse) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetExpensesByCategoryPeriodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expenses      []*CategoryExpense     `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExpensesByCategoryPeriodResponse) Reset() {
+	*x = GetExpensesByCategoryPeriodResponse{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExpensesByCategoryPeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpensesByCategoryPeriodResponse) ProtoMessage() {}
+
+func (x *GetExpensesByCategoryPeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpensesByCategoryPeriodResponse.ProtoReflect.Descriptor instead.
+func (*GetExpensesByCategoryPeriodResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetExpensesByCategoryPeriodResponse) GetExpenses() []*CategoryExpense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+type MonthlyCategoryExpense struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	MonthNumber   int32                  `protobuf:"varint,2,opt,name=month_number,json=monthNumber,proto3" json:"month_number,omitempty"`
+	Year          int32                  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	Categories    []*CategoryExpense     `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	Total         float64                `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonthlyCategoryExpense) Reset() {
+	*x = MonthlyCategoryExpense{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthlyCategoryExpense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthlyCategoryExpense) ProtoMessage() {}
+
+func (x *MonthlyCategoryExpense) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthlyCategoryExpense.ProtoReflect.Descriptor instead.
+func (*MonthlyCategoryExpense) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MonthlyCategoryExpense) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *MonthlyCategoryExpense) GetMonthNumber() int32 {
+	if x != nil {
+		return x.MonthNumber
+	}
+	return 0
+}
+
+func (x *MonthlyCategoryExpense) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *MonthlyCategoryExpense) GetCategories() []*CategoryExpense {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *MonthlyCategoryExpense) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetMonthlyExpensesByCategoryYearResponse struct {
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	Expenses       []*MonthlyCategoryExpense `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	AvailableYears []int32                   `protobuf:"varint,2,rep,packed,name=available_years,json=availableYears,proto3" json:"available_years,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetMonthlyExpensesByCategoryYearResponse) Reset() {
+	*x = GetMonthlyExpensesByCategoryYearResponse{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMonthlyExpensesByCategoryYearResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMonthlyExpensesByCategoryYearResponse) ProtoMessage() {}
+
+func (x *GetMonthlyExpensesByCategoryYearResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMonthlyExpensesByCategoryYearResponse.ProtoReflect.Descriptor instead.
+func (*GetMonthlyExpensesByCategoryYearResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetMonthlyExpensesByCategoryYearResponse) GetExpenses() []*MonthlyCategoryExpense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *GetMonthlyExpensesByCategoryYearResponse) GetAvailableYears() []int32 {
+	if x != nil {
+		return x.AvailableYears
+	}
+	return nil
+}
+
+type MonthlyTagExpense struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	MonthNumber   int32                  `protobuf:"varint,2,opt,name=month_number,json=monthNumber,proto3" json:"month_number,omitempty"`
+	Year          int32                  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	Tags          []*TagExpense          `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Total         float64                `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonthlyTagExpense) Reset() {
+	*x = MonthlyTagExpense{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthlyTagExpense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthlyTagExpense) ProtoMessage() {}
+
+func (x *MonthlyTagExpense) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthlyTagExpense.ProtoReflect.Descriptor instead.
+func (*MonthlyTagExpense) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MonthlyTagExpense) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *MonthlyTagExpense) GetMonthNumber() int32 {
+	if x != nil {
+		return x.MonthNumber
+	}
+	return 0
+}
+
+func (x *MonthlyTagExpense) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *MonthlyTagExpense) GetTags() []*TagExpense {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *MonthlyTagExpense) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetMonthlyExpensesByTagYearResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Expenses       []*MonthlyTagExpense   `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	AvailableYears []int32                `protobuf:"varint,2,rep,packed,name=available_years,json=availableYears,proto3" json:"available_years,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetMonthlyExpensesByTagYearResponse) Reset() {
+	*x = GetMonthlyExpensesByTagYearResponse{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMonthlyExpensesByTagYearResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMonthlyExpensesByTagYearResponse) ProtoMessage() {}
+
+func (x *GetMonthlyExpensesByTagYearResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMonthlyExpensesByTagYearResponse.ProtoReflect.Descriptor instead.
+func (*GetMonthlyExpensesByTagYearResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetMonthlyExpensesByTagYearResponse) GetExpenses() []*MonthlyTagExpense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *GetMonthlyExpensesByTagYearResponse) GetAvailableYears() []int32 {
+	if x != nil {
+		return x.AvailableYears
+	}
+	return nil
+}
+
+type GetTotalExpensesForCurrentMonthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTotalExpensesForCurrentMonthRequest) Reset() {
+	*x = GetTotalExpensesForCurrentMonthRequest{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTotalExpensesForCurrentMonthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTotalExpensesForCurrentMonthRequest) ProtoMessage() {}
+
+func (x *GetTotalExpensesForCurrentMonthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTotalExpensesForCurrentMonthRequest.ProtoReflect.Descriptor instead.
+func (*GetTotalExpensesForCurrentMonthRequest) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{12}
+}
+
+type GetTotalExpensesForCurrentMonthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalExpenses float64                `protobuf:"fixed64,1,opt,name=total_expenses,json=totalExpenses,proto3" json:"total_expenses,omitempty"`
+	CurrentMonth  string                 `protobuf:"bytes,2,opt,name=current_month,json=currentMonth,proto3" json:"current_month,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTotalExpensesForCurrentMonthResponse) Reset() {
+	*x = GetTotalExpensesForCurrentMonthResponse{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTotalExpensesForCurrentMonthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTotalExpensesForCurrentMonthResponse) ProtoMessage() {}
+
+func (x *GetTotalExpensesForCurrentMonthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTotalExpensesForCurrentMonthResponse.ProtoReflect.Descriptor instead.
+func (*GetTotalExpensesForCurrentMonthResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetTotalExpensesForCurrentMonthResponse) GetTotalExpenses() float64 {
+	if x != nil {
+		return x.TotalExpenses
+	}
+	return 0
+}
+
+func (x *GetTotalExpensesForCurrentMonthResponse) GetCurrentMonth() string {
+	if x != nil {
+		return x.CurrentMonth
+	}
+	return ""
+}
+
+type GetTotalExpensesForCurrentWeekRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Also returns last week's total and the change from it.
+	Compare       bool `protobuf:"varint,1,opt,name=compare,proto3" json:"compare,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTotalExpensesForCurrentWeekRequest) Reset() {
+	*x = GetTotalExpensesForCurrentWeekRequest{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTotalExpensesForCurrentWeekRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTotalExpensesForCurrentWeekRequest) ProtoMessage() {}
+
+func (x *GetTotalExpensesForCurrentWeekRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTotalExpensesForCurrentWeekRequest.ProtoReflect.Descriptor instead.
+func (*GetTotalExpensesForCurrentWeekRequest) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetTotalExpensesForCurrentWeekRequest) GetCompare() bool {
+	if x != nil {
+		return x.Compare
+	}
+	return false
+}
+
+type GetTotalExpensesForCurrentWeekResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TotalExpenses     float64                `protobuf:"fixed64,1,opt,name=total_expenses,json=totalExpenses,proto3" json:"total_expenses,omitempty"`
+	CurrentWeek       string                 `protobuf:"bytes,2,opt,name=current_week,json=currentWeek,proto3" json:"current_week,omitempty"`
+	WeekStart         string                 `protobuf:"bytes,3,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`
+	PreviousWeekTotal *float64               `protobuf:"fixed64,4,opt,name=previous_week_total,json=previousWeekTotal,proto3,oneof" json:"previous_week_total,omitempty"`
+	PreviousWeek      string                 `protobuf:"bytes,5,opt,name=previous_week,json=previousWeek,proto3" json:"previous_week,omitempty"`
+	Change            *float64               `protobuf:"fixed64,6,opt,name=change,proto3,oneof" json:"change,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetTotalExpensesForCurrentWeekResponse) Reset() {
+	*x = GetTotalExpensesForCurrentWeekResponse{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTotalExpensesForCurrentWeekResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTotalExpensesForCurrentWeekResponse) ProtoMessage() {}
+
+func (x *GetTotalExpensesForCurrentWeekResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTotalExpensesForCurrentWeekResponse.ProtoReflect.Descriptor instead.
+func (*GetTotalExpensesForCurrentWeekResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetTotalExpensesForCurrentWeekResponse) GetTotalExpenses() float64 {
+	if x != nil {
+		return x.TotalExpenses
+	}
+	return 0
+}
+
+func (x *GetTotalExpensesForCurrentWeekResponse) GetCurrentWeek() string {
+	if x != nil {
+		return x.CurrentWeek
+	}
+	return ""
+}
+
+func (x *GetTotalExpensesForCurrentWeekResponse) GetWeekStart() string {
+	if x != nil {
+		return x.WeekStart
+	}
+	return ""
+}
+
+func (x *GetTotalExpensesForCurrentWeekResponse) GetPreviousWeekTotal() float64 {
+	if x != nil && x.PreviousWeekTotal != nil {
+		return *x.PreviousWeekTotal
+	}
+	return 0
+}
+
+func (x *GetTotalExpensesForCurrentWeekResponse) GetPreviousWeek() string {
+	if x != nil {
+		return x.PreviousWeek
+	}
+	return ""
+}
+
+func (x *GetTotalExpensesForCurrentWeekResponse) GetChange() float64 {
+	if x != nil && x.Change != nil {
+		return *x.Change
+	}
+	return 0
+}
+
+type MonthTotal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	MonthNumber   int32                  `protobuf:"varint,2,opt,name=month_number,json=monthNumber,proto3" json:"month_number,omitempty"`
+	Total         float64                `protobuf:"fixed64,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonthTotal) Reset() {
+	*x = MonthTotal{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthTotal) ProtoMessage() {}
+
+func (x *MonthTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthTotal.ProtoReflect.Descriptor instead.
+func (*MonthTotal) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MonthTotal) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *MonthTotal) GetMonthNumber() int32 {
+	if x != nil {
+		return x.MonthNumber
+	}
+	return 0
+}
+
+func (x *MonthTotal) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetTotalExpensesMonthCurrentYearResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Year           int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Total          float64                `protobuf:"fixed64,2,opt,name=total,proto3" json:"total,omitempty"`
+	Months         []*MonthTotal          `protobuf:"bytes,3,rep,name=months,proto3" json:"months,omitempty"`
+	AvailableYears []int32                `protobuf:"varint,4,rep,packed,name=available_years,json=availableYears,proto3" json:"available_years,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTotalExpensesMonthCurrentYearResponse) Reset() {
+	*x = GetTotalExpensesMonthCurrentYearResponse{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTotalExpensesMonthCurrentYearResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTotalExpensesMonthCurrentYearResponse) ProtoMessage() {}
+
+func (x *GetTotalExpensesMonthCurrentYearResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTotalExpensesMonthCurrentYearResponse.ProtoReflect.Descriptor instead.
+func (*GetTotalExpensesMonthCurrentYearResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetTotalExpensesMonthCurrentYearResponse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetTotalExpensesMonthCurrentYearResponse) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetTotalExpensesMonthCurrentYearResponse) GetMonths() []*MonthTotal {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+func (x *GetTotalExpensesMonthCurrentYearResponse) GetAvailableYears() []int32 {
+	if x != nil {
+		return x.AvailableYears
+	}
+	return nil
+}
+
+type CategoryTagTotal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TagAmount     float64                `protobuf:"fixed64,2,opt,name=tag_amount,json=tagAmount,proto3" json:"tag_amount,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryTagTotal) Reset() {
+	*x = CategoryTagTotal{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryTagTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTagTotal) ProtoMessage() {}
+
+func (x *CategoryTagTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTagTotal.ProtoReflect.Descriptor instead.
+func (*CategoryTagTotal) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CategoryTagTotal) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryTagTotal) GetTagAmount() float64 {
+	if x != nil {
+		return x.TagAmount
+	}
+	return 0
+}
+
+func (x *CategoryTagTotal) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type CategoryWithTags struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CategoryAmount float64                `protobuf:"fixed64,2,opt,name=category_amount,json=categoryAmount,proto3" json:"category_amount,omitempty"`
+	Color          string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	Tags           []*CategoryTagTotal    `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CategoryWithTags) Reset() {
+	*x = CategoryWithTags{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryWithTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryWithTags) ProtoMessage() {}
+
+func (x *CategoryWithTags) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryWithTags.ProtoReflect.Descriptor instead.
+func (*CategoryWithTags) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CategoryWithTags) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryWithTags) GetCategoryAmount() float64 {
+	if x != nil {
+		return x.CategoryAmount
+	}
+	return 0
+}
+
+func (x *CategoryWithTags) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *CategoryWithTags) GetTags() []*CategoryTagTotal {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type GetCategoryTagsTotalsByMonthYearResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Month           string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	MonthNumber     int32                  `protobuf:"varint,2,opt,name=month_number,json=monthNumber,proto3" json:"month_number,omitempty"`
+	Year            int32                  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	ExpensesAmount  float64                `protobuf:"fixed64,4,opt,name=expenses_amount,json=expensesAmount,proto3" json:"expenses_amount,omitempty"`
+	Categories      []*CategoryWithTags    `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`
+	AvailableYears  []int32                `protobuf:"varint,6,rep,packed,name=available_years,json=availableYears,proto3" json:"available_years,omitempty"`
+	AvailableMonths []*MonthOption         `protobuf:"bytes,7,rep,name=available_months,json=availableMonths,proto3" json:"available_months,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCategoryTagsTotalsByMonthYearResponse) Reset() {
+	*x = GetCategoryTagsTotalsByMonthYearResponse{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTagsTotalsByMonthYearResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTagsTotalsByMonthYearResponse) ProtoMessage() {}
+
+func (x *GetCategoryTagsTotalsByMonthYearResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTagsTotalsByMonthYearResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryTagsTotalsByMonthYearResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetCategoryTagsTotalsByMonthYearResponse) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *GetCategoryTagsTotalsByMonthYearResponse) GetMonthNumber() int32 {
+	if x != nil {
+		return x.MonthNumber
+	}
+	return 0
+}
+
+func (x *GetCategoryTagsTotalsByMonthYearResponse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetCategoryTagsTotalsByMonthYearResponse) GetExpensesAmount() float64 {
+	if x != nil {
+		return x.ExpensesAmount
+	}
+	return 0
+}
+
+func (x *GetCategoryTagsTotalsByMonthYearResponse) GetCategories() []*CategoryWithTags {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetCategoryTagsTotalsByMonthYearResponse) GetAvailableYears() []int32 {
+	if x != nil {
+		return x.AvailableYears
+	}
+	return nil
+}
+
+func (x *GetCategoryTagsTotalsByMonthYearResponse) GetAvailableMonths() []*MonthOption {
+	if x != nil {
+		return x.AvailableMonths
+	}
+	return nil
+}
+
+type GetAvailableMonthsYearsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailableMonthsYearsRequest) Reset() {
+	*x = GetAvailableMonthsYearsRequest{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableMonthsYearsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableMonthsYearsRequest) ProtoMessage() {}
+
+func (x *GetAvailableMonthsYearsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableMonthsYearsRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailableMonthsYearsRequest) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{21}
+}
+
+type GetAvailableMonthsYearsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AvailableYears  []int32                `protobuf:"varint,1,rep,packed,name=available_years,json=availableYears,proto3" json:"available_years,omitempty"`
+	AvailableMonths []*MonthOption         `protobuf:"bytes,2,rep,name=available_months,json=availableMonths,proto3" json:"available_months,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetAvailableMonthsYearsResponse) Reset() {
+	*x = GetAvailableMonthsYearsResponse{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableMonthsYearsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableMonthsYearsResponse) ProtoMessage() {}
+
+func (x *GetAvailableMonthsYearsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableMonthsYearsResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailableMonthsYearsResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetAvailableMonthsYearsResponse) GetAvailableYears() []int32 {
+	if x != nil {
+		return x.AvailableYears
+	}
+	return nil
+}
+
+func (x *GetAvailableMonthsYearsResponse) GetAvailableMonths() []*MonthOption {
+	if x != nil {
+		return x.AvailableMonths
+	}
+	return nil
+}
+
+type DayToDayExpense struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           string                 `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	DayName       string                 `protobuf:"bytes,2,opt,name=day_name,json=dayName,proto3" json:"day_name,omitempty"`
+	Month         string                 `protobuf:"bytes,3,opt,name=month,proto3" json:"month,omitempty"`
+	Year          string                 `protobuf:"bytes,4,opt,name=year,proto3" json:"year,omitempty"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DayToDayExpense) Reset() {
+	*x = DayToDayExpense{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DayToDayExpense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DayToDayExpense) ProtoMessage() {}
+
+func (x *DayToDayExpense) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DayToDayExpense.ProtoReflect.Descriptor instead.
+func (*DayToDayExpense) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DayToDayExpense) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *DayToDayExpense) GetDayName() string {
+	if x != nil {
+		return x.DayName
+	}
+	return ""
+}
+
+func (x *DayToDayExpense) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *DayToDayExpense) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *DayToDayExpense) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type GetDayToDayExpensesPeriodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expenses      []*DayToDayExpense     `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDayToDayExpensesPeriodResponse) Reset() {
+	*x = GetDayToDayExpensesPeriodResponse{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDayToDayExpensesPeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDayToDayExpensesPeriodResponse) ProtoMessage() {}
+
+func (x *GetDayToDayExpensesPeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDayToDayExpensesPeriodResponse.ProtoReflect.Descriptor instead.
+func (*GetDayToDayExpensesPeriodResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetDayToDayExpensesPeriodResponse) GetExpenses() []*DayToDayExpense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+type GetTopMerchantsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartDate string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Basis     string                 `protobuf:"bytes,3,opt,name=basis,proto3" json:"basis,omitempty"`
+	// Defaults to 10, at most 100.
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopMerchantsRequest) Reset() {
+	*x = GetTopMerchantsRequest{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopMerchantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopMerchantsRequest) ProtoMessage() {}
+
+func (x *GetTopMerchantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopMerchantsRequest.ProtoReflect.Descriptor instead.
+func (*GetTopMerchantsRequest) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetTopMerchantsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetTopMerchantsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetTopMerchantsRequest) GetBasis() string {
+	if x != nil {
+		return x.Basis
+	}
+	return ""
+}
+
+func (x *GetTopMerchantsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TopMerchant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    string                 `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	MerchantName  string                 `protobuf:"bytes,2,opt,name=merchant_name,json=merchantName,proto3" json:"merchant_name,omitempty"`
+	Total         float64                `protobuf:"fixed64,3,opt,name=total,proto3" json:"total,omitempty"`
+	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Average       float64                `protobuf:"fixed64,5,opt,name=average,proto3" json:"average,omitempty"`
+	SharePercent  float64                `protobuf:"fixed64,6,opt,name=share_percent,json=sharePercent,proto3" json:"share_percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopMerchant) Reset() {
+	*x = TopMerchant{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopMerchant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopMerchant) ProtoMessage() {}
+
+func (x *TopMerchant) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopMerchant.ProtoReflect.Descriptor instead.
+func (*TopMerchant) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TopMerchant) GetMerchantId() string {
+	if x != nil {
+		return x.MerchantId
+	}
+	return ""
+}
+
+func (x *TopMerchant) GetMerchantName() string {
+	if x != nil {
+		return x.MerchantName
+	}
+	return ""
+}
+
+func (x *TopMerchant) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TopMerchant) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TopMerchant) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *TopMerchant) GetSharePercent() float64 {
+	if x != nil {
+		return x.SharePercent
+	}
+	return 0
+}
+
+type GetTopMerchantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         float64                `protobuf:"fixed64,1,opt,name=total,proto3" json:"total,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Merchants     []*TopMerchant         `protobuf:"bytes,3,rep,name=merchants,proto3" json:"merchants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopMerchantsResponse) Reset() {
+	*x = GetTopMerchantsResponse{}
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopMerchantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopMerchantsResponse) ProtoMessage() {}
+
+func (x *GetTopMerchantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_presenters_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopMerchantsResponse.ProtoReflect.Descriptor instead.
+func (*GetTopMerchantsResponse) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_presenters_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetTopMerchantsResponse) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetTopMerchantsResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetTopMerchantsResponse) GetMerchants() []*TopMerchant {
+	if x != nil {
+		return x.Merchants
+	}
+	return nil
+}
+
+var File_expensetracker_v1_presenters_proto protoreflect.FileDescriptor
+
+const file_expensetracker_v1_presenters_proto_rawDesc = "" +
+	"\n" +
+	"\"expensetracker/v1/presenters.proto\x12\x11expensetracker.v1\"_\n" +
+	"\rPeriodRequest\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x14\n" +
+	"\x05basis\x18\x03 \x01(\tR\x05basis\"7\n" +
+	"\vYearRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05basis\x18\x02 \x01(\tR\x05basis\"<\n" +
+	"\x10MonthYearRequest\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\x05R\x05month\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\"9\n" +
+	"\vMonthOption\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"s\n" +
+	"\x0fCategoryExpense\x12#\n" +
+	"\rcategory_name\x18\x01 \x01(\tR\fcategoryName\x12%\n" +
+	"\x0ecategory_color\x18\x02 \x01(\tR\rcategoryColor\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x01R\x05total\"Z\n" +
+	"\n" +
+	"TagExpense\x12\x19\n" +
+	"\btag_name\x18\x01 \x01(\tR\atagName\x12\x1b\n" +
+	"\ttag_color\x18\x02 \x01(\tR\btagColor\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x01R\x05total\"9\n" +
+	"!GetTotalExpensesForPeriodResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x01R\x05total\"e\n" +
+	"#GetExpensesByCategoryPeriodResponse\x12>\n" +
+	"\bexpenses\x18\x01 \x03(\v2\".expensetracker.v1.CategoryExpenseR\bexpenses\"\xbf\x01\n" +
+	"\x16MonthlyCategoryExpense\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12!\n" +
+	"\fmonth_number\x18\x02 \x01(\x05R\vmonthNumber\x12\x12\n" +
+	"\x04year\x18\x03 \x01(\x05R\x04year\x12B\n" +
+	"\n" +
+	"categories\x18\x04 \x03(\v2\".expensetracker.v1.CategoryExpenseR\n" +
+	"categories\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x01R\x05total\"\x9a\x01\n" +
+	"(GetMonthlyExpensesByCategoryYearResponse\x12E\n" +
+	"\bexpenses\x18\x01 \x03(\v2).expensetracker.v1.MonthlyCategoryExpenseR\bexpenses\x12'\n" +
+	"\x0favailable_years\x18\x02 \x03(\x05R\x0eavailableYears\"\xa9\x01\n" +
+	"\x11MonthlyTagExpense\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12!\n" +
+	"\fmonth_number\x18\x02 \x01(\x05R\vmonthNumber\x12\x12\n" +
+	"\x04year\x18\x03 \x01(\x05R\x04year\x121\n" +
+	"\x04tags\x18\x04 \x03(\v2\x1d.expensetracker.v1.TagExpenseR\x04tags\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x01R\x05total\"\x90\x01\n" +
+	"#GetMonthlyExpensesByTagYearResponse\x12@\n" +
+	"\bexpenses\x18\x01 \x03(\v2$.expensetracker.v1.MonthlyTagExpenseR\bexpenses\x12'\n" +
+	"\x0favailable_years\x18\x02 \x03(\x05R\x0eavailableYears\"(\n" +
+	"&GetTotalExpensesForCurrentMonthRequest\"u\n" +
+	"'GetTotalExpensesForCurrentMonthResponse\x12%\n" +
+	"\x0etotal_expenses\x18\x01 \x01(\x01R\rtotalExpenses\x12#\n" +
+	"\rcurrent_month\x18\x02 \x01(\tR\fcurrentMonth\"A\n" +
+	"%GetTotalExpensesForCurrentWeekRequest\x12\x18\n" +
+	"\acompare\x18\x01 \x01(\bR\acompare\"\xab\x02\n" +
+	"&GetTotalExpensesForCurrentWeekResponse\x12%\n" +
+	"\x0etotal_expenses\x18\x01 \x01(\x01R\rtotalExpenses\x12!\n" +
+	"\fcurrent_week\x18\x02 \x01(\tR\vcurrentWeek\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\x03 \x01(\tR\tweekStart\x123\n" +
+	"\x13previous_week_total\x18\x04 \x01(\x01H\x00R\x11previousWeekTotal\x88\x01\x01\x12#\n" +
+	"\rprevious_week\x18\x05 \x01(\tR\fpreviousWeek\x12\x1b\n" +
+	"\x06change\x18\x06 \x01(\x01H\x01R\x06change\x88\x01\x01B\x16\n" +
+	"\x14_previous_week_totalB\t\n" +
+	"\a_change\"[\n" +
+	"\n" +
+	"MonthTotal\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12!\n" +
+	"\fmonth_number\x18\x02 \x01(\x05R\vmonthNumber\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x01R\x05total\"\xb4\x01\n" +
+	"(GetTotalExpensesMonthCurrentYearResponse\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x01R\x05total\x125\n" +
+	"\x06months\x18\x03 \x03(\v2\x1d.expensetracker.v1.MonthTotalR\x06months\x12'\n" +
+	"\x0favailable_years\x18\x04 \x03(\x05R\x0eavailableYears\"[\n" +
+	"\x10CategoryTagTotal\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"tag_amount\x18\x02 \x01(\x01R\ttagAmount\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\"\x9e\x01\n" +
+	"\x10CategoryWithTags\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12'\n" +
+	"\x0fcategory_amount\x18\x02 \x01(\x01R\x0ecategoryAmount\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x127\n" +
+	"\x04tags\x18\x04 \x03(\v2#.expensetracker.v1.CategoryTagTotalR\x04tags\"\xd9\x02\n" +
+	"(GetCategoryTagsTotalsByMonthYearResponse\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12!\n" +
+	"\fmonth_number\x18\x02 \x01(\x05R\vmonthNumber\x12\x12\n" +
+	"\x04year\x18\x03 \x01(\x05R\x04year\x12'\n" +
+	"\x0fexpenses_amount\x18\x04 \x01(\x01R\x0eexpensesAmount\x12C\n" +
+	"\n" +
+	"categories\x18\x05 \x03(\v2#.expensetracker.v1.CategoryWithTagsR\n" +
+	"categories\x12'\n" +
+	"\x0favailable_years\x18\x06 \x03(\x05R\x0eavailableYears\x12I\n" +
+	"\x10available_months\x18\a \x03(\v2\x1e.expensetracker.v1.MonthOptionR\x0favailableMonths\" \n" +
+	"\x1eGetAvailableMonthsYearsRequest\"\x95\x01\n" +
+	"\x1fGetAvailableMonthsYearsResponse\x12'\n" +
+	"\x0favailable_years\x18\x01 \x03(\x05R\x0eavailableYears\x12I\n" +
+	"\x10available_months\x18\x02 \x03(\v2\x1e.expensetracker.v1.MonthOptionR\x0favailableMonths\"\x80\x01\n" +
+	"\x0fDayToDayExpense\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\tR\x03day\x12\x19\n" +
+	"\bday_name\x18\x02 \x01(\tR\adayName\x12\x14\n" +
+	"\x05month\x18\x03 \x01(\tR\x05month\x12\x12\n" +
+	"\x04year\x18\x04 \x01(\tR\x04year\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\"c\n" +
+	"!GetDayToDayExpensesPeriodResponse\x12>\n" +
+	"\bexpenses\x18\x01 \x03(\v2\".expensetracker.v1.DayToDayExpenseR\bexpenses\"~\n" +
+	"\x16GetTopMerchantsRequest\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x14\n" +
+	"\x05basis\x18\x03 \x01(\tR\x05basis\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xbe\x01\n" +
+	"\vTopMerchant\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\tR\n" +
+	"merchantId\x12#\n" +
+	"\rmerchant_name\x18\x02 \x01(\tR\fmerchantName\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x01R\x05total\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\x12\x18\n" +
+	"\aaverage\x18\x05 \x01(\x01R\aaverage\x12#\n" +
+	"\rshare_percent\x18\x06 \x01(\x01R\fsharePercent\"\x83\x01\n" +
+	"\x17GetTopMerchantsResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x01R\x05total\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12<\n" +
+	"\tmerchants\x18\x03 \x03(\v2\x1e.expensetracker.v1.TopMerchantR\tmerchants2\x96\v\n" +
+	"\x11PresentersService\x12s\n" +
+	"\x19GetTotalExpensesForPeriod\x12 .expensetracker.v1.PeriodRequest\x1a4.expensetracker.v1.GetTotalExpensesForPeriodResponse\x12w\n" +
+	"\x1bGetExpensesByCategoryPeriod\x12 .expensetracker.v1.PeriodRequest\x1a6.expensetracker.v1.GetExpensesByCategoryPeriodResponse\x12\x7f\n" +
+	" GetMonthlyExpensesByCategoryYear\x12\x1e.expensetracker.v1.YearRequest\x1a;.expensetracker.v1.GetMonthlyExpensesByCategoryYearResponse\x12u\n" +
+	"\x1bGetMonthlyExpensesByTagYear\x12\x1e.expensetracker.v1.YearRequest\x1a6.expensetracker.v1.GetMonthlyExpensesByTagYearResponse\x12\x98\x01\n" +
+	"\x1fGetTotalExpensesForCurrentMonth\x129.expensetracker.v1.GetTotalExpensesForCurrentMonthRequest\x1a:.expensetracker.v1.GetTotalExpensesForCurrentMonthResponse\x12\x95\x01\n" +
+	"\x1eGetTotalExpensesForCurrentWeek\x128.expensetracker.v1.GetTotalExpensesForCurrentWeekRequest\x1a9.expensetracker.v1.GetTotalExpensesForCurrentWeekResponse\x12\x7f\n" +
+	" GetTotalExpensesMonthCurrentYear\x12\x1e.expensetracker.v1.YearRequest\x1a;.expensetracker.v1.GetTotalExpensesMonthCurrentYearResponse\x12\x84\x01\n" +
+	" GetCategoryTagsTotalsByMonthYear\x12#.expensetracker.v1.MonthYearRequest\x1a;.expensetracker.v1.GetCategoryTagsTotalsByMonthYearResponse\x12\x80\x01\n" +
+	"\x17GetAvailableMonthsYears\x121.expensetracker.v1.GetAvailableMonthsYearsRequest\x1a2.expensetracker.v1.GetAvailableMonthsYearsResponse\x12s\n" +
+	"\x19GetDayToDayExpensesPeriod\x12 .expensetracker.v1.PeriodRequest\x1a4.expensetracker.v1.GetDayToDayExpensesPeriodResponse\x12h\n" +
+	"\x0fGetTopMerchants\x12).expensetracker.v1.GetTopMerchantsRequest\x1a*.expensetracker.v1.GetTopMerchantsResponseB_Z]github.com/GuilhermeDeOliveiraAmorim/expense-tracker/proto/expensetracker/v1;expensetrackerv1b\x06proto3"
+
+var (
+	file_expensetracker_v1_presenters_proto_rawDescOnce sync.Once
+	file_expensetracker_v1_presenters_proto_rawDescData []byte
+)
+
+func file_expensetracker_v1_presenters_proto_rawDescGZIP() []byte {
+	file_expensetracker_v1_presenters_proto_rawDescOnce.Do(func() {
+		file_expensetracker_v1_presenters_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_expensetracker_v1_presenters_proto_rawDesc), len(file_expensetracker_v1_presenters_proto_rawDesc)))
+	})
+	return file_expensetracker_v1_presenters_proto_rawDescData
+}
+
+var file_expensetracker_v1_presenters_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_expensetracker_v1_presenters_proto_goTypes = []any{
+	(*PeriodRequest)(nil),                            // 0: expensetracker.v1.PeriodRequest
+	(*YearRequest)(nil),                              // 1: expensetracker.v1.YearRequest
+	(*MonthYearRequest)(nil),                         // 2: expensetracker.v1.MonthYearRequest
+	(*MonthOption)(nil),                              // 3: expensetracker.v1.MonthOption
+	(*CategoryExpense)(nil),                          // 4: expensetracker.v1.CategoryExpense
+	(*TagExpense)(nil),                               // 5: expensetracker.v1.TagExpense
+	(*GetTotalExpensesForPeriodResponse)(nil),        // 6: expensetracker.v1.GetTotalExpensesForPeriodResponse
+	(*GetExpensesByCategoryPeriodResponse)(nil),      // 7: expensetracker.v1.GetExpensesByCategoryPeriodResponse
+	(*MonthlyCategoryExpense)(nil),                   // 8: expensetracker.v1.MonthlyCategoryExpense
+	(*GetMonthlyExpensesByCategoryYearResponse)(nil), // 9: expensetracker.v1.GetMonthlyExpensesByCategoryYearResponse
+	(*MonthlyTagExpense)(nil),                        // 10: expensetracker.v1.MonthlyTagExpense
+	(*GetMonthlyExpensesByTagYearResponse)(nil),      // 11: expensetracker.v1.GetMonthlyExpensesByTagYearResponse
+	(*GetTotalExpensesForCurrentMonthRequest)(nil),   // 12: expensetracker.v1.GetTotalExpensesForCurrentMonthRequest
+	(*GetTotalExpensesForCurrentMonthResponse)(nil),  // 13: expensetracker.v1.GetTotalExpensesForCurrentMonthResponse
+	(*GetTotalExpensesForCurrentWeekRequest)(nil),    // 14: expensetracker.v1.GetTotalExpensesForCurrentWeekRequest
+	(*GetTotalExpensesForCurrentWeekResponse)(nil),   // 15: expensetracker.v1.GetTotalExpensesForCurrentWeekResponse
+	(*MonthTotal)(nil),                               // 16: expensetracker.v1.MonthTotal
+	(*GetTotalExpensesMonthCurrentYearResponse)(nil), // 17: expensetracker.v1.GetTotalExpensesMonthCurrentYearResponse
+	(*CategoryTagTotal)(nil),                         // 18: expensetracker.v1.CategoryTagTotal
+	(*CategoryWithTags)(nil),                         // 19: expensetracker.v1.CategoryWithTags
+	(*GetCategoryTagsTotalsByMonthYearResponse)(nil), // 20: expensetracker.v1.GetCategoryTagsTotalsByMonthYearResponse
+	(*GetAvailableMonthsYearsRequest)(nil),           // 21: expensetracker.v1.GetAvailableMonthsYearsRequest
+	(*GetAvailableMonthsYearsResponse)(nil),          // 22: expensetracker.v1.GetAvailableMonthsYearsResponse
+	(*DayToDayExpense)(nil),                          // 23: expensetracker.v1.DayToDayExpense
+	(*GetDayToDayExpensesPeriodResponse)(nil),        // 24: expensetracker.v1.GetDayToDayExpensesPeriodResponse
+	(*GetTopMerchantsRequest)(nil),                   // 25: expensetracker.v1.GetTopMerchantsRequest
+	(*TopMerchant)(nil),                              // 26: expensetracker.v1.TopMerchant
+	(*GetTopMerchantsResponse)(nil),                  // 27: expensetracker.v1.GetTopMerchantsResponse
+}
+var file_expensetracker_v1_presenters_proto_depIdxs = []int32{
+	4,  // 0: expensetracker.v1.GetExpensesByCategoryPeriodResponse.expenses:type_name -> expensetracker.v1.CategoryExpense
+	4,  // 1: expensetracker.v1.MonthlyCategoryExpense.categories:type_name -> expensetracker.v1.CategoryExpense
+	8,  // 2: expensetracker.v1.GetMonthlyExpensesByCategoryYearResponse.expenses:type_name -> expensetracker.v1.MonthlyCategoryExpense
+	5,  // 3: expensetracker.v1.MonthlyTagExpense.tags:type_name -> expensetracker.v1.TagExpense
+	10, // 4: expensetracker.v1.GetMonthlyExpensesByTagYearResponse.expenses:type_name -> expensetracker.v1.MonthlyTagExpense
+	16, // 5: expensetracker.v1.GetTotalExpensesMonthCurrentYearResponse.months:type_name -> expensetracker.v1.MonthTotal
+	18, // 6: expensetracker.v1.CategoryWithTags.tags:type_name -> expensetracker.v1.CategoryTagTotal
+	19, // 7: expensetracker.v1.GetCategoryTagsTotalsByMonthYearResponse.categories:type_name -> expensetracker.v1.CategoryWithTags
+	3,  // 8: expensetracker.v1.GetCategoryTagsTotalsByMonthYearResponse.available_months:type_name -> expensetracker.v1.MonthOption
+	3,  // 9: expensetracker.v1.GetAvailableMonthsYearsResponse.available_months:type_name -> expensetracker.v1.MonthOption
+	23, // 10: expensetracker.v1.GetDayToDayExpensesPeriodResponse.expenses:type_name -> expensetracker.v1.DayToDayExpense
+	26, // 11: expensetracker.v1.GetTopMerchantsResponse.merchants:type_name -> expensetracker.v1.TopMerchant
+	0,  // 12: expensetracker.v1.PresentersService.GetTotalExpensesForPeriod:input_type -> expensetracker.v1.PeriodRequest
+	0,  // 13: expensetracker.v1.PresentersService.GetExpensesByCategoryPeriod:input_type -> expensetracker.v1.PeriodRequest
+	1,  // 14: expensetracker.v1.PresentersService.GetMonthlyExpensesByCategoryYear:input_type -> expensetracker.v1.YearRequest
+	1,  // 15: expensetracker.v1.PresentersService.GetMonthlyExpensesByTagYear:input_type -> expensetracker.v1.YearRequest
+	12, // 16: expensetracker.v1.PresentersService.GetTotalExpensesForCurrentMonth:input_type -> expensetracker.v1.GetTotalExpensesForCurrentMonthRequest
+	14, // 17: expensetracker.v1.PresentersService.GetTotalExpensesForCurrentWeek:input_type -> expensetracker.v1.GetTotalExpensesForCurrentWeekRequest
+	1,  // 18: expensetracker.v1.PresentersService.GetTotalExpensesMonthCurrentYear:input_type -> expensetracker.v1.YearRequest
+	2,  // 19: expensetracker.v1.PresentersService.GetCategoryTagsTotalsByMonthYear:input_type -> expensetracker.v1.MonthYearRequest
+	21, // 20: expensetracker.v1.PresentersService.GetAvailableMonthsYears:input_type -> expensetracker.v1.GetAvailableMonthsYearsRequest
+	0,  // 21: expensetracker.v1.PresentersService.GetDayToDayExpensesPeriod:input_type -> expensetracker.v1.PeriodRequest
+	25, // 22: expensetracker.v1.PresentersService.GetTopMerchants:input_type -> expensetracker.v1.GetTopMerchantsRequest
+	6,  // 23: expensetracker.v1.PresentersService.GetTotalExpensesForPeriod:output_type -> expensetracker.v1.GetTotalExpensesForPeriodResponse
+	7,  // 24: expensetracker.v1.PresentersService.GetExpensesByCategoryPeriod:output_type -> expensetracker.v1.GetExpensesByCategoryPeriodResponse
+	9,  // 25: expensetracker.v1.PresentersService.GetMonthlyExpensesByCategoryYear:output_type -> expensetracker.v1.GetMonthlyExpensesByCategoryYearResponse
+	11, // 26: expensetracker.v1.PresentersService.GetMonthlyExpensesByTagYear:output_type -> expensetracker.v1.GetMonthlyExpensesByTagYearResponse
+	13, // 27: expensetracker.v1.PresentersService.GetTotalExpensesForCurrentMonth:output_type -> expensetracker.v1.GetTotalExpensesForCurrentMonthResponse
+	15, // 28: expensetracker.v1.PresentersService.GetTotalExpensesForCurrentWeek:output_type -> expensetracker.v1.GetTotalExpensesForCurrentWeekResponse
+	17, // 29: expensetracker.v1.PresentersService.GetTotalExpensesMonthCurrentYear:output_type -> expensetracker.v1.GetTotalExpensesMonthCurrentYearResponse
+	20, // 30: expensetracker.v1.PresentersService.GetCategoryTagsTotalsByMonthYear:output_type -> expensetracker.v1.GetCategoryTagsTotalsByMonthYearResponse
+	22, // 31: expensetracker.v1.PresentersService.GetAvailableMonthsYears:output_type -> expensetracker.v1.GetAvailableMonthsYearsResponse
+	24, // 32: expensetracker.v1.PresentersService.GetDayToDayExpensesPeriod:output_type -> expensetracker.v1.GetDayToDayExpensesPeriodResponse
+	27, // 33: expensetracker.v1.PresentersService.GetTopMerchants:output_type -> expensetracker.v1.GetTopMerchantsResponse
+	23, // [23:34] is the sub-list for method output_type
+	12, // [12:23] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_expensetracker_v1_presenters_proto_init() }
+func file_expensetracker_v1_presenters_proto_init() {
+	if File_expensetracker_v1_presenters_proto != nil {
+		return
+	}
+	file_expensetracker_v1_presenters_proto_msgTypes[15].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_expensetracker_v1_presenters_proto_rawDesc), len(file_expensetracker_v1_presenters_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   28,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_expensetracker_v1_presenters_proto_goTypes,
+		DependencyIndexes: file_expensetracker_v1_presenters_proto_depIdxs,
+		MessageInfos:      file_expensetracker_v1_presenters_proto_msgTypes,
+	}.Build()
+	File_expensetracker_v1_presenters_proto = out.File
+	file_expensetracker_v1_presenters_proto_goTypes = nil
+	file_expensetracker_v1_presenters_proto_depIdxs = nil
+}
@@ -0,0 +1,509 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: expensetracker/v1/presenters.proto
+
+package expensetrackerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PresentersService_GetTotalExpensesForPeriod_FullMethodName        = "/expensetracker.v1.PresentersService/GetTotalExpensesForPeriod"
+	PresentersService_GetExpensesByCategoryPeriod_FullMethodName      = "/expensetracker.v1.PresentersService/GetExpensesByCategoryPeriod"
+	PresentersService_GetMonthlyExpensesByCategoryYear_FullMethodName = "/expensetracker.v1.PresentersService/GetMonthlyExpensesByCategoryYear"
+	PresentersService_GetMonthlyExpensesByTagYear_FullMethodName      = "/expensetracker.v1.PresentersService/GetMonthlyExpensesByTagYear"
+	PresentersService_GetTotalExpensesForCurrentMonth_FullMethodName  = "/expensetracker.v1.PresentersService/GetTotalExpensesForCurrentMonth"
+	PresentersService_GetTotalExpensesForCurrentWeek_FullMethodName   = "/expensetracker.v1.PresentersService/GetTotalExpensesForCurrentWeek"
+	PresentersService_GetTotalExpensesMonthCurrentYear_FullMethodName = "/expensetracker.v1.PresentersService/GetTotalExpensesMonthCurrentYear"
+	PresentersService_GetCategoryTagsTotalsByMonthYear_FullMethodName = "/expensetracker.v1.PresentersService/GetCategoryTagsTotalsByMonthYear"
+	PresentersService_GetAvailableMonthsYears_FullMethodName          = "/expensetracker.v1.PresentersService/GetAvailableMonthsYears"
+	PresentersService_GetDayToDayExpensesPeriod_FullMethodName        = "/expensetracker.v1.PresentersService/GetDayToDayExpensesPeriod"
+	PresentersService_GetTopMerchants_FullMethodName                  = "/expensetracker.v1.PresentersService/GetTopMerchants"
+)
+
+// PresentersServiceClient is the client API for PresentersService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PresentersService serves the report aggregates. Dates are DDMMYYYY in the
+// user's timezone. Basis is "purchase" (the default) or "statement". Month and
+// year names are localized from the accept-language metadata.
+type PresentersServiceClient interface {
+	GetTotalExpensesForPeriod(ctx context.Context, in *PeriodRequest, opts ...grpc.CallOption) (*GetTotalExpensesForPeriodResponse, error)
+	GetExpensesByCategoryPeriod(ctx context.Context, in *PeriodRequest, opts ...grpc.CallOption) (*GetExpensesByCategoryPeriodResponse, error)
+	GetMonthlyExpensesByCategoryYear(ctx context.Context, in *YearRequest, opts ...grpc.CallOption) (*GetMonthlyExpensesByCategoryYearResponse, error)
+	GetMonthlyExpensesByTagYear(ctx context.Context, in *YearRequest, opts ...grpc.CallOption) (*GetMonthlyExpensesByTagYearResponse, error)
+	GetTotalExpensesForCurrentMonth(ctx context.Context, in *GetTotalExpensesForCurrentMonthRequest, opts ...grpc.CallOption) (*GetTotalExpensesForCurrentMonthResponse, error)
+	GetTotalExpensesForCurrentWeek(ctx context.Context, in *GetTotalExpensesForCurrentWeekRequest, opts ...grpc.CallOption) (*GetTotalExpensesForCurrentWeekResponse, error)
+	GetTotalExpensesMonthCurrentYear(ctx context.Context, in *YearRequest, opts ...grpc.CallOption) (*GetTotalExpensesMonthCurrentYearResponse, error)
+	GetCategoryTagsTotalsByMonthYear(ctx context.Context, in *MonthYearRequest, opts ...grpc.CallOption) (*GetCategoryTagsTotalsByMonthYearResponse, error)
+	GetAvailableMonthsYears(ctx context.Context, in *GetAvailableMonthsYearsRequest, opts ...grpc.CallOption) (*GetAvailableMonthsYearsResponse, error)
+	GetDayToDayExpensesPeriod(ctx context.Context, in *PeriodRequest, opts ...grpc.CallOption) (*GetDayToDayExpensesPeriodResponse, error)
+	GetTopMerchants(ctx context.Context, in *GetTopMerchantsRequest, opts ...grpc.CallOption) (*GetTopMerchantsResponse, error)
+}
+
+type presentersServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPresentersServiceClient(cc grpc.ClientConnInterface) PresentersServiceClient {
+	return &presentersServiceClient{cc}
+}
+
+func (c *presentersServiceClient) GetTotalExpensesForPeriod(ctx context.Context, in *PeriodRequest, opts ...grpc.CallOption) (*GetTotalExpensesForPeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTotalExpensesForPeriodResponse)
+	err := c.cc.Invoke(ctx, PresentersService_GetTotalExpensesForPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *presentersServiceClient) GetExpensesByCategoryPeriod(ctx context.Context, in *PeriodRequest, opts ...grpc.CallOption) (*GetExpensesByCategoryPeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExpensesByCategoryPeriodResponse)
+	err := c.cc.Invoke(ctx, PresentersService_GetExpensesByCategoryPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *presentersServiceClient) GetMonthlyExpensesByCategoryYear(ctx context.Context, in *YearRequest, opts ...grpc.CallOption) (*GetMonthlyExpensesByCategoryYearResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMonthlyExpensesByCategoryYearResponse)
+	err := c.cc.Invoke(ctx, PresentersService_GetMonthlyExpensesByCategoryYear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *presentersServiceClient) GetMonthlyExpensesByTagYear(ctx context.Context, in *YearRequest, opts ...grpc.CallOption) (*GetMonthlyExpensesByTagYearResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMonthlyExpensesByTagYearResponse)
+	err := c.cc.Invoke(ctx, PresentersService_GetMonthlyExpensesByTagYear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *presentersServiceClient) GetTotalExpensesForCurrentMonth(ctx context.Context, in *GetTotalExpensesForCurrentMonthRequest, opts ...grpc.CallOption) (*GetTotalExpensesForCurrentMonthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTotalExpensesForCurrentMonthResponse)
+	err := c.cc.Invoke(ctx, PresentersService_GetTotalExpensesForCurrentMonth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *presentersServiceClient) GetTotalExpensesForCurrentWeek(ctx context.Context, in *GetTotalExpensesForCurrentWeekRequest, opts ...grpc.CallOption) (*GetTotalExpensesForCurrentWeekResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTotalExpensesForCurrentWeekResponse)
+	err := c.cc.Invoke(ctx, PresentersService_GetTotalExpensesForCurrentWeek_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *presentersServiceClient) GetTotalExpensesMonthCurrentYear(ctx context.Context, in *YearRequest, opts ...grpc.CallOption) (*GetTotalExpensesMonthCurrentYearResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTotalExpensesMonthCurrentYearResponse)
+	err := c.cc.Invoke(ctx, PresentersService_GetTotalExpensesMonthCurrentYear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *presentersServiceClient) GetCategoryTagsTotalsByMonthYear(ctx context.Context, in *MonthYearRequest, opts ...grpc.CallOption) (*GetCategoryTagsTotalsByMonthYearResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryTagsTotalsByMonthYearResponse)
+	err := c.cc.Invoke(ctx, PresentersService_GetCategoryTagsTotalsByMonthYear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *presentersServiceClient) GetAvailableMonthsYears(ctx context.Context, in *GetAvailableMonthsYearsRequest, opts ...grpc.CallOption) (*GetAvailableMonthsYearsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAvailableMonthsYearsResponse)
+	err := c.cc.Invoke(ctx, PresentersService_GetAvailableMonthsYears_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *presentersServiceClient) GetDayToDayExpensesPeriod(ctx context.Context, in *PeriodRequest, opts ...grpc.CallOption) (*GetDayToDayExpensesPeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDayToDayExpensesPeriodResponse)
+	err := c.cc.Invoke(ctx, PresentersService_GetDayToDayExpensesPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *presentersServiceClient) GetTopMerchants(ctx context.Context, in *GetTopMerchantsRequest, opts ...grpc.CallOption) (*GetTopMerchantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopMerchantsResponse)
+	err := c.cc.Invoke(ctx, PresentersService_GetTopMerchants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PresentersServiceServer is the server API for PresentersService service.
+// All implementations must embed UnimplementedPresentersServiceServer
+// for forward compatibility.
+//
+// PresentersService serves the report aggregates. Dates are DDMMYYYY in the
+// user's timezone. Basis is "purchase" (the default) or "statement". Month and
+// year names are localized from the accept-language metadata.
+type PresentersServiceServer interface {
+	GetTotalExpensesForPeriod(context.Context, *PeriodRequest) (*GetTotalExpensesForPeriodResponse, error)
+	GetExpensesByCategoryPeriod(context.Context, *PeriodRequest) (*GetExpensesByCategoryPeriodResponse, error)
+	GetMonthlyExpensesByCategoryYear(context.Context, *YearRequest) (*GetMonthlyExpensesByCategoryYearResponse, error)
+	GetMonthlyExpensesByTagYear(context.Context, *YearRequest) (*GetMonthlyExpensesByTagYearResponse, error)
+	GetTotalExpensesForCurrentMonth(context.Context, *GetTotalExpensesForCurrentMonthRequest) (*GetTotalExpensesForCurrentMonthResponse, error)
+	GetTotalExpensesForCurrentWeek(context.Context, *GetTotalExpensesForCurrentWeekRequest) (*GetTotalExpensesForCurrentWeekResponse, error)
+	GetTotalExpensesMonthCurrentYear(context.Context, *YearRequest) (*GetTotalExpensesMonthCurrentYearResponse, error)
+	GetCategoryTagsTotalsByMonthYear(context.Context, *MonthYearRequest) (*GetCategoryTagsTotalsByMonthYearResponse, error)
+	GetAvailableMonthsYears(context.Context, *GetAvailableMonthsYearsRequest) (*GetAvailableMonthsYearsResponse, error)
+	GetDayToDayExpensesPeriod(context.Context, *PeriodRequest) (*GetDayToDayExpensesPeriodResponse, error)
+	GetTopMerchants(context.Context, *GetTopMerchantsRequest) (*GetTopMerchantsResponse, error)
+	mustEmbedUnimplementedPresentersServiceServer()
+}
+
+// UnimplementedPresentersServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPresentersServiceServer struct{}
+
+func (UnimplementedPresentersServiceServer) GetTotalExpensesForPeriod(context.Context, *PeriodRequest) (*GetTotalExpensesForPeriodResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTotalExpensesForPeriod not implemented")
+}
+func (UnimplementedPresentersServiceServer) GetExpensesByCategoryPeriod(context.Context, *PeriodRequest) (*GetExpensesByCategoryPeriodResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExpensesByCategoryPeriod not implemented")
+}
+func (UnimplementedPresentersServiceServer) GetMonthlyExpensesByCategoryYear(context.Context, *YearRequest) (*GetMonthlyExpensesByCategoryYearResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMonthlyExpensesByCategoryYear not implemented")
+}
+func (UnimplementedPresentersServiceServer) GetMonthlyExpensesByTagYear(context.Context, *YearRequest) (*GetMonthlyExpensesByTagYearResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMonthlyExpensesByTagYear not implemented")
+}
+func (UnimplementedPresentersServiceServer) GetTotalExpensesForCurrentMonth(context.Context, *GetTotalExpensesForCurrentMonthRequest) (*GetTotalExpensesForCurrentMonthResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTotalExpensesForCurrentMonth not implemented")
+}
+func (UnimplementedPresentersServiceServer) GetTotalExpensesForCurrentWeek(context.Context, *GetTotalExpensesForCurrentWeekRequest) (*GetTotalExpensesForCurrentWeekResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTotalExpensesForCurrentWeek not implemented")
+}
+func (UnimplementedPresentersServiceServer) GetTotalExpensesMonthCurrentYear(context.Context, *YearRequest) (*GetTotalExpensesMonthCurrentYearResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTotalExpensesMonthCurrentYear not implemented")
+}
+func (UnimplementedPresentersServiceServer) GetCategoryTagsTotalsByMonthYear(context.Context, *MonthYearRequest) (*GetCategoryTagsTotalsByMonthYearResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCategoryTagsTotalsByMonthYear not implemented")
+}
+func (UnimplementedPresentersServiceServer) GetAvailableMonthsYears(context.Context, *GetAvailableMonthsYearsRequest) (*GetAvailableMonthsYearsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAvailableMonthsYears not implemented")
+}
+func (UnimplementedPresentersServiceServer) GetDayToDayExpensesPeriod(context.Context, *PeriodRequest) (*GetDayToDayExpensesPeriodResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDayToDayExpensesPeriod not implemented")
+}
+func (UnimplementedPresentersServiceServer) GetTopMerchants(context.Context, *GetTopMerchantsRequest) (*GetTopMerchantsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTopMerchants not implemented")
+}
+func (UnimplementedPresentersServiceServer) mustEmbedUnimplementedPresentersServiceServer() {}
+func (UnimplementedPresentersServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafePresentersServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PresentersServiceServer will
+// result in compilation errors.
+type UnsafePresentersServiceServer interface {
+	mustEmbedUnimplementedPresentersServiceServer()
+}
+
+func RegisterPresentersServiceServer(s grpc.ServiceRegistrar, srv PresentersServiceServer) {
+	// If the following call panics, it indicates UnimplementedPresentersServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PresentersService_ServiceDesc, srv)
+}
+
+func _PresentersService_GetTotalExpensesForPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresentersServiceServer).GetTotalExpensesForPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PresentersService_GetTotalExpensesForPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresentersServiceServer).GetTotalExpensesForPeriod(ctx, req.(*PeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PresentersService_GetExpensesByCategoryPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresentersServiceServer).GetExpensesByCategoryPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PresentersService_GetExpensesByCategoryPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresentersServiceServer).GetExpensesByCategoryPeriod(ctx, req.(*PeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PresentersService_GetMonthlyExpensesByCategoryYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(YearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresentersServiceServer).GetMonthlyExpensesByCategoryYear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PresentersService_GetMonthlyExpensesByCategoryYear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresentersServiceServer).GetMonthlyExpensesByCategoryYear(ctx, req.(*YearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PresentersService_GetMonthlyExpensesByTagYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(YearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresentersServiceServer).GetMonthlyExpensesByTagYear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PresentersService_GetMonthlyExpensesByTagYear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresentersServiceServer).GetMonthlyExpensesByTagYear(ctx, req.(*YearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PresentersService_GetTotalExpensesForCurrentMonth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTotalExpensesForCurrentMonthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresentersServiceServer).GetTotalExpensesForCurrentMonth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PresentersService_GetTotalExpensesForCurrentMonth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresentersServiceServer).GetTotalExpensesForCurrentMonth(ctx, req.(*GetTotalExpensesForCurrentMonthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PresentersService_GetTotalExpensesForCurrentWeek_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTotalExpensesForCurrentWeekRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresentersServiceServer).GetTotalExpensesForCurrentWeek(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PresentersService_GetTotalExpensesForCurrentWeek_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresentersServiceServer).GetTotalExpensesForCurrentWeek(ctx, req.(*GetTotalExpensesForCurrentWeekRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PresentersService_GetTotalExpensesMonthCurrentYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(YearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresentersServiceServer).GetTotalExpensesMonthCurrentYear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PresentersService_GetTotalExpensesMonthCurrentYear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresentersServiceServer).GetTotalExpensesMonthCurrentYear(ctx, req.(*YearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PresentersService_GetCategoryTagsTotalsByMonthYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MonthYearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresentersServiceServer).GetCategoryTagsTotalsByMonthYear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PresentersService_GetCategoryTagsTotalsByMonthYear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresentersServiceServer).GetCategoryTagsTotalsByMonthYear(ctx, req.(*MonthYearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PresentersService_GetAvailableMonthsYears_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailableMonthsYearsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresentersServiceServer).GetAvailableMonthsYears(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PresentersService_GetAvailableMonthsYears_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresentersServiceServer).GetAvailableMonthsYears(ctx, req.(*GetAvailableMonthsYearsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PresentersService_GetDayToDayExpensesPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresentersServiceServer).GetDayToDayExpensesPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PresentersService_GetDayToDayExpensesPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresentersServiceServer).GetDayToDayExpensesPeriod(ctx, req.(*PeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PresentersService_GetTopMerchants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopMerchantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresentersServiceServer).GetTopMerchants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PresentersService_GetTopMerchants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresentersServiceServer).GetTopMerchants(ctx, req.(*GetTopMerchantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PresentersService_ServiceDesc is the grpc.ServiceDesc for PresentersService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PresentersService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "expensetracker.v1.PresentersService",
+	HandlerType: (*PresentersServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTotalExpensesForPeriod",
+			Handler:    _PresentersService_GetTotalExpensesForPeriod_Handler,
+		},
+		{
+			MethodName: "GetExpensesByCategoryPeriod",
+			Handler:    _PresentersService_GetExpensesByCategoryPeriod_Handler,
+		},
+		{
+			MethodName: "GetMonthlyExpensesByCategoryYear",
+			Handler:    _PresentersService_GetMonthlyExpensesByCategoryYear_Handler,
+		},
+		{
+			MethodName: "GetMonthlyExpensesByTagYear",
+			Handler:    _PresentersService_GetMonthlyExpensesByTagYear_Handler,
+		},
+		{
+			MethodName: "GetTotalExpensesForCurrentMonth",
+			Handler:    _PresentersService_GetTotalExpensesForCurrentMonth_Handler,
+		},
+		{
+			MethodName: "GetTotalExpensesForCurrentWeek",
+			Handler:    _PresentersService_GetTotalExpensesForCurrentWeek_Handler,
+		},
+		{
+			MethodName: "GetTotalExpensesMonthCurrentYear",
+			Handler:    _PresentersService_GetTotalExpensesMonthCurrentYear_Handler,
+		},
+		{
+			MethodName: "GetCategoryTagsTotalsByMonthYear",
+			Handler:    _PresentersService_GetCategoryTagsTotalsByMonthYear_Handler,
+		},
+		{
+			MethodName: "GetAvailableMonthsYears",
+			Handler:    _PresentersService_GetAvailableMonthsYears_Handler,
+		},
+		{
+			MethodName: "GetDayToDayExpensesPeriod",
+			Handler:    _PresentersService_GetDayToDayExpensesPeriod_Handler,
+		},
+		{
+			MethodName: "GetTopMerchants",
+			Handler:    _PresentersService_GetTopMerchants_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "expensetracker/v1/presenters.proto",
+}
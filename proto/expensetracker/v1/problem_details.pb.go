@@ -0,0 +1,162 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: expensetracker/v1/problem_details.proto
+
+package expensetrackerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ProblemDetails is packed in the details of the google.rpc.Status of every
+// failed call, as "type.googleapis.com/expensetracker.v1.ProblemDetails". It
+// carries the same fields the REST API returns under "error", localized from
+// the accept-language metadata.
+type ProblemDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	Detail        string                 `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	Instance      string                 `protobuf:"bytes,5,opt,name=instance,proto3" json:"instance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProblemDetails) Reset() {
+	*x = ProblemDetails{}
+	mi := &file_expensetracker_v1_problem_details_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProblemDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProblemDetails) ProtoMessage() {}
+
+func (x *ProblemDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_expensetracker_v1_problem_details_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProblemDetails.ProtoReflect.Descriptor instead.
+func (*ProblemDetails) Descriptor() ([]byte, []int) {
+	return file_expensetracker_v1_problem_details_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProblemDetails) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProblemDetails) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ProblemDetails) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ProblemDetails) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *ProblemDetails) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+var File_expensetracker_v1_problem_details_proto protoreflect.FileDescriptor
+
+const file_expensetracker_v1_problem_details_proto_rawDesc = "" +
+	"\n" +
+	"'expensetracker/v1/problem_details.proto\x12\x11expensetracker.v1\"\x86\x01\n" +
+	"\x0eProblemDetails\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\x12\x1a\n" +
+	"\binstance\x18\x05 \x01(\tR\binstanceB_Z]github.com/GuilhermeDeOliveiraAmorim/expense-tracker/proto/expensetracker/v1;expensetrackerv1b\x06proto3"
+
+var (
+	file_expensetracker_v1_problem_details_proto_rawDescOnce sync.Once
+	file_expensetracker_v1_problem_details_proto_rawDescData []byte
+)
+
+func file_expensetracker_v1_problem_details_proto_rawDescGZIP() []byte {
+	file_expensetracker_v1_problem_details_proto_rawDescOnce.Do(func() {
+		file_expensetracker_v1_problem_details_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_expensetracker_v1_problem_details_proto_rawDesc), len(file_expensetracker_v1_problem_details_proto_rawDesc)))
+	})
+	return file_expensetracker_v1_problem_details_proto_rawDescData
+}
+
+var file_expensetracker_v1_problem_details_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_expensetracker_v1_problem_details_proto_goTypes = []any{
+	(*ProblemDetails)(nil), // 0: expensetracker.v1.ProblemDetails
+}
+var file_expensetracker_v1_problem_details_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_expensetracker_v1_problem_details_proto_init() }
+func file_expensetracker_v1_problem_details_proto_init() {
+	if File_expensetracker_v1_problem_details_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_expensetracker_v1_problem_details_proto_rawDesc), len(file_expensetracker_v1_problem_details_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_expensetracker_v1_problem_details_proto_goTypes,
+		DependencyIndexes: file_expensetracker_v1_problem_details_proto_depIdxs,
+		MessageInfos:      file_expensetracker_v1_problem_details_proto_msgTypes,
+	}.Build()
+	File_expensetracker_v1_problem_details_proto = out.File
+	file_expensetracker_v1_problem_details_proto_goTypes = nil
+	file_expensetracker_v1_problem_details_proto_depIdxs = nil
+}